@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"os"
@@ -12,6 +13,7 @@ import (
 	"bytes"
 
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/commands"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/metrics"
 	"github.com/Seklfreak/Robyul2/models"
@@ -23,14 +25,26 @@ import (
 )
 
 var (
-	didLaunch = false
+	didLaunch     = false
+	didLaunchLock sync.Mutex
 )
 
 func BotOnReady(session *discordgo.Session, event *discordgo.Ready) {
+	onShardReady(session, event)
+
+	didLaunchLock.Lock()
 	if !didLaunch {
-		OnFirstReady(session, event)
+		// modules are initialised once all shards are connected
+		if !cache.AllShardsReady() {
+			didLaunchLock.Unlock()
+			cache.GetLogger().WithField("module", "bot").Infof("Shard %d connected to discord, waiting for the other shards", session.ShardID)
+			return
+		}
 		didLaunch = true
+		didLaunchLock.Unlock()
+		OnFirstReady(cache.GetSession(), event)
 	} else {
+		didLaunchLock.Unlock()
 		OnReconnect(session, event)
 	}
 }
@@ -49,9 +63,6 @@ func OnFirstReady(session *discordgo.Session, event *discordgo.Ready) {
 		cache.AddAutoleaverGuildID(guild.ID)
 	}
 
-	// Cache the session
-	cache.SetSession(session)
-
 	// Load and init all modules
 	modules.Init(session)
 
@@ -68,7 +79,7 @@ func OnFirstReady(session *discordgo.Session, event *discordgo.Ready) {
 			}
 
 			//if guild.Large {
-			err := session.RequestGuildMembers(guild.ID, "", 0)
+			err := cache.GetSessionForGuild(guild.ID).RequestGuildMembers(guild.ID, "", 0)
 			if err != nil && strings.Contains(err.Error(), "no websocket connection exists") {
 				cache.GetLogger().WithField("module", "bot").Warn("OnFirstReady: no websocket connection exists, stopping Robyul")
 				BotRuntimeChannel <- os.Interrupt
@@ -85,9 +96,6 @@ func OnFirstReady(session *discordgo.Session, event *discordgo.Ready) {
 		}
 	}()
 
-	// Run ratelimiter
-	ratelimits.Container.Init()
-
 	go func() {
 		time.Sleep(3 * time.Second)
 
@@ -118,7 +126,7 @@ func BotDestroy() {
 }
 
 func OnReconnect(session *discordgo.Session, event *discordgo.Ready) {
-	cache.GetLogger().WithField("module", "bot").Info("Reconnected to discord!")
+	cache.GetLogger().WithField("module", "bot").Infof("Shard %d reconnected to discord!", session.ShardID)
 
 	// request guild members from the gateway
 	go func() {
@@ -132,7 +140,7 @@ func OnReconnect(session *discordgo.Session, event *discordgo.Ready) {
 		}
 
 		for _, guild := range cache.GetSession().State.Guilds {
-			if helpers.IsBlacklistedGuild(guild.ID) {
+			if helpers.IsBlacklistedGuild(guild.ID) || cache.ShardForGuild(guild.ID) != session.ShardID {
 				continue
 			}
 
@@ -266,8 +274,7 @@ func BotOnMessageCreate(session *discordgo.Session, message *discordgo.MessageCr
 	// Check if the message contains @mentions for us
 	if strings.HasPrefix(message.Content, "<@") && len(message.Mentions) > 0 && message.Mentions[0].ID == session.State.User.ID {
 		// Consume a key for this action
-		e := ratelimits.Container.Drain(1, message.Author.ID)
-		if e != nil {
+		if !isAllowedToRequestCommand(channel.GuildID, message.Message, 1) {
 			return
 		}
 
@@ -298,13 +305,13 @@ func BotOnMessageCreate(session *discordgo.Session, message *discordgo.MessageCr
 			if prefix == "" {
 				helpers.SendMessage(
 					channel.ID,
-					helpers.GetText("bot.prefix.not-set"),
+					helpers.GetMessageText(message.Message, "bot.prefix.not-set"),
 				)
 			}
 
 			helpers.SendMessage(
 				channel.ID,
-				helpers.GetTextF("bot.prefix.is", prefix),
+				helpers.GetMessageTextF(message.Message, "bot.prefix.is", prefix),
 			)
 			return
 
@@ -340,7 +347,7 @@ func BotOnMessageCreate(session *discordgo.Session, message *discordgo.MessageCr
 					helpers.SendError(message.Message, err)
 				} else {
 					helpers.SendMessage(channel.ID,
-						helpers.GetTextF("plugins.mod.prefix-set-success",
+						helpers.GetMessageTextF(message.Message, "plugins.mod.prefix-set-success",
 							helpers.GetPrefixForServer(channel.GuildID)))
 				}
 			})
@@ -476,14 +483,6 @@ func BotOnMessageCreate(session *discordgo.Session, message *discordgo.MessageCr
 		return
 	}
 
-	// Check if the user is allowed to request commands
-	if !ratelimits.Container.HasKeys(message.Author.ID) && !helpers.IsBotAdmin(message.Author.ID) {
-		helpers.SendMessage(message.ChannelID, helpers.GetTextF("bot.ratelimit.hit", message.Author.ID))
-
-		ratelimits.Container.Set(message.Author.ID, -1)
-		return
-	}
-
 	// Split the message into parts
 	parts := strings.Fields(message.Content)
 
@@ -493,6 +492,13 @@ func BotOnMessageCreate(session *discordgo.Session, message *discordgo.MessageCr
 	// Check if the user calls for help
 	if cmd == "h" || cmd == "help" {
 		metrics.CommandsExecuted.Add(1)
+		if len(parts) > 1 {
+			if command := commands.Find(parts[1:]); command != nil {
+				_, err = helpers.SendEmbed(message.ChannelID, commands.HelpEmbed(command, prefix))
+				helpers.RelaxLog(err)
+				return
+			}
+		}
 		sendHelp(message)
 		return
 	}
@@ -500,6 +506,18 @@ func BotOnMessageCreate(session *discordgo.Session, message *discordgo.MessageCr
 	// Separate arguments from the command
 	content := strings.TrimSpace(strings.Replace(message.Content, prefix+cmd, "", -1))
 
+	// Resolve guild aliases and ignore commands disabled on this guild
+	cmd = helpers.GuildCommandResolveAlias(channel.GuildID, cmd)
+	if helpers.GuildCommandIsDisabled(channel.GuildID, cmd) {
+		go helpers.AddNoPermissionsReaction(message.ChannelID, message.ID)
+		return
+	}
+
+	// Check if the user and the guild are allowed to request commands
+	if modules.IsCommand(cmd) && !isAllowedToRequestCommand(channel.GuildID, message.Message, modules.CommandCost(cmd)) {
+		return
+	}
+
 	// Log commands
 	cache.GetLogger().WithFields(logrus.Fields{
 		"module":    "bot",
@@ -512,6 +530,29 @@ func BotOnMessageCreate(session *discordgo.Session, message *discordgo.MessageCr
 	modules.CallBotPlugin(cmd, content, message.Message)
 }
 
+// isAllowedToRequestCommand consumes the keys of a command from the buckets of the user and the guild, and tells
+// the user once if they or the guild are rate limited. Bot admins and Robyul mods are not rate limited.
+func isAllowedToRequestCommand(guildID string, message *discordgo.Message, cost int) bool {
+	if helpers.IsBotAdmin(message.Author.ID) || helpers.IsRobyulMod(message.Author.ID) {
+		return true
+	}
+
+	err := ratelimits.DrainCommand(guildID, message.Author.ID, cost)
+	if err == nil {
+		return true
+	}
+
+	if limitErr, ok := err.(*ratelimits.CommandLimitError); ok && limitErr.Notify {
+		wait := helpers.HumanizeDuration(limitErr.Wait + time.Second)
+		if limitErr.Guild {
+			helpers.SendMessage(message.ChannelID, helpers.GetMessageTextF(message, "bot.ratelimit.hit-guild", wait))
+		} else {
+			helpers.SendMessage(message.ChannelID, helpers.GetMessageTextF(message, "bot.ratelimit.hit", message.Author.ID, wait))
+		}
+	}
+	return false
+}
+
 func emojiFile(base, s string) string {
 	found := ""
 	filename := ""
@@ -606,9 +647,11 @@ func BotOnReactionRemove(session *discordgo.Session, reaction *discordgo.Message
 }
 
 func BotOnGuildCreate(session *discordgo.Session, guild *discordgo.GuildCreate) {
+	onShardGuildCreate(session, guild)
 }
 
 func BotOnGuildDelete(session *discordgo.Session, guild *discordgo.GuildDelete) {
+	cache.RemoveShardGuildID(session.ShardID, guild.ID)
 }
 
 func sendHelp(message *discordgo.MessageCreate) {
@@ -619,6 +662,6 @@ func sendHelp(message *discordgo.MessageCreate) {
 
 	helpers.SendMessage(
 		message.ChannelID,
-		helpers.GetTextF("bot.help", message.Author.ID, channel.GuildID),
+		helpers.GetMessageTextF(message.Message, "bot.help", message.Author.ID, channel.GuildID),
 	)
 }
@@ -21,6 +21,13 @@ func SetMachineryServer(s *machinery.Server) {
 	machineryServerMutex.Unlock()
 }
 
+func HasMachineryServer() bool {
+	machineryServerMutex.RLock()
+	defer machineryServerMutex.RUnlock()
+
+	return machineryServer != nil
+}
+
 func GetMachineryServer() *machinery.Server {
 	machineryServerMutex.RLock()
 	defer machineryServerMutex.RUnlock()
@@ -61,10 +68,16 @@ func HasMachineryRedisClient() bool {
 }
 
 func AddMachineryActiveWorker(worker *machinery.Worker) {
+	machineryServerMutex.Lock()
+	defer machineryServerMutex.Unlock()
+
 	machineryActiveWorkers = append(machineryActiveWorkers, worker)
 }
 
 func RemoveMachineryActiveWorker(worker *machinery.Worker) {
+	machineryServerMutex.Lock()
+	defer machineryServerMutex.Unlock()
+
 	newWorkers := make([]*machinery.Worker, 0)
 	for _, activeWorker := range machineryActiveWorkers {
 		if activeWorker.ConsumerTag == worker.ConsumerTag {
@@ -77,5 +90,8 @@ func RemoveMachineryActiveWorker(worker *machinery.Worker) {
 }
 
 func GetMachineryActiveWorkers() (workers []*machinery.Worker) {
+	machineryServerMutex.RLock()
+	defer machineryServerMutex.RUnlock()
+
 	return machineryActiveWorkers
 }
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"errors"
+	"strings"
 	"sync"
 )
 
@@ -9,6 +10,7 @@ var (
 	pluginCommandList         []string
 	pluginExtendedCommandList []string
 	triggerPluginCommandList  []string
+	pluginCommandSets         map[string][]string
 	modulelistsMutex          sync.RWMutex
 )
 
@@ -45,3 +47,26 @@ func GetPluginExtendedList() []string {
 
 	return pluginExtendedCommandList
 }
+
+// SetPluginCommandSets sets the commands handled together, the commands of a plugin or a command and its aliases
+// the first command of a set is its canonical name
+func SetPluginCommandSets(sets [][]string) {
+	commandSets := make(map[string][]string)
+	for _, set := range sets {
+		for _, command := range set {
+			commandSets[strings.ToLower(command)] = set
+		}
+	}
+
+	modulelistsMutex.Lock()
+	pluginCommandSets = commandSets
+	modulelistsMutex.Unlock()
+}
+
+// GetPluginCommandSet returns the set of the command, nil if no plugin handles the command
+func GetPluginCommandSet(command string) []string {
+	modulelistsMutex.RLock()
+	defer modulelistsMutex.RUnlock()
+
+	return pluginCommandSets[strings.ToLower(command)]
+}
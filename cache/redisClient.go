@@ -32,6 +32,13 @@ func SetRedisClient(s *redis.Client) {
 	redisMutext.Unlock()
 }
 
+func HasRedisClient() bool {
+	redisMutext.RLock()
+	defer redisMutext.RUnlock()
+
+	return redisClient != nil
+}
+
 func GetRedisClient() *redis.Client {
 	redisMutext.RLock()
 	defer redisMutext.RUnlock()
@@ -2,6 +2,8 @@ package cache
 
 import (
 	"errors"
+	"reflect"
+	"strconv"
 	"sync"
 
 	"github.com/bwmarrin/discordgo"
@@ -9,15 +11,34 @@ import (
 
 var (
 	session      *discordgo.Session
+	sessions     []*discordgo.Session
 	sessionMutex sync.RWMutex
 )
 
+// SetSession sets the session of a bot with a single shard
 func SetSession(s *discordgo.Session) {
+	SetSessions([]*discordgo.Session{s})
+}
+
+// SetSessions sets the sessions of all shards, ordered by shard ID
+// the first session is returned by GetSession
+func SetSessions(s []*discordgo.Session) {
 	sessionMutex.Lock()
-	session = s
+	sessions = s
+	session = s[0]
 	sessionMutex.Unlock()
 }
 
+// HasSession returns true once the sessions have been set
+func HasSession() bool {
+	sessionMutex.RLock()
+	defer sessionMutex.RUnlock()
+
+	return session != nil
+}
+
+// GetSession returns the session of the first shard
+// all shards share the same state, use GetSessionForGuild for gateway requests
 func GetSession() *discordgo.Session {
 	sessionMutex.RLock()
 	defer sessionMutex.RUnlock()
@@ -28,3 +49,128 @@ func GetSession() *discordgo.Session {
 
 	return session
 }
+
+// GetSessions returns the sessions of all shards, ordered by shard ID
+func GetSessions() []*discordgo.Session {
+	sessionMutex.RLock()
+	defer sessionMutex.RUnlock()
+
+	if session == nil {
+		panic(errors.New("Tried to get discord sessions before cache#SetSessions() was called"))
+	}
+
+	result := make([]*discordgo.Session, len(sessions))
+	copy(result, sessions)
+	return result
+}
+
+// GetShardCount returns the number of shards
+func GetShardCount() int {
+	sessionMutex.RLock()
+	defer sessionMutex.RUnlock()
+
+	if len(sessions) <= 0 {
+		return 1
+	}
+	return len(sessions)
+}
+
+// ShardForGuild returns the ID of the shard receiving the events of the guild
+// Source: https://discordapp.com/developers/docs/topics/gateway#sharding
+func ShardForGuild(guildID string) int {
+	id, err := strconv.ParseUint(guildID, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return int((id >> 22) % uint64(GetShardCount()))
+}
+
+// GetSessionForGuild returns the session of the shard receiving the events of the guild
+func GetSessionForGuild(guildID string) *discordgo.Session {
+	shardID := ShardForGuild(guildID)
+
+	sessionMutex.RLock()
+	defer sessionMutex.RUnlock()
+
+	if shardID < len(sessions) {
+		return sessions[shardID]
+	}
+	if session == nil {
+		panic(errors.New("Tried to get discord session before cache#SetSession() was called"))
+	}
+	return session
+}
+
+// GetSessionForChannel returns the session of the shard receiving the events of the channel
+// direct message channels belong to the first shard
+func GetSessionForChannel(channelID string) *discordgo.Session {
+	primary := GetSession()
+
+	channel, err := primary.State.Channel(channelID)
+	if err != nil || channel.GuildID == "" {
+		return primary
+	}
+	return GetSessionForGuild(channel.GuildID)
+}
+
+// AddHandler adds the event handler to the sessions of all shards, events are received by the shard of their guild
+// returns a function to remove the handler from all sessions
+func AddHandler(handler interface{}) func() {
+	var removers []func()
+	for _, shardSession := range GetSessions() {
+		removers = append(removers, shardSession.AddHandler(handler))
+	}
+
+	return func() {
+		for _, remove := range removers {
+			remove()
+		}
+	}
+}
+
+// AddHandlerOnce adds the event handler to the sessions of all shards, it is called for the first matching event of
+// any shard and then removed from all sessions
+func AddHandlerOnce(handler interface{}) func() {
+	var (
+		lock    sync.Mutex
+		called  bool
+		removed bool
+		remove  func()
+	)
+	removeAll := func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		removed = true
+		if remove != nil {
+			remove()
+			remove = nil
+		}
+	}
+
+	handlerValue := reflect.ValueOf(handler)
+	onceHandler := reflect.MakeFunc(handlerValue.Type(), func(args []reflect.Value) []reflect.Value {
+		lock.Lock()
+		if called {
+			lock.Unlock()
+			return nil
+		}
+		called = true
+		lock.Unlock()
+
+		removeAll()
+		return handlerValue.Call(args)
+	})
+
+	removeHandlers := AddHandler(onceHandler.Interface())
+
+	// the handler might have been called or removed while it was added
+	lock.Lock()
+	defer lock.Unlock()
+	if removed {
+		removeHandlers()
+	} else {
+		remove = removeHandlers
+	}
+	return removeAll
+}
@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// ShardStatus is the state of the gateway connection of a shard
+type ShardStatus struct {
+	ID         int
+	Ready      bool
+	ReadySince time.Time
+	// when the shard lost its gateway connection, zero if it never was ready
+	DisconnectedSince time.Time
+	Guilds            int
+	Latency           time.Duration
+}
+
+type shardState struct {
+	ready             bool
+	readySince        time.Time
+	disconnectedSince time.Time
+	guildIDs          map[string]bool
+}
+
+var (
+	shardStates     = make(map[int]*shardState)
+	shardStatesLock sync.RWMutex
+)
+
+func getShardState(shardID int) *shardState {
+	state, ok := shardStates[shardID]
+	if !ok {
+		state = &shardState{guildIDs: make(map[string]bool)}
+		shardStates[shardID] = state
+	}
+	return state
+}
+
+// SetShardReady sets if the gateway connection of a shard is ready
+func SetShardReady(shardID int, ready bool) {
+	shardStatesLock.Lock()
+	defer shardStatesLock.Unlock()
+
+	state := getShardState(shardID)
+	if ready && !state.ready {
+		state.readySince = time.Now()
+	}
+	if !ready && state.ready {
+		state.disconnectedSince = time.Now()
+	}
+	state.ready = ready
+}
+
+// IsShardReady returns true if the gateway connection of a shard is ready
+func IsShardReady(shardID int) bool {
+	shardStatesLock.RLock()
+	defer shardStatesLock.RUnlock()
+
+	state, ok := shardStates[shardID]
+	return ok && state.ready
+}
+
+// AllShardsReady returns true if the gateway connections of all shards are ready
+func AllShardsReady() bool {
+	for shardID := 0; shardID < GetShardCount(); shardID++ {
+		if !IsShardReady(shardID) {
+			return false
+		}
+	}
+	return true
+}
+
+// SetShardGuildIDs replaces the guilds of a shard, for example after a ready event
+func SetShardGuildIDs(shardID int, guildIDs []string) {
+	shardStatesLock.Lock()
+	defer shardStatesLock.Unlock()
+
+	state := getShardState(shardID)
+	state.guildIDs = make(map[string]bool, len(guildIDs))
+	for _, guildID := range guildIDs {
+		state.guildIDs[guildID] = true
+	}
+}
+
+// AddShardGuildID adds a guild to a shard, returns false if the shard already had the guild
+func AddShardGuildID(shardID int, guildID string) (added bool) {
+	shardStatesLock.Lock()
+	defer shardStatesLock.Unlock()
+
+	state := getShardState(shardID)
+	if state.guildIDs[guildID] {
+		return false
+	}
+	state.guildIDs[guildID] = true
+	return true
+}
+
+// RemoveShardGuildID removes a guild from a shard
+func RemoveShardGuildID(shardID int, guildID string) {
+	shardStatesLock.Lock()
+	defer shardStatesLock.Unlock()
+
+	delete(getShardState(shardID).guildIDs, guildID)
+}
+
+// GetShardGuildIDs returns the guilds of all shards, except the given shard
+func GetShardGuildIDs(exceptShardID int) (guildIDs []string) {
+	shardStatesLock.RLock()
+	defer shardStatesLock.RUnlock()
+
+	for shardID, state := range shardStates {
+		if shardID == exceptShardID {
+			continue
+		}
+		for guildID := range state.guildIDs {
+			guildIDs = append(guildIDs, guildID)
+		}
+	}
+	return guildIDs
+}
+
+// GetShardStatuses returns the status of all shards, ordered by shard ID
+func GetShardStatuses() (statuses []ShardStatus) {
+	shardSessions := GetSessions()
+
+	shardStatesLock.RLock()
+	defer shardStatesLock.RUnlock()
+
+	for shardID, shardSession := range shardSessions {
+		status := ShardStatus{ID: shardID}
+		if state, ok := shardStates[shardID]; ok {
+			status.Ready = state.ready
+			status.ReadySince = state.readySince
+			status.DisconnectedSince = state.disconnectedSince
+			status.Guilds = len(state.guildIDs)
+		}
+
+		shardSession.RLock()
+		if shardSession.LastHeartbeatAck.After(shardSession.LastHeartbeatSent) {
+			status.Latency = shardSession.LastHeartbeatAck.Sub(shardSession.LastHeartbeatSent)
+		}
+		shardSession.RUnlock()
+
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
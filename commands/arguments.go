@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/bwmarrin/discordgo"
+	"github.com/karrick/tparse/v2"
+)
+
+var (
+	roleMentionRegex = regexp.MustCompile(`^<@&(\d+)>$`)
+)
+
+type token struct {
+	value string
+	start int // byte offset in the content
+}
+
+// tokenize splits the content at whitespace, like strings.Fields, but remembers where each token starts
+func tokenize(content string) (tokens []token) {
+	start := -1
+	for i, r := range content {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				tokens = append(tokens, token{value: content[start:i], start: start})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{value: content[start:], start: start})
+	}
+	return tokens
+}
+
+// parseInt parses a whole number argument
+func parseInt(input string) (int, error) {
+	return strconv.Atoi(input)
+}
+
+// parseDuration parses a duration argument, supports units like d and w on top of time.ParseDuration
+func parseDuration(input string) (time.Duration, error) {
+	now := time.Now()
+	until, err := tparse.AddDuration(now, input)
+	if err != nil {
+		return 0, err
+	}
+	duration := until.Sub(now)
+	if duration <= 0 {
+		return 0, errors.New("duration has to be positive")
+	}
+	return duration, nil
+}
+
+// ParseRole finds a role on the guild by mention, ID or name
+func ParseRole(guildID, input string) (*discordgo.Role, error) {
+	guild, err := helpers.GetGuild(guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if result := roleMentionRegex.FindStringSubmatch(input); len(result) == 2 {
+		input = result[1]
+	}
+
+	for _, role := range guild.Roles {
+		if role.ID == input || strings.ToLower(role.Name) == strings.ToLower(input) {
+			return role, nil
+		}
+	}
+
+	return nil, errors.New("role not found")
+}
+
+// parseArgument parses a single argument into its typed value
+func parseArgument(argument Argument, input string, msg *discordgo.Message, guildID string) (value interface{}, err error) {
+	switch argument.Type {
+	case ArgumentInt:
+		return parseInt(input)
+	case ArgumentDuration:
+		return parseDuration(input)
+	case ArgumentUser:
+		user, err := helpers.GetUserFromMention(input)
+		if err != nil || user == nil || user.ID == "" {
+			return nil, errors.New("user not found")
+		}
+		return user, nil
+	case ArgumentChannel:
+		return helpers.GetChannelFromMention(msg, input)
+	case ArgumentRole:
+		return ParseRole(guildID, input)
+	}
+	return input, nil
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenize(t *testing.T) {
+	content := "add  #channel  some   text"
+	tokens := tokenize(content)
+	if len(tokens) != 4 {
+		t.Fatalf("expected 4 tokens, got %d", len(tokens))
+	}
+	if tokens[1].value != "#channel" {
+		t.Errorf("expected #channel, got %s", tokens[1].value)
+	}
+	if rest := content[tokens[2].start:]; rest != "some   text" {
+		t.Errorf("expected the remaining content with spacing, got %q", rest)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	duration, err := parseDuration("1h30m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duration != 90*time.Minute {
+		t.Errorf("expected 1h30m, got %s", duration)
+	}
+
+	duration, err = parseDuration("2d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duration < 47*time.Hour || duration > 49*time.Hour {
+		t.Errorf("expected about 48h, got %s", duration)
+	}
+
+	if _, err = parseDuration("abc"); err == nil {
+		t.Error("expected an error for an invalid duration")
+	}
+}
+
+func TestUsage(t *testing.T) {
+	command := &Command{
+		Name: "storage",
+		Subcommands: []*Command{
+			{
+				Name: "quota",
+				Arguments: []Argument{
+					{Name: "guild id", Type: ArgumentString},
+					{Name: "bytes", Type: ArgumentInt, Optional: true},
+				},
+				Handler: func(ctx *Context) {},
+			},
+		},
+	}
+	if err := validate(command); err != nil {
+		t.Fatal(err)
+	}
+	command.setParents()
+
+	if usage := command.Subcommand("quota").Usage("_"); usage != "_storage quota <guild id> [bytes]" {
+		t.Errorf("unexpected usage: %s", usage)
+	}
+	if usage := command.Usage("_"); usage != "_storage <quota>" {
+		t.Errorf("unexpected usage: %s", usage)
+	}
+}
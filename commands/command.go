@@ -0,0 +1,173 @@
+// Package commands is a framework for plugins to declare their commands, subcommands and arguments.
+// Commands declared with it get argument parsing, permission checks, usage text and help output for free.
+package commands
+
+import (
+	"strings"
+
+	"github.com/Seklfreak/Robyul2/models"
+)
+
+type ArgumentType int
+
+const (
+	ArgumentString   ArgumentType = iota // a single word
+	ArgumentText                         // all remaining content, has to be the last argument
+	ArgumentInt                          // a whole number
+	ArgumentDuration                     // a duration, for example 1h30m or 2d
+	ArgumentUser                         // a user mention or user ID
+	ArgumentChannel                      // a text channel mention or channel ID on the same guild
+	ArgumentRole                         // a role mention, role ID or role name on the same guild
+)
+
+func (t ArgumentType) String() string {
+	switch t {
+	case ArgumentText:
+		return "text"
+	case ArgumentInt:
+		return "number"
+	case ArgumentDuration:
+		return "duration"
+	case ArgumentUser:
+		return "user"
+	case ArgumentChannel:
+		return "channel"
+	case ArgumentRole:
+		return "role"
+	}
+	return "word"
+}
+
+type Permission int
+
+const (
+	PermissionEveryone Permission = iota
+	PermissionMod
+	PermissionAdmin
+	PermissionRobyulMod
+	PermissionBotAdmin
+)
+
+func (p Permission) String() string {
+	switch p {
+	case PermissionMod:
+		return "Server Moderators"
+	case PermissionAdmin:
+		return "Server Admins"
+	case PermissionRobyulMod:
+		return "Robyul Moderators"
+	case PermissionBotAdmin:
+		return "Bot Admins"
+	}
+	return "Everyone"
+}
+
+type Argument struct {
+	Name     string
+	Type     ArgumentType
+	Optional bool // optional arguments can only be followed by other optional arguments
+}
+
+// Handler is called after all checks passed and all arguments have been parsed
+type Handler func(ctx *Context)
+
+type Command struct {
+	Name        string
+	Aliases     []string
+	Description string
+	Arguments   []Argument
+	Permission  Permission
+	// Module is checked with helpers.ModuleIsAllowed, on subcommands it overwrites the module of the parent
+	Module models.ModulePermissionsModule
+	// Cost is the amount of rate limit keys the command uses, 0 uses one key
+	Cost        int
+	Subcommands []*Command
+	// Handler can be nil if the command only groups subcommands
+	Handler Handler
+
+	parent *Command
+}
+
+// Matches returns true if the given name is the name or an alias of the command
+func (c *Command) Matches(name string) bool {
+	name = strings.ToLower(name)
+	if strings.ToLower(c.Name) == name {
+		return true
+	}
+	for _, alias := range c.Aliases {
+		if strings.ToLower(alias) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Subcommand returns the subcommand matching the given name, or nil
+func (c *Command) Subcommand(name string) *Command {
+	for _, subcommand := range c.Subcommands {
+		if subcommand.Matches(name) {
+			return subcommand
+		}
+	}
+	return nil
+}
+
+// FullName returns the name including all parent commands, for example "storage quota"
+func (c *Command) FullName() string {
+	if c.parent != nil {
+		return c.parent.FullName() + " " + c.Name
+	}
+	return c.Name
+}
+
+// Root returns the top level command
+func (c *Command) Root() *Command {
+	if c.parent != nil {
+		return c.parent.Root()
+	}
+	return c
+}
+
+// Usage returns the usage text, for example "_storage quota <guild id> <bytes>"
+func (c *Command) Usage(prefix string) string {
+	usage := prefix + c.FullName()
+	if c.Handler == nil && len(c.Subcommands) > 0 {
+		return usage + " <" + c.subcommandNames() + ">"
+	}
+	for _, argument := range c.Arguments {
+		name := argument.Name
+		if argument.Type == ArgumentText {
+			name += "…"
+		}
+		if argument.Optional {
+			usage += " [" + name + "]"
+		} else {
+			usage += " <" + name + ">"
+		}
+	}
+	return usage
+}
+
+// module returns the module permission of the command, inherited from the parent if not set
+func (c *Command) module() models.ModulePermissionsModule {
+	if c.Module == 0 && c.parent != nil {
+		return c.parent.module()
+	}
+	return c.Module
+}
+
+func (c *Command) subcommandNames() string {
+	names := make([]string, 0, len(c.Subcommands))
+	for _, subcommand := range c.Subcommands {
+		names = append(names, subcommand.Name)
+	}
+	return strings.Join(names, "|")
+}
+
+// setParents links all subcommands to their parent commands
+func (c *Command) setParents() {
+	for _, subcommand := range c.Subcommands {
+		subcommand.parent = c
+		subcommand.setParents()
+	}
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Context is passed to command handlers, it contains the message and all parsed arguments
+type Context struct {
+	Command *Command
+	Msg     *discordgo.Message
+	Channel *discordgo.Channel
+	GuildID string
+	Prefix  string
+	Locale  string // the locale of the author, or of the guild
+
+	values map[string]interface{}
+}
+
+// Has returns true if the argument has been given, useful for optional arguments
+func (c *Context) Has(name string) bool {
+	_, ok := c.values[name]
+	return ok
+}
+
+// String returns the value of a String or Text argument
+func (c *Context) String(name string) string {
+	value, _ := c.values[name].(string)
+	return value
+}
+
+// Int returns the value of an Int argument
+func (c *Context) Int(name string) int {
+	value, _ := c.values[name].(int)
+	return value
+}
+
+// Duration returns the value of a Duration argument
+func (c *Context) Duration(name string) time.Duration {
+	value, _ := c.values[name].(time.Duration)
+	return value
+}
+
+// User returns the value of a User argument
+func (c *Context) User(name string) *discordgo.User {
+	value, _ := c.values[name].(*discordgo.User)
+	return value
+}
+
+// ChannelArg returns the value of a Channel argument, Channel is the channel the command has been used in
+func (c *Context) ChannelArg(name string) *discordgo.Channel {
+	value, _ := c.values[name].(*discordgo.Channel)
+	return value
+}
+
+// Role returns the value of a Role argument
+func (c *Context) Role(name string) *discordgo.Role {
+	value, _ := c.values[name].(*discordgo.Role)
+	return value
+}
+
+// Send sends a message to the channel the command has been used in
+func (c *Context) Send(content string) {
+	_, err := helpers.SendMessage(c.Msg.ChannelID, content)
+	helpers.Relax(err)
+}
+
+// Text returns a translation in the locale of the context
+func (c *Context) Text(id string, replacements ...interface{}) string {
+	return helpers.GetTextLF(c.Locale, id, replacements...)
+}
+
+// SendText sends a translated message to the channel the command has been used in
+func (c *Context) SendText(id string, replacements ...interface{}) {
+	c.Send(c.Text(id, replacements...))
+}
+
+// SendComplex sends a complex message to the channel the command has been used in
+func (c *Context) SendComplex(data *discordgo.MessageSend) {
+	_, err := helpers.SendComplex(c.Msg.ChannelID, data)
+	helpers.Relax(err)
+}
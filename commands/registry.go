@@ -0,0 +1,245 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/bwmarrin/discordgo"
+)
+
+var (
+	registry      = make(map[string]*Command, 0) // lowercase name or alias => command
+	registryMutex sync.RWMutex
+)
+
+// Register adds a top level command to the registry, returns an error if the name or an alias is already taken
+func Register(command *Command) error {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	names := append([]string{command.Name}, command.Aliases...)
+	for _, name := range names {
+		if _, ok := registry[strings.ToLower(name)]; ok {
+			return fmt.Errorf("command %s is already registered", name)
+		}
+	}
+	if err := validate(command); err != nil {
+		return err
+	}
+
+	command.setParents()
+	for _, name := range names {
+		registry[strings.ToLower(name)] = command
+	}
+	return nil
+}
+
+// Get returns the top level command for a name or alias, or nil
+func Get(name string) *Command {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	return registry[strings.ToLower(name)]
+}
+
+// Find walks the subcommands of the given path, for example ["storage", "quota"], and returns the deepest match
+func Find(path []string) *Command {
+	if len(path) <= 0 {
+		return nil
+	}
+	command := Get(path[0])
+	if command == nil {
+		return nil
+	}
+	for _, name := range path[1:] {
+		subcommand := command.Subcommand(name)
+		if subcommand == nil {
+			break
+		}
+		command = subcommand
+	}
+	return command
+}
+
+// List returns all top level commands, sorted by name
+func List() (commands []*Command) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	seen := make(map[*Command]bool, 0)
+	for _, command := range registry {
+		if seen[command] {
+			continue
+		}
+		seen[command] = true
+		commands = append(commands, command)
+	}
+	sort.Slice(commands, func(i, j int) bool {
+		return commands[i].Name < commands[j].Name
+	})
+	return commands
+}
+
+// Execute runs a command, walks the subcommands, checks the permissions and parses the arguments
+// command	: the top level command
+// content	: the content without prefix and command
+// msg		: the message that triggered the command
+func Execute(command *Command, content string, msg *discordgo.Message) {
+	channel, err := helpers.GetChannel(msg.ChannelID)
+	helpers.Relax(err)
+
+	tokens := tokenize(content)
+	for len(tokens) > 0 {
+		subcommand := command.Subcommand(tokens[0].value)
+		if subcommand == nil {
+			break
+		}
+		command = subcommand
+		tokens = tokens[1:]
+	}
+
+	if module := command.module(); module != 0 {
+		if !helpers.ModuleIsAllowed(msg.ChannelID, msg.ID, msg.Author.ID, module) {
+			return
+		}
+	}
+
+	ctx := &Context{
+		Command: command,
+		Msg:     msg,
+		Channel: channel,
+		GuildID: channel.GuildID,
+		Prefix:  helpers.GetPrefixForServer(channel.GuildID),
+		Locale:  helpers.GetLocale(channel.GuildID, msg.Author.ID),
+		values:  make(map[string]interface{}, 0),
+	}
+
+	if !hasPermission(command.Permission, msg) {
+		ctx.SendText(permissionTextID(command.Permission))
+		return
+	}
+
+	if command.Handler == nil {
+		ctx.SendText("bot.commands.usage", command.Usage(ctx.Prefix))
+		return
+	}
+
+	for i, argument := range command.Arguments {
+		if i >= len(tokens) {
+			if argument.Optional {
+				break
+			}
+			ctx.SendText("bot.commands.missing-argument", argument.Name, command.Usage(ctx.Prefix))
+			return
+		}
+
+		input := tokens[i].value
+		if argument.Type == ArgumentText {
+			input = strings.TrimSpace(content[tokens[i].start:])
+		}
+
+		value, err := parseArgument(argument, input, msg, channel.GuildID)
+		if err != nil {
+			ctx.SendText("bot.commands.invalid-argument", argument.Name, argument.Type.String(), command.Usage(ctx.Prefix))
+			return
+		}
+		ctx.values[argument.Name] = value
+	}
+
+	command.Handler(ctx)
+}
+
+// HelpEmbed returns an embed describing the command, its usage, aliases, permissions and subcommands
+func HelpEmbed(command *Command, prefix string) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Color:       0x0FADED,
+		Title:       prefix + command.FullName(),
+		Description: command.Description,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Usage", Value: "`" + command.Usage(prefix) + "`"},
+		},
+	}
+
+	if len(command.Aliases) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: "Aliases", Value: strings.Join(command.Aliases, ", "), Inline: true,
+		})
+	}
+	if command.Permission != PermissionEveryone {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: "Permission", Value: command.Permission.String(), Inline: true,
+		})
+	}
+	if len(command.Subcommands) > 0 {
+		var subcommandsText string
+		for _, subcommand := range command.Subcommands {
+			subcommandsText += "`" + subcommand.Usage(prefix) + "`"
+			if subcommand.Description != "" {
+				subcommandsText += " " + subcommand.Description
+			}
+			subcommandsText += "\n"
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: "Subcommands", Value: subcommandsText,
+		})
+	}
+
+	return embed
+}
+
+func hasPermission(permission Permission, msg *discordgo.Message) bool {
+	switch permission {
+	case PermissionMod:
+		return helpers.IsMod(msg)
+	case PermissionAdmin:
+		return helpers.IsAdmin(msg)
+	case PermissionRobyulMod:
+		return helpers.IsRobyulMod(msg.Author.ID)
+	case PermissionBotAdmin:
+		return helpers.IsBotAdmin(msg.Author.ID)
+	}
+	return true
+}
+
+func permissionTextID(permission Permission) string {
+	switch permission {
+	case PermissionMod:
+		return "mod.no_permission"
+	case PermissionAdmin:
+		return "admin.no_permission"
+	case PermissionRobyulMod:
+		return "robyulmod.no_permission"
+	}
+	return "botadmin.no_permission"
+}
+
+// validate checks the argument definitions of a command and all its subcommands
+func validate(command *Command) error {
+	if command.Name == "" {
+		return errors.New("command without name")
+	}
+	optional := false
+	for i, argument := range command.Arguments {
+		if argument.Type == ArgumentText && i != len(command.Arguments)-1 {
+			return fmt.Errorf("command %s: text argument %s has to be the last argument", command.Name, argument.Name)
+		}
+		if optional && !argument.Optional {
+			return fmt.Errorf("command %s: required argument %s after optional argument", command.Name, argument.Name)
+		}
+		optional = argument.Optional
+	}
+	for _, subcommand := range command.Subcommands {
+		if err := validate(subcommand); err != nil {
+			return err
+		}
+	}
+	if command.Handler == nil && len(command.Subcommands) <= 0 {
+		cache.GetLogger().WithField("module", "commands").Warnf("command %s has no handler and no subcommands", command.Name)
+	}
+	return nil
+}
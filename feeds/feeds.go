@@ -0,0 +1,465 @@
+package feeds
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/metrics/prom"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// how often each source looks for feeds that are due
+	checkTick = 30 * time.Second
+	// the longest time between two checks after repeated failures
+	maxBackoff = 6 * time.Hour
+	// how many posted IDs are kept per feed for deduplication
+	maxPostedIDs = 200
+)
+
+var (
+	ErrUnknownSource = errors.New("unknown feed source")
+	ErrAlreadyAdded  = errors.New("feed already exists in this channel")
+)
+
+// Add validates the target with the source and stores a new feed
+// source		: the name of the source, for example rss
+// target		: the target as given by the user
+// channel		: the channel to post to
+// userID		: the user adding the feed
+// mentionRoleID	: the role to mention in every post, can be empty
+func Add(source, target string, channel *discordgo.Channel, userID, mentionRoleID string) (entry models.FeedEntry, err error) {
+	return AddEntry(source, target, models.FeedEntry{
+		GuildID:       channel.GuildID,
+		ChannelID:     channel.ID,
+		AddedByUserID: userID,
+		MentionRoleID: mentionRoleID,
+		PostMode:      models.FeedPostModeEmbed,
+	})
+}
+
+// AddEntry validates the target with the source and stores a new feed with the settings of entry
+// entry has to contain at least the guild, the channel and the user adding the feed
+func AddEntry(source, target string, entry models.FeedEntry) (models.FeedEntry, error) {
+	feedSource := GetSource(source)
+	if feedSource == nil {
+		return entry, ErrUnknownSource
+	}
+
+	normalised, name, err := feedSource.Resolve(target)
+	if err != nil {
+		return entry, err
+	}
+
+	count, err := helpers.MdbCount(models.FeedsTable, bson.M{"source": source, "target": normalised, "channelid": entry.ChannelID})
+	if err != nil {
+		return entry, err
+	}
+	if count > 0 {
+		return entry, ErrAlreadyAdded
+	}
+
+	entry.AddedAt = time.Now()
+	entry.Source = source
+	entry.Target = normalised
+	entry.TargetName = name
+	// check soon to fill the posted IDs
+	entry.NextCheck = time.Now()
+	entry.ID, err = helpers.MDbInsert(models.FeedsTable, entry)
+	return entry, err
+}
+
+// Get returns a feed on a guild by its human readable ID
+func Get(guildID, id string) (entry models.FeedEntry, err error) {
+	err = helpers.MdbOne(
+		helpers.MdbCollection(models.FeedsTable).Find(bson.M{"guildid": guildID, "_id": helpers.HumanToMdbId(id)}),
+		&entry,
+	)
+	return entry, err
+}
+
+// GetAll returns all feeds of a source on a guild
+func GetAll(guildID, source string) (entries []models.FeedEntry, err error) {
+	err = helpers.MDbIter(helpers.MdbCollection(models.FeedsTable).Find(bson.M{"guildid": guildID, "source": source})).All(&entries)
+	return entries, err
+}
+
+// Update stores changes to a feed
+func Update(entry models.FeedEntry) error {
+	return helpers.MDbUpdate(models.FeedsTable, entry.ID, entry)
+}
+
+// Remove deletes a feed
+func Remove(entry models.FeedEntry) error {
+	return helpers.MDbDelete(models.FeedsTable, entry.ID)
+}
+
+// NewListItem returns how a feed is shown in the feeds list
+func NewListItem(entry models.FeedEntry) ListItem {
+	details := fmt.Sprintf("%s mode", postModeName(entry.PostMode))
+	if extraDetails := Details(entry.MentionRoleID, entry.Template); extraDetails != "" {
+		details += ", " + extraDetails
+	}
+	if entry.Failures > 0 {
+		details += fmt.Sprintf(", %d failed checks", entry.Failures)
+	}
+	return ListItem{
+		Source:    entry.Source,
+		ID:        helpers.MdbIdToHuman(entry.ID),
+		ChannelID: entry.ChannelID,
+		Name:      entry.TargetName,
+		Details:   details,
+	}
+}
+
+// List returns all feeds of a guild, of all sources, sorted by source and channel
+func List(guildID string) (items []ListItem, err error) {
+	var entries []models.FeedEntry
+	err = helpers.MDbIter(helpers.MdbCollection(models.FeedsTable).Find(bson.M{"guildid": guildID})).All(&entries)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		items = append(items, NewListItem(entry))
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Source != items[j].Source {
+			return items[i].Source < items[j].Source
+		}
+		return items[i].ChannelID < items[j].ChannelID
+	})
+	return items, nil
+}
+
+// checkLoop checks all due feeds of a source, until the bot starts to shut down
+func checkLoop(source Source) {
+	defer helpers.Recover()
+	defer func() {
+		if helpers.IsShuttingDown() {
+			return
+		}
+		checkLoops.Add(1)
+		go func() {
+			defer checkLoops.Done()
+			defer helpers.Recover()
+			logger(source).Error("The checkLoop died. Please investigate! Will be restarted in 60 seconds")
+			if helpers.SleepUnlessShuttingDown(60 * time.Second) {
+				checkLoop(source)
+			}
+		}()
+	}()
+
+	for !helpers.IsShuttingDown() {
+		start := time.Now()
+
+		// every target is only fetched once for all of its feeds
+		var targets []string
+		err := helpers.MdbCollection(models.FeedsTable).Find(
+			bson.M{"source": source.Name(), "nextcheck": bson.M{"$lte": time.Now()}},
+		).Distinct("target", &targets)
+		helpers.Relax(err)
+
+		workers := 1
+		if concurrent, ok := source.(Concurrent); ok && concurrent.Workers() > 1 {
+			workers = concurrent.Workers()
+		}
+
+		jobs := make(chan string)
+		var running sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			running.Add(1)
+			go func() {
+				defer running.Done()
+				for target := range jobs {
+					checkTarget(source, target)
+				}
+			}()
+		}
+		for _, target := range targets {
+			// finish the running checks, the remaining targets are still due after a restart
+			if helpers.IsShuttingDown() {
+				break
+			}
+			jobs <- target
+		}
+		close(jobs)
+		running.Wait()
+
+		if len(targets) > 0 {
+			prom.FeedRefreshDuration.WithLabelValues(source.Name()).Observe(time.Since(start).Seconds())
+		}
+
+		helpers.SleepUnlessShuttingDown(checkTick)
+	}
+}
+
+// Shutdown waits until the running checks of all sources finished
+func Shutdown(ctx context.Context) {
+	if !helpers.WaitWithContext(ctx, &checkLoops) {
+		cache.GetLogger().WithField("module", "feeds").Warn("Shutdown deadline exceeded, not all checks finished")
+	}
+}
+
+// checkTarget fetches a target and posts new posts to all due feeds of the target
+func checkTarget(source Source, target string) {
+	defer helpers.Recover()
+
+	unlock := lockTarget(source.Name(), target)
+	defer unlock()
+
+	// read the feeds while holding the lock, a push could have posted in the meantime
+	var entries []models.FeedEntry
+	err := helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.FeedsTable).Find(
+		bson.M{"source": source.Name(), "target": target, "nextcheck": bson.M{"$lte": time.Now()}},
+	)).All(&entries)
+	helpers.Relax(err)
+	if len(entries) <= 0 {
+		return
+	}
+
+	if checker, ok := source.(TargetChecker); ok {
+		updated, err := checker.CheckTarget(target, entries)
+		if err != nil {
+			checkFailed(source, target, entries, err)
+			return
+		}
+		for _, entry := range updated {
+			checkSucceeded(source, entry)
+		}
+		return
+	}
+
+	// feeds with the same state share a fetch, every feed keeps the state of its own checks
+	for _, stateEntries := range bundleByState(entries) {
+		// a feed which has never been checked needs all current posts
+		state := stateEntries[0].State
+		if stateEntries[0].LastCheck.IsZero() {
+			state = nil
+		}
+
+		posts, newState, err := source.Fetch(target, state)
+		if err != nil {
+			checkFailed(source, target, stateEntries, err)
+			continue
+		}
+
+		// post the oldest posts first
+		sort.SliceStable(posts, func(i, j int) bool {
+			return posts[i].Time.Before(posts[j].Time)
+		})
+
+		for _, entry := range stateEntries {
+			// the first check only remembers the current posts, so adding a feed does not spam the channel
+			postNew(source, &entry, posts, entry.LastCheck.IsZero())
+
+			entry.State = newState
+			checkSucceeded(source, entry)
+		}
+	}
+}
+
+// bundleByState groups feeds by their state, feeds which have never been checked are grouped together
+func bundleByState(entries []models.FeedEntry) (bundles [][]models.FeedEntry) {
+	indexes := make(map[string]int, 0)
+	for _, entry := range entries {
+		key := "new"
+		if !entry.LastCheck.IsZero() {
+			// maps are marshalled with sorted keys
+			marshalled, _ := json.Marshal(entry.State)
+			key = "state" + string(marshalled)
+		}
+
+		index, ok := indexes[key]
+		if !ok {
+			index = len(bundles)
+			indexes[key] = index
+			bundles = append(bundles, nil)
+		}
+		bundles[index] = append(bundles[index], entry)
+	}
+	return bundles
+}
+
+// checkFailed schedules the next check of failed feeds with a backoff
+func checkFailed(source Source, target string, entries []models.FeedEntry, err error) {
+	for _, entry := range entries {
+		entry.Failures++
+		entry.NextCheck = time.Now().Add(backoff(source.Interval(target), entry.Failures))
+		helpers.RelaxLog(helpers.MDbUpdateWithoutLogging(models.FeedsTable, entry.ID, entry))
+	}
+	logger(source).WithField("target", target).Warnf(
+		"checking target failed %d times: %s", entries[0].Failures+1, err.Error(),
+	)
+}
+
+// checkSucceeded stores a checked feed and schedules its next check
+func checkSucceeded(source Source, entry models.FeedEntry) {
+	entry.Failures = 0
+	entry.LastCheck = time.Now()
+	entry.NextCheck = time.Now().Add(source.Interval(entry.Target))
+	helpers.RelaxLog(helpers.MDbUpdateWithoutLogging(models.FeedsTable, entry.ID, entry))
+}
+
+// postNew posts the posts a feed did not post yet, the posts have to be sorted oldest first
+// rememberOnly only remembers the posts without posting them
+func postNew(source Source, entry *models.FeedEntry, posts []Post, rememberOnly bool) {
+	for _, post := range posts {
+		if helpers.StringSliceContains(post.ID, entry.PostedIDs) {
+			continue
+		}
+
+		if !rememberOnly {
+			// posts stay pending until they are old enough
+			if entry.PostDelay > 0 && !post.Time.IsZero() &&
+				time.Since(post.Time) < time.Duration(entry.PostDelay)*time.Minute {
+				continue
+			}
+
+			// posts below the minimum score stay pending until they reach it or get too old
+			if waitsForScore(entry.Filter, post) {
+				continue
+			}
+
+			// filtered posts are remembered as well, so they are not checked again
+			if Matches(entry.Filter, post) {
+				err := Send(source, *entry, post)
+				if err != nil {
+					helpers.RelaxLog(err)
+					// rejected posts, for example because of missing permissions, will not fix themselves,
+					// so only other errors are retried on the next check
+					if !isPermanentSendError(err) {
+						continue
+					}
+				}
+			}
+		}
+
+		entry.PostedIDs = append(entry.PostedIDs, post.ID)
+	}
+
+	if len(entry.PostedIDs) > maxPostedIDs {
+		entry.PostedIDs = entry.PostedIDs[len(entry.PostedIDs)-maxPostedIDs:]
+	}
+}
+
+// Push posts posts a source received without a check, for example from a stream or a push notification, to all
+// feeds of the target which have been checked before, the state and the schedule of the feeds are kept
+func Push(source Source, target string, posts []Post) (err error) {
+	unlock := lockTarget(source.Name(), target)
+	defer unlock()
+
+	var entries []models.FeedEntry
+	err = helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.FeedsTable).Find(
+		bson.M{"source": source.Name(), "target": target, "lastcheck": bson.M{"$gt": time.Time{}}},
+	)).All(&entries)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].Time.Before(posts[j].Time)
+	})
+
+	for _, entry := range entries {
+		postedIDs := len(entry.PostedIDs)
+		postNew(source, &entry, posts, false)
+		if len(entry.PostedIDs) == postedIDs {
+			continue
+		}
+
+		err = helpers.MDbUpdateWithoutLogging(models.FeedsTable, entry.ID, entry)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Send posts a post to the channel of a feed, using the template of the feed or its post mode
+func Send(source Source, entry models.FeedEntry, post Post) (err error) {
+	data := &discordgo.MessageSend{}
+
+	switch entry.PostMode {
+	case models.FeedPostModeLink:
+		data.Content = post.URL
+	case models.FeedPostModeText:
+		var parts []string
+		if post.Title != "" {
+			parts = append(parts, "**"+post.Title+"**")
+		}
+		if post.Text != "" {
+			parts = append(parts, post.Text)
+		}
+		parts = append(parts, "<"+post.URL+">")
+		// the media is linked directly, so Discord shows it
+		parts = append(parts, post.ImageURLs...)
+		if post.VideoURL != "" && post.VideoURL != post.URL {
+			parts = append(parts, post.VideoURL)
+		}
+		data.Content = strings.Join(parts, "\n")
+	default:
+		data.Content = "<" + post.URL + ">"
+		data.Embed = source.Render(entry, post)
+	}
+
+	data = ApplyTemplate(data, entry.Template, entry.TargetName, post)
+	ApplyMentionRole(data, entry.MentionRoleID)
+
+	logger(source).WithField("target", entry.Target).Infof(
+		"posting %s to #%s", post.ID, entry.ChannelID,
+	)
+
+	_, err = helpers.SendComplex(entry.ChannelID, data)
+	return err
+}
+
+// isPermanentSendError returns true if discord rejected a message with a client error other than a rate limit
+func isPermanentSendError(err error) bool {
+	errD, ok := err.(*discordgo.RESTError)
+	if !ok || errD.Response == nil {
+		return false
+	}
+
+	return errD.Response.StatusCode >= 400 && errD.Response.StatusCode < 500 &&
+		errD.Response.StatusCode != http.StatusTooManyRequests
+}
+
+// backoff returns the time until the next check after failures failed checks in a row
+func backoff(interval time.Duration, failures int) time.Duration {
+	wait := interval
+	for i := 0; i < failures && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+	return wait
+}
+
+func postModeName(mode models.FeedPostMode) string {
+	switch mode {
+	case models.FeedPostModeLink:
+		return "link"
+	case models.FeedPostModeText:
+		return "text"
+	}
+	return "embed"
+}
+
+func logger(source Source) *logrus.Entry {
+	return cache.GetLogger().WithField("module", "feeds").WithField("source", source.Name())
+}
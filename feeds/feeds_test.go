@@ -0,0 +1,66 @@
+package feeds
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestBackoff(t *testing.T) {
+	if wait := backoff(5*time.Minute, 0); wait != 5*time.Minute {
+		t.Errorf("expected the interval without failures, got %s", wait)
+	}
+	if wait := backoff(5*time.Minute, 3); wait != 40*time.Minute {
+		t.Errorf("expected 40m after three failures, got %s", wait)
+	}
+	if wait := backoff(5*time.Minute, 100); wait != maxBackoff {
+		t.Errorf("expected the maximum backoff, got %s", wait)
+	}
+}
+
+func TestIsPermanentSendError(t *testing.T) {
+	for status, permanent := range map[int]bool{
+		http.StatusBadRequest:            true,
+		http.StatusForbidden:             true,
+		http.StatusNotFound:              true,
+		http.StatusRequestEntityTooLarge: true,
+		http.StatusTooManyRequests:       false,
+		http.StatusInternalServerError:   false,
+		http.StatusBadGateway:            false,
+	} {
+		err := &discordgo.RESTError{Response: &http.Response{StatusCode: status}}
+		if isPermanentSendError(err) != permanent {
+			t.Errorf("expected permanent to be %t for status %d", permanent, status)
+		}
+	}
+	if isPermanentSendError(errors.New("connection reset")) {
+		t.Error("expected network errors to be retried")
+	}
+}
+
+func TestBundleByState(t *testing.T) {
+	checked := time.Now()
+	bundles := bundleByState([]models.FeedEntry{
+		{Target: "a", LastCheck: checked, State: map[string]string{"etag": "1"}},
+		{Target: "b", LastCheck: checked, State: map[string]string{"etag": "2"}},
+		{Target: "c"},
+		{Target: "d", LastCheck: checked, State: map[string]string{"etag": "1"}},
+		{Target: "e", State: map[string]string{"etag": "1"}},
+	})
+	if len(bundles) != 3 {
+		t.Fatalf("expected three bundles, got %d", len(bundles))
+	}
+	if len(bundles[0]) != 2 || bundles[0][0].Target != "a" || bundles[0][1].Target != "d" {
+		t.Errorf("expected feeds with the same state to be bundled, got %v", bundles[0])
+	}
+	if len(bundles[1]) != 1 || bundles[1][0].Target != "b" {
+		t.Errorf("expected a feed with another state to be checked on its own, got %v", bundles[1])
+	}
+	if len(bundles[2]) != 2 || bundles[2][0].Target != "c" || bundles[2][1].Target != "e" {
+		t.Errorf("expected feeds which have never been checked to be bundled, got %v", bundles[2])
+	}
+}
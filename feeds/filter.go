@@ -0,0 +1,304 @@
+package feeds
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+)
+
+const (
+	// how long posts below the minimum score stay pending, for example until a Reddit submission got enough votes
+	scorePendingMaxAge = 24 * time.Hour
+)
+
+var (
+	ErrFilterInvalid = errors.New("invalid filter setting")
+
+	filterRegexes     = make(map[string]*regexp.Regexp, 0)
+	filterRegexesLock sync.Mutex
+)
+
+// FilterOptions are the filter settings a feed supports besides keywords, regexes and media
+type FilterOptions struct {
+	Score    bool     // minimum score, for example upvotes on Reddit
+	Flair    bool     // Reddit flairs
+	Category bool     // for example Twitch games
+	Types    []string // post types which can be selected, for example vod, live and upcoming on VLive
+}
+
+// waitsForScore returns true if a post only fails the minimum score of the filter and is young enough to reach it
+func waitsForScore(filter models.FeedFilter, post Post) bool {
+	if filter.MinScore <= 0 || post.Score >= filter.MinScore ||
+		post.Time.IsZero() || time.Since(post.Time) >= scorePendingMaxAge {
+		return false
+	}
+
+	filter.MinScore = 0
+	return Matches(filter, post)
+}
+
+// Matches returns true if a post passes the filter of a feed
+func Matches(filter models.FeedFilter, post Post) bool {
+	text := post.Title + "\n" + post.Text
+	lowerText := strings.ToLower(text)
+
+	if len(filter.IncludeKeywords) > 0 {
+		var found bool
+		for _, keyword := range filter.IncludeKeywords {
+			if strings.Contains(lowerText, strings.ToLower(keyword)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, keyword := range filter.ExcludeKeywords {
+		if strings.Contains(lowerText, strings.ToLower(keyword)) {
+			return false
+		}
+	}
+
+	if filter.IncludeRegex != "" {
+		regex := filterRegex(filter.IncludeRegex)
+		if regex == nil || !regex.MatchString(text) {
+			return false
+		}
+	}
+	if filter.ExcludeRegex != "" {
+		regex := filterRegex(filter.ExcludeRegex)
+		if regex != nil && regex.MatchString(text) {
+			return false
+		}
+	}
+
+	hasMedia := len(post.ImageURLs) > 0 || post.VideoURL != ""
+	switch filter.Media {
+	case models.FeedFilterMediaOnly:
+		if !hasMedia {
+			return false
+		}
+	case models.FeedFilterTextOnly:
+		if hasMedia {
+			return false
+		}
+	}
+
+	if filter.MinScore > 0 && post.Score < filter.MinScore {
+		return false
+	}
+
+	if len(filter.Flairs) > 0 {
+		var found bool
+		for _, flair := range filter.Flairs {
+			if strings.EqualFold(flair, post.Flair) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(filter.Categories) > 0 {
+		var found bool
+		for _, category := range filter.Categories {
+			if strings.EqualFold(category, post.Category) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(filter.Types) > 0 && !helpers.StringSliceContains(post.Type, filter.Types) {
+		return false
+	}
+
+	return true
+}
+
+// ApplyFilterArgs changes a filter by the arguments of a filter subcommand, for example include teaser, mv
+// include, exclude, flair, category and types take a comma separated list, without a value they clear the setting
+// include-regex and exclude-regex take a regex, media takes all, media or text, score takes a number, reset clears everything
+func ApplyFilterArgs(filter *models.FeedFilter, options FilterOptions, args []string) (err error) {
+	if len(args) < 1 {
+		return ErrFilterInvalid
+	}
+	value := strings.TrimSpace(strings.Join(args[1:], " "))
+
+	switch strings.ToLower(args[0]) {
+	case "include":
+		filter.IncludeKeywords = splitFilterList(value)
+	case "exclude":
+		filter.ExcludeKeywords = splitFilterList(value)
+	case "include-regex", "exclude-regex":
+		if value != "" {
+			if _, err = regexp.Compile(value); err != nil {
+				return ErrFilterInvalid
+			}
+		}
+		if strings.ToLower(args[0]) == "include-regex" {
+			filter.IncludeRegex = value
+		} else {
+			filter.ExcludeRegex = value
+		}
+	case "media":
+		switch strings.ToLower(value) {
+		case "", "all":
+			filter.Media = models.FeedFilterMediaAll
+		case "media":
+			filter.Media = models.FeedFilterMediaOnly
+		case "text":
+			filter.Media = models.FeedFilterTextOnly
+		default:
+			return ErrFilterInvalid
+		}
+	case "score":
+		if !options.Score {
+			return ErrFilterInvalid
+		}
+		var score int
+		if value != "" {
+			score, err = strconv.Atoi(value)
+			if err != nil || score < 0 {
+				return ErrFilterInvalid
+			}
+		}
+		filter.MinScore = score
+	case "flair", "flairs":
+		if !options.Flair {
+			return ErrFilterInvalid
+		}
+		filter.Flairs = splitFilterList(value)
+	case "category", "categories", "game", "games":
+		if !options.Category {
+			return ErrFilterInvalid
+		}
+		filter.Categories = splitFilterList(value)
+	case "type", "types":
+		if len(options.Types) <= 0 {
+			return ErrFilterInvalid
+		}
+		types := splitFilterList(strings.ToLower(value))
+		for _, postType := range types {
+			if !helpers.StringSliceContains(postType, options.Types) {
+				return ErrFilterInvalid
+			}
+		}
+		filter.Types = types
+	case "reset":
+		*filter = models.FeedFilter{}
+	default:
+		return ErrFilterInvalid
+	}
+	return nil
+}
+
+// DescribeFilter returns a human readable description of a filter, one setting per line, or an empty string if nothing is filtered
+func DescribeFilter(filter models.FeedFilter) (text string) {
+	if len(filter.IncludeKeywords) > 0 {
+		text += fmt.Sprintf("Include keywords: `%s`\n", strings.Join(filter.IncludeKeywords, "`, `"))
+	}
+	if len(filter.ExcludeKeywords) > 0 {
+		text += fmt.Sprintf("Exclude keywords: `%s`\n", strings.Join(filter.ExcludeKeywords, "`, `"))
+	}
+	if filter.IncludeRegex != "" {
+		text += fmt.Sprintf("Include regex: `%s`\n", filter.IncludeRegex)
+	}
+	if filter.ExcludeRegex != "" {
+		text += fmt.Sprintf("Exclude regex: `%s`\n", filter.ExcludeRegex)
+	}
+	switch filter.Media {
+	case models.FeedFilterMediaOnly:
+		text += "Only posts with media\n"
+	case models.FeedFilterTextOnly:
+		text += "Only posts without media\n"
+	}
+	if filter.MinScore > 0 {
+		text += fmt.Sprintf("Minimum score: %d\n", filter.MinScore)
+	}
+	if len(filter.Flairs) > 0 {
+		text += fmt.Sprintf("Flairs: `%s`\n", strings.Join(filter.Flairs, "`, `"))
+	}
+	if len(filter.Categories) > 0 {
+		text += fmt.Sprintf("Categories: `%s`\n", strings.Join(filter.Categories, "`, `"))
+	}
+	if len(filter.Types) > 0 {
+		text += fmt.Sprintf("Types: `%s`\n", strings.Join(filter.Types, "`, `"))
+	}
+	return text
+}
+
+// FilterCommand handles the settings of a filter subcommand after the feed ID and returns the reply
+// without settings it describes the current filter, otherwise it changes the filter and changed is true
+func FilterCommand(locale string, filter *models.FeedFilter, options FilterOptions, name string, args []string) (reply string, changed bool) {
+	if len(args) <= 0 {
+		description := DescribeFilter(*filter)
+		if description == "" {
+			return helpers.GetTextLF(locale, "plugins.feeds.filter-none", name), false
+		}
+		return helpers.GetTextLF(locale, "plugins.feeds.filter-status", name, description), false
+	}
+
+	err := ApplyFilterArgs(filter, options, args)
+	if err != nil {
+		var additionalSettings string
+		if options.Score {
+			additionalSettings += ", `score <number>`"
+		}
+		if options.Flair {
+			additionalSettings += ", `flair <flairs>`"
+		}
+		if options.Category {
+			additionalSettings += ", `category <categories>`"
+		}
+		if len(options.Types) > 0 {
+			additionalSettings += ", `types <" + strings.Join(options.Types, ", ") + ">`"
+		}
+		return helpers.GetTextLF(locale, "plugins.feeds.filter-invalid", additionalSettings), false
+	}
+
+	description := DescribeFilter(*filter)
+	if description == "" {
+		description = helpers.GetTextL(locale, "plugins.feeds.filter-everything")
+	}
+	return helpers.GetTextLF(locale, "plugins.feeds.filter-set-success", name, description), true
+}
+
+func splitFilterList(value string) (list []string) {
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// filterRegex returns the compiled regex, regexes are validated when they are set, so invalid ones are nil
+func filterRegex(expression string) *regexp.Regexp {
+	filterRegexesLock.Lock()
+	defer filterRegexesLock.Unlock()
+
+	if regex, ok := filterRegexes[expression]; ok {
+		return regex
+	}
+	regex, err := regexp.Compile(expression)
+	if err != nil {
+		regex = nil
+	}
+	filterRegexes[expression] = regex
+	return regex
+}
@@ -0,0 +1,106 @@
+package feeds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/models"
+)
+
+func TestMatches(t *testing.T) {
+	post := Post{
+		Title:     "[MV] New Song",
+		Text:      "Official music video",
+		ImageURLs: []string{"https://example.com/thumbnail.jpg"},
+		Score:     120,
+		Flair:     "Music Video",
+		Category:  "Just Chatting",
+		Type:      "vod",
+	}
+
+	tests := []struct {
+		name   string
+		filter models.FeedFilter
+		want   bool
+	}{
+		{"empty filter", models.FeedFilter{}, true},
+		{"include keyword", models.FeedFilter{IncludeKeywords: []string{"teaser", "mv"}}, true},
+		{"include keyword missing", models.FeedFilter{IncludeKeywords: []string{"teaser"}}, false},
+		{"exclude keyword", models.FeedFilter{ExcludeKeywords: []string{"official"}}, false},
+		{"include regex", models.FeedFilter{IncludeRegex: `^\[MV\]`}, true},
+		{"exclude regex", models.FeedFilter{ExcludeRegex: `(?i)music`}, false},
+		{"media only", models.FeedFilter{Media: models.FeedFilterMediaOnly}, true},
+		{"text only", models.FeedFilter{Media: models.FeedFilterTextOnly}, false},
+		{"score reached", models.FeedFilter{MinScore: 100}, true},
+		{"score too low", models.FeedFilter{MinScore: 500}, false},
+		{"flair", models.FeedFilter{Flairs: []string{"music video"}}, true},
+		{"other flair", models.FeedFilter{Flairs: []string{"Discussion"}}, false},
+		{"category", models.FeedFilter{Categories: []string{"just chatting", "Music"}}, true},
+		{"other category", models.FeedFilter{Categories: []string{"Music"}}, false},
+		{"type", models.FeedFilter{Types: []string{"live", "vod"}}, true},
+		{"other type", models.FeedFilter{Types: []string{"upcoming"}}, false},
+	}
+
+	for _, test := range tests {
+		if got := Matches(test.filter, post); got != test.want {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestWaitsForScore(t *testing.T) {
+	post := Post{
+		Title: "Discussion",
+		Score: 10,
+		Time:  time.Now().Add(-time.Hour),
+	}
+	oldPost := post
+	oldPost.Time = time.Now().Add(-2 * scorePendingMaxAge)
+
+	tests := []struct {
+		name   string
+		filter models.FeedFilter
+		post   Post
+		want   bool
+	}{
+		{"no minimum score", models.FeedFilter{}, post, false},
+		{"score reached", models.FeedFilter{MinScore: 5}, post, false},
+		{"score too low", models.FeedFilter{MinScore: 50}, post, true},
+		{"score too low and too old", models.FeedFilter{MinScore: 50}, oldPost, false},
+		{"score too low and filtered", models.FeedFilter{MinScore: 50, ExcludeKeywords: []string{"discussion"}}, post, false},
+	}
+
+	for _, test := range tests {
+		if got := waitsForScore(test.filter, test.post); got != test.want {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestApplyFilterArgs(t *testing.T) {
+	var filter models.FeedFilter
+
+	if err := ApplyFilterArgs(&filter, FilterOptions{}, []string{"include", "dance", "practice,", "mv"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(filter.IncludeKeywords) != 2 || filter.IncludeKeywords[0] != "dance practice" || filter.IncludeKeywords[1] != "mv" {
+		t.Errorf("unexpected include keywords: %#v", filter.IncludeKeywords)
+	}
+
+	if err := ApplyFilterArgs(&filter, FilterOptions{}, []string{"include-regex", "("}); err != ErrFilterInvalid {
+		t.Errorf("expected an invalid regex to be rejected, got %v", err)
+	}
+	if err := ApplyFilterArgs(&filter, FilterOptions{}, []string{"score", "10"}); err != ErrFilterInvalid {
+		t.Errorf("expected score to be rejected without the option, got %v", err)
+	}
+	if err := ApplyFilterArgs(&filter, FilterOptions{Types: []string{"vod", "live"}}, []string{"types", "VOD,", "upcoming"}); err != ErrFilterInvalid {
+		t.Errorf("expected an unknown type to be rejected, got %v", err)
+	}
+
+	if err := ApplyFilterArgs(&filter, FilterOptions{}, []string{"include"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(filter.IncludeKeywords) != 0 {
+		t.Errorf("expected include keywords to be cleared, got %#v", filter.IncludeKeywords)
+	}
+}
@@ -0,0 +1,389 @@
+// Package rss parses RSS 2.0, RSS 1.0, Atom and JSON Feed documents and provides them as a feeds.Source.
+package rss
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown feed format")
+
+	htmlTagRegex   = regexp.MustCompile(`<[^>]*>`)
+	htmlImageRegex = regexp.MustCompile(`<img[^>]+src="([^"]+)"`)
+
+	dateLayouts = []string{
+		time.RFC1123Z,
+		time.RFC1123,
+		time.RFC3339,
+		time.RFC822Z,
+		time.RFC822,
+		"Mon, 2 Jan 2006 15:04:05 -0700",
+		"Mon, 2 Jan 2006 15:04:05 MST",
+		"2 Jan 2006 15:04:05 -0700",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02",
+	}
+)
+
+// Feed is a parsed feed of any format
+type Feed struct {
+	Title string
+	Link  string
+	Items []Item
+}
+
+// Item is a parsed item of any format
+type Item struct {
+	GUID      string
+	Link      string
+	Title     string
+	Text      string // without HTML
+	Author    string
+	ImageURL  string
+	Published time.Time
+}
+
+// ID returns the ID used for deduplication, the GUID, the link or the title
+func (i Item) ID() string {
+	if i.GUID != "" {
+		return i.GUID
+	}
+	if i.Link != "" {
+		return i.Link
+	}
+	return i.Title
+}
+
+type rssDocument struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Link  string    `xml:"link"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	Items []rssItem `xml:"item"` // RSS 1.0 items are next to the channel
+}
+
+type rssItem struct {
+	GUID        string `xml:"guid"`
+	About       string `xml:"http://www.w3.org/1999/02/22-rdf-syntax-ns# about,attr"`
+	Link        string `xml:"link"`
+	Title       string `xml:"title"`
+	Description string `xml:"description"`
+	Content     string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Author      string `xml:"author"`
+	Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	PubDate     string `xml:"pubDate"`
+	Date        string `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Enclosures  []struct {
+		URL  string `xml:"url,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"enclosure"`
+	MediaContents []struct {
+		URL    string `xml:"url,attr"`
+		Medium string `xml:"medium,attr"`
+	} `xml:"http://search.yahoo.com/mrss/ content"`
+	MediaThumbnails []struct {
+		URL string `xml:"url,attr"`
+	} `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+}
+
+type atomDocument struct {
+	Title   string      `xml:"title"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Links     []atomLink `xml:"link"`
+	Summary   string     `xml:"summary"`
+	Content   string     `xml:"content"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Authors   []struct {
+		Name string `xml:"name"`
+	} `xml:"author"`
+	MediaThumbnails []struct {
+		URL string `xml:"url,attr"`
+	} `xml:"http://search.yahoo.com/mrss/ group>thumbnail"`
+}
+
+type jsonFeedDocument struct {
+	Version     string `json:"version"`
+	Title       string `json:"title"`
+	HomePageURL string `json:"home_page_url"`
+	Items       []struct {
+		ID            json.RawMessage  `json:"id"` // string in version 1.1, number in some version 1 feeds
+		URL           string           `json:"url"`
+		Title         string           `json:"title"`
+		ContentText   string           `json:"content_text"`
+		ContentHTML   string           `json:"content_html"`
+		Summary       string           `json:"summary"`
+		Image         string           `json:"image"`
+		BannerImage   string           `json:"banner_image"`
+		DatePublished string           `json:"date_published"`
+		Author        *jsonFeedAuthor  `json:"author"`
+		Authors       []jsonFeedAuthor `json:"authors"`
+	} `json:"items"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+// Parse parses a RSS 2.0, RSS 1.0, Atom or JSON Feed document
+func Parse(data []byte) (feed Feed, err error) {
+	trimmedData := bytes.TrimSpace(data)
+	if len(trimmedData) <= 0 {
+		return feed, ErrUnknownFormat
+	}
+
+	if trimmedData[0] == '{' {
+		return parseJSONFeed(trimmedData)
+	}
+
+	rootName, err := xmlRootName(trimmedData)
+	if err != nil {
+		return feed, err
+	}
+
+	switch strings.ToLower(rootName) {
+	case "rss", "rdf":
+		return parseRSS(trimmedData)
+	case "feed":
+		return parseAtom(trimmedData)
+	}
+	return feed, ErrUnknownFormat
+}
+
+func parseRSS(data []byte) (feed Feed, err error) {
+	var document rssDocument
+	err = newXMLDecoder(data).Decode(&document)
+	if err != nil {
+		return feed, err
+	}
+
+	feed.Title = strings.TrimSpace(document.Channel.Title)
+	feed.Link = strings.TrimSpace(document.Channel.Link)
+
+	for _, rssItem := range append(document.Channel.Items, document.Items...) {
+		item := Item{
+			GUID:   strings.TrimSpace(rssItem.GUID),
+			Link:   strings.TrimSpace(rssItem.Link),
+			Title:  cleanText(rssItem.Title),
+			Author: strings.TrimSpace(rssItem.Author),
+		}
+		if item.GUID == "" {
+			item.GUID = strings.TrimSpace(rssItem.About)
+		}
+		if item.Author == "" {
+			item.Author = strings.TrimSpace(rssItem.Creator)
+		}
+
+		description := rssItem.Description
+		if description == "" {
+			description = rssItem.Content
+		}
+		item.Text = cleanText(description)
+
+		item.Published = parseDate(rssItem.PubDate)
+		if item.Published.IsZero() {
+			item.Published = parseDate(rssItem.Date)
+		}
+
+		for _, enclosure := range rssItem.Enclosures {
+			if strings.HasPrefix(enclosure.Type, "image/") {
+				item.ImageURL = enclosure.URL
+				break
+			}
+		}
+		for _, mediaContent := range rssItem.MediaContents {
+			if item.ImageURL == "" && (mediaContent.Medium == "image" || mediaContent.Medium == "") {
+				item.ImageURL = mediaContent.URL
+			}
+		}
+		if item.ImageURL == "" && len(rssItem.MediaThumbnails) > 0 {
+			item.ImageURL = rssItem.MediaThumbnails[0].URL
+		}
+		if item.ImageURL == "" {
+			item.ImageURL = firstImage(rssItem.Description + rssItem.Content)
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+	return feed, nil
+}
+
+func parseAtom(data []byte) (feed Feed, err error) {
+	var document atomDocument
+	err = newXMLDecoder(data).Decode(&document)
+	if err != nil {
+		return feed, err
+	}
+
+	feed.Title = cleanText(document.Title)
+	feed.Link = atomAlternateLink(document.Links)
+
+	for _, entry := range document.Entries {
+		item := Item{
+			GUID:  strings.TrimSpace(entry.ID),
+			Link:  atomAlternateLink(entry.Links),
+			Title: cleanText(entry.Title),
+		}
+		if len(entry.Authors) > 0 {
+			item.Author = strings.TrimSpace(entry.Authors[0].Name)
+		}
+
+		text := entry.Summary
+		if text == "" {
+			text = entry.Content
+		}
+		item.Text = cleanText(text)
+
+		item.Published = parseDate(entry.Published)
+		if item.Published.IsZero() {
+			item.Published = parseDate(entry.Updated)
+		}
+
+		for _, link := range entry.Links {
+			if link.Rel == "enclosure" && strings.HasPrefix(link.Type, "image/") {
+				item.ImageURL = link.Href
+				break
+			}
+		}
+		if item.ImageURL == "" && len(entry.MediaThumbnails) > 0 {
+			item.ImageURL = entry.MediaThumbnails[0].URL
+		}
+		if item.ImageURL == "" {
+			item.ImageURL = firstImage(entry.Summary + entry.Content)
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+	return feed, nil
+}
+
+func parseJSONFeed(data []byte) (feed Feed, err error) {
+	var document jsonFeedDocument
+	err = json.Unmarshal(data, &document)
+	if err != nil {
+		return feed, err
+	}
+	if !strings.HasPrefix(document.Version, "https://jsonfeed.org/version/") {
+		return feed, ErrUnknownFormat
+	}
+
+	feed.Title = strings.TrimSpace(document.Title)
+	feed.Link = strings.TrimSpace(document.HomePageURL)
+
+	for _, jsonItem := range document.Items {
+		item := Item{
+			GUID:      strings.Trim(string(jsonItem.ID), "\" "),
+			Link:      strings.TrimSpace(jsonItem.URL),
+			Title:     cleanText(jsonItem.Title),
+			ImageURL:  jsonItem.Image,
+			Published: parseDate(jsonItem.DatePublished),
+		}
+		if jsonItem.Author != nil {
+			item.Author = strings.TrimSpace(jsonItem.Author.Name)
+		} else if len(jsonItem.Authors) > 0 {
+			item.Author = strings.TrimSpace(jsonItem.Authors[0].Name)
+		}
+
+		switch {
+		case jsonItem.Summary != "":
+			item.Text = cleanText(jsonItem.Summary)
+		case jsonItem.ContentText != "":
+			item.Text = strings.TrimSpace(jsonItem.ContentText)
+		default:
+			item.Text = cleanText(jsonItem.ContentHTML)
+		}
+
+		if item.ImageURL == "" {
+			item.ImageURL = jsonItem.BannerImage
+		}
+		if item.ImageURL == "" {
+			item.ImageURL = firstImage(jsonItem.ContentHTML)
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+	return feed, nil
+}
+
+// xmlRootName returns the local name of the first element of a XML document
+func xmlRootName(data []byte) (name string, err error) {
+	decoder := newXMLDecoder(data)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", ErrUnknownFormat
+		}
+		if element, ok := token.(xml.StartElement); ok {
+			return element.Name.Local, nil
+		}
+	}
+}
+
+func newXMLDecoder(data []byte) *xml.Decoder {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// many feeds are not strictly valid XML, for example because of HTML entities
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	decoder.CharsetReader = charset.NewReaderLabel
+	return decoder
+}
+
+func atomAlternateLink(links []atomLink) string {
+	for _, link := range links {
+		if link.Rel == "" || link.Rel == "alternate" {
+			return strings.TrimSpace(link.Href)
+		}
+	}
+	return ""
+}
+
+// cleanText removes HTML tags and entities and collapses whitespace
+func cleanText(text string) string {
+	text = htmlTagRegex.ReplaceAllString(text, " ")
+	text = html.UnescapeString(text)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func firstImage(htmlText string) string {
+	matches := htmlImageRegex.FindStringSubmatch(htmlText)
+	if len(matches) < 2 {
+		return ""
+	}
+	return html.UnescapeString(matches[1])
+}
+
+func parseDate(text string) time.Time {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return time.Time{}
+	}
+	for _, layout := range dateLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			return parsed
+		}
+	}
+	return time.Time{}
+}
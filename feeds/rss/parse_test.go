@@ -0,0 +1,96 @@
+package rss
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func parseFixture(t *testing.T, name string) Feed {
+	data, err := ioutil.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	feed, err := Parse(data)
+	if err != nil {
+		t.Fatalf("parsing %s failed: %s", name, err.Error())
+	}
+	return feed
+}
+
+func TestParseRSS2(t *testing.T) {
+	feed := parseFixture(t, "rss2.xml")
+
+	if feed.Title != "Fan Site News" || feed.Link != "https://fansite.example.com/" {
+		t.Errorf("unexpected feed: %q %q", feed.Title, feed.Link)
+	}
+	if len(feed.Items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(feed.Items))
+	}
+
+	item := feed.Items[0]
+	if item.ID() != "post-2" || item.Title != "Comeback & Teaser" || item.Text != "The teaser is out!" ||
+		item.Author != "admin" || item.ImageURL != "https://fansite.example.com/teaser.jpg" {
+		t.Errorf("unexpected first item: %#v", item)
+	}
+	if !item.Published.Equal(time.Date(2018, 10, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected date: %s", item.Published)
+	}
+
+	item = feed.Items[1]
+	if item.ID() != "https://fansite.example.com/posts/1" || item.Text != "This week's schedule" ||
+		item.ImageURL != "https://fansite.example.com/schedule.png" {
+		t.Errorf("unexpected second item: %#v", item)
+	}
+}
+
+func TestParseRSS1(t *testing.T) {
+	feed := parseFixture(t, "rss1.xml")
+
+	if feed.Title != "Old Blog" || len(feed.Items) != 1 {
+		t.Fatalf("unexpected feed: %#v", feed)
+	}
+	item := feed.Items[0]
+	if item.ID() != "https://blog.example.com/entry/1" || item.Title != "Café review" || item.Published.IsZero() {
+		t.Errorf("unexpected item: %#v", item)
+	}
+}
+
+func TestParseAtom(t *testing.T) {
+	feed := parseFixture(t, "atom.xml")
+
+	if feed.Title != "Example News" || feed.Link != "https://news.example.com/" || len(feed.Items) != 1 {
+		t.Fatalf("unexpected feed: %#v", feed)
+	}
+	item := feed.Items[0]
+	if item.ID() != "tag:news.example.com,2018:win" || item.Link != "https://news.example.com/articles/win" ||
+		item.Text != "First win!" || item.Author != "Reporter" || item.ImageURL != "https://news.example.com/win.jpg" {
+		t.Errorf("unexpected item: %#v", item)
+	}
+	if !item.Published.Equal(time.Date(2018, 10, 2, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the published date, got %s", item.Published)
+	}
+}
+
+func TestParseJSONFeed(t *testing.T) {
+	feed := parseFixture(t, "feed.json")
+
+	if feed.Title != "JSON Blog" || len(feed.Items) != 2 {
+		t.Fatalf("unexpected feed: %#v", feed)
+	}
+	if item := feed.Items[0]; item.ID() != "2" || item.Text != "Hello" || item.Author != "Writer" ||
+		item.ImageURL != "https://json.example.com/2.png" {
+		t.Errorf("unexpected first item: %#v", item)
+	}
+	if item := feed.Items[1]; item.ID() != "1" || item.Text != "First post without a title" {
+		t.Errorf("unexpected second item: %#v", item)
+	}
+}
+
+func TestParseUnknown(t *testing.T) {
+	for _, data := range []string{"", "<html><body>no feed</body></html>", `{"title": "not a feed"}`} {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("expected an error for %q", data)
+		}
+	}
+}
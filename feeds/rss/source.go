@@ -0,0 +1,248 @@
+package rss
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/feeds"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	rssColor = "F26522"
+	// feeds larger than this are not parsed
+	maxFeedSize = 5 * 1024 * 1024
+	// the longest text posted in an embed
+	maxTextLength = 500
+
+	stateETag         = "etag"
+	stateLastModified = "last-modified"
+)
+
+var (
+	ErrInvalidURL       = errors.New("invalid feed url")
+	ErrForbiddenAddress = errors.New("feed url points to a private address")
+	ErrFeedTooLarge     = errors.New("feed is too large")
+
+	// reserved ranges which are not covered by the net.IP predicates
+	forbiddenNetworks = parseNetworks(
+		"0.0.0.0/8",       // this network
+		"100.64.0.0/10",   // carrier-grade NAT
+		"192.0.0.0/24",    // IETF protocol assignments
+		"192.0.2.0/24",    // documentation
+		"192.88.99.0/24",  // 6to4 relay anycast
+		"198.18.0.0/15",   // benchmarking
+		"198.51.100.0/24", // documentation
+		"203.0.113.0/24",  // documentation
+		"240.0.0.0/4",     // reserved, including the limited broadcast address
+		"64:ff9b::/96",    // NAT64, can point at private IPv4 addresses
+		"64:ff9b:1::/48",  // local NAT64
+		"100::/64",        // discard
+		"2001::/23",       // IETF protocol assignments, including Teredo
+		"2001:db8::/32",   // documentation
+		"2002::/16",       // 6to4, can point at private IPv4 addresses
+	)
+)
+
+// Source is the feeds.Source for RSS, Atom and JSON Feed documents
+type Source struct {
+	Client *http.Client
+}
+
+// New returns a source with an HTTP client which only connects to public addresses
+func New() *Source {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: rejectForbiddenAddress,
+	}
+	return &Source{Client: &http.Client{
+		Timeout: 15 * time.Second,
+		// no proxy, the checked address has to be the address of the feed
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}}
+}
+
+// rejectForbiddenAddress is called for every connection after the host has been resolved,
+// so neither the URL nor redirects or DNS answers can point the requests at the internal network
+func rejectForbiddenAddress(network, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || isForbiddenIP(ip) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// isForbiddenIP returns true for loopback, private, link-local and other addresses which are not on the internet
+func isForbiddenIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return true
+	}
+
+	for _, network := range forbiddenNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseNetworks parses CIDR notations, panics on invalid ones
+func parseNetworks(cidrs ...string) (networks []*net.IPNet) {
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func (s *Source) Name() string {
+	return "rss"
+}
+
+func (s *Source) Interval(target string) time.Duration {
+	return 10 * time.Minute
+}
+
+// Resolve checks that the URL is a feed and returns the feed title as name
+func (s *Source) Resolve(target string) (normalised, name string, err error) {
+	target = strings.Trim(strings.TrimSpace(target), "<>")
+	feedURL, err := url.Parse(target)
+	if err != nil || (feedURL.Scheme != "http" && feedURL.Scheme != "https") || feedURL.Host == "" {
+		return "", "", ErrInvalidURL
+	}
+	normalised = feedURL.String()
+
+	feed, _, err := s.fetch(normalised, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	name = feed.Title
+	if name == "" {
+		name = feedURL.Host
+	}
+	return normalised, name, nil
+}
+
+// Fetch requests the feed, if the ETag or Last-Modified of the last check are known the request is conditional
+func (s *Source) Fetch(target string, state map[string]string) (posts []feeds.Post, newState map[string]string, err error) {
+	feed, newState, err := s.fetch(target, state)
+	if err != nil {
+		return nil, state, err
+	}
+
+	// feeds list the newest items first, reversing them keeps items without a date in the right order
+	for i := len(feed.Items) - 1; i >= 0; i-- {
+		posts = append(posts, itemToPost(feed, feed.Items[i]))
+	}
+	return posts, newState, nil
+}
+
+// Render creates an embed for an item
+func (s *Source) Render(entry models.FeedEntry, post feeds.Post) *discordgo.MessageEmbed {
+	embed := feeds.Embed(post)
+	embed.Color = helpers.GetDiscordColorFromHex(rssColor)
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: entry.TargetName}
+	return embed
+}
+
+// fetch requests and parses a feed, returns an empty feed if it has not been modified since the last check
+func (s *Source) fetch(target string, state map[string]string) (feed Feed, newState map[string]string, err error) {
+	request, err := http.NewRequest("GET", target, nil)
+	if err != nil {
+		return feed, state, err
+	}
+	request.Header.Set("User-Agent", helpers.DEFAULT_UA)
+	request.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/feed+json, application/json, application/xml;q=0.9, text/xml;q=0.9, */*;q=0.8")
+	if state[stateETag] != "" {
+		request.Header.Set("If-None-Match", state[stateETag])
+	}
+	if state[stateLastModified] != "" {
+		request.Header.Set("If-Modified-Since", state[stateLastModified])
+	}
+
+	response, err := s.Client.Do(request)
+	if err != nil {
+		return feed, state, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotModified {
+		return feed, state, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return feed, state, fmt.Errorf("expected status 200; got %d", response.StatusCode)
+	}
+
+	if response.ContentLength > maxFeedSize {
+		return feed, state, ErrFeedTooLarge
+	}
+	// one byte more than allowed, to notice feeds which are too large without a Content-Length
+	data, err := ioutil.ReadAll(io.LimitReader(response.Body, maxFeedSize+1))
+	if err != nil {
+		return feed, state, err
+	}
+	if len(data) > maxFeedSize {
+		return feed, state, ErrFeedTooLarge
+	}
+
+	feed, err = Parse(data)
+	if err != nil {
+		return feed, state, err
+	}
+
+	newState = make(map[string]string, 0)
+	if etag := response.Header.Get("ETag"); etag != "" {
+		newState[stateETag] = etag
+	}
+	if lastModified := response.Header.Get("Last-Modified"); lastModified != "" {
+		newState[stateLastModified] = lastModified
+	}
+	return feed, newState, nil
+}
+
+func itemToPost(feed Feed, item Item) (post feeds.Post) {
+	post = feeds.Post{
+		ID:     item.ID(),
+		URL:    item.Link,
+		Title:  item.Title,
+		Text:   item.Text,
+		Author: item.Author,
+		Type:   "post",
+		Time:   item.Published,
+	}
+	if post.URL == "" {
+		post.URL = feed.Link
+	}
+	if text := []rune(post.Text); len(text) > maxTextLength {
+		post.Text = string(text[0:maxTextLength-1]) + "…"
+	}
+	if item.ImageURL != "" {
+		post.ImageURLs = []string{item.ImageURL}
+	}
+	return post
+}
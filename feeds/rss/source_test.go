@@ -0,0 +1,130 @@
+package rss
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchConditional(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Tue, 02 Oct 2018 10:00:00 GMT")
+		http.ServeFile(w, r, "testdata/rss2.xml")
+	}))
+	defer server.Close()
+
+	// the test server listens on a loopback address, which the client of New rejects
+	source := &Source{Client: server.Client()}
+
+	normalised, name, err := source.Resolve("<" + server.URL + ">")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if normalised != server.URL || name != "Fan Site News" {
+		t.Errorf("unexpected resolved feed: %q %q", normalised, name)
+	}
+
+	posts, state, err := source.Fetch(normalised, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 2 || posts[0].ID != "https://fansite.example.com/posts/1" || posts[1].ID != "post-2" {
+		t.Errorf("expected both items oldest first, got %#v", posts)
+	}
+	if state[stateETag] != `"v1"` || state[stateLastModified] == "" {
+		t.Errorf("expected the ETag and Last-Modified in the state, got %#v", state)
+	}
+
+	posts, newState, err := source.Fetch(normalised, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 0 || newState[stateETag] != `"v1"` {
+		t.Errorf("expected no posts and the same state for an unmodified feed, got %#v %#v", posts, newState)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestResolveInvalid(t *testing.T) {
+	for _, target := range []string{"ftp://example.com/feed", "not a url", "https://"} {
+		if _, _, err := New().Resolve(target); err != ErrInvalidURL {
+			t.Errorf("expected ErrInvalidURL for %q, got %v", target, err)
+		}
+	}
+}
+
+func TestResolveForbiddenAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "testdata/rss2.xml")
+	}))
+	defer server.Close()
+
+	if _, _, err := New().Resolve(server.URL); !errors.Is(err, ErrForbiddenAddress) {
+		t.Errorf("expected ErrForbiddenAddress for %q, got %v", server.URL, err)
+	}
+}
+
+func TestIsForbiddenIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"10.1.2.3", true},
+		{"172.16.0.1", true},
+		{"192.168.1.1", true},
+		{"169.254.169.254", true},
+		{"fe80::1", true},
+		{"fd00::1", true},
+		{"0.0.0.0", true},
+		{"::ffff:127.0.0.1", true},
+		{"0.1.2.3", true},
+		{"100.64.0.1", true},
+		{"::ffff:100.64.0.1", true},
+		{"192.0.0.8", true},
+		{"198.18.0.1", true},
+		{"203.0.113.5", true},
+		{"255.255.255.255", true},
+		{"64:ff9b::a00:1", true},
+		{"2001:db8::1", true},
+		{"2002:a00:1::1", true},
+		{"100.128.0.1", false},
+		{"93.184.216.34", false},
+		{"2606:2800:220:1:248:1893:25c8:1946", false},
+	}
+
+	for _, test := range tests {
+		if got := isForbiddenIP(net.ParseIP(test.ip)); got != test.want {
+			t.Errorf("%s: expected %v, got %v", test.ip, test.want, got)
+		}
+	}
+}
+
+func TestFetchTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// without a Content-Length, so the size is only known after reading the body
+		w.(http.Flusher).Flush()
+		w.Write([]byte("<rss><channel><title>"))
+		w.Write([]byte(strings.Repeat("a", maxFeedSize)))
+		w.Write([]byte("</title></channel></rss>"))
+	}))
+	defer server.Close()
+
+	source := &Source{Client: server.Client()}
+	if _, _, err := source.Fetch(server.URL, nil); err != ErrFeedTooLarge {
+		t.Errorf("expected ErrFeedTooLarge, got %v", err)
+	}
+}
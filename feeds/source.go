@@ -0,0 +1,174 @@
+// Package feeds is a framework for social media integrations.
+// Sources fetch and normalise posts and render them as embeds, the package handles scheduling with backoff,
+// deduplication, mention roles, post modes and listing of all feeds.
+package feeds
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Post is a normalised post of any source
+type Post struct {
+	ID            string // unique for the target, used for deduplication
+	URL           string
+	Title         string
+	Text          string
+	Author        string
+	AuthorURL     string
+	AuthorIconURL string
+	ImageURLs     []string
+	VideoURL      string
+	Type          string // source specific, for example post, video or live
+	Score         int    // for example the upvotes on Reddit
+	Flair         string
+	Category      string // for example the game on Twitch
+	Time          time.Time
+	Raw           interface{} // the original post of the source for Render, it is not stored
+}
+
+// Source is implemented by every feed integration
+type Source interface {
+	// Name is the unique lowercase name of the source, for example rss
+	Name() string
+
+	// Interval is the time between two checks of the target, if the checks succeed
+	Interval(target string) time.Duration
+
+	// Resolve validates a target when a feed is added, returns the normalised target and a human readable name
+	Resolve(target string) (normalised, name string, err error)
+
+	// Fetch fetches the latest posts of a target, normalised and in any order
+	// state is the source specific state of the last check, the returned state will be stored for the next check
+	Fetch(target string, state map[string]string) (posts []Post, newState map[string]string, err error)
+
+	// Render creates the embed for a post
+	Render(entry models.FeedEntry, post Post) *discordgo.MessageEmbed
+}
+
+// TargetChecker is implemented by sources which do more than posting new posts, for example editing the message
+// of a running stream. CheckTarget replaces fetching and posting for all due feeds of a target and returns the
+// changed feeds, scheduling, the backoff and saving the feeds are still handled by the package
+type TargetChecker interface {
+	CheckTarget(target string, entries []models.FeedEntry) (updated []models.FeedEntry, err error)
+}
+
+// Filterable is implemented by sources which support filter settings besides keywords, regexes and media
+type Filterable interface {
+	FilterOptions() FilterOptions
+}
+
+// Concurrent is implemented by sources which check multiple targets at the same time, for example because every
+// check needs several slow requests
+type Concurrent interface {
+	Workers() int
+}
+
+// ListItem is a feed shown in the feeds list
+type ListItem struct {
+	Source    string
+	ID        string
+	ChannelID string
+	Name      string
+	Details   string
+}
+
+var (
+	sources     = make(map[string]Source, 0)
+	sourcesLock sync.RWMutex
+	// one lock per source and target, so checks and pushes of a target do not post the same post twice
+	targetLocks     = make(map[string]*sync.Mutex, 0)
+	targetLocksLock sync.Mutex
+	// running check loops, waited for by Shutdown
+	checkLoops sync.WaitGroup
+)
+
+// Register adds a source and starts checking its feeds
+func Register(source Source) error {
+	sourcesLock.Lock()
+	defer sourcesLock.Unlock()
+
+	if _, ok := sources[source.Name()]; ok {
+		return fmt.Errorf("feed source %s is already registered", source.Name())
+	}
+	sources[source.Name()] = source
+
+	checkLoops.Add(1)
+	go func() {
+		defer checkLoops.Done()
+		defer helpers.Recover()
+		checkLoop(source)
+	}()
+	return nil
+}
+
+// GetSource returns the registered source with the name, or nil
+func GetSource(name string) Source {
+	sourcesLock.RLock()
+	defer sourcesLock.RUnlock()
+
+	return sources[name]
+}
+
+// SourceFilterOptions returns the filter settings the source with the name supports
+func SourceFilterOptions(name string) FilterOptions {
+	if filterable, ok := GetSource(name).(Filterable); ok {
+		return filterable.FilterOptions()
+	}
+	return FilterOptions{}
+}
+
+// SourceNames returns the names of all sources, sorted
+func SourceNames() (names []string) {
+	sourcesLock.RLock()
+	defer sourcesLock.RUnlock()
+
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lockTarget locks a target of a source and returns the function to unlock it
+func lockTarget(source, target string) (unlock func()) {
+	targetLocksLock.Lock()
+	lock, ok := targetLocks[source+"\x00"+target]
+	if !ok {
+		lock = new(sync.Mutex)
+		targetLocks[source+"\x00"+target] = lock
+	}
+	targetLocksLock.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// Embed creates a default embed for a post, sources can use it in Render and add their own color and footer
+func Embed(post Post) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		URL:         post.URL,
+		Title:       post.Title,
+		Description: post.Text,
+	}
+	if post.Author != "" {
+		embed.Author = &discordgo.MessageEmbedAuthor{
+			Name:    post.Author,
+			URL:     post.AuthorURL,
+			IconURL: post.AuthorIconURL,
+		}
+	}
+	if len(post.ImageURLs) > 0 {
+		embed.Image = &discordgo.MessageEmbedImage{URL: post.ImageURLs[0]}
+	}
+	if !post.Time.IsZero() {
+		embed.Timestamp = post.Time.Format(time.RFC3339)
+	}
+	return embed
+}
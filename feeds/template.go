@@ -0,0 +1,127 @@
+package feeds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Seklfreak/Robyul2/commands"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/bwmarrin/discordgo"
+)
+
+// TemplatePlaceholders are the placeholders which can be used in feed templates
+const TemplatePlaceholders = "{FEED_NAME}, {POST_TITLE}, {POST_TEXT}, {POST_URL}, {POST_AUTHOR}, {POST_IMAGE}, {POST_TYPE}, {POST_CATEGORY}"
+
+// TemplateMessage creates the message for a post from a template, templates can be embed codes with the TemplatePlaceholders
+func TemplateMessage(template, feedName string, post Post) (data *discordgo.MessageSend) {
+	var imageURL string
+	if len(post.ImageURLs) > 0 {
+		imageURL = post.ImageURLs[0]
+	}
+
+	text := strings.NewReplacer(
+		"{FEED_NAME}", feedName,
+		"{POST_TITLE}", post.Title,
+		"{POST_TEXT}", post.Text,
+		"{POST_URL}", post.URL,
+		"{POST_AUTHOR}", post.Author,
+		"{POST_IMAGE}", imageURL,
+		"{POST_TYPE}", post.Type,
+		"{POST_CATEGORY}", post.Category,
+	).Replace(template)
+
+	data = &discordgo.MessageSend{Content: text}
+	if helpers.IsEmbedCode(text) {
+		ptext, embed, err := helpers.ParseEmbedCode(text)
+		if err == nil {
+			data.Content = ptext
+			if !embedIsEmpty(embed) {
+				data.Embed = embed
+			}
+		}
+	}
+	return data
+}
+
+// ApplyTemplate replaces the text posted around the embed with the template of a feed
+// embed code templates with embed fields replace the embed as well, without a template the message is returned unchanged
+func ApplyTemplate(data *discordgo.MessageSend, template, feedName string, post Post) *discordgo.MessageSend {
+	if template == "" {
+		return data
+	}
+
+	templateData := TemplateMessage(template, feedName, post)
+	if templateData.Embed == nil {
+		templateData.Embed = data.Embed
+	}
+	return templateData
+}
+
+// ApplyMentionRole mentions the role in front of the message, does nothing without a role
+func ApplyMentionRole(data *discordgo.MessageSend, roleID string) {
+	if roleID == "" {
+		return
+	}
+	data.Content = "<@&" + roleID + ">\n" + data.Content
+}
+
+// TemplateCommand handles the text of a template subcommand after the feed ID and returns the reply
+// without text it shows the current template, reset removes it, anything else is set as the new template
+func TemplateCommand(locale string, template *string, name string, text string) (reply string, changed bool) {
+	text = strings.TrimSpace(text)
+	switch strings.ToLower(text) {
+	case "":
+		if *template == "" {
+			return helpers.GetTextLF(locale, "plugins.feeds.template-none", name, TemplatePlaceholders), false
+		}
+		return helpers.GetTextLF(locale, "plugins.feeds.template-status", name, *template), false
+	case "reset":
+		if *template == "" {
+			return helpers.GetTextLF(locale, "plugins.feeds.template-none", name, TemplatePlaceholders), false
+		}
+		*template = ""
+		return helpers.GetTextLF(locale, "plugins.feeds.template-removed-success", name), true
+	}
+
+	*template = text
+	return helpers.GetTextLF(locale, "plugins.feeds.template-set-success", name), true
+}
+
+// MentionCommand handles the role of a mention subcommand after the feed ID and returns the reply
+// without a role it removes the mention, the role can be given as mention, ID or name
+func MentionCommand(locale, guildID string, mentionRoleID *string, name string, roleText string) (reply string, changed bool) {
+	roleText = strings.TrimSpace(roleText)
+	if roleText == "" {
+		if *mentionRoleID == "" {
+			return helpers.GetTextLF(locale, "plugins.feeds.mention-none", name), false
+		}
+		*mentionRoleID = ""
+		return helpers.GetTextLF(locale, "plugins.feeds.mention-removed-success", name), true
+	}
+
+	role, err := commands.ParseRole(guildID, roleText)
+	if err != nil {
+		return helpers.GetTextL(locale, "bot.arguments.invalid"), false
+	}
+
+	*mentionRoleID = role.ID
+	return helpers.GetTextLF(locale, "plugins.feeds.mention-set-success", name, role.Name), true
+}
+
+// Details describes the template and the mention role of a feed for feed lists, empty if neither is set
+func Details(mentionRoleID, template string) string {
+	var details []string
+	if template != "" {
+		details = append(details, "template")
+	}
+	if mentionRoleID != "" {
+		details = append(details, fmt.Sprintf("mentions <@&%s>", mentionRoleID))
+	}
+	return strings.Join(details, ", ")
+}
+
+// embedIsEmpty returns true for embed codes which only set the text, for example ptext={POST_URL}
+func embedIsEmpty(embed *discordgo.MessageEmbed) bool {
+	return embed == nil || (embed.Title == "" && embed.Description == "" && embed.Image == nil && embed.Thumbnail == nil &&
+		embed.Footer == nil && embed.Author == nil && len(embed.Fields) <= 0)
+}
@@ -0,0 +1,51 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestApplyTemplate(t *testing.T) {
+	post := Post{
+		URL:    "https://example.com/post",
+		Title:  "New Song",
+		Author: "Artist",
+		Type:   "vod",
+	}
+	embed := &discordgo.MessageEmbed{Title: "original"}
+
+	data := ApplyTemplate(&discordgo.MessageSend{Content: "<https://example.com/post>", Embed: embed}, "", "Feed", post)
+	if data.Content != "<https://example.com/post>" || data.Embed != embed {
+		t.Errorf("empty template changed the message: %+v", data)
+	}
+
+	data = ApplyTemplate(&discordgo.MessageSend{Content: "<https://example.com/post>", Embed: embed},
+		"{POST_AUTHOR} posted a {POST_TYPE}: {POST_URL}", "Feed", post)
+	if data.Content != "Artist posted a vod: https://example.com/post" {
+		t.Errorf("unexpected content %q", data.Content)
+	}
+	if data.Embed != embed {
+		t.Error("text template replaced the embed")
+	}
+
+	data = ApplyTemplate(&discordgo.MessageSend{Content: "<https://example.com/post>", Embed: embed},
+		"ptext=New on {FEED_NAME}", "Feed", post)
+	if data.Content != "New on Feed" || data.Embed != embed {
+		t.Errorf("text only embed code should keep the embed: %+v", data)
+	}
+
+	data = ApplyTemplate(&discordgo.MessageSend{Content: "<https://example.com/post>", Embed: embed},
+		"ptext={POST_URL} | title={POST_TITLE}", "Feed", post)
+	if data.Content != "https://example.com/post" {
+		t.Errorf("unexpected content %q", data.Content)
+	}
+	if data.Embed == nil || data.Embed.Title != "New Song" {
+		t.Errorf("embed code did not replace the embed: %+v", data.Embed)
+	}
+
+	ApplyMentionRole(data, "123")
+	if data.Content != "<@&123>\nhttps://example.com/post" {
+		t.Errorf("unexpected content with mention %q", data.Content)
+	}
+}
@@ -0,0 +1,253 @@
+// Package health reports if the bot is alive and ready, for the liveness and readiness probes of container
+// orchestration. The probes are served next to the metrics, so they are available while the bot connects.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/modules"
+)
+
+const (
+	// how long a dependency may take to answer
+	checkTimeout = 3 * time.Second
+	// how long a shard may be disconnected before the bot counts as stuck
+	defaultGatewayTimeout = 5 * time.Minute
+)
+
+var startedAt = time.Now()
+
+// Check is the result of a single check
+type Check struct {
+	Name    string
+	OK      bool
+	Message string
+}
+
+// Report is the result of all checks of a probe, it is OK if all checks are OK
+type Report struct {
+	OK     bool
+	Checks []Check
+}
+
+// Init serves the probes as /healthz and /readyz on the metrics server
+func Init() {
+	http.HandleFunc("/healthz", func(writer http.ResponseWriter, _ *http.Request) {
+		WriteReport(writer, Liveness())
+	})
+	http.HandleFunc("/readyz", func(writer http.ResponseWriter, _ *http.Request) {
+		WriteReport(writer, Readiness())
+	})
+}
+
+// WriteReport writes the report as JSON, with 503 if it is not OK
+func WriteReport(writer http.ResponseWriter, report Report) {
+	status := http.StatusOK
+	if !report.OK {
+		status = http.StatusServiceUnavailable
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(report)
+}
+
+// Liveness fails if the bot is stuck and should be restarted, currently if a shard could not connect to the
+// gateway for longer than health.gateway_timeout_seconds. Dependencies are not checked, a restart would not fix them.
+func Liveness() Report {
+	return newReport(checkGatewayAlive)
+}
+
+// Readiness fails while the bot can not serve, because it is still starting, shutting down, or a dependency is
+// unavailable
+func Readiness() Report {
+	return newReport(
+		checkShutdown,
+		checkGatewayReady,
+		checkPlugins,
+		checkMongoDB,
+		checkRedis,
+		checkElastic,
+		checkMachinery,
+	)
+}
+
+// newReport runs the checks at the same time
+func newReport(checks ...func() Check) (report Report) {
+	report.Checks = make([]Check, len(checks))
+
+	var running sync.WaitGroup
+	for i, check := range checks {
+		running.Add(1)
+		go func(i int, check func() Check) {
+			defer running.Done()
+			report.Checks[i] = check()
+		}(i, check)
+	}
+	running.Wait()
+
+	report.OK = true
+	for _, check := range report.Checks {
+		if !check.OK {
+			report.OK = false
+		}
+	}
+	return report
+}
+
+func getGatewayTimeout() time.Duration {
+	if seconds, ok := helpers.GetConfig().Path("health.gateway_timeout_seconds").Data().(float64); ok && seconds > 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return defaultGatewayTimeout
+}
+
+func checkGatewayAlive() Check {
+	check := Check{Name: "gateway", OK: true}
+	timeout := getGatewayTimeout()
+
+	if !cache.HasSession() {
+		check.Message = "connecting"
+		if time.Since(startedAt) > timeout {
+			check.OK = false
+			check.Message = fmt.Sprintf("not connected after %s", timeout)
+		}
+		return check
+	}
+
+	disconnected := 0
+	for _, shard := range cache.GetShardStatuses() {
+		if shard.Ready {
+			continue
+		}
+		disconnected++
+
+		since := shard.DisconnectedSince
+		if since.IsZero() {
+			since = startedAt
+		}
+		if time.Since(since) > timeout {
+			check.OK = false
+			check.Message = fmt.Sprintf("shard %d disconnected for more than %s", shard.ID, timeout)
+			return check
+		}
+	}
+	if disconnected > 0 {
+		check.Message = fmt.Sprintf("%d of %d shards connecting", disconnected, cache.GetShardCount())
+	}
+	return check
+}
+
+func checkGatewayReady() Check {
+	check := Check{Name: "gateway"}
+	if !cache.HasSession() {
+		check.Message = "connecting"
+		return check
+	}
+
+	ready := 0
+	for _, shard := range cache.GetShardStatuses() {
+		if shard.Ready {
+			ready++
+		}
+	}
+	check.OK = ready == cache.GetShardCount()
+	check.Message = fmt.Sprintf("%d of %d shards ready", ready, cache.GetShardCount())
+	return check
+}
+
+func checkPlugins() Check {
+	initialized, total := modules.InitProgress()
+	return Check{
+		Name:    "plugins",
+		OK:      total > 0 && initialized >= total,
+		Message: fmt.Sprintf("%d of %d plugins initialized", initialized, total),
+	}
+}
+
+func checkShutdown() Check {
+	if helpers.IsShuttingDown() {
+		return Check{Name: "shutdown", Message: "shutting down"}
+	}
+	return Check{Name: "shutdown", OK: true}
+}
+
+func checkMongoDB() Check {
+	return checkWithTimeout("mongodb", func() error {
+		if helpers.GetMDbSession() == nil {
+			return errors.New("not connected")
+		}
+		session := helpers.GetMDbSession().Copy()
+		defer session.Close()
+		session.SetSocketTimeout(checkTimeout)
+
+		return session.Ping()
+	})
+}
+
+func checkRedis() Check {
+	return checkWithTimeout("redis", func() error {
+		if !cache.HasRedisClient() {
+			return errors.New("not connected")
+		}
+		return cache.GetRedisClient().Ping().Err()
+	})
+}
+
+func checkElastic() Check {
+	if url, _ := helpers.GetConfig().Path("elasticsearch.url").Data().(string); url == "" {
+		return Check{Name: "elasticsearch", OK: true, Message: "disabled"}
+	}
+
+	return checkWithTimeout("elasticsearch", func() error {
+		if !cache.HasElastic() {
+			return errors.New("not connected")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+		defer cancel()
+		_, err := cache.GetElastic().ClusterHealth().Do(ctx)
+		return err
+	})
+}
+
+func checkMachinery() Check {
+	if !cache.HasMachineryServer() {
+		return Check{Name: "machinery", Message: "not started"}
+	}
+
+	workers := len(cache.GetMachineryActiveWorkers())
+	return Check{
+		Name:    "machinery",
+		OK:      workers > 0,
+		Message: fmt.Sprintf("%d active workers", workers),
+	}
+}
+
+// checkWithTimeout fails the check if the function returns an error or takes longer than checkTimeout
+func checkWithTimeout(name string, function func() error) Check {
+	result := make(chan error, 1)
+	go func() {
+		defer helpers.Recover()
+		result <- function()
+	}()
+
+	select {
+	case err := <-result:
+		if err != nil {
+			return Check{Name: name, Message: err.Error()}
+		}
+		return Check{Name: name, OK: true}
+	case <-time.After(checkTimeout):
+		return Check{Name: name, Message: fmt.Sprintf("no answer after %s", checkTimeout)}
+	}
+}
@@ -0,0 +1,39 @@
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewReport(t *testing.T) {
+	passing := func() Check { return Check{Name: "passing", OK: true} }
+	failing := func() Check { return Check{Name: "failing"} }
+
+	report := newReport(passing, passing)
+	if !report.OK || len(report.Checks) != 2 {
+		t.Errorf("expected passing report, got %+v", report)
+	}
+
+	report = newReport(passing, failing)
+	if report.OK {
+		t.Errorf("expected failing report, got %+v", report)
+	}
+	if report.Checks[1].Name != "failing" {
+		t.Errorf("checks are not in order: %+v", report.Checks)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	WriteReport(recorder, Report{OK: true})
+	if recorder.Code != http.StatusOK {
+		t.Errorf("expected 200 for passing report, got %d", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	WriteReport(recorder, Report{})
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 for failing report, got %d", recorder.Code)
+	}
+}
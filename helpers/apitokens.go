@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/globalsign/mgo/bson"
+)
+
+const (
+	apiTokenPrefix = "rbl_"
+	apiTokenBytes  = 24
+)
+
+// NewApiToken generates a new random API token, returns the token and its hash to store
+func NewApiToken() (token, tokenHash string, err error) {
+	randomBytes := make([]byte, apiTokenBytes)
+	_, err = rand.Read(randomBytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	token = apiTokenPrefix + hex.EncodeToString(randomBytes)
+	return token, HashApiToken(token), nil
+}
+
+// HashApiToken returns the hash an API token is stored with
+func HashApiToken(token string) (tokenHash string) {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// IsApiToken checks if the text looks like an API token generated by NewApiToken
+func IsApiToken(text string) bool {
+	return strings.HasPrefix(text, apiTokenPrefix)
+}
+
+// ParseApiTokenScope returns the scope with the given name, ok is false if there is no such scope
+func ParseApiTokenScope(text string) (scope models.ApiTokenScope, ok bool) {
+	for _, scope := range models.ApiTokenScopes {
+		if strings.ToLower(text) == string(scope) {
+			return scope, true
+		}
+	}
+	return "", false
+}
+
+// GetApiToken returns the API token entry for the token
+func GetApiToken(token string) (entry models.ApiTokenEntry, err error) {
+	err = MdbOneWithoutLogging(
+		MdbCollection(models.ApiTokensTable).Find(bson.M{"tokenhash": HashApiToken(token)}),
+		&entry,
+	)
+	return entry, err
+}
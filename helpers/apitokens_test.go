@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/Seklfreak/Robyul2/models"
+)
+
+func TestNewApiToken(t *testing.T) {
+	token, tokenHash, err := NewApiToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsApiToken(token) {
+		t.Errorf("%s: expected an API token", token)
+	}
+	if tokenHash != HashApiToken(token) || tokenHash == token {
+		t.Errorf("%s: unexpected hash %s", token, tokenHash)
+	}
+
+	otherToken, _, err := NewApiToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if otherToken == token {
+		t.Error("expected different tokens")
+	}
+}
+
+func TestParseApiTokenScope(t *testing.T) {
+	if scope, ok := ParseApiTokenScope("Statistics:Read"); !ok || scope != models.ApiTokenScopeStatisticsRead {
+		t.Errorf("unexpected scope %s", scope)
+	}
+	if _, ok := ParseApiTokenScope("settings:read"); ok {
+		t.Error("expected an invalid scope")
+	}
+}
@@ -0,0 +1,178 @@
+package helpers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed cron expression with the five standard fields
+// minute, hour, day of month, month and day of week
+type CronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// if both day fields are restricted a day matches if either of them matches
+	dayOfMonthRestricted, dayOfWeekRestricted bool
+}
+
+type cronField struct {
+	min, max int
+	names    []string // names of the values, starting at min
+}
+
+var (
+	cronMinute     = cronField{min: 0, max: 59}
+	cronHour       = cronField{min: 0, max: 23}
+	cronDayOfMonth = cronField{min: 1, max: 31}
+	cronMonth      = cronField{min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	cronDayOfWeek  = cronField{min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	ErrInvalidCron = errors.New("invalid cron expression")
+)
+
+// ParseCron parses a cron expression like "30 9 * * mon-fri" or a descriptor like "@daily"
+func ParseCron(spec string) (schedule *CronSchedule, err error) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if descriptor, ok := cronDescriptors[spec]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, ErrInvalidCron
+	}
+
+	schedule = new(CronSchedule)
+	if schedule.minute, err = cronMinute.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if schedule.hour, err = cronHour.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfMonth, err = cronDayOfMonth.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if schedule.month, err = cronMonth.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfWeek, err = cronDayOfWeek.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	// 7 is sunday as well
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	schedule.dayOfMonthRestricted = fields[2] != "*" && !strings.HasPrefix(fields[2], "*/")
+	schedule.dayOfWeekRestricted = fields[4] != "*" && !strings.HasPrefix(fields[4], "*/")
+
+	return schedule, nil
+}
+
+// IsCron returns true if the text is a valid cron expression or descriptor
+func IsCron(spec string) bool {
+	_, err := ParseCron(spec)
+	return err == nil
+}
+
+func (f cronField) parse(text string) (bits uint64, err error) {
+	for _, part := range strings.Split(text, ",") {
+		step := 1
+		if index := strings.Index(part, "/"); index >= 0 {
+			step, err = strconv.Atoi(part[index+1:])
+			if err != nil || step <= 0 {
+				return 0, ErrInvalidCron
+			}
+			part = part[:index]
+		}
+
+		start, end := f.min, f.max
+		if part != "*" {
+			rangeParts := strings.SplitN(part, "-", 2)
+			start, err = f.value(rangeParts[0])
+			if err != nil {
+				return 0, err
+			}
+			end = start
+			if len(rangeParts) > 1 {
+				end, err = f.value(rangeParts[1])
+				if err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				// "5/15" runs from 5 to the end of the field
+				end = f.max
+			}
+		}
+		if start > end {
+			return 0, ErrInvalidCron
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(text string) (value int, err error) {
+	for i, name := range f.names {
+		if text == name {
+			return f.min + i, nil
+		}
+	}
+
+	value, err = strconv.Atoi(text)
+	if err != nil || value < f.min || value > f.max {
+		return 0, ErrInvalidCron
+	}
+	return value, nil
+}
+
+// Next returns the first time after the given time matching the schedule, in the location of the given time
+// returns a zero time if there is no match within the next five years
+func (s *CronSchedule) Next(after time.Time) time.Time {
+	location := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, location)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, location)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, location)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if s.dayOfMonthRestricted && s.dayOfWeekRestricted {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
@@ -0,0 +1,48 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	for _, spec := range []string{"* * * * *", "30 9 * * mon-fri", "*/15 0-6,18 1,15 jan-jun 7", "@daily", "5/10 * * * *"} {
+		if _, err := ParseCron(spec); err != nil {
+			t.Errorf("%s: unexpected error %s", spec, err.Error())
+		}
+	}
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "5-1 * * * *", "*/0 * * * *", "tomorrow"} {
+		if _, err := ParseCron(spec); err == nil {
+			t.Errorf("%s: expected an error", spec)
+		}
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+
+	tests := []struct {
+		spec     string
+		after    time.Time
+		expected time.Time
+	}{
+		{"30 9 * * mon-fri", time.Date(2018, 6, 1, 10, 0, 0, 0, time.UTC), time.Date(2018, 6, 4, 9, 30, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2018, 6, 1, 10, 0, 0, 0, time.UTC), time.Date(2018, 6, 1, 11, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// either day field matches if both are restricted
+		{"0 12 13 * fri", time.Date(2018, 6, 9, 0, 0, 0, 0, time.UTC), time.Date(2018, 6, 13, 12, 0, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2018, 6, 1, 8, 0, 0, 0, berlin), time.Date(2018, 6, 1, 9, 0, 0, 0, berlin)},
+	}
+	for _, test := range tests {
+		schedule, err := ParseCron(test.spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next := schedule.Next(test.after); !next.Equal(test.expected) {
+			t.Errorf("%s after %s: expected %s, got %s", test.spec, test.after, test.expected, next)
+		}
+	}
+}
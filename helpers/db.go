@@ -87,6 +87,15 @@ func SetPrefixForServer(guild string, prefix string) error {
 	return GuildSettingsSet(guild, settings)
 }
 
+// GetLocationForServer gets the timezone of $guild, UTC if none is set
+func GetLocationForServer(guildID string) *time.Location {
+	location, err := time.LoadLocation(GuildSettingsGetCached(guildID).Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
 func GuildSettingsUpdater() {
 	for {
 		for _, guild := range cache.GetSession().State.Guilds {
@@ -1,22 +1,23 @@
 package dgwidgets
 
 import (
+	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/bwmarrin/discordgo"
 )
 
-// NextMessageCreateC returns a channel for the next MessageCreate event
-func nextMessageCreateC(s *discordgo.Session) chan *discordgo.MessageCreate {
+// NextMessageCreateC returns a channel for the next MessageCreate event of any shard
+func nextMessageCreateC() chan *discordgo.MessageCreate {
 	out := make(chan *discordgo.MessageCreate)
-	s.AddHandlerOnce(func(_ *discordgo.Session, e *discordgo.MessageCreate) {
+	cache.AddHandlerOnce(func(_ *discordgo.Session, e *discordgo.MessageCreate) {
 		out <- e
 	})
 	return out
 }
 
-// NextMessageReactionAddC returns a channel for the next MessageReactionAdd event
-func nextMessageReactionAddC(s *discordgo.Session) chan *discordgo.MessageReactionAdd {
+// NextMessageReactionAddC returns a channel for the next MessageReactionAdd event of any shard
+func nextMessageReactionAddC() chan *discordgo.MessageReactionAdd {
 	out := make(chan *discordgo.MessageReactionAdd)
-	s.AddHandlerOnce(func(_ *discordgo.Session, e *discordgo.MessageReactionAdd) {
+	cache.AddHandlerOnce(func(_ *discordgo.Session, e *discordgo.MessageReactionAdd) {
 		out <- e
 	})
 	return out
@@ -108,7 +108,7 @@ func (w *Widget) Spawn() error {
 		// Navigation timeout enabled
 		if w.Timeout != 0 {
 			select {
-			case k := <-nextMessageReactionAddC(cache.GetSession()):
+			case k := <-nextMessageReactionAddC():
 				reaction = k.MessageReaction
 			case <-time.After(startTime.Add(w.Timeout).Sub(time.Now())):
 				return nil
@@ -117,7 +117,7 @@ func (w *Widget) Spawn() error {
 			}
 		} else /*Navigation timeout not enabled*/ {
 			select {
-			case k := <-nextMessageReactionAddC(cache.GetSession()):
+			case k := <-nextMessageReactionAddC():
 				reaction = k.MessageReaction
 			case <-w.Close:
 				return nil
@@ -183,7 +183,7 @@ func (w *Widget) QueryInput(prompt string, userID string, timeout time.Duration)
 
 	for {
 		select {
-		case usermsg := <-nextMessageCreateC(cache.GetSession()):
+		case usermsg := <-nextMessageCreateC():
 			if usermsg.Author.ID != userID {
 				continue
 			}
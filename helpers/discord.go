@@ -336,7 +336,7 @@ func IsModByID(guildID string, userID string) bool {
 // RequireAdmin only calls $cb if the author is an admin or has MANAGE_SERVER permission
 func RequireAdmin(msg *discordgo.Message, cb Callback) {
 	if !IsAdmin(msg) {
-		SendMessage(msg.ChannelID, GetText("admin.no_permission"))
+		SendMessage(msg.ChannelID, GetMessageText(msg, "admin.no_permission"))
 		return
 	}
 
@@ -346,7 +346,7 @@ func RequireAdmin(msg *discordgo.Message, cb Callback) {
 // RequireAdmin only calls $cb if the author is an admin or has MANAGE_SERVER permission
 func RequireAdminOrStaff(msg *discordgo.Message, cb Callback) {
 	if !IsAdmin(msg) && !IsRobyulMod(msg.Author.ID) {
-		SendMessage(msg.ChannelID, GetText("admin.no_permission"))
+		SendMessage(msg.ChannelID, GetMessageText(msg, "admin.no_permission"))
 		return
 	}
 
@@ -356,7 +356,7 @@ func RequireAdminOrStaff(msg *discordgo.Message, cb Callback) {
 // RequireAdmin only calls $cb if the author is an admin or has MANAGE_SERVER permission
 func RequireMod(msg *discordgo.Message, cb Callback) {
 	if !IsMod(msg) {
-		SendMessage(msg.ChannelID, GetText("mod.no_permission"))
+		SendMessage(msg.ChannelID, GetMessageText(msg, "mod.no_permission"))
 		return
 	}
 
@@ -366,7 +366,7 @@ func RequireMod(msg *discordgo.Message, cb Callback) {
 // RequireBotAdmin only calls $cb if the author is a bot admin
 func RequireBotAdmin(msg *discordgo.Message, cb Callback) {
 	if !IsBotAdmin(msg.Author.ID) {
-		SendMessage(msg.ChannelID, GetText("botadmin.no_permission"))
+		SendMessage(msg.ChannelID, GetMessageText(msg, "botadmin.no_permission"))
 		return
 	}
 
@@ -376,7 +376,7 @@ func RequireBotAdmin(msg *discordgo.Message, cb Callback) {
 // RequireSupportMod only calls $cb if the author is a support mod
 func RequireRobyulMod(msg *discordgo.Message, cb Callback) {
 	if !IsRobyulMod(msg.Author.ID) {
-		SendMessage(msg.ChannelID, GetText("robyulmod.no_permission"))
+		SendMessage(msg.ChannelID, GetMessageText(msg, "robyulmod.no_permission"))
 		return
 	}
 
@@ -384,26 +384,28 @@ func RequireRobyulMod(msg *discordgo.Message, cb Callback) {
 }
 
 func ConfirmEmbed(channelID string, author *discordgo.User, confirmMessageText string, confirmEmojiID string, abortEmojiID string) bool {
+	locale := GetChannelLocale(channelID, author.ID)
+
 	// send embed asking the user to confirm
 	confirmMessages, err := SendComplex(channelID,
 		&discordgo.MessageSend{
 			Content: "<@" + author.ID + ">",
 			Embed: &discordgo.MessageEmbed{
-				Title:       GetText("bot.embeds.please-confirm-title"),
+				Title:       GetTextL(locale, "bot.embeds.please-confirm-title"),
 				Description: confirmMessageText,
 			},
 		})
 	if err != nil {
-		SendMessage(channelID, GetTextF("bot.errors.general", err.Error()))
+		SendMessage(channelID, GetTextLF(locale, "bot.errors.general", err.Error()))
 		return false
 	}
 	if len(confirmMessages) <= 0 {
-		SendMessage(channelID, GetText("bot.errors.generic-nomessage"))
+		SendMessage(channelID, GetTextL(locale, "bot.errors.generic-nomessage"))
 		return false
 	}
 	confirmMessage := confirmMessages[0]
 	if len(confirmMessage.Embeds) <= 0 {
-		SendMessage(channelID, GetText("bot.errors.no-embed"))
+		SendMessage(channelID, GetTextL(locale, "bot.errors.no-embed"))
 		return false
 	}
 
@@ -412,7 +414,7 @@ func ConfirmEmbed(channelID string, author *discordgo.User, confirmMessageText s
 	cache.GetSession().MessageReactionAdd(confirmMessage.ChannelID, confirmMessage.ID, abortEmojiID)
 
 	responseChannel := make(chan bool, 1)
-	stopHandler := cache.GetSession().AddHandler(func(session *discordgo.Session, reaction *discordgo.MessageReactionAdd) {
+	stopHandler := cache.AddHandler(func(session *discordgo.Session, reaction *discordgo.MessageReactionAdd) {
 		if reaction == nil || reaction.MessageID != confirmMessage.ID || reaction.UserID != author.ID {
 			return
 		}
@@ -593,22 +595,17 @@ func UnmuteUser(guildID string, userID string) (err error) {
 	return nil
 }
 func UnmuteUserSignature(guildID string, userID string) (signature *tasks.Signature) {
-	signature = &tasks.Signature{
-		Name: "unmute_user",
-		Args: []tasks.Arg{
-			{
-				Type:  "string",
-				Value: guildID,
-			},
-			{
-				Type:  "string",
-				Value: userID,
-			},
+	return NewMachinerySignature(
+		"unmute_user",
+		tasks.Arg{
+			Type:  "string",
+			Value: guildID,
 		},
-	}
-	signature.RetryCount = 3
-	signature.OnError = []*tasks.Signature{{Name: "log_error"}}
-	return signature
+		tasks.Arg{
+			Type:  "string",
+			Value: userID,
+		},
+	)
 }
 
 func AddMuteRole(guildID string, userID string) (err error) {
@@ -811,11 +808,6 @@ func persistencyRemoveCachedRole(GuildID string, UserID string, roleID string) (
 	return err
 }
 
-func LogMachineryError(errorMessage string) (err error) {
-	cache.GetLogger().WithField("module", "machinery").Error("Task Failed: ", errorMessage)
-	return err
-}
-
 func GetGuildMember(guildID string, userID string) (*discordgo.Member, error) {
 	targetMember, err := cache.GetSession().State.Member(guildID, userID)
 	if targetMember == nil || targetMember.GuildID == "" || targetMember.JoinedAt == "" {
@@ -847,7 +839,7 @@ func GetGuild(guildID string) (*discordgo.Guild, error) {
 	if targetGuild == nil || targetGuild.ID == "" {
 		//cache.GetLogger().WithField("module", "discord").WithField("method", "GetGuild").Debug(
 		//		fmt.Sprintf("discord api request: Guild: %s", guildID))
-		targetGuild, err = cache.GetSession().Guild(guildID)
+		targetGuild, err = cache.GetSessionForGuild(guildID).Guild(guildID)
 	}
 	return targetGuild, err
 }
@@ -1142,14 +1134,14 @@ func SendMessage(channelID, content string) (messages []*discordgo.Message, err
 	content = CleanDiscordContent(content)
 	if len(content) > 2000 {
 		for _, page := range AutoPagify(content) {
-			message, err = cache.GetSession().ChannelMessageSend(channelID, page)
+			message, err = cache.GetSessionForChannel(channelID).ChannelMessageSend(channelID, page)
 			if err != nil {
 				return messages, err
 			}
 			messages = append(messages, message)
 		}
 	} else {
-		message, err = cache.GetSession().ChannelMessageSend(channelID, content)
+		message, err = cache.GetSessionForChannel(channelID).ChannelMessageSend(channelID, content)
 		if err != nil {
 			return messages, err
 		}
@@ -1173,7 +1165,7 @@ func SendMessageBoxed(channelID, content string) (messages []*discordgo.Message,
 
 func SendEmbed(channelID string, embed *discordgo.MessageEmbed) (messages []*discordgo.Message, err error) {
 	var message *discordgo.Message
-	message, err = cache.GetSession().ChannelMessageSendEmbed(channelID, TruncateEmbed(embed))
+	message, err = cache.GetSessionForChannel(channelID).ChannelMessageSendEmbed(channelID, TruncateEmbed(embed))
 	if err != nil {
 		return messages, err
 	}
@@ -1195,10 +1187,10 @@ func SendComplex(channelID string, data *discordgo.MessageSend) (messages []*dis
 	if len(pages) > 0 {
 		for i, page := range pages {
 			if i+1 < len(pages) {
-				message, err = cache.GetSession().ChannelMessageSend(channelID, page)
+				message, err = cache.GetSessionForChannel(channelID).ChannelMessageSend(channelID, page)
 			} else {
 				data.Content = page
-				message, err = cache.GetSession().ChannelMessageSendComplex(channelID, data)
+				message, err = cache.GetSessionForChannel(channelID).ChannelMessageSendComplex(channelID, data)
 			}
 			if err != nil {
 				return messages, err
@@ -1206,7 +1198,7 @@ func SendComplex(channelID string, data *discordgo.MessageSend) (messages []*dis
 			messages = append(messages, message)
 		}
 	} else {
-		message, err = cache.GetSession().ChannelMessageSendComplex(channelID, data)
+		message, err = cache.GetSessionForChannel(channelID).ChannelMessageSendComplex(channelID, data)
 		if err != nil {
 			return messages, err
 		}
@@ -1216,7 +1208,7 @@ func SendComplex(channelID string, data *discordgo.MessageSend) (messages []*dis
 }
 
 func EditMessage(channelID, messageID, content string) (message *discordgo.Message, err error) {
-	message, err = cache.GetSession().ChannelMessageEdit(channelID, messageID, content)
+	message, err = cache.GetSessionForChannel(channelID).ChannelMessageEdit(channelID, messageID, content)
 	content = CleanDiscordContent(content)
 	if err != nil {
 		return nil, err
@@ -1226,7 +1218,7 @@ func EditMessage(channelID, messageID, content string) (message *discordgo.Messa
 }
 
 func EditEmbed(channelID, messageID string, embed *discordgo.MessageEmbed) (message *discordgo.Message, err error) {
-	message, err = cache.GetSession().ChannelMessageEditEmbed(channelID, messageID, TruncateEmbed(embed))
+	message, err = cache.GetSessionForChannel(channelID).ChannelMessageEditEmbed(channelID, messageID, TruncateEmbed(embed))
 	if err != nil {
 		return nil, err
 	} else {
@@ -1242,7 +1234,7 @@ func EditComplex(data *discordgo.MessageEdit) (message *discordgo.Message, err e
 		content := CleanDiscordContent(*data.Content)
 		data.Content = &content
 	}
-	message, err = cache.GetSession().ChannelMessageEditComplex(data)
+	message, err = cache.GetSessionForChannel(data.Channel).ChannelMessageEditComplex(data)
 	if err != nil {
 		return nil, err
 	} else {
@@ -1250,6 +1242,17 @@ func EditComplex(data *discordgo.MessageEdit) (message *discordgo.Message, err e
 	}
 }
 
+// UpdateStatusComplex updates the status of the bot on all shards
+func UpdateStatusComplex(data discordgo.UpdateStatusData) (err error) {
+	for _, session := range cache.GetSessions() {
+		err = session.UpdateStatusComplex(data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func CleanDiscordContent(content string) (output string) {
 	return strings.Replace(strings.Replace(content, "@everyone", "@"+ZERO_WIDTH_SPACE+"everyone", -1), "@here", "@"+ZERO_WIDTH_SPACE+"here", -1)
 }
@@ -43,6 +43,8 @@ func ElasticOnMessageCreate(session *discordgo.Session, message *discordgo.Messa
 		return
 	}
 
+	countLiveMessage(channel.GuildID)
+
 	go func() {
 		defer Recover()
 
@@ -396,6 +398,8 @@ func ElasticAddJoin(member *discordgo.Member, usedInvite, usedVanityName string)
 		elasticJoinData.UserID = ""
 	}
 
+	PublishLiveEvent(models.LiveEventTypeJoin, member.GuildID, elasticJoinData)
+
 	_, err = cache.GetElastic().Index().
 		Index(models.ElasticIndexJoins).
 		Type("doc").
@@ -429,6 +433,8 @@ func ElasticAddLeave(member *discordgo.Member) error {
 		elasticLeaveData.UserID = ""
 	}
 
+	PublishLiveEvent(models.LiveEventTypeLeave, member.GuildID, elasticLeaveData)
+
 	_, err = cache.GetElastic().Index().
 		Index(models.ElasticIndexLeaves).
 		Type("doc").
@@ -85,6 +85,9 @@ func EventlogLog(createdAt time.Time, guildID, targetID, targetType, userID, act
 		return false, err
 	}
 
+	publishLiveEventlog(createdAt, guildID, targetID, targetType, userID, actionType, reason,
+		cleanChanges(changes), cleanOptions(options), waitingForAuditLogBackfill)
+
 	messageIDs := make([]string, 0)
 	eventlogChannelIDs := GuildSettingsGetCached(guildID).EventlogChannelIDs
 	for _, eventlogChannelID := range eventlogChannelIDs {
@@ -329,6 +332,9 @@ func getEventlogEmbed(eventlogID string, createdAt time.Time, guildID, targetID,
 		actionType == models.EventlogTypeRobyulTroublemakerReport ||
 		actionType == models.EventlogTypeRobyulPersistencyRoleRemove ||
 		actionType == models.EventlogTypeRobyulEventlogConfigUpdate ||
+		actionType == models.EventlogTypeRobyulCommandDisable ||
+		actionType == models.EventlogTypeRobyulCommandAliasRemove ||
+		actionType == models.EventlogTypeRobyulFeedRemove ||
 		actionType == models.EventlogTypeRobyulTwitterFeedRemove {
 		embed.Color = GetDiscordColorFromHex("#b22222") // firebrick red
 	}
@@ -3,30 +3,72 @@ package helpers
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Jeffail/gabs"
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+	"github.com/globalsign/mgo/bson"
+	redisCache "github.com/go-redis/cache"
 )
 
-var translations *gabs.Container
+const (
+	// DefaultLocale is used if no locale is set, and for all keys missing in other locales
+	DefaultLocale = "en"
+)
+
+var (
+	// SupportedLocales are all locales with a translation file, _assets/i18n.json for English, _assets/i18n.<locale>.json for the others
+	SupportedLocales = []string{"en", "ko", "es"}
+
+	translations map[string]*gabs.Container
+)
 
 func LoadTranslations() {
-	jsonFile, err := Asset("_assets/i18n.json")
-	Relax(err)
+	translations = make(map[string]*gabs.Container, 0)
+
+	for _, locale := range SupportedLocales {
+		filename := "_assets/i18n." + locale + ".json"
+		if locale == DefaultLocale {
+			filename = "_assets/i18n.json"
+		}
 
-	json, err := gabs.ParseJSON(jsonFile)
-	Relax(err)
+		jsonFile, err := Asset(filename)
+		Relax(err)
 
-	translations = json
+		json, err := gabs.ParseJSON(jsonFile)
+		Relax(err)
+
+		translations[locale] = json
+	}
+}
+
+// Returns true if there is a translation file for the locale
+// locale	: the locale to check, for example ko
+func IsSupportedLocale(locale string) bool {
+	return StringSliceContains(locale, SupportedLocales)
 }
 
 func GetText(id string) string {
-	if !translations.ExistsP(id) {
+	return GetTextL(DefaultLocale, id)
+}
+
+func GetTextF(id string, replacements ...interface{}) string {
+	return fmt.Sprintf(GetText(id), replacements...)
+}
+
+// Returns the translation of a key in a locale, falls back to English if the locale does not have the key
+// locale	: the locale to use, for example ko
+// id		: the key, for example bot.arguments.invalid
+func GetTextL(locale, id string) string {
+	item := getTranslation(locale, id)
+	if item == nil {
 		return id
 	}
 
-	item := translations.Path(id)
-
 	// If this is an object return __
 	if strings.Contains(item.String(), "{") {
 		item = item.Path("__")
@@ -38,9 +80,192 @@ func GetText(id string) string {
 		return arr[rand.Intn(len(arr))].(string)
 	}
 
-	return item.Data().(string)
+	text, _ := item.Data().(string)
+	return text
 }
 
-func GetTextF(id string, replacements ...interface{}) string {
-	return fmt.Sprintf(GetText(id), replacements...)
+func GetTextLF(locale, id string, replacements ...interface{}) string {
+	return fmt.Sprintf(GetTextL(locale, id), replacements...)
+}
+
+// Returns the plural form of a key matching count, the key has to be an object with the plural forms as keys (one, other)
+// locale		: the locale to use, for example ko
+// id			: the key, for example plugins.stats.members
+// count		: the number to choose the plural form for
+// replacements	: format arguments, count is not added automatically
+func GetTextPluralLF(locale, id string, count int, replacements ...interface{}) string {
+	form := pluralForm(locale, count)
+	if getTranslation(locale, id+"."+form) == nil {
+		form = "other"
+	}
+
+	return GetTextLF(locale, id+"."+form, replacements...)
+}
+
+// Returns the translation for the locale of a message, see GetLocale
+func GetMessageText(msg *discordgo.Message, id string) string {
+	return GetTextL(GetMessageLocale(msg), id)
+}
+
+func GetMessageTextF(msg *discordgo.Message, id string, replacements ...interface{}) string {
+	return GetTextLF(GetMessageLocale(msg), id, replacements...)
+}
+
+// Returns the locale for a message, the locale of the author if set, otherwise the locale of the guild
+func GetMessageLocale(msg *discordgo.Message) string {
+	if msg == nil || msg.Author == nil {
+		return DefaultLocale
+	}
+
+	return GetChannelLocale(msg.ChannelID, msg.Author.ID)
+}
+
+// Returns the locale of a user in a channel, see GetLocale
+// channelID	: the channel
+// userID		: the user, can be empty
+func GetChannelLocale(channelID, userID string) string {
+	channel, err := GetChannelWithoutApi(channelID)
+	if err != nil {
+		return GetLocale("", userID)
+	}
+
+	return GetLocale(channel.GuildID, userID)
+}
+
+// Returns the locale of a user on a guild, the locale of the user takes precedence over the locale of the guild
+// guildID	: the guild, can be empty
+// userID	: the user, can be empty
+func GetLocale(guildID, userID string) string {
+	if userID != "" {
+		if locale := GetUserLocale(userID); locale != "" {
+			return locale
+		}
+	}
+
+	if guildID != "" {
+		if locale := GuildSettingsGetCached(guildID).Locale; IsSupportedLocale(locale) {
+			return locale
+		}
+	}
+
+	return DefaultLocale
+}
+
+// Returns the locale a user has set for themselves, or an empty string, cached in redis
+// userID	: the user
+func GetUserLocale(userID string) (locale string) {
+	cacheCodec := cache.GetRedisCacheCodec()
+	key := fmt.Sprintf("robyul2-discord:user-locale:%s", userID)
+
+	if err := cacheCodec.Get(key, &locale); err == nil {
+		return locale
+	}
+
+	var userdata models.ProfileUserdataEntry
+	err := MdbOneWithoutLogging(
+		MdbCollection(models.ProfileUserdataTable).Find(bson.M{"userid": userID}).Select(bson.M{"locale": 1}),
+		&userdata,
+	)
+	if err == nil && IsSupportedLocale(userdata.Locale) {
+		locale = userdata.Locale
+	}
+
+	err = cacheCodec.Set(&redisCache.Item{
+		Key:        key,
+		Object:     locale,
+		Expiration: time.Minute * 10,
+	})
+	RelaxLog(err)
+
+	return locale
+}
+
+// Sets the locale of a user, an empty locale resets it to the guild locale
+// userID	: the user
+// locale	: the new locale
+func SetUserLocale(userID, locale string) (err error) {
+	userdata, err := GetUserUserdata(userID)
+	if err != nil {
+		return err
+	}
+
+	userdata.Locale = locale
+	err = MDbUpdate(models.ProfileUserdataTable, userdata.ID, userdata)
+	if err != nil {
+		return err
+	}
+
+	return cache.GetRedisCacheCodec().Delete(fmt.Sprintf("robyul2-discord:user-locale:%s", userID))
+}
+
+// Returns all keys that exist in English but are missing in the locale, sorted
+// locale	: the locale to check, for example ko
+func MissingTranslations(locale string) (missing []string) {
+	localeTranslations, ok := translations[locale]
+	if !ok || locale == DefaultLocale {
+		return nil
+	}
+
+	for _, key := range translationKeys(translations[DefaultLocale], "") {
+		if !localeTranslations.ExistsP(key) {
+			missing = append(missing, key)
+		}
+	}
+
+	sort.Strings(missing)
+	return missing
+}
+
+// getTranslation returns the translation in the locale, or in English as fallback, or nil if neither has it
+func getTranslation(locale, id string) *gabs.Container {
+	if localeTranslations, ok := translations[locale]; ok && localeTranslations.ExistsP(id) {
+		return localeTranslations.Path(id)
+	}
+
+	if translations[DefaultLocale].ExistsP(id) {
+		return translations[DefaultLocale].Path(id)
+	}
+
+	return nil
+}
+
+// translationKeys returns all keys with a translation below the container, objects with __ or plural forms count as one key
+func translationKeys(container *gabs.Container, prefix string) (keys []string) {
+	children, err := container.ChildrenMap()
+	if err != nil {
+		return []string{prefix}
+	}
+
+	// plural forms differ between locales, so only the key itself has to exist
+	if _, ok := children["other"]; ok {
+		return []string{prefix}
+	}
+
+	for key, child := range children {
+		if key == "__" {
+			keys = append(keys, prefix)
+			continue
+		}
+		childPrefix := key
+		if prefix != "" {
+			childPrefix = prefix + "." + key
+		}
+		keys = append(keys, translationKeys(child, childPrefix)...)
+	}
+
+	return keys
+}
+
+// pluralForm returns the CLDR plural category of count for the locale, only one and other are used by the supported locales
+func pluralForm(locale string, count int) string {
+	switch locale {
+	case "ko":
+		// Korean has no plural forms
+		return "other"
+	}
+
+	if count == 1 {
+		return "one"
+	}
+	return "other"
 }
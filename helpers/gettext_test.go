@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestGetTextL(t *testing.T) {
+	LoadTranslations()
+
+	if text := GetTextL("es", "bot.arguments.invalid"); text != "¡Argumentos inválidos!" {
+		t.Errorf("expected the spanish translation, got %s", text)
+	}
+	if text := GetTextL("es", "bot.embeds.please-confirm-title"); text != GetText("bot.embeds.please-confirm-title") {
+		t.Errorf("expected the english fallback, got %s", text)
+	}
+	if text := GetTextL("xx", "bot.arguments.invalid"); text != GetText("bot.arguments.invalid") {
+		t.Errorf("expected the english fallback for an unknown locale, got %s", text)
+	}
+	if text := GetTextL("ko", "does.not.exist"); text != "does.not.exist" {
+		t.Errorf("expected the key for a missing translation, got %s", text)
+	}
+}
+
+func TestGetTextPluralLF(t *testing.T) {
+	LoadTranslations()
+
+	if text := GetTextPluralLF("en", "plugins.language.missing-result", 1, 1, "ko"); text != "1 text is not translated to `ko` yet." {
+		t.Errorf("unexpected singular: %s", text)
+	}
+	if text := GetTextPluralLF("en", "plugins.language.missing-result", 2, 2, "ko"); text != "2 texts are not translated to `ko` yet." {
+		t.Errorf("unexpected plural: %s", text)
+	}
+	// korean only has the other form
+	if text := GetTextPluralLF("ko", "plugins.language.missing-result", 1, 1, "es"); text != "1개의 텍스트가 아직 `es` 로 번역되지 않았어요." {
+		t.Errorf("unexpected korean plural: %s", text)
+	}
+}
+
+func TestMissingTranslations(t *testing.T) {
+	LoadTranslations()
+
+	missing := MissingTranslations("ko")
+	if len(missing) <= 0 {
+		t.Fatal("expected missing korean translations")
+	}
+	for _, key := range missing {
+		if key == "bot.arguments.invalid" || key == "plugins.language.missing-result" {
+			t.Errorf("%s is translated but reported as missing", key)
+		}
+	}
+	if len(MissingTranslations("en")) != 0 {
+		t.Error("expected no missing translations for the default locale")
+	}
+}
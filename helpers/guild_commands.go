@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/Seklfreak/Robyul2/cache"
+)
+
+var (
+	// commands that can not be disabled or aliased, so admins can not lock themselves out
+	guildCommandsProtected = []string{"commandsettings", "cmdsettings", "help", "h"}
+)
+
+// Returns true if the command can not be disabled or used as an alias
+// name	: the command name, without prefix
+func GuildCommandIsProtected(name string) bool {
+	for _, command := range GuildCommandSet(name) {
+		if StringSliceContains(strings.ToLower(command), guildCommandsProtected) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the commands which are disabled together with the command, all commands of its plugin, or the
+// command and its aliases for command plugins. Returns only the command itself if no plugin handles it.
+// name	: the command name, without prefix
+func GuildCommandSet(name string) []string {
+	if set := cache.GetPluginCommandSet(name); len(set) > 0 {
+		return set
+	}
+	return []string{strings.ToLower(name)}
+}
+
+// Returns the canonical name of the command, the first command of its set, disabled commands are stored by it
+// name	: the command name, without prefix
+func GuildCommandCanonical(name string) string {
+	return strings.ToLower(GuildCommandSet(name)[0])
+}
+
+// Returns true if the command has been disabled on the guild, aliases of disabled commands are disabled as well
+// guildID	: the guild to check
+// name		: the command name, without prefix
+func GuildCommandIsDisabled(guildID, name string) bool {
+	if GuildCommandIsProtected(name) {
+		return false
+	}
+
+	canonical := GuildCommandCanonical(name)
+	for _, disabledCommand := range GuildSettingsGetCached(guildID).DisabledCommands {
+		if GuildCommandCanonical(disabledCommand) == canonical {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the command an alias points to on the guild, or the name itself if it is not an alias
+// guildID	: the guild to resolve the alias on
+// name		: the command name or alias, without prefix
+func GuildCommandResolveAlias(guildID, name string) string {
+	for _, alias := range GuildSettingsGetCached(guildID).CommandAliases {
+		if strings.ToLower(alias.Alias) == strings.ToLower(name) {
+			return alias.Command
+		}
+	}
+	return name
+}
@@ -0,0 +1,33 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/Seklfreak/Robyul2/cache"
+)
+
+func TestGuildCommandCanonical(t *testing.T) {
+	cache.SetPluginCommandSets([][]string{
+		{"8ball", "8"},
+		{"commandsettings", "cmdsettings"},
+	})
+	defer cache.SetPluginCommandSets(nil)
+
+	for name, expected := range map[string]string{
+		"8ball":   "8ball",
+		"8":       "8ball",
+		"8BALL":   "8ball",
+		"unknown": "unknown",
+	} {
+		if canonical := GuildCommandCanonical(name); canonical != expected {
+			t.Errorf("%s: expected %s, got %s", name, expected, canonical)
+		}
+	}
+
+	if !GuildCommandIsProtected("cmdsettings") {
+		t.Error("expected the alias of a protected command to be protected")
+	}
+	if GuildCommandIsProtected("8") {
+		t.Error("expected 8 not to be protected")
+	}
+}
@@ -0,0 +1,140 @@
+package helpers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/models"
+)
+
+const (
+	// events are dropped for subscribers which have this many events queued
+	liveEventsQueueSize = 100
+	// how often the message rate is sent to the subscribers
+	LiveMessageRateInterval = 10 * time.Second
+)
+
+// eventlog action types streamed as moderation events
+var liveModerationActionTypes = map[string]bool{
+	models.EventlogTypeBanAdd:                   true,
+	models.EventlogTypeBanRemove:                true,
+	models.EventlogTypeRobyulMute:               true,
+	models.EventlogTypeRobyulUnmute:             true,
+	models.EventlogTypeRobyulCleanup:            true,
+	models.EventlogTypeRobyulNukeParticipate:    true,
+	models.EventlogTypeRobyulTroublemakerReport: true,
+}
+
+type liveSubscription struct {
+	guildID string
+	events  chan models.LiveEvent
+}
+
+var (
+	liveSubscriptions      = make(map[*liveSubscription]bool)
+	liveMessageCounts      = make(map[string]int)
+	liveLock               sync.Mutex
+	liveMessageRateStarted sync.Once
+)
+
+// SubscribeLiveEvents returns a channel receiving all live events of the guild,
+// unsubscribe has to be called once the events are not read anymore
+func SubscribeLiveEvents(guildID string) (events <-chan models.LiveEvent, unsubscribe func()) {
+	liveMessageRateStarted.Do(func() {
+		go func() {
+			defer Recover()
+			liveMessageRateLoop()
+		}()
+	})
+
+	subscription := &liveSubscription{
+		guildID: guildID,
+		events:  make(chan models.LiveEvent, liveEventsQueueSize),
+	}
+
+	liveLock.Lock()
+	liveSubscriptions[subscription] = true
+	liveLock.Unlock()
+
+	return subscription.events, func() {
+		liveLock.Lock()
+		delete(liveSubscriptions, subscription)
+		liveLock.Unlock()
+	}
+}
+
+// PublishLiveEvent sends the event to all subscribers of the guild, without blocking
+func PublishLiveEvent(eventType models.LiveEventType, guildID string, data interface{}) {
+	liveLock.Lock()
+	defer liveLock.Unlock()
+
+	for subscription := range liveSubscriptions {
+		if subscription.guildID != guildID {
+			continue
+		}
+		select {
+		case subscription.events <- models.LiveEvent{
+			Type:      eventType,
+			GuildID:   guildID,
+			CreatedAt: time.Now(),
+			Data:      data,
+		}:
+		default:
+		}
+	}
+}
+
+// publishLiveEventlog streams an eventlog entry, moderation actions are streamed as moderation events
+func publishLiveEventlog(createdAt time.Time, guildID, targetID, targetType, userID, actionType, reason string,
+	changes []models.ElasticEventlogChange, options []models.ElasticEventlogOption, waitingForAuditLogBackfill bool) {
+	eventType := models.LiveEventTypeEventlog
+	if liveModerationActionTypes[actionType] {
+		eventType = models.LiveEventTypeModeration
+	}
+
+	PublishLiveEvent(eventType, guildID, models.Rest_Eventlog_Entry{
+		CreatedAt:      createdAt.UTC(),
+		TargetID:       targetID,
+		TargetType:     targetType,
+		UserID:         userID,
+		ActionType:     actionType,
+		Reason:         reason,
+		Changes:        changes,
+		Options:        options,
+		WaitingForData: waitingForAuditLogBackfill,
+	})
+}
+
+// countLiveMessage counts a message for the message rate, only while the guild has subscribers
+func countLiveMessage(guildID string) {
+	liveLock.Lock()
+	defer liveLock.Unlock()
+
+	for subscription := range liveSubscriptions {
+		if subscription.guildID == guildID {
+			liveMessageCounts[guildID]++
+			return
+		}
+	}
+}
+
+// liveMessageRateLoop sends the message count of the last interval to the subscribers of every guild
+func liveMessageRateLoop() {
+	for range time.Tick(LiveMessageRateInterval) {
+		liveLock.Lock()
+		guildIDs := make(map[string]bool)
+		for subscription := range liveSubscriptions {
+			guildIDs[subscription.guildID] = true
+		}
+		counts := liveMessageCounts
+		liveMessageCounts = make(map[string]int)
+		liveLock.Unlock()
+
+		for guildID := range guildIDs {
+			PublishLiveEvent(models.LiveEventTypeMessageRate, guildID, models.LiveMessageRate{
+				Messages:        counts[guildID],
+				IntervalSeconds: int(LiveMessageRateInterval.Seconds()),
+			})
+		}
+	}
+}
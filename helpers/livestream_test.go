@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/models"
+)
+
+func TestPublishLiveEvent(t *testing.T) {
+	events, unsubscribe := SubscribeLiveEvents("1")
+	otherEvents, unsubscribeOther := SubscribeLiveEvents("2")
+	defer unsubscribeOther()
+
+	PublishLiveEvent(models.LiveEventTypeJoin, "1", models.ElasticJoin{GuildID: "1", UserID: "3"})
+
+	select {
+	case event := <-events:
+		if event.Type != models.LiveEventTypeJoin || event.GuildID != "1" {
+			t.Errorf("unexpected event %+v", event)
+		}
+	default:
+		t.Error("event not received")
+	}
+	select {
+	case event := <-otherEvents:
+		t.Errorf("event of another guild received: %+v", event)
+	default:
+	}
+
+	unsubscribe()
+	PublishLiveEvent(models.LiveEventTypeJoin, "1", models.ElasticJoin{GuildID: "1", UserID: "3"})
+	select {
+	case event := <-events:
+		t.Errorf("event received after unsubscribing: %+v", event)
+	default:
+	}
+}
+
+func TestPublishLiveEventDropsForSlowSubscribers(t *testing.T) {
+	_, unsubscribe := SubscribeLiveEvents("1")
+	defer unsubscribe()
+
+	// must not block once the queue is full
+	for i := 0; i < liveEventsQueueSize*2; i++ {
+		PublishLiveEvent(models.LiveEventTypeLeave, "1", models.ElasticLeave{GuildID: "1"})
+	}
+}
+
+func TestPublishLiveEventlogModeration(t *testing.T) {
+	events, unsubscribe := SubscribeLiveEvents("1")
+	defer unsubscribe()
+
+	publishLiveEventlog(time.Now(), "1", "3", models.EventlogTargetTypeUser, "4", models.EventlogTypeBanAdd, "", nil, nil, false)
+	publishLiveEventlog(time.Now(), "1", "5", models.EventlogTargetTypeRole, "4", models.EventlogTypeRoleCreate, "", nil, nil, false)
+
+	if event := <-events; event.Type != models.LiveEventTypeModeration {
+		t.Errorf("ban streamed as %s", event.Type)
+	}
+	if event := <-events; event.Type != models.LiveEventTypeEventlog {
+		t.Errorf("role create streamed as %s", event.Type)
+	}
+}
@@ -0,0 +1,266 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/metrics/prom"
+)
+
+const (
+	// MachineryQueueDefault is the queue for tasks without a policy
+	MachineryQueueDefault = "robyul_tasks"
+	// MachineryQueueCritical is the queue for tasks users are waiting for, like unmutes and autoroles
+	MachineryQueueCritical = "robyul_tasks_critical"
+	// MachineryQueueBulk is the queue for slow tasks nobody is waiting for, like backfills and imports
+	MachineryQueueBulk = "robyul_tasks_bulk"
+
+	machineryDeadLettersKey  = "robyul2-discord:machinery:dead-letters"
+	machineryDeadLettersKept = 250
+	machineryAttemptHeader   = "robyul_attempt"
+)
+
+// MachineryQueues are all queues workers consume from
+var MachineryQueues = []string{MachineryQueueCritical, MachineryQueueDefault, MachineryQueueBulk}
+
+// MachineryTaskPolicy decides where a task runs and how often it is retried
+type MachineryTaskPolicy struct {
+	Queue string
+	// how often a failed task is retried before it is moved to the dead letters
+	Retries int
+	// the delay before the first retry, doubled for every further retry up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+var machineryTaskPolicies = map[string]MachineryTaskPolicy{
+	"unmute_user":            {Queue: MachineryQueueCritical, Retries: 5, Backoff: 5 * time.Second, MaxBackoff: 5 * time.Minute},
+	"apply_autorole":         {Queue: MachineryQueueCritical, Retries: 5, Backoff: 5 * time.Second, MaxBackoff: 5 * time.Minute},
+	"post_scheduled_message": {Queue: MachineryQueueDefault, Retries: 3, Backoff: 10 * time.Second, MaxBackoff: 5 * time.Minute},
+	"audit_log_backfill":     {Queue: MachineryQueueBulk, Retries: 2, Backoff: time.Minute, MaxBackoff: 10 * time.Minute},
+}
+
+// MachineryWorkerPool is a number of workers consuming a queue
+type MachineryWorkerPool struct {
+	Queue       string
+	Workers     int
+	Concurrency int
+}
+
+// MachineryDeadLetter is a task that failed after all retries
+type MachineryDeadLetter struct {
+	UUID     string
+	Task     string
+	Queue    string
+	Args     []tasks.Arg
+	Error    string
+	Attempts int
+	FailedAt time.Time
+}
+
+var (
+	machineryTaskStarts     = make(map[string]time.Time)
+	machineryTaskStartsLock sync.Mutex
+)
+
+// GetMachineryTaskPolicy returns the policy of the task, tasks without a policy run on the default queue without
+// retries
+func GetMachineryTaskPolicy(name string) MachineryTaskPolicy {
+	if policy, ok := machineryTaskPolicies[name]; ok {
+		return policy
+	}
+	return MachineryTaskPolicy{Queue: MachineryQueueDefault}
+}
+
+// Delay returns how long to wait before the retry after the given attempt, starting at 1
+func (p MachineryTaskPolicy) Delay(attempt int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// NewMachinerySignature creates a signature for the task, sent to the queue of its policy.
+// Retries are handled by MachineryPostTaskHandler instead of machinery, so the signature has no RetryCount.
+func NewMachinerySignature(name string, args ...tasks.Arg) *tasks.Signature {
+	return &tasks.Signature{
+		Name:       name,
+		Args:       args,
+		RoutingKey: GetMachineryTaskPolicy(name).Queue,
+		Headers:    tasks.Headers{machineryAttemptHeader: 1},
+	}
+}
+
+// GetMachineryWorkerPools returns the worker pools of all queues, configured in machinery.pools.<queue>.workers and
+// machinery.pools.<queue>.concurrency
+func GetMachineryWorkerPools() (pools []MachineryWorkerPool) {
+	defaults := map[string]MachineryWorkerPool{
+		MachineryQueueCritical: {Workers: 1, Concurrency: 4},
+		MachineryQueueDefault:  {Workers: 1, Concurrency: 2},
+		MachineryQueueBulk:     {Workers: 1, Concurrency: 1},
+	}
+
+	for _, queue := range MachineryQueues {
+		pool := defaults[queue]
+		pool.Queue = queue
+		if workers, ok := GetConfig().Path("machinery.pools." + queue + ".workers").Data().(float64); ok && workers >= 0 {
+			pool.Workers = int(workers)
+		}
+		if concurrency, ok := GetConfig().Path("machinery.pools." + queue + ".concurrency").Data().(float64); ok && concurrency >= 1 {
+			pool.Concurrency = int(concurrency)
+		}
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// MachineryPreTaskHandler remembers when a task started, for the duration metric
+func MachineryPreTaskHandler(signature *tasks.Signature) {
+	machineryTaskStartsLock.Lock()
+	machineryTaskStarts[signature.UUID] = time.Now()
+	machineryTaskStartsLock.Unlock()
+}
+
+// MachineryPostTaskHandler exports the metrics of a finished task, retries it if it failed and its policy allows
+// another attempt, and moves it to the dead letters otherwise
+func MachineryPostTaskHandler(signature *tasks.Signature) {
+	defer Recover()
+
+	machineryTaskStartsLock.Lock()
+	started, ok := machineryTaskStarts[signature.UUID]
+	delete(machineryTaskStarts, signature.UUID)
+	machineryTaskStartsLock.Unlock()
+	if ok {
+		prom.MachineryTaskDuration.WithLabelValues(signature.Name).Observe(time.Since(started).Seconds())
+	}
+
+	state, err := cache.GetMachineryServer().GetBackend().GetState(signature.UUID)
+	if err != nil {
+		RelaxLog(err)
+		return
+	}
+
+	switch state.State {
+	case tasks.StateSuccess:
+		prom.MachineryTasks.WithLabelValues(signature.Name, "success").Inc()
+	case tasks.StateRetry:
+		// retried by machinery, for signatures with a RetryCount
+		prom.MachineryTasks.WithLabelValues(signature.Name, "retry").Inc()
+	case tasks.StateFailure:
+		policy := GetMachineryTaskPolicy(signature.Name)
+		attempt := getMachineryAttempt(signature)
+		if attempt <= policy.Retries {
+			prom.MachineryTasks.WithLabelValues(signature.Name, "retry").Inc()
+			RelaxLog(retryMachineryTask(signature, attempt, policy.Delay(attempt)))
+			return
+		}
+
+		prom.MachineryTasks.WithLabelValues(signature.Name, "failure").Inc()
+		RelaxLog(addMachineryDeadLetter(signature, state.Error, attempt))
+	}
+}
+
+func retryMachineryTask(signature *tasks.Signature, attempt int, delay time.Duration) (err error) {
+	cache.GetLogger().WithField("module", "machinery").Warnf("task %s %s failed on attempt %d, retrying in %s",
+		signature.Name, signature.UUID, attempt, delay)
+
+	retry := *signature
+	retry.Headers = tasks.Headers{}
+	for key, value := range signature.Headers {
+		retry.Headers[key] = value
+	}
+	retry.Headers[machineryAttemptHeader] = attempt + 1
+	eta := time.Now().UTC().Add(delay)
+	retry.ETA = &eta
+
+	_, err = cache.GetMachineryServer().SendTask(&retry)
+	return err
+}
+
+func addMachineryDeadLetter(signature *tasks.Signature, taskErr string, attempts int) (err error) {
+	cache.GetLogger().WithField("module", "machinery").Errorf("task %s %s failed after %d attempts: %s",
+		signature.Name, signature.UUID, attempts, taskErr)
+
+	marshalled, err := json.Marshal(MachineryDeadLetter{
+		UUID:     signature.UUID,
+		Task:     signature.Name,
+		Queue:    signature.RoutingKey,
+		Args:     signature.Args,
+		Error:    taskErr,
+		Attempts: attempts,
+		FailedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	redis := cache.GetRedisClient()
+	err = redis.LPush(machineryDeadLettersKey, marshalled).Err()
+	if err != nil {
+		return err
+	}
+	return redis.LTrim(machineryDeadLettersKey, 0, machineryDeadLettersKept-1).Err()
+}
+
+// GetMachineryDeadLetters returns the latest tasks that failed after all retries, newest first
+func GetMachineryDeadLetters(limit int) (deadLetters []MachineryDeadLetter, err error) {
+	items, err := cache.GetRedisClient().LRange(machineryDeadLettersKey, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		var deadLetter MachineryDeadLetter
+		err = json.Unmarshal([]byte(item), &deadLetter)
+		if err != nil {
+			RelaxLog(err)
+			continue
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, nil
+}
+
+// CountMachineryDeadLetters returns how many dead letters are kept
+func CountMachineryDeadLetters() (count int64, err error) {
+	return cache.GetRedisClient().LLen(machineryDeadLettersKey).Result()
+}
+
+// getMachineryAttempt returns the attempt of the task, starting at 1, the header is a json.Number once the signature
+// was sent through the broker
+func getMachineryAttempt(signature *tasks.Signature) int {
+	switch attempt := signature.Headers[machineryAttemptHeader].(type) {
+	case int:
+		return attempt
+	case float64:
+		return int(attempt)
+	case json.Number:
+		if value, err := attempt.Int64(); err == nil {
+			return int(value)
+		}
+	case string:
+		if value, err := strconv.Atoi(attempt); err == nil {
+			return value
+		}
+	}
+	return 1
+}
+
+// String summarises the dead letter for the debug command
+func (d MachineryDeadLetter) String() string {
+	args := make([]interface{}, 0, len(d.Args))
+	for _, arg := range d.Args {
+		args = append(args, arg.Value)
+	}
+	return fmt.Sprintf("%s %s %v on %s, %d attempts, failed %s: %s",
+		d.Task, d.UUID, args, d.Queue, d.Attempts, d.FailedAt.UTC().Format(time.RFC3339), d.Error)
+}
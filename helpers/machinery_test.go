@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+func TestMachineryTaskPolicyDelay(t *testing.T) {
+	policy := MachineryTaskPolicy{Backoff: 5 * time.Second, MaxBackoff: time.Minute}
+
+	expected := []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute}
+	for i, delay := range expected {
+		if got := policy.Delay(i + 1); got != delay {
+			t.Errorf("attempt %d: expected %s, got %s", i+1, delay, got)
+		}
+	}
+}
+
+func TestGetMachineryAttempt(t *testing.T) {
+	signature := NewMachinerySignature("unmute_user")
+	if signature.RoutingKey != MachineryQueueCritical {
+		t.Errorf("expected queue %s, got %s", MachineryQueueCritical, signature.RoutingKey)
+	}
+	if attempt := getMachineryAttempt(signature); attempt != 1 {
+		t.Errorf("expected attempt 1, got %d", attempt)
+	}
+
+	// headers are numbers once the signature went through the broker
+	signature.Headers = tasks.Headers{machineryAttemptHeader: json.Number("3")}
+	if attempt := getMachineryAttempt(signature); attempt != 3 {
+		t.Errorf("expected attempt 3, got %d", attempt)
+	}
+
+	if attempt := getMachineryAttempt(&tasks.Signature{}); attempt != 1 {
+		t.Errorf("expected attempt 1 without header, got %d", attempt)
+	}
+}
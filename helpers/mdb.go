@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/metrics/prom"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/pkg/errors"
 )
@@ -121,6 +122,7 @@ func MDbInsert(collection models.MongoDbCollection, data interface{}) (rid bson.
 	start := time.Now()
 	err = GetMDb().C(collection.String()).Insert(recordData.Interface())
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MDbInsert()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 
 	if cache.HasKeen() {
 		go func() {
@@ -188,6 +190,7 @@ func MDbUpdate(collection models.MongoDbCollection, id bson.ObjectId, data inter
 	start := time.Now()
 	err = GetMDb().C(collection.String()).UpdateId(id, data)
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MDbUpdate()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 
 	if cache.HasKeen() {
 		go func() {
@@ -226,6 +229,7 @@ func MDbUpdateQuery(collection models.MongoDbCollection, selector interface{}, d
 	start := time.Now()
 	err = GetMDb().C(collection.String()).Update(selector, data)
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MDbUpdateSelector()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 
 	if cache.HasKeen() {
 		go func() {
@@ -264,6 +268,7 @@ func MDbUpsertID(collection models.MongoDbCollection, id bson.ObjectId, data int
 	start := time.Now()
 	_, err = GetMDb().C(collection.String()).UpsertId(id, data)
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MDbUpsertID()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 
 	if cache.HasKeen() {
 		go func() {
@@ -304,6 +309,7 @@ func MDbUpsert(collection models.MongoDbCollection, selector interface{}, data i
 	start := time.Now()
 	_, err = GetMDb().C(collection.String()).Upsert(selector, data)
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MDbUpsert()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 
 	if cache.HasKeen() {
 		go func() {
@@ -340,6 +346,7 @@ func MDbDelete(collection models.MongoDbCollection, id bson.ObjectId) (err error
 	start := time.Now()
 	err = GetMDb().C(collection.String()).RemoveId(id)
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MDbDelete()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 
 	if cache.HasKeen() {
 		go func() {
@@ -377,6 +384,7 @@ func MdbDeleteQuery(collection models.MongoDbCollection, selector interface{}) (
 	start := time.Now()
 	err = GetMDb().C(collection.String()).Remove(selector)
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MdbDeleteQuery()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 
 	if cache.HasKeen() {
 		go func() {
@@ -414,6 +422,7 @@ func MDbIter(query *mgo.Query) (iter *mgo.Iter) {
 	start := time.Now()
 	iter = query.Iter()
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MdbIter()", mdbQueryCollection(query)).Observe(took.Seconds())
 	if cache.HasKeen() {
 		go func() {
 			defer Recover()
@@ -446,6 +455,7 @@ func MdbOne(query *mgo.Query, object interface{}) (err error) {
 	start := time.Now()
 	err = query.One(object)
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MdbOne()", mdbQueryCollection(query)).Observe(took.Seconds())
 	if cache.HasKeen() {
 		go func() {
 			defer Recover()
@@ -478,6 +488,7 @@ func MdbPipeOne(collection models.MongoDbCollection, pipeline interface{}, objec
 	start := time.Now()
 	err = MdbCollection(collection).Pipe(pipeline).One(object)
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MdbPipeOne()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 	if cache.HasKeen() {
 		go func() {
 			defer Recover()
@@ -505,6 +516,7 @@ func MdbCount(collection models.MongoDbCollection, query interface{}) (count int
 	start := time.Now()
 	count, err = MdbCollection(collection).Find(query).Count()
 	took := time.Since(start)
+	prom.MongoDbQueryDuration.WithLabelValues("MdbCount()", stripRobyulDatabaseFromCollection(collection.String())).Observe(took.Seconds())
 	if cache.HasKeen() {
 		go func() {
 			defer Recover()
@@ -555,6 +567,12 @@ func IsMdbNotFound(err error) (notFound bool) {
 	return false
 }
 
+// returns the collection name of a query, without the database name
+func mdbQueryCollection(query *mgo.Query) string {
+	queryOp := reflect.ValueOf(query).Elem().FieldByName("query").FieldByName("op")
+	return stripRobyulDatabaseFromCollection(queryOp.FieldByName("collection").String())
+}
+
 func stripRobyulDatabaseFromCollection(input string) (output string) {
 	return strings.TrimPrefix(input, mDbDatabase+".")
 }
@@ -73,6 +73,8 @@ const (
 	ModulePermEventlog  // eventlog/
 	ModulePermCrypto    // crypto.go
 	ModulePermImgur     // imgur.go
+	ModulePermRSS       // rss.go
+	ModulePermSchedule  // schedule.go
 
 	ModulePermAll = ModulePermStats | ModulePermTranslator | ModulePermUrban | ModulePermWeather | ModulePermVLive |
 		ModulePermInstagram | ModulePermFacebook | ModulePermWolframAlpha | ModulePermLastFm | ModulePermTwitter |
@@ -84,7 +86,8 @@ const (
 		ModulePermAutoRole | ModulePermBias | ModulePermDiscordmoney | ModulePermGallery |
 		ModulePermGuildAnnouncements | ModulePermMirror | ModulePermMirror | ModulePermMod | ModulePermNotifications |
 		ModulePermNuke | ModulePermPersistency | ModulePermPing | ModulePermTroublemaker | ModulePermVanityInvite |
-		ModulePerm8ball | ModulePermFeedback | ModulePermEmbedPost | ModulePermEventlog | ModulePermCrypto | ModulePermImgur
+		ModulePerm8ball | ModulePermFeedback | ModulePermEmbedPost | ModulePermEventlog | ModulePermCrypto | ModulePermImgur |
+		ModulePermRSS | ModulePermSchedule
 )
 
 var (
@@ -143,6 +146,8 @@ var (
 		{Names: []string{"eventlog"}, Permission: ModulePermEventlog},
 		{Names: []string{"crypto"}, Permission: ModulePermCrypto},
 		{Names: []string{"imgur"}, Permission: ModulePermImgur},
+		{Names: []string{"rss"}, Permission: ModulePermRSS},
+		{Names: []string{"schedule"}, Permission: ModulePermSchedule},
 	}
 )
 
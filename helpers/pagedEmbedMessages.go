@@ -400,7 +400,7 @@ func (p *pagedEmbedMessage) hasError(err error) bool {
 
 func waitForUserMessage() chan *discordgo.MessageCreate {
 	out := make(chan *discordgo.MessageCreate)
-	cache.GetSession().AddHandlerOnce(func(_ *discordgo.Session, e *discordgo.MessageCreate) {
+	cache.AddHandlerOnce(func(_ *discordgo.Session, e *discordgo.MessageCreate) {
 		out <- e
 	})
 	return out
@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var shutdownContext, cancelShutdownContext = context.WithCancel(context.Background())
+
+// ShutdownContext is done once the bot starts to shut down, loops should stop at their next safe point
+func ShutdownContext() context.Context {
+	return shutdownContext
+}
+
+// BeginShutdown marks the bot as shutting down
+func BeginShutdown() {
+	cancelShutdownContext()
+}
+
+// IsShuttingDown returns true once the bot started to shut down
+func IsShuttingDown() bool {
+	return shutdownContext.Err() != nil
+}
+
+// SleepUnlessShuttingDown sleeps for the duration, returns false early if the bot starts to shut down
+func SleepUnlessShuttingDown(duration time.Duration) bool {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-shutdownContext.Done():
+		return false
+	}
+}
+
+// WaitWithContext waits for the wait group, returns false if ctx is done first
+func WaitWithContext(ctx context.Context, waitGroup *sync.WaitGroup) bool {
+	done := make(chan bool)
+	go func() {
+		waitGroup.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
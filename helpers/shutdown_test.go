@@ -0,0 +1,24 @@
+package helpers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWaitWithContext(t *testing.T) {
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if WaitWithContext(ctx, &waitGroup) {
+		t.Error("returned true before the wait group finished")
+	}
+
+	waitGroup.Done()
+	if !WaitWithContext(context.Background(), &waitGroup) {
+		t.Error("returned false after the wait group finished")
+	}
+}
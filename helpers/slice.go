@@ -42,3 +42,13 @@ func StringSliceDiff(rolesA, rolesB []string) (added, removed []string) {
 
 	return
 }
+
+// StringSliceContains returns true if needle is in haystack
+func StringSliceContains(needle string, haystack []string) bool {
+	for _, item := range haystack {
+		if item == needle {
+			return true
+		}
+	}
+	return false
+}
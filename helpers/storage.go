@@ -25,6 +25,8 @@ import (
 
 	"mime"
 
+	"regexp"
+
 	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/globalsign/mgo/bson"
@@ -85,6 +87,11 @@ func AddFile(name string, data []byte, metadata AddFileMetadata, source string,
 	filetype, _ := SniffMime(data)
 	// get filesize
 	filesize := binary.Size(data)
+	// check guild storage quota
+	err = checkGuildStorageQuota(guildID, source, filesize)
+	if err != nil {
+		return "", err
+	}
 	// update metadata
 	if metadata.AdditionalMetadata == nil {
 		metadata.AdditionalMetadata = make(map[string]string, 0)
@@ -333,6 +340,15 @@ func RetrieveFilesByAdditionalObjectMetadata(key, value string) (objectNames []s
 	return objectNames, nil
 }
 
+// Retrieves the information of all files with object names starting with the prefix
+// prefix	: the prefix of the object names
+func RetrieveFilesByPrefix(prefix string) (entries []models.StorageEntry, err error) {
+	err = MDbIterWithoutLogging(MdbCollection(models.StorageTable).Find(
+		bson.M{"objectname": bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)}},
+	)).All(&entries)
+	return entries, err
+}
+
 // Retrieves a file's public url, returns an error if file is not public
 // objectName	:  the name of the object
 func GetFileLink(objectName string) (url string, err error) {
@@ -0,0 +1,242 @@
+package helpers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/globalsign/mgo/bson"
+)
+
+const (
+	// default storage quota per guild, can be overwritten in the config (storage.guild_quota) or per guild
+	StorageDefaultGuildQuota uint64 = 500 * 1024 * 1024
+	// unreferenced objects younger than this will not be garbage collected, so uploads in progress are safe
+	StorageGCGracePeriod = 24 * time.Hour
+)
+
+var (
+	ErrStorageQuotaExceeded = errors.New("storage quota exceeded for this guild")
+
+	// sources that do not count towards the guild quota, because they are created by Robyul or belong to users instead of guilds
+	storageQuotaExemptSources = []string{"eventlog", "biasgame", "levels"}
+
+	// default retention per source, objects older than this will be deleted, can be overwritten in the config (storage.retention)
+	storageDefaultRetentionPolicies = map[string]time.Duration{
+		"eventlog": 90 * 24 * time.Hour,
+	}
+
+	// collects all object names from MongoDB that are still in use by a source
+	// objects of sources not listed here will never be garbage collected, unless they have a retention policy
+	storageReferenceCollectors = map[string]func() (map[string]bool, error){
+		"customcommands": func() (map[string]bool, error) {
+			return storageCollectReferences(models.CustomCommandsTable, "storageobjectname")
+		},
+		"levels": func() (map[string]bool, error) {
+			return storageCollectReferences(
+				models.ProfileBackgroundsTable, "objectname",
+				models.ProfileBadgesTable, "objectname",
+				models.ProfileUserdataTable, "backgroundobjectname",
+			)
+		},
+		"dog": func() (map[string]bool, error) {
+			return storageCollectReferences(models.DogLinksTable, "objectname")
+		},
+		"biasgame": func() (map[string]bool, error) {
+			return storageCollectReferences(
+				models.IdolTable, "images.objectname",
+				models.IdolSuggestionsTable, "objectname",
+			)
+		},
+	}
+)
+
+type StorageUsage struct {
+	Files    int
+	Storage  uint64            // in bytes
+	Traffic  uint64            // in bytes
+	BySource map[string]uint64 // source => bytes
+}
+
+type StorageGCResult struct {
+	Checked        int
+	DeletedOrphans int
+	DeletedExpired int
+	FreedStorage   uint64 // in bytes
+}
+
+// Returns the storage usage of a guild
+// guildID	: the guild to calculate the usage for
+func GetGuildStorageUsage(guildID string) (usage StorageUsage, err error) {
+	var results []struct {
+		Source  string `bson:"_id"`
+		Files   int
+		Storage int64
+		Traffic int64
+	}
+	err = MdbCollection(models.StorageTable).Pipe([]bson.M{
+		{"$match": bson.M{"guildid": guildID}},
+		{"$group": bson.M{
+			"_id":     "$source",
+			"files":   bson.M{"$sum": 1},
+			"storage": bson.M{"$sum": "$filesize"},
+			"traffic": bson.M{"$sum": bson.M{"$multiply": []interface{}{"$filesize", "$retrievedcount"}}},
+		}},
+	}).All(&results)
+	if err != nil {
+		return usage, err
+	}
+
+	usage.BySource = make(map[string]uint64, 0)
+	for _, result := range results {
+		usage.Files += result.Files
+		usage.Storage += uint64(result.Storage)
+		usage.Traffic += uint64(result.Traffic)
+		usage.BySource[result.Source] = uint64(result.Storage)
+	}
+
+	return usage, nil
+}
+
+// Returns the storage quota of a guild in bytes
+// guildID	: the guild to get the quota for
+func GetGuildStorageQuota(guildID string) (quota uint64) {
+	settings := GuildSettingsGetCached(guildID)
+	if settings.StorageQuota > 0 {
+		return uint64(settings.StorageQuota)
+	}
+
+	if GetConfig().ExistsP("storage.guild_quota") {
+		if configQuota, ok := GetConfig().Path("storage.guild_quota").Data().(float64); ok && configQuota > 0 {
+			return uint64(configQuota)
+		}
+	}
+
+	return StorageDefaultGuildQuota
+}
+
+// Checks if a new file would exceed the storage quota of a guild, returns ErrStorageQuotaExceeded if it would
+// guildID	: the guild the file belongs to, an empty guildID is always allowed
+// source	: the source of the new file
+// filesize	: the size of the new file in bytes
+func checkGuildStorageQuota(guildID, source string, filesize int) (err error) {
+	if guildID == "" || StringSliceContains(source, storageQuotaExemptSources) {
+		return nil
+	}
+
+	usage, err := GetGuildStorageUsage(guildID)
+	if err != nil {
+		return err
+	}
+
+	var exemptStorage uint64
+	for _, exemptSource := range storageQuotaExemptSources {
+		exemptStorage += usage.BySource[exemptSource]
+	}
+
+	if usage.Storage-exemptStorage+uint64(filesize) > GetGuildStorageQuota(guildID) {
+		return ErrStorageQuotaExceeded
+	}
+
+	return nil
+}
+
+// Returns the retention for a source, zero means objects of this source are kept forever
+// source	: the source to get the retention for
+func GetStorageRetention(source string) (retention time.Duration) {
+	if GetConfig().ExistsP("storage.retention." + source) {
+		if configRetention, ok := GetConfig().Path("storage.retention." + source).Data().(string); ok {
+			retention, err := time.ParseDuration(configRetention)
+			if err == nil {
+				return retention
+			}
+			cache.GetLogger().WithField("module", "storage").Warnf(
+				"invalid retention for source %s in config: %s", source, err.Error(),
+			)
+		}
+	}
+
+	return storageDefaultRetentionPolicies[source]
+}
+
+// Deletes all objects that are expired by their retention policy or not referenced by any MongoDB entry anymore
+// dryRun	: if true no objects will be deleted
+func StorageGarbageCollect(dryRun bool) (result StorageGCResult, err error) {
+	references := make(map[string]map[string]bool, 0)
+	for source, collector := range storageReferenceCollectors {
+		references[source], err = collector()
+		if err != nil {
+			return result, err
+		}
+	}
+
+	var entry models.StorageEntry
+	iter := MdbCollection(models.StorageTable).Find(nil).Select(bson.M{
+		"objectname": 1, "source": 1, "uploaddate": 1, "filesize": 1,
+	}).Iter()
+	for iter.Next(&entry) {
+		result.Checked++
+
+		expired := false
+		if retention := GetStorageRetention(entry.Source); retention > 0 {
+			expired = time.Since(entry.UploadDate) > retention
+		}
+
+		orphaned := false
+		if sourceReferences, ok := references[entry.Source]; ok && !expired {
+			orphaned = !sourceReferences[entry.ObjectName] && time.Since(entry.UploadDate) > StorageGCGracePeriod
+		}
+
+		if !expired && !orphaned {
+			continue
+		}
+
+		if !dryRun {
+			err = DeleteFile(entry.ObjectName)
+			if err != nil {
+				cache.GetLogger().WithField("module", "storage").Warnf(
+					"garbage collection failed to delete #%s: %s", entry.ObjectName, err.Error(),
+				)
+				continue
+			}
+		}
+
+		if expired {
+			result.DeletedExpired++
+		} else {
+			result.DeletedOrphans++
+		}
+		result.FreedStorage += uint64(entry.Filesize)
+	}
+	err = iter.Close()
+
+	cache.GetLogger().WithField("module", "storage").Infof(
+		"garbage collection (dry run: %t) checked %d objects, deleted %d orphans and %d expired objects",
+		dryRun, result.Checked, result.DeletedOrphans, result.DeletedExpired,
+	)
+
+	return result, err
+}
+
+// collects all values of the given fields as a set
+// tableAndFields	: pairs of a models.MongoDbCollection and the lowercase field name holding the object name
+func storageCollectReferences(tableAndFields ...interface{}) (references map[string]bool, err error) {
+	references = make(map[string]bool, 0)
+	for i := 0; i+1 < len(tableAndFields); i += 2 {
+		table := tableAndFields[i].(models.MongoDbCollection)
+		field := tableAndFields[i+1].(string)
+
+		var objectNames []string
+		err = MdbCollection(table).Find(bson.M{field: bson.M{"$nin": []interface{}{"", nil}}}).Distinct(field, &objectNames)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, objectName := range objectNames {
+			references[objectName] = true
+		}
+	}
+
+	return references, nil
+}
@@ -33,6 +33,29 @@ func WebhookExecuteWithResult(id, token string, data *discordgo.WebhookParams) (
 	return message, err
 }
 
+// Edits the content of a message sent by a webhook
+// id			: the ID of the webhook which sent the message
+// token		: the token of the webhook
+// messageID	: the message to edit
+// content		: the new content
+func WebhookMessageEdit(id, token, messageID, content string) (message *discordgo.Message, err error) {
+	uri := discordgo.EndpointWebhookToken(id, token) + "/messages/" + messageID
+
+	data := struct {
+		Content string `json:"content"`
+	}{
+		Content: CleanDiscordContent(content),
+	}
+
+	result, err := cache.GetSession().RequestWithBucketID("PATCH", uri, data, discordgo.EndpointWebhookToken("", "")+"/messages/")
+	if err != nil {
+		return message, err
+	}
+
+	err = json.Unmarshal(result, &message)
+	return message, err
+}
+
 // Gets a webhook for a channel (checks for permission, and uses cache)
 // guildID		: the guild from which to get the webhook
 // channelID	: the channel for which to get the webhook
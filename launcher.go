@@ -10,6 +10,8 @@ import (
 	"os/signal"
 	"runtime"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	unleash "github.com/Unleash/unleash-client-go"
@@ -18,11 +20,16 @@ import (
 	marchineryConfig "github.com/RichardKnop/machinery/v1/config"
 	marchineryLog "github.com/RichardKnop/machinery/v1/log"
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/feeds"
+	"github.com/Seklfreak/Robyul2/health"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/logging"
 	"github.com/Seklfreak/Robyul2/metrics"
+	"github.com/Seklfreak/Robyul2/metrics/prom"
 	"github.com/Seklfreak/Robyul2/migrations"
+	"github.com/Seklfreak/Robyul2/modules"
 	"github.com/Seklfreak/Robyul2/modules/plugins"
+	"github.com/Seklfreak/Robyul2/modules/plugins/eventlog"
 	"github.com/Seklfreak/Robyul2/rest"
 	"github.com/Seklfreak/Robyul2/robyulstate"
 	"github.com/Seklfreak/Robyul2/version"
@@ -40,6 +47,13 @@ import (
 	_ "net/http/pprof"
 )
 
+const (
+	// how long loops, queues and plugins get to finish their work on shutdown
+	shutdownDeadline = 45 * time.Second
+	// the shutdown is forced after this time
+	shutdownForceTimeout = 60 * time.Second
+)
+
 var (
 	BotRuntimeChannel chan os.Signal
 )
@@ -98,12 +112,18 @@ func main() {
 
 	// Read i18n
 	helpers.LoadTranslations()
+	for _, locale := range helpers.SupportedLocales {
+		if missing := helpers.MissingTranslations(locale); len(missing) > 0 {
+			log.WithField("module", "launcher").Warnf("locale %s is missing %d translations", locale, len(missing))
+		}
+	}
 
 	// Show version
 	version.DumpInfo()
 
-	// Start metric server
+	// Start metric server, with the health probes
 	metrics.Init()
+	health.Init()
 
 	// Make the randomness more random
 	rand.Seed(time.Now().UTC().UnixNano())
@@ -139,6 +159,7 @@ func main() {
 			),
 			elastic.SetSniff(true),
 			elastic.SetErrorLog(log),
+			elastic.SetHttpClient(&http.Client{Transport: prom.ElasticTransport(http.DefaultTransport)}),
 			// elastic.SetInfoLog(log),
 		)
 		if err != nil {
@@ -168,17 +189,34 @@ func main() {
 		cache.SetPolr(polrClient)
 	}
 
-	// Run migrations
-	migrations.Run()
-
-	// stop after migrations?
-	for _, arg := range os.Args {
+	// stop after migrations? the mode can follow, stop-after-migration [up|status|dry-run]
+	stopAfterMigration := false
+	migrationMode := migrations.ModeUp
+	for i, arg := range os.Args {
 		if arg == "stop-after-migration" {
-			log.WithField("module", "launcher").Info("stopping after migration")
-			return
+			stopAfterMigration = true
+			if len(os.Args) > i+1 {
+				// other arguments are left alone
+				switch mode := migrations.Mode(os.Args[i+1]); mode {
+				case migrations.ModeUp, migrations.ModeStatus, migrations.ModeDryRun:
+					migrationMode = mode
+				}
+			}
 		}
 	}
 
+	// Run migrations
+	err = migrations.Run(migrationMode)
+	if err != nil {
+		log.WithField("module", "launcher").Error("migrations failed:", err.Error())
+		panic(err)
+	}
+
+	if stopAfterMigration {
+		log.WithField("module", "launcher").Info("stopping after migration")
+		return
+	}
+
 	// Connecting to redis
 	log.WithField("module", "launcher").Info("Connecting to redis...")
 	redisClient := redis.NewClient(&redis.Options{
@@ -261,44 +299,42 @@ func main() {
 		}
 	}
 	log.WithField("module", "launcher").Info("Connecting Robyul to discord...")
-	discord, err := discordgo.New("Bot " + config.Path("discord.token").Data().(string))
+	shardSessions, err := NewShardSessions("Bot " + config.Path("discord.token").Data().(string))
 	if err != nil {
 		panic(err)
 	}
-
-	discord.Lock()
-	discord.Debug = false
-	//discord.LogLevel = discordgo.LogInformational
-	discord.LogLevel = discordgo.LogError
-	discord.StateEnabled = true
-	discord.MaxRestRetries = 5
-	discord.State.MaxMessageCount = 10
-	discord.Unlock()
-
-	discord.AddHandler(BotOnReady)
-	discord.AddHandler(BotOnMessageCreate)
-	discord.AddHandler(BotOnMessageDelete)
-	discord.AddHandler(BotOnGuildMemberAdd)
-	discord.AddHandler(BotOnGuildMemberRemove)
-	discord.AddHandler(BotOnReactionAdd)
-	discord.AddHandler(BotOnReactionRemove)
-	discord.AddHandler(BotOnGuildBanAdd)
-	discord.AddHandler(BotOnGuildBanRemove)
-	discord.AddHandlerOnce(metrics.OnReady)
-	discord.AddHandler(metrics.OnMessageCreate)
-	discord.AddHandler(BotOnMemberListChunk)
-	discord.AddHandler(BotGuildOnPresenceUpdate)
-	discord.AddHandler(BotOnGuildCreate)
-	discord.AddHandler(BotOnGuildDelete)
-
-	if cache.HasElastic() {
-		discord.AddHandler(helpers.ElasticOnMessageCreate)
-		discord.AddHandler(helpers.ElasticOnMessageUpdate)
-		discord.AddHandler(helpers.ElasticOnMessageDelete)
-		discord.AddHandler(helpers.ElasticOnGuildMemberRemove)
-		discord.AddHandler(helpers.ElasticOnPresenceUpdate)
-		// Guild Member Add in modules/plugins/mod.go
+	cache.SetSessions(shardSessions)
+	log.WithField("module", "launcher").Infof("Using %d shard(s)", len(shardSessions))
+
+	for _, discord := range shardSessions {
+		discord.AddHandler(BotOnReady)
+		discord.AddHandler(BotOnShardResumed)
+		discord.AddHandler(BotOnShardDisconnect)
+		discord.AddHandler(BotOnMessageCreate)
+		discord.AddHandler(BotOnMessageDelete)
+		discord.AddHandler(BotOnGuildMemberAdd)
+		discord.AddHandler(BotOnGuildMemberRemove)
+		discord.AddHandler(BotOnReactionAdd)
+		discord.AddHandler(BotOnReactionRemove)
+		discord.AddHandler(BotOnGuildBanAdd)
+		discord.AddHandler(BotOnGuildBanRemove)
+		discord.AddHandler(metrics.OnMessageCreate)
+		discord.AddHandler(BotOnMemberListChunk)
+		discord.AddHandler(BotGuildOnPresenceUpdate)
+		discord.AddHandler(BotOnGuildCreate)
+		discord.AddHandler(BotOnGuildDelete)
+
+		if cache.HasElastic() {
+			discord.AddHandler(helpers.ElasticOnMessageCreate)
+			discord.AddHandler(helpers.ElasticOnMessageUpdate)
+			discord.AddHandler(helpers.ElasticOnMessageDelete)
+			discord.AddHandler(helpers.ElasticOnGuildMemberRemove)
+			discord.AddHandler(helpers.ElasticOnPresenceUpdate)
+			// Guild Member Add in modules/plugins/mod.go
+		}
 	}
+	// all shards share the state, the metrics are collected once
+	shardSessions[0].AddHandlerOnce(metrics.OnReady)
 
 	robyulState := robyulstate.NewState()
 	robyulState.Logger = func(msgL, caller int, format string, a ...interface{}) {
@@ -328,10 +364,12 @@ func main() {
 		}
 	}
 
-	discord.AddHandler(robyulState.OnInterface)
+	for _, discord := range shardSessions {
+		discord.AddHandler(robyulState.OnInterface)
+	}
 
 	// Connect to discord
-	err = discord.Open()
+	err = OpenShardSessions(shardSessions)
 	if err != nil {
 		raven.CaptureErrorAndWait(err, nil)
 		panic(err)
@@ -348,8 +386,8 @@ func main() {
 			"http://localhost:8000",
 			"http://robyul-web.local:8000",
 		},
-		AllowedHeaders: []string{"Content-Type", "Accept", "Origin", "X-CSRF-Token", "Authorization"},
-		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type", "Accept", "Origin", "X-CSRF-Token", "Authorization", "If-None-Match"},
+		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE"},
 		MaxAge:         1000,
 		Container:      wsContainer,
 	}
@@ -376,39 +414,66 @@ func main() {
 	}()
 	log.WithField("module", "launcher").Info("REST API listening on localhost:2021")
 
-	// Launch machinery
+	// Launch machinery, every worker gets its own server because a broker can only consume one queue at a time
 	marchineryLog.Set(log.WithField("module", "machinery"))
-	machineryServerConfig := &marchineryConfig.Config{
-		Broker:          "redis://" + config.Path("redis.address").Data().(string) + "/1",
-		DefaultQueue:    "robyul_tasks",
-		ResultBackend:   "redis://" + config.Path("redis.address").Data().(string) + "/1",
-		ResultsExpireIn: 3600,
-	}
-	machineryServer, err := machinery.NewServer(machineryServerConfig)
-	if err != nil {
-		raven.CaptureErrorAndWait(err, nil)
-		panic(err)
+	machineryTasks := map[string]interface{}{
+		"unmute_user":            helpers.UnmuteUserMachinery,
+		"apply_autorole":         plugins.AutoroleApply,
+		"post_scheduled_message": plugins.ScheduledMessagePost,
+		"audit_log_backfill":     eventlog.AuditLogBackfill,
 	}
-	log.WithField("module", "launcher").Info("started machinery server, default queue: robyul_tasks")
-	machineryServer.RegisterTasks(map[string]interface{}{
-		"unmute_user":    helpers.UnmuteUserMachinery,
-		"apply_autorole": plugins.AutoroleApply,
-		"log_error":      helpers.LogMachineryError,
-	})
-	cache.SetMachineryServer(machineryServer)
-	worker := machineryServer.NewWorker("robyul_worker_1", 1)
-	go func() {
-		cache.AddMachineryActiveWorker(worker)
-		err = worker.Launch()
-		cache.RemoveMachineryActiveWorker(worker)
+	newMachineryServer := func(queue string) *machinery.Server {
+		machineryServer, err := machinery.NewServer(&marchineryConfig.Config{
+			Broker:          "redis://" + config.Path("redis.address").Data().(string) + "/1",
+			DefaultQueue:    queue,
+			ResultBackend:   "redis://" + config.Path("redis.address").Data().(string) + "/1",
+			ResultsExpireIn: 3600,
+			// the workers are stopped with everything else on shutdown
+			NoUnixSignals: true,
+		})
+		if err == nil {
+			err = machineryServer.RegisterTasks(machineryTasks)
+		}
 		if err != nil {
-			if !strings.Contains(err.Error(), "Signal received: interrupt") && !strings.Contains(err.Error(), "Worker quit gracefully") {
-				raven.CaptureErrorAndWait(err, nil)
-				panic(err)
-			}
+			raven.CaptureErrorAndWait(err, nil)
+			panic(err)
 		}
-	}()
-	log.WithField("module", "launcher").Info("started machinery worker robyul_worker_1 with concurrency 1")
+		return machineryServer
+	}
+	cache.SetMachineryServer(newMachineryServer(helpers.MachineryQueueDefault))
+	log.WithField("module", "launcher").Infof("started machinery server, default queue: %s", helpers.MachineryQueueDefault)
+
+	var machineryWorkers []*machinery.Worker
+	var machineryWorkersRunning sync.WaitGroup
+	for _, pool := range helpers.GetMachineryWorkerPools() {
+		for i := 1; i <= pool.Workers; i++ {
+			worker := newMachineryServer(pool.Queue).NewCustomQueueWorker(
+				fmt.Sprintf("%s_worker_%d", pool.Queue, i), pool.Concurrency, pool.Queue)
+			worker.SetPreTaskHandler(helpers.MachineryPreTaskHandler)
+			worker.SetPostTaskHandler(helpers.MachineryPostTaskHandler)
+			// failed tasks are retried or moved to the dead letters by the post task handler
+			worker.SetErrorHandler(func(err error) {
+				log.WithField("module", "machinery").Warn(err.Error())
+			})
+			machineryWorkers = append(machineryWorkers, worker)
+
+			machineryWorkersRunning.Add(1)
+			go func() {
+				defer machineryWorkersRunning.Done()
+				cache.AddMachineryActiveWorker(worker)
+				err := worker.Launch()
+				cache.RemoveMachineryActiveWorker(worker)
+				if err != nil && !helpers.IsShuttingDown() {
+					if !strings.Contains(err.Error(), "Signal received: interrupt") && !strings.Contains(err.Error(), "Worker quit gracefully") {
+						raven.CaptureErrorAndWait(err, nil)
+						panic(err)
+					}
+				}
+			}()
+		}
+		log.WithField("module", "launcher").Infof("started %d machinery workers for queue %s with concurrency %d",
+			pool.Workers, pool.Queue, pool.Concurrency)
+	}
 	machineryRedisClient := redis.NewClient(&redis.Options{
 		Addr:     config.Path("redis.address").Data().(string),
 		Password: "", // no password set
@@ -421,20 +486,51 @@ func main() {
 
 	// Make a channel that waits for a os signal
 	BotRuntimeChannel = make(chan os.Signal, 1)
-	signal.Notify(BotRuntimeChannel, os.Interrupt, os.Kill)
+	signal.Notify(BotRuntimeChannel, os.Interrupt, os.Kill, syscall.SIGTERM)
 
 	// Wait until the os wants us to shutdown
 	<-BotRuntimeChannel
 
 	log.WithField("module", "launcher").Info("Robyul is stopping")
 
+	// stop the loops at their next safe point, and give them until the deadline to finish their work
+	helpers.BeginShutdown()
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownDeadline)
+	defer cancelShutdown()
+
 	// shutdown everything
 	finished := make(chan bool, 1)
 	go func() {
+		var draining sync.WaitGroup
+		draining.Add(3)
+		go func() {
+			defer draining.Done()
+			log.WithField("module", "launcher").Info("Waiting for running machinery tasks...")
+			for _, worker := range machineryWorkers {
+				go worker.Quit()
+			}
+			if !helpers.WaitWithContext(shutdownCtx, &machineryWorkersRunning) {
+				log.WithField("module", "launcher").Warn("machinery tasks did not finish before the shutdown deadline")
+			}
+		}()
+		go func() {
+			defer draining.Done()
+			log.WithField("module", "launcher").Info("Waiting for plugins to finish their work...")
+			modules.Shutdown(shutdownCtx)
+		}()
+		go func() {
+			defer draining.Done()
+			log.WithField("module", "launcher").Info("Waiting for feed checks...")
+			feeds.Shutdown(shutdownCtx)
+		}()
+		draining.Wait()
+
 		log.WithField("module", "launcher").Info("Uninitializing plugins...")
 		BotDestroy()
-		log.WithField("module", "launcher").Info("Disconnecting bot discord session...")
-		discord.Close()
+		log.WithField("module", "launcher").Info("Disconnecting bot discord sessions...")
+		for _, discord := range shardSessions {
+			discord.Close()
+		}
 		log.WithField("module", "launcher").Info("Disconnecting friend discord sessions...")
 		for _, friendSession := range cache.GetFriends() {
 			friendSession.Close()
@@ -442,12 +538,12 @@ func main() {
 		finished <- true
 	}()
 
-	// wait 60 second for everything to finish, or shut it down anyway
+	// wait for everything to finish, or shut it down anyway
 	select {
 	case <-finished:
 		log.WithField("module", "launcher").Infoln("shutdown successful")
-	case <-time.After(60 * time.Second):
-		log.WithField("module", "launcher").Infoln("forcing shutdown after 60 seconds")
+	case <-time.After(shutdownForceTimeout):
+		log.WithField("module", "launcher").Infof("forcing shutdown after %s", shutdownForceTimeout)
 	}
 }
 
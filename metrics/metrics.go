@@ -4,10 +4,12 @@ import (
 	"expvar"
 	"net/http"
 	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/metrics/prom"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
 	"gopkg.in/mgo.v2/bson"
@@ -162,10 +164,11 @@ var (
 	EventlogPendingAuditlogBackfills = expvar.NewInt("eventlog_pending_auditlog_backfills")
 )
 
-// Init starts a http server on 127.0.0.1:1337
+// Init starts a http server on 127.0.0.1:1337, serving expvar on /debug/vars and Prometheus on /metrics
 func Init() {
 	cache.GetLogger().WithField("module", "metrics").Info("Listening on TCP/1337")
 	Uptime.Set(time.Now().Unix())
+	http.Handle("/metrics", prom.Handler())
 	go http.ListenAndServe(helpers.GetConfig().Path("metrics_ip").Data().(string)+":1337", nil)
 }
 
@@ -203,6 +206,17 @@ func CollectDiscordMetrics(session *discordgo.Session) {
 		UserCount.Set(int64(len(users)))
 		ChannelCount.Set(int64(channels))
 		GuildCount.Set(int64(len(guilds)))
+
+		for _, shard := range cache.GetShardStatuses() {
+			shardLabel := strconv.Itoa(shard.ID)
+			ready := 0.0
+			if shard.Ready {
+				ready = 1
+			}
+			prom.ShardReady.WithLabelValues(shardLabel).Set(ready)
+			prom.ShardGuilds.WithLabelValues(shardLabel).Set(float64(shard.Guilds))
+			prom.ShardHeartbeatLatency.WithLabelValues(shardLabel).Set(shard.Latency.Seconds())
+		}
 	}
 }
 
@@ -215,13 +229,13 @@ func CollectRuntimeMetrics() {
 
 		CoroutineCount.Set(int64(runtime.NumGoroutine()))
 
-		VliveChannelsCount.Set(entriesCountMgo(models.VliveTable, nil))
+		VliveChannelsCount.Set(entriesCountMgo(models.FeedsTable, bson.M{"source": "vlive"}))
 
-		InstagramAccountsCount.Set(entriesCountMgo(models.InstagramTable, nil))
+		InstagramAccountsCount.Set(entriesCountMgo(models.FeedsTable, bson.M{"source": "instagram"}))
 
-		TwitterAccountsCount.Set(entriesCountMgo(models.TwitterTable, nil))
+		TwitterAccountsCount.Set(entriesCountMgo(models.FeedsTable, bson.M{"source": "twitter"}))
 
-		FacebookPagesCount.Set(entriesCountMgo(models.FacebookTable, nil))
+		FacebookPagesCount.Set(entriesCountMgo(models.FeedsTable, bson.M{"source": "facebook"}))
 
 		GalleriesCount.Set(entriesCountMgo(models.GalleryTable, nil))
 
@@ -229,11 +243,11 @@ func CollectRuntimeMetrics() {
 
 		RandomPictureSourcesCount.Set(entriesCountMgo(models.RandompictureSourcesTable, nil))
 
-		RedditSubredditsCount.Set(entriesCountMgo(models.RedditSubredditsTable, nil))
+		RedditSubredditsCount.Set(entriesCountMgo(models.FeedsTable, bson.M{"source": "reddit"}))
 
-		YoutubeChannelsCount.Set(entriesCountMgo(models.YoutubeChannelTable, nil))
+		YoutubeChannelsCount.Set(entriesCountMgo(models.FeedsTable, bson.M{"source": "youtube"}))
 
-		TwitchChannelsCount.Set(entriesCountMgo(models.TwitchTable, nil))
+		TwitchChannelsCount.Set(entriesCountMgo(models.FeedsTable, bson.M{"source": "twitch"}))
 
 		VanityInvitesCount.Set(entriesCountMgo(models.VanityInvitesTable, nil))
 
@@ -249,6 +263,13 @@ func CollectRuntimeMetrics() {
 			delayedTasks, err := cache.GetMachineryRedisClient().ZCard(key).Result()
 			helpers.Relax(err)
 			MachineryDelayedTasksCount.Set(delayedTasks)
+			prom.MachineryQueueDepth.WithLabelValues(key).Set(float64(delayedTasks))
+
+			for _, key = range helpers.MachineryQueues {
+				pendingTasks, err := cache.GetMachineryRedisClient().LLen(key).Result()
+				helpers.Relax(err)
+				prom.MachineryQueueDepth.WithLabelValues(key).Set(float64(pendingTasks))
+			}
 		}
 
 		key = models.YoutubeQuotaRedisKey
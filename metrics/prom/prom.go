@@ -0,0 +1,190 @@
+// Package prom contains the labelled Prometheus metrics, it has no dependencies on other Robyul packages
+// so it can be used from everywhere, including helpers
+package prom
+
+import (
+	"bytes"
+	"encoding/json"
+	"expvar"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "robyul"
+
+var (
+	// CommandsExecuted counts all executed commands by plugin and command
+	CommandsExecuted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "commands_executed_total",
+		Help:      "Number of executed commands.",
+	}, []string{"plugin", "command"})
+
+	// CommandDuration observes how long commands took by plugin and command
+	CommandDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "command_duration_seconds",
+		Help:      "Time it took to execute a command.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"plugin", "command"})
+
+	// DiscordRestErrors counts all failed Discord REST requests by HTTP status and Discord error code
+	DiscordRestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "discord_rest_errors_total",
+		Help:      "Number of failed Discord REST requests.",
+	}, []string{"status", "code"})
+
+	// FeedRefreshDuration observes how long a full refresh of a feed took
+	FeedRefreshDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "feed_refresh_duration_seconds",
+		Help:      "Time it took to check all entries of a feed.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"feed"})
+
+	// MongoDbQueryDuration observes how long MongoDB queries took by method and collection
+	MongoDbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "mongodb_query_duration_seconds",
+		Help:      "Time it took to run a MongoDB query.",
+		Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 5},
+	}, []string{"method", "collection"})
+
+	// ElasticRequestDuration observes how long ElasticSearch requests took by HTTP method and status
+	ElasticRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "elastic_request_duration_seconds",
+		Help:      "Time it took to run an ElasticSearch request.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 5, 15},
+	}, []string{"code", "method"})
+
+	// MachineryQueueDepth is the number of tasks waiting in a machinery queue
+	MachineryQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "machinery_queue_depth",
+		Help:      "Number of tasks waiting in a machinery queue.",
+	}, []string{"queue"})
+
+	// MachineryTasks counts finished machinery task attempts by task and result, which is success, retry or failure
+	MachineryTasks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "machinery_tasks_total",
+		Help:      "Number of finished machinery task attempts.",
+	}, []string{"task", "result"})
+
+	// MachineryTaskDuration observes how long machinery tasks took by task
+	MachineryTaskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "machinery_task_duration_seconds",
+		Help:      "Time it took to run a machinery task.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300},
+	}, []string{"task"})
+
+	// ShardReady is 1 if the gateway connection of a shard is ready
+	ShardReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "shard_ready",
+		Help:      "Whether the gateway connection of a shard is ready.",
+	}, []string{"shard"})
+
+	// ShardGuilds is the number of guilds of a shard
+	ShardGuilds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "shard_guilds",
+		Help:      "Number of guilds of a shard.",
+	}, []string{"shard"})
+
+	// ShardHeartbeatLatency is the latest gateway heartbeat latency of a shard
+	ShardHeartbeatLatency = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "shard_heartbeat_latency_seconds",
+		Help:      "Latest gateway heartbeat latency of a shard.",
+	}, []string{"shard"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		CommandsExecuted,
+		CommandDuration,
+		DiscordRestErrors,
+		FeedRefreshDuration,
+		MongoDbQueryDuration,
+		ElasticRequestDuration,
+		MachineryQueueDepth,
+		MachineryTasks,
+		MachineryTaskDuration,
+		ShardReady,
+		ShardGuilds,
+		ShardHeartbeatLatency,
+		expvarCollector{},
+	)
+}
+
+// Handler returns the http handler for the /metrics endpoint
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ElasticTransport wraps a http.RoundTripper to observe the duration of all ElasticSearch requests
+func ElasticTransport(next http.RoundTripper) http.RoundTripper {
+	return promhttp.InstrumentRoundTripperDuration(ElasticRequestDuration, next)
+}
+
+// DiscordTransport wraps a http.RoundTripper to count all failed Discord REST requests
+func DiscordTransport(next http.RoundTripper) http.RoundTripper {
+	return promhttp.RoundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		response, err := next.RoundTrip(request)
+		if err != nil || response.StatusCode < 400 {
+			return response, err
+		}
+
+		// read the Discord error code and restore the body for discordgo
+		var code string
+		body, readErr := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		response.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if readErr == nil {
+			var restError struct {
+				Code int `json:"code"`
+			}
+			if json.Unmarshal(body, &restError) == nil && restError.Code != 0 {
+				code = strconv.Itoa(restError.Code)
+			}
+		}
+
+		DiscordRestErrors.WithLabelValues(strconv.Itoa(response.StatusCode), code).Inc()
+		return response, err
+	})
+}
+
+// expvarCollector exports all numeric expvar metrics as Prometheus gauges, so dashboards don't need the expvar endpoint
+type expvarCollector struct{}
+
+// Describe sends no descriptors, which makes this an unchecked collector, because the expvars are not known in advance
+func (c expvarCollector) Describe(ch chan<- *prometheus.Desc) {
+}
+
+func (c expvarCollector) Collect(ch chan<- prometheus.Metric) {
+	expvar.Do(func(kv expvar.KeyValue) {
+		var value float64
+		switch v := kv.Value.(type) {
+		case *expvar.Int:
+			value = float64(v.Value())
+		case *expvar.Float:
+			value = v.Value()
+		default:
+			return
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			prometheus.NewDesc(prometheus.BuildFQName(namespace, "", kv.Key), "expvar "+kv.Key, nil, nil),
+			prometheus.GaugeValue,
+			value,
+		)
+	})
+}
@@ -0,0 +1,35 @@
+package prom
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestDiscordTransport(t *testing.T) {
+	body := `{"code": 50013, "message": "Missing Permissions"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: DiscordTransport(http.DefaultTransport)}
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("prom.DiscordTransport() failed request: %s", err.Error())
+	}
+	defer response.Body.Close()
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil || string(data) != body {
+		t.Fatalf("prom.DiscordTransport() failed to restore the response body")
+	}
+
+	if testutil.ToFloat64(DiscordRestErrors.WithLabelValues("403", "50013")) != 1 {
+		t.Fatalf("prom.DiscordTransport() failed to count the error by status and code")
+	}
+}
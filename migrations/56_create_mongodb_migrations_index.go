@@ -0,0 +1,13 @@
+package migrations
+
+import (
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/globalsign/mgo"
+)
+
+func m56_create_mongodb_migrations_index(dryRun bool) (err error) {
+	return ensureIndex(models.MigrationsTable, mgo.Index{
+		Key:    []string{"migrationid"},
+		Unique: true,
+	}, dryRun)
+}
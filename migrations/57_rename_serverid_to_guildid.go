@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"github.com/Seklfreak/Robyul2/models"
+)
+
+// collections which stored the guild ID as serverid before
+var serverIDCollections = []models.MongoDbCollection{
+	models.BiasTable,
+	models.InstagramTable,
+	models.TwitchTable,
+	models.VliveTable,
+	models.YoutubeChannelTable,
+}
+
+func m57_rename_serverid_to_guildid(dryRun bool) (err error) {
+	for _, collection := range serverIDCollections {
+		err = renameField(collection, "serverid", "guildid", dryRun)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"github.com/globalsign/mgo"
+)
+
+func m58_create_mongodb_guildid_indexes(dryRun bool) (err error) {
+	for _, collection := range serverIDCollections {
+		err = ensureIndex(collection, mgo.Index{
+			Key:        []string{"guildid"},
+			Background: true,
+		}, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package migrations
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/globalsign/mgo/bson"
+)
+
+// the feeds framework keeps only the latest posted IDs
+const legacyFeedsMaxPostedIDs = 200
+
+// legacyFeeds converts the entries of the collections of the integrations which had their own loops
+var legacyFeeds = []struct {
+	collection models.MongoDbCollection
+	convert    func(iter func(result interface{}) bool) (entries []models.FeedEntry)
+}{
+	{models.TwitterTable, convertLegacyTwitter},
+	{models.InstagramTable, convertLegacyInstagram},
+	{models.VliveTable, convertLegacyVlive},
+	{models.YoutubeChannelTable, convertLegacyYoutube},
+	{models.RedditSubredditsTable, convertLegacyReddit},
+	{models.TwitchTable, convertLegacyTwitch},
+	{models.FacebookTable, convertLegacyFacebook},
+}
+
+// m59_move_legacy_feeds moves the feeds of twitter, instagram, vlive, youtube, reddit, twitch and facebook
+// into the feeds collection, the IDs are kept so the eventlog entries still match
+func m59_move_legacy_feeds(dryRun bool) (err error) {
+	for _, legacyFeed := range legacyFeeds {
+		iter := helpers.MdbCollection(legacyFeed.collection).Find(nil).Iter()
+		entries := legacyFeed.convert(iter.Next)
+		err = iter.Close()
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			cache.GetLogger().WithField("module", "migrations").Infof(
+				"would move %d feeds of %s", len(entries), legacyFeed.collection)
+			continue
+		}
+
+		for _, entry := range entries {
+			_, err = helpers.MdbCollection(models.FeedsTable).UpsertId(entry.ID, entry)
+			if err != nil {
+				return err
+			}
+			err = helpers.MdbCollection(legacyFeed.collection).RemoveId(entry.ID)
+			if err != nil {
+				return err
+			}
+		}
+		cache.GetLogger().WithField("module", "migrations").Infof(
+			"moved %d feeds of %s", len(entries), legacyFeed.collection)
+	}
+	return nil
+}
+
+// legacyFeedEntry returns the fields all migrated feeds share, feeds with posted IDs are due immediately,
+// feeds without only remember the current posts on their first check
+func legacyFeedEntry(id bson.ObjectId, guildID, channelID, source, target, targetName string, postedIDs []string) models.FeedEntry {
+	if len(postedIDs) > legacyFeedsMaxPostedIDs {
+		postedIDs = postedIDs[len(postedIDs)-legacyFeedsMaxPostedIDs:]
+	}
+	entry := models.FeedEntry{
+		ID:         id,
+		GuildID:    guildID,
+		ChannelID:  channelID,
+		AddedAt:    id.Time(),
+		Source:     source,
+		Target:     target,
+		TargetName: targetName,
+		PostedIDs:  postedIDs,
+		NextCheck:  time.Now(),
+	}
+	if len(postedIDs) > 0 {
+		entry.LastCheck = time.Now()
+	}
+	return entry
+}
+
+func convertLegacyTwitter(iter func(result interface{}) bool) (entries []models.FeedEntry) {
+	var legacy models.TwitterEntry
+	for iter(&legacy) {
+		var postedIDs []string
+		for _, tweet := range legacy.PostedTweets {
+			postedIDs = append(postedIDs, tweet.ID)
+		}
+		target := legacy.AccountID
+		if target == "" {
+			target = legacy.AccountScreenName
+		}
+		entry := legacyFeedEntry(legacy.ID, legacy.GuildID, legacy.ChannelID,
+			"twitter", target, "@"+legacy.AccountScreenName, postedIDs)
+		entry.MentionRoleID = legacy.MentionRoleID
+		entry.Template = legacy.Template
+		switch legacy.PostMode {
+		case models.TwitterPostModeDiscordEmbed:
+			entry.PostMode = models.FeedPostModeLink
+		case models.TwitterPostModeText:
+			entry.PostMode = models.FeedPostModeText
+		}
+		if legacy.ExcludeRTs || legacy.ExcludeMentions {
+			entry.Filter.Types = []string{"tweet"}
+			if !legacy.ExcludeRTs {
+				entry.Filter.Types = append(entry.Filter.Types, "retweet")
+			}
+			if !legacy.ExcludeMentions {
+				entry.Filter.Types = append(entry.Filter.Types, "mention")
+			}
+		}
+		entries = append(entries, entry)
+		legacy = models.TwitterEntry{}
+	}
+	return entries
+}
+
+func convertLegacyInstagram(iter func(result interface{}) bool) (entries []models.FeedEntry) {
+	var legacy models.InstagramEntry
+	for iter(&legacy) {
+		var postedIDs []string
+		for _, post := range legacy.PostedPosts {
+			postedIDs = append(postedIDs, post.ID)
+		}
+		entry := legacyFeedEntry(legacy.ID, legacy.GuildID, legacy.ChannelID,
+			"instagram", legacy.Username, "@"+legacy.Username, postedIDs)
+		entry.MentionRoleID = legacy.MentionRoleID
+		entry.Template = legacy.Template
+		entry.Filter = legacy.Filter
+		if legacy.SendPostType == models.InstagramSendPostTypeDirectLinks {
+			entry.PostMode = models.FeedPostModeText
+		}
+		userID := legacy.InstagramUserIDString
+		if userID == "" && legacy.InstagramUserID != 0 {
+			userID = strconv.FormatInt(legacy.InstagramUserID, 10)
+		}
+		entry.Options = map[string]string{"userid": userID}
+		if !legacy.LastPostCheck.IsZero() {
+			entry.State = map[string]string{"lastpost": strconv.FormatInt(legacy.LastPostCheck.Unix(), 10)}
+		}
+		entries = append(entries, entry)
+		legacy = models.InstagramEntry{}
+	}
+	return entries
+}
+
+func convertLegacyVlive(iter func(result interface{}) bool) (entries []models.FeedEntry) {
+	var legacy models.VliveEntry
+	for iter(&legacy) {
+		var postedIDs []string
+		for _, video := range legacy.PostedVOD {
+			postedIDs = append(postedIDs, "vod-"+strconv.FormatInt(video.Seq, 10))
+		}
+		for _, video := range legacy.PostedUpcoming {
+			postedIDs = append(postedIDs, "upcoming-"+strconv.FormatInt(video.Seq, 10))
+		}
+		for _, video := range legacy.PostedLive {
+			postedIDs = append(postedIDs, "live-"+strconv.FormatInt(video.Seq, 10))
+		}
+		for _, notice := range legacy.PostedNotices {
+			postedIDs = append(postedIDs, "notice-"+strconv.FormatInt(notice.Number, 10))
+		}
+		for _, celeb := range legacy.PostedCelebs {
+			postedIDs = append(postedIDs, "celeb-"+celeb.ID)
+		}
+		entry := legacyFeedEntry(legacy.ID, legacy.GuildID, legacy.ChannelID,
+			"vlive", legacy.VLiveChannel.Code, legacy.VLiveChannel.Name, postedIDs)
+		entry.MentionRoleID = legacy.MentionRoleID
+		entry.Template = legacy.Template
+		entry.Filter = legacy.Filter
+		entries = append(entries, entry)
+		legacy = models.VliveEntry{}
+	}
+	return entries
+}
+
+func convertLegacyYoutube(iter func(result interface{}) bool) (entries []models.FeedEntry) {
+	var legacy models.YoutubeChannelEntry
+	for iter(&legacy) {
+		entry := legacyFeedEntry(legacy.ID, legacy.GuildID, legacy.ChannelID,
+			"youtube", legacy.YoutubeChannelID, legacy.YoutubeChannelName, legacy.YoutubePostedVideos)
+		entry.MentionRoleID = legacy.MentionRoleID
+		entry.Template = legacy.Template
+		entry.Filter = legacy.Filter
+		if legacy.LastSuccessfulCheckTime > 0 {
+			entry.State = map[string]string{"lastcheck": strconv.FormatInt(legacy.LastSuccessfulCheckTime, 10)}
+		}
+		entries = append(entries, entry)
+		legacy = models.YoutubeChannelEntry{}
+	}
+	return entries
+}
+
+func convertLegacyReddit(iter func(result interface{}) bool) (entries []models.FeedEntry) {
+	var legacy models.RedditSubredditEntry
+	for iter(&legacy) {
+		// the legacy feeds did not store the posted submissions
+		entry := legacyFeedEntry(legacy.ID, legacy.GuildID, legacy.ChannelID,
+			"reddit", legacy.SubredditName, "r/"+legacy.SubredditName, nil)
+		entry.AddedByUserID = legacy.AddedByUserID
+		if !legacy.AddedAt.IsZero() {
+			entry.AddedAt = legacy.AddedAt
+		}
+		entry.MentionRoleID = legacy.MentionRoleID
+		entry.Template = legacy.Template
+		entry.Filter = legacy.Filter
+		entry.PostDelay = legacy.PostDelay
+		if legacy.PostDirectLinks {
+			entry.PostMode = models.FeedPostModeText
+		}
+		entries = append(entries, entry)
+		legacy = models.RedditSubredditEntry{}
+	}
+	return entries
+}
+
+func convertLegacyTwitch(iter func(result interface{}) bool) (entries []models.FeedEntry) {
+	var legacy models.TwitchEntry
+	for iter(&legacy) {
+		entry := legacyFeedEntry(legacy.ID, legacy.GuildID, legacy.ChannelID,
+			"twitch", strings.ToLower(legacy.TwitchChannelName), legacy.TwitchChannelName, nil)
+		// the live message is kept in Live, the checks do not use the posted IDs
+		entry.LastCheck = time.Now()
+		entry.MentionRoleID = legacy.MentionRoleID
+		entry.Template = legacy.Template
+		entry.Filter = legacy.Filter
+		entry.Options = make(map[string]string)
+		if legacy.PostMode == models.TwitchPostModeNew {
+			entry.Options["summary"] = "new"
+		}
+		if legacy.PostVOD {
+			entry.Options["vod"] = helpers.StoreBoolAsString(true)
+		}
+		if legacy.IsLive {
+			entry.Live = models.FeedLive{
+				PostID:    strconv.FormatInt(legacy.StreamID, 10),
+				StartedAt: legacy.StreamStartedAt,
+				Title:     legacy.StreamTitle,
+				Category:  legacy.StreamGame,
+				PeakScore: legacy.PeakViewers,
+				MessageID: legacy.LiveMessageID,
+				Filtered:  legacy.LiveFiltered,
+				UpdatedAt: legacy.LiveUpdatedAt,
+			}
+		}
+		entries = append(entries, entry)
+		legacy = models.TwitchEntry{}
+	}
+	return entries
+}
+
+func convertLegacyFacebook(iter func(result interface{}) bool) (entries []models.FeedEntry) {
+	var legacy models.FacebookEntry
+	for iter(&legacy) {
+		var postedIDs []string
+		for _, post := range legacy.PostedPosts {
+			postedIDs = append(postedIDs, post.ID)
+		}
+		entry := legacyFeedEntry(legacy.ID, legacy.GuildID, legacy.ChannelID,
+			"facebook", legacy.Username, legacy.Username, postedIDs)
+		entry.MentionRoleID = legacy.MentionRoleID
+		entry.Template = legacy.Template
+		entries = append(entries, entry)
+		legacy = models.FacebookEntry{}
+	}
+	return entries
+}
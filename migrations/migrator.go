@@ -1,39 +1,193 @@
 package migrations
 
 import (
-	"reflect"
-	"runtime"
+	"fmt"
+	"time"
 
 	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/pkg/errors"
 )
 
-var migrations = []helpers.Callback{
-	m28_create_elastic_indexes,
-	m29_create_elastic_presence_update_index,
-	m43_create_elastic_vanityinvite_click_index,
-	m45_create_elastic_index_messages,
-	m46_create_elastic_index_joins,
-	m47_create_elastic_index_leaves,
-	m49_create_elastic_index_presence_updates,
-	m50_create_elastic_vanity_invite_clicks,
-	m51_reindex_elasticv5_to_v6,
-	m52_create_elastic_index_voice_sessions,
-	m55_create_elastic_index_eventlogs,
-}
-
-// Run executes all registered migrations
-func Run() {
-	log := cache.GetLogger()
-	log.WithField("module", "migrator").Info("Running migrations...")
-	for _, migration := range migrations {
-		migrationName := runtime.FuncForPC(
-			reflect.ValueOf(migration).Pointer(),
-		).Name()
-
-		log.WithField("module", "migrator").Info("Running " + migrationName)
+// Mode selects what Run does with the pending migrations
+type Mode string
+
+const (
+	ModeUp     Mode = "up"      // applies all pending migrations
+	ModeStatus Mode = "status"  // lists applied and pending migrations
+	ModeDryRun Mode = "dry-run" // reports what the pending migrations would change
+)
+
+// Migration is a single versioned migration, applied migrations are stored in MigrationsTable
+// and will not run again. Run must not change anything if dryRun is true.
+type Migration struct {
+	ID   int
+	Name string
+	Run  func(dryRun bool) (err error)
+}
+
+var migrations = []Migration{
+	{28, "create_elastic_indexes", legacy(m28_create_elastic_indexes)},
+	{29, "create_elastic_presence_update_index", legacy(m29_create_elastic_presence_update_index)},
+	{43, "create_elastic_vanityinvite_click_index", legacy(m43_create_elastic_vanityinvite_click_index)},
+	{45, "create_elastic_index_messages", legacyElastic(m45_create_elastic_index_messages)},
+	{46, "create_elastic_index_joins", legacyElastic(m46_create_elastic_index_joins)},
+	{47, "create_elastic_index_leaves", legacyElastic(m47_create_elastic_index_leaves)},
+	{49, "create_elastic_index_presence_updates", legacyElastic(m49_create_elastic_index_presence_updates)},
+	{50, "create_elastic_vanity_invite_clicks", legacyElastic(m50_create_elastic_vanity_invite_clicks)},
+	{51, "reindex_elasticv5_to_v6", legacyElastic(m51_reindex_elasticv5_to_v6)},
+	{52, "create_elastic_index_voice_sessions", legacyElastic(m52_create_elastic_index_voice_sessions)},
+	{55, "create_elastic_index_eventlogs", legacyElastic(m55_create_elastic_index_eventlogs)},
+	{56, "create_mongodb_migrations_index", m56_create_mongodb_migrations_index},
+	{57, "rename_serverid_to_guildid", m57_rename_serverid_to_guildid},
+	{58, "create_mongodb_guildid_indexes", m58_create_mongodb_guildid_indexes},
+	{59, "move_legacy_feeds", m59_move_legacy_feeds},
+}
+
+// ErrSkipped is returned by migrations which can not run yet, they are not recorded and run again on the next start
+var ErrSkipped = errors.New("migration skipped")
+
+// legacy wraps the migrations written before migrations were tracked, they are idempotent and panic on errors
+func legacy(migration helpers.Callback) func(dryRun bool) (err error) {
+	return func(dryRun bool) (err error) {
+		if dryRun {
+			return nil
+		}
 		migration()
+		return nil
+	}
+}
+
+// legacyElastic wraps the legacy migrations of ElasticSearch, they are skipped until ElasticSearch is configured
+func legacyElastic(migration helpers.Callback) func(dryRun bool) (err error) {
+	run := legacy(migration)
+	return func(dryRun bool) (err error) {
+		if !cache.HasElastic() {
+			return ErrSkipped
+		}
+		return run(dryRun)
+	}
+}
+
+// Run executes the registered migrations in the given mode
+func Run(mode Mode) (err error) {
+	log := cache.GetLogger().WithField("module", "migrator")
+
+	applied, err := getAppliedMigrations()
+	if err != nil {
+		return errors.Wrap(err, "unable to read applied migrations")
+	}
+
+	switch mode {
+	case ModeUp, ModeDryRun:
+		log.Infof("Running migrations (%s)...", mode)
+		var pending int
+		for _, migration := range migrations {
+			if _, ok := applied[migration.ID]; ok {
+				continue
+			}
+			pending++
+
+			if mode == ModeDryRun {
+				log.Infof("Would run %d_%s", migration.ID, migration.Name)
+				err = migration.Run(true)
+				if err == ErrSkipped {
+					log.Infof("Would skip %d_%s", migration.ID, migration.Name)
+					continue
+				}
+				if err != nil {
+					return errors.Wrapf(err, "dry-run of migration %d_%s failed", migration.ID, migration.Name)
+				}
+				continue
+			}
+
+			log.Infof("Running %d_%s", migration.ID, migration.Name)
+			started := time.Now()
+			err = migration.Run(false)
+			if err == ErrSkipped {
+				log.Infof("Skipped %d_%s, it will run again on the next start", migration.ID, migration.Name)
+				continue
+			}
+			if err != nil {
+				return errors.Wrapf(err, "migration %d_%s failed", migration.ID, migration.Name)
+			}
+			_, err = helpers.MDbInsertWithoutLogging(models.MigrationsTable, models.MigrationEntry{
+				MigrationID: migration.ID,
+				Name:        migration.Name,
+				AppliedAt:   time.Now(),
+				Duration:    time.Since(started),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "unable to record migration %d_%s", migration.ID, migration.Name)
+			}
+		}
+		if pending == 0 {
+			log.Info("No pending migrations")
+		}
+		log.Info("Migrations finished!")
+	case ModeStatus:
+		for _, migration := range migrations {
+			if entry, ok := applied[migration.ID]; ok {
+				log.Infof("%d_%s: applied at %s, took %s",
+					migration.ID, migration.Name, entry.AppliedAt.Format(time.RFC3339), entry.Duration)
+				continue
+			}
+			log.Infof("%d_%s: pending", migration.ID, migration.Name)
+		}
+	default:
+		return fmt.Errorf("unknown migration mode %s", mode)
+	}
+
+	return nil
+}
+
+func getAppliedMigrations() (applied map[int]models.MigrationEntry, err error) {
+	var entries []models.MigrationEntry
+	err = helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.MigrationsTable).Find(nil)).All(&entries)
+	if err != nil {
+		return nil, err
+	}
+
+	applied = make(map[int]models.MigrationEntry, len(entries))
+	for _, entry := range entries {
+		applied[entry.MigrationID] = entry
+	}
+	return applied, nil
+}
+
+// renameField renames a field on all documents of the collection which do not have the new field yet
+func renameField(collection models.MongoDbCollection, from, to string, dryRun bool) (err error) {
+	selector := bson.M{from: bson.M{"$exists": true}, to: bson.M{"$exists": false}}
+
+	if dryRun {
+		count, err := helpers.MdbCountWithoutLogging(collection, selector)
+		if err != nil {
+			return err
+		}
+		cache.GetLogger().WithField("module", "migrations").Infof(
+			"would rename %s to %s on %d documents of %s", from, to, count, collection)
+		return nil
+	}
+
+	info, err := helpers.MdbCollection(collection).UpdateAll(selector, bson.M{"$rename": bson.M{from: to}})
+	if err != nil {
+		return err
+	}
+	cache.GetLogger().WithField("module", "migrations").Infof(
+		"renamed %s to %s on %d documents of %s", from, to, info.Updated, collection)
+	return nil
+}
+
+// ensureIndex creates an index on the collection, does nothing if the index exists already
+func ensureIndex(collection models.MongoDbCollection, index mgo.Index, dryRun bool) (err error) {
+	if dryRun {
+		cache.GetLogger().WithField("module", "migrations").Infof(
+			"would create index %v on %s", index.Key, collection)
+		return nil
 	}
 
-	log.WithField("module", "migrator").Info("Migrations finished!")
+	return helpers.MdbCollection(collection).EnsureIndex(index)
 }
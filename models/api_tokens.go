@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+const (
+	ApiTokensTable MongoDbCollection = "api_tokens"
+)
+
+type ApiTokenScope string
+
+const (
+	ApiTokenScopeStatisticsRead ApiTokenScope = "statistics:read"
+	ApiTokenScopeEventlogRead   ApiTokenScope = "eventlog:read"
+	ApiTokenScopeSettingsWrite  ApiTokenScope = "settings:write"
+)
+
+var ApiTokenScopes = []ApiTokenScope{
+	ApiTokenScopeStatisticsRead,
+	ApiTokenScopeEventlogRead,
+	ApiTokenScopeSettingsWrite,
+}
+
+type ApiTokenEntry struct {
+	ID         bson.ObjectId `bson:"_id,omitempty"`
+	GuildID    string
+	UserID     string // the admin who created the token
+	Name       string
+	TokenHash  string // SHA-256 of the token, the token itself is only shown once
+	Scopes     []ApiTokenScope
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+func (e ApiTokenEntry) HasScope(scope ApiTokenScope) bool {
+	for _, tokenScope := range e.Scopes {
+		if tokenScope == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -10,7 +10,7 @@ const (
 
 type BiasEntry struct {
 	ID         bson.ObjectId `bson:"_id,omitempty"`
-	GuildID    string        // TODO: renamed from serverID
+	GuildID    string        // renamed from serverID
 	ChannelID  string
 	Categories []BiasEntryCategory
 }
@@ -73,6 +73,20 @@ type Config struct {
 
 	AdminRoleIDs []string
 	ModRoleIDs   []string
+
+	StorageQuota int64 // in bytes, 0 uses the default quota
+
+	DisabledCommands []string
+	CommandAliases   []CommandAlias
+
+	Locale string // empty uses the default locale
+
+	Timezone string // empty uses UTC
+}
+
+type CommandAlias struct {
+	Alias   string
+	Command string
 }
 
 type InspectTriggersEnabled struct {
@@ -48,8 +48,10 @@ const (
 	EventlogTypeRobyulNotificationsChannelIgnore    = "Robyul_Notifications_Channel_Ignore"    // EventlogTargetTypeChannel
 	EventlogTypeRobyulVliveFeedAdd                  = "Robyul_Vlive_Feed_Add"                  // EventlogTargetTypeRobyulVliveFeed
 	EventlogTypeRobyulVliveFeedRemove               = "Robyul_Vlive_Feed_Remove"               // EventlogTargetTypeRobyulVliveFeed
+	EventlogTypeRobyulVliveFeedUpdate               = "Robyul_Vlive_Feed_Update"               // EventlogTargetTypeRobyulVliveFeed
 	EventlogTypeRobyulYouTubeChannelFeedAdd         = "Robyul_YouTube_Channel_Feed_Add"        // EventlogTargetTypeRobyulYouTubeChannelFeed
 	EventlogTypeRobyulYouTubeChannelFeedRemove      = "Robyul_YouTube_Channel_Feed_Remove"     // EventlogTargetTypeRobyulYouTubeChannelFeed
+	EventlogTypeRobyulYouTubeChannelFeedUpdate      = "Robyul_YouTube_Channel_Feed_Update"     // EventlogTargetTypeRobyulYouTubeChannelFeed
 	EventlogTypeRobyulInstagramFeedAdd              = "Robyul_Instagram_Feed_Add"              // EventlogTargetTypeRobyulInstagramFeed
 	EventlogTypeRobyulInstagramFeedRemove           = "Robyul_Instagram_Feed_Remove"           // EventlogTargetTypeRobyulInstagramFeed
 	EventlogTypeRobyulInstagramFeedUpdate           = "Robyul_Instagram_Feed_Update"           // EventlogTargetTypeRobyulInstagramFeed
@@ -58,6 +60,7 @@ const (
 	EventlogTypeRobyulRedditFeedUpdate              = "Robyul_Reddit_Feed_Update"              // EventlogTargetTypeRobyulRedditFeed
 	EventlogTypeRobyulFacebookFeedAdd               = "Robyul_Facebook_Feed_Add"               // EventlogTargetTypeRobyulFacebookFeed
 	EventlogTypeRobyulFacebookFeedRemove            = "Robyul_Facebook_Feed_Remove"            // EventlogTargetTypeRobyulFacebookFeed
+	EventlogTypeRobyulFacebookFeedUpdate            = "Robyul_Facebook_Feed_Update"            // EventlogTargetTypeRobyulFacebookFeed
 	EventlogTypeRobyulCleanup                       = "Robyul_Cleanup"                         //
 	EventlogTypeRobyulMute                          = "Robyul_Mute"                            // EventlogTargetTypeUser
 	EventlogTypeRobyulUnmute                        = "Robyul_Unmute"                          // EventlogTargetTypeUser
@@ -99,6 +102,7 @@ const (
 	EventlogTypeRobyulCommandsJsonImport            = "Robyul_Commands_Json_Import"            // EventlogTargetTypeGuild
 	EventlogTypeRobyulTwitchFeedAdd                 = "Robyul_Twitch_Feed_Add"                 // EventlogTargetTypeRobyulTwitchFeed
 	EventlogTypeRobyulTwitchFeedRemove              = "Robyul_Twitch_Feed_Remove"              // EventlogTargetTypeRobyulTwitchFeed
+	EventlogTypeRobyulTwitchFeedUpdate              = "Robyul_Twitch_Feed_Update"              // EventlogTargetTypeRobyulTwitchFeed
 	EventlogTypeRobyulNukeParticipate               = "Robyul_Nuke_Participate"                // EventlogTargetTypeGuild
 	EventlogTypeRobyulTroublemakerParticipate       = "Robyul_Troublemaker_Participate"        // EventlogTargetTypeGuild
 	EventlogTypeRobyulTroublemakerReport            = "Robyul_Troublemaker_Report"             // EventlogTargetTypeUser
@@ -116,7 +120,21 @@ const (
 	EventlogTypeRobyulEventlogConfigUpdate          = "Robyul_Module_Eventlog_Config_Update"   // EventlogTargetTypeGuild
 	EventlogTypeRobyulTwitterFeedAdd                = "Robyul_Twitter_Feed_Add"                // EventlogTargetTypeRobyulTwitterFeed
 	EventlogTypeRobyulTwitterFeedRemove             = "Robyul_Twitter_Feed_Remove"             // EventlogTargetTypeRobyulTwitterFeed
+	EventlogTypeRobyulTwitterFeedUpdate             = "Robyul_Twitter_Feed_Update"             // EventlogTargetTypeRobyulTwitterFeed
 	EventlogTypeRobyulActionRevert                  = "Robyul_Action_Revert"                   // EventlogTargetTypeRobyulEventlogItem
+	EventlogTypeRobyulCommandDisable                = "Robyul_Command_Disable"                 // EventlogTargetTypeGuild
+	EventlogTypeRobyulCommandEnable                 = "Robyul_Command_Enable"                  // EventlogTargetTypeGuild
+	EventlogTypeRobyulCommandAliasAdd               = "Robyul_Command_Alias_Add"               // EventlogTargetTypeGuild
+	EventlogTypeRobyulCommandAliasRemove            = "Robyul_Command_Alias_Remove"            // EventlogTargetTypeGuild
+	EventlogTypeRobyulLocaleUpdate                  = "Robyul_Locale_Update"                   // EventlogTargetTypeGuild
+	EventlogTypeRobyulFeedAdd                       = "Robyul_Feed_Add"                        // EventlogTargetTypeRobyulFeed
+	EventlogTypeRobyulFeedRemove                    = "Robyul_Feed_Remove"                     // EventlogTargetTypeRobyulFeed
+	EventlogTypeRobyulFeedUpdate                    = "Robyul_Feed_Update"                     // EventlogTargetTypeRobyulFeed
+	EventlogTypeRobyulScheduledMessageAdd           = "Robyul_ScheduledMessage_Add"            // EventlogTargetTypeRobyulScheduledMessage
+	EventlogTypeRobyulScheduledMessageRemove        = "Robyul_ScheduledMessage_Remove"         // EventlogTargetTypeRobyulScheduledMessage
+	EventlogTypeRobyulScheduledMessageUpdate        = "Robyul_ScheduledMessage_Update"         // EventlogTargetTypeRobyulScheduledMessage
+	EventlogTypeRobyulApiTokenCreate                = "Robyul_ApiToken_Create"                 // EventlogTargetTypeRobyulApiToken
+	EventlogTypeRobyulApiTokenRevoke                = "Robyul_ApiToken_Revoke"                 // EventlogTargetTypeRobyulApiToken
 
 	EventlogTargetTypeRobyulBadge               = "robyul-badge"
 	EventlogTargetTypeRobyulVliveFeed           = "robyul-vlive-feed"
@@ -132,6 +150,9 @@ const (
 	EventlogTargetTypeRobyulPublicObject        = "robyul-public-object"
 	EventlogTargetTypeRobyulMirrorType          = "robyul-mirror-type"
 	EventlogTargetTypeRobyulEventlogItem        = "robyul-eventlog-item"
+	EventlogTargetTypeRobyulFeed                = "robyul-feed"
+	EventlogTargetTypeRobyulScheduledMessage    = "robyul-scheduled-message"
+	EventlogTargetTypeRobyulApiToken            = "robyul-api-token"
 
 	AuditLogBackfillRedisList = "robyul-discord:eventlog:auditlog-backfills:v2"
 )
@@ -8,12 +8,17 @@ const (
 	FacebookTable MongoDbCollection = "facebook"
 )
 
+// FacebookEntry is a feed before the feeds framework, moved to FeedsTable by migration 59
+//
+// Deprecated: use FeedEntry
 type FacebookEntry struct {
-	ID          bson.ObjectId `bson:"_id,omitempty"`
-	GuildID     string
-	ChannelID   string
-	Username    string
-	PostedPosts []FacebookPostEntry
+	ID            bson.ObjectId `bson:"_id,omitempty"`
+	GuildID       string
+	ChannelID     string
+	Username      string
+	PostedPosts   []FacebookPostEntry
+	MentionRoleID string
+	Template      string
 }
 
 type FacebookPostEntry struct {
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+const (
+	FeedsTable MongoDbCollection = "feeds"
+)
+
+const (
+	FeedPostModeEmbed FeedPostMode = iota // Robyul embed with the link above it
+	FeedPostModeLink                      // only the link, Discord creates the embed
+	FeedPostModeText                      // title, text and link without an embed
+)
+
+type FeedPostMode int
+
+const (
+	FeedFilterMediaAll  FeedFilterMedia = iota // posts with and without media
+	FeedFilterMediaOnly                        // only posts with pictures or videos
+	FeedFilterTextOnly                         // only posts without pictures or videos
+)
+
+type FeedFilterMedia int
+
+// FeedEntry is a feed of any feeds.Source posting to a channel
+type FeedEntry struct {
+	ID            bson.ObjectId `bson:"_id,omitempty"`
+	GuildID       string
+	ChannelID     string
+	AddedByUserID string
+	AddedAt       time.Time
+	Source        string // the name of the feeds.Source, for example rss
+	Target        string // what the source follows, for example the feed URL
+	TargetName    string // human readable name of the target
+	MentionRoleID string
+	PostMode      FeedPostMode
+	Template      string            // embed code with placeholders, replaces the post mode if set
+	PostDelay     int               // minutes a post has to be old before it is posted, for example to let votes settle
+	Options       map[string]string // source specific settings, for example whether Twitch links the VOD
+	PostedIDs     []string          // IDs of the latest posted posts, oldest first
+	State         map[string]string // source specific state, for example the ETag of the last response
+	LastCheck     time.Time
+	NextCheck     time.Time
+	Failures      int // failed checks in a row, used for the backoff
+	Filter        FeedFilter
+	Live          FeedLive
+}
+
+// FeedLive is the running live post of a feed, for example a Twitch stream, reset when it ends
+type FeedLive struct {
+	PostID    string // empty if nothing is live
+	StartedAt time.Time
+	Title     string // as shown in the live message
+	Category  string
+	PeakScore int // for example the peak viewers
+	MessageID string
+	Filtered  bool      // the post did not pass the filter yet, checked again while it runs
+	UpdatedAt time.Time // last edit of the live message
+}
+
+// FeedFilter decides which posts of a feed are posted, empty fields do not filter
+type FeedFilter struct {
+	IncludeKeywords []string // at least one has to be in the title or text
+	ExcludeKeywords []string // none may be in the title or text
+	IncludeRegex    string
+	ExcludeRegex    string
+	Media           FeedFilterMedia
+	MinScore        int      // for example the upvotes on Reddit
+	Flairs          []string // Reddit flairs, at least one has to match
+	Categories      []string // for example Twitch games, at least one has to match
+	Types           []string // source specific post types, for example vod, live or upcoming on VLive
+}
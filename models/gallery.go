@@ -8,10 +8,33 @@ const (
 	GalleryTable MongoDbCollection = "galleries"
 )
 
+const (
+	GalleryMediaTypeImages = "images"
+	GalleryMediaTypeVideos = "videos"
+	GalleryMediaTypeLinks  = "links" // links which are neither images nor videos
+	GalleryMediaTypeFiles  = "files" // attachments which are neither images nor videos
+)
+
 type GalleryEntry struct {
-	ID              bson.ObjectId `bson:"_id,omitempty"`
-	SourceChannelID string
-	TargetChannelID string
-	GuildID         string
-	AddedByUserID   string
+	ID               bson.ObjectId `bson:"_id,omitempty"`
+	SourceChannelID  string
+	TargetChannelID  string   // the first target, kept for galleries created before multiple targets
+	TargetChannelIDs []string // all targets, includes TargetChannelID
+	GuildID          string
+	AddedByUserID    string
+	MediaTypes       []string // empty copies all types
+	LinkDomains      []string // links in the message are only copied from these domains, empty copies all
+	MinReactions     int      // copies a post after it received this many reactions, 0 copies right away
+	Caption          string   // with placeholders, replaces the default caption
+}
+
+// Targets returns the target channel IDs of the gallery
+func (e GalleryEntry) Targets() (targets []string) {
+	if len(e.TargetChannelIDs) > 0 {
+		return e.TargetChannelIDs
+	}
+	if e.TargetChannelID != "" {
+		return []string{e.TargetChannelID}
+	}
+	return nil
 }
@@ -23,9 +23,12 @@ const (
 	InstagramSendPostTypeDirectLinks
 )
 
+// InstagramEntry is a feed before the feeds framework, moved to FeedsTable by migration 59
+//
+// Deprecated: use FeedEntry
 type InstagramEntry struct {
 	ID                    bson.ObjectId `bson:"_id,omitempty"`
-	GuildID               string        // TODO: renamed from ServerID
+	GuildID               string        // renamed from ServerID
 	ChannelID             string
 	Username              string
 	InstagramUserID       int64 // deprecated
@@ -34,6 +37,9 @@ type InstagramEntry struct {
 	IsLive                bool
 	SendPostType          InstagramSendPostType
 	LastPostCheck         time.Time
+	MentionRoleID         string
+	Template              string
+	Filter                FeedFilter
 }
 
 type InstagramPostEntry struct {
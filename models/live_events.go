@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+type LiveEventType string
+
+const (
+	LiveEventTypeEventlog    LiveEventType = "eventlog"     // Data is a Rest_Eventlog_Entry
+	LiveEventTypeModeration  LiveEventType = "moderation"   // Data is a Rest_Eventlog_Entry of a moderation action
+	LiveEventTypeJoin        LiveEventType = "join"         // Data is an ElasticJoin
+	LiveEventTypeLeave       LiveEventType = "leave"        // Data is an ElasticLeave
+	LiveEventTypeMessageRate LiveEventType = "message-rate" // Data is a LiveMessageRate
+)
+
+var LiveEventTypes = []LiveEventType{
+	LiveEventTypeEventlog,
+	LiveEventTypeModeration,
+	LiveEventTypeJoin,
+	LiveEventTypeLeave,
+	LiveEventTypeMessageRate,
+}
+
+// LiveEvent is an event of a guild streamed to the dashboard by the REST API
+type LiveEvent struct {
+	Type      LiveEventType
+	GuildID   string
+	CreatedAt time.Time
+	Data      interface{}
+}
+
+// LiveMessageRate is the count of messages received on a guild during the interval
+type LiveMessageRate struct {
+	Messages        int
+	IntervalSeconds int
+}
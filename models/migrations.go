@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+const (
+	MigrationsTable MongoDbCollection = "migrations"
+)
+
+type MigrationEntry struct {
+	ID          bson.ObjectId `bson:"_id,omitempty"`
+	MigrationID int
+	Name        string
+	AppliedAt   time.Time
+	Duration    time.Duration
+}
@@ -13,9 +13,17 @@ const (
 	MirrorTypeText
 )
 
+type MirrorPostMode int
+
+const (
+	MirrorPostModeWebhook MirrorPostMode = iota // posts with the name and avatar of the author
+	MirrorPostModeBot                           // posts as the bot, for channels without the manage webhooks permission
+)
+
 type MirrorEntry struct {
 	ID                bson.ObjectId `bson:"_id,omitempty"`
 	Type              MirrorType
+	PostMode          MirrorPostMode
 	ConnectedChannels []MirrorChannelEntry
 }
 
@@ -31,4 +31,5 @@ type ProfileUserdataEntry struct {
 	Timezone             string
 	Birthday             string
 	HideLastFm           bool
+	Locale               string
 }
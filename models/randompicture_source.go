@@ -11,7 +11,9 @@ type RandompictureSourceEntry struct {
 	PreviousID         string
 	GuildID            string
 	PostToChannelIDs   []string
-	DriveFolderIDs     []string
+	Provider           string   // empty for Google Drive
+	DriveFolderIDs     []string // targets of Google Drive sources
+	Targets            []string // targets of all other providers
 	Aliases            []string
 	BlacklistedRoleIDs []string
 }
@@ -10,6 +10,9 @@ const (
 	RedditSubredditsTable MongoDbCollection = "reddit_subreddits"
 )
 
+// RedditSubredditEntry is a feed before the feeds framework, moved to FeedsTable by migration 59
+//
+// Deprecated: use FeedEntry
 type RedditSubredditEntry struct {
 	ID              bson.ObjectId `bson:"_id,omitempty"`
 	SubredditName   string
@@ -20,4 +23,7 @@ type RedditSubredditEntry struct {
 	AddedAt         time.Time
 	PostDelay       int
 	PostDirectLinks bool
+	MentionRoleID   string
+	Template        string
+	Filter          FeedFilter
 }
@@ -269,3 +269,61 @@ const (
 	Redis_Key_Feature_Levels_Badges  = "robyul2-discord:feature:levels-badges:server:%s"
 	Redis_Key_Feature_RandomPictures = "robyul2-discord:feature:randompictures:server:%s"
 )
+
+type Rest_Config_CustomCommand struct {
+	ID              string
+	Keyword         string
+	Content         string
+	CreatedByUserID string
+	CreatedAt       time.Time
+	Triggered       int
+}
+
+type Rest_Config_Feed struct {
+	ID        string
+	Source    string
+	ChannelID string
+	Name      string
+	Details   string
+}
+
+// Rest_Receive_Feed adds a feed, the target is looked up like with the commands
+type Rest_Receive_Feed struct {
+	ChannelID       string
+	Target          string // the Twitter or Instagram account, the YouTube or V Live channel or the subreddit
+	MentionRoleID   string
+	PostMode        FeedPostMode // link is only supported by twitter, text by twitter, instagram and reddit
+	PostDelay       int          // minutes, reddit only
+	ExcludeRTs      bool         // twitter only
+	ExcludeMentions bool         // twitter only
+}
+
+type Rest_Config_Starboard struct {
+	ChannelID string
+	Minimum   int
+	Emoji     []string
+}
+
+type Rest_Config_LevelsRole struct {
+	ID         string
+	RoleID     string
+	StartLevel int
+	LastLevel  int // -1 for no last level
+}
+
+type Rest_Config_AutoRole struct {
+	RoleID string
+	Delay  string // empty for roles applied on join, a duration like 1h30m for delayed roles
+}
+
+type Rest_Config_ModulePermission struct {
+	Type     string // channel or role
+	TargetID string
+	Allowed  []string
+	Denied   []string
+}
+
+type Rest_Config_Eventlog struct {
+	Enabled    bool
+	ChannelIDs []string
+}
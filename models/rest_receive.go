@@ -6,3 +6,24 @@ type Rest_Receive_SetSettings struct {
 		Values []string
 	}
 }
+
+type Rest_Receive_CustomCommand struct {
+	Keyword string
+	Content string
+}
+
+type Rest_Receive_LevelsRole struct {
+	RoleID     string
+	StartLevel int
+	LastLevel  int // -1 for no last level
+}
+
+type Rest_Receive_AutoRole struct {
+	RoleID string
+	Delay  string // empty to apply the role on join, a duration like 1h30m to apply it later
+}
+
+type Rest_Receive_ModulePermission struct {
+	Allowed []string
+	Denied  []string
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+const (
+	ScheduledMessagesTable MongoDbCollection = "scheduled_messages"
+)
+
+type ScheduledMessageEntry struct {
+	ID        bson.ObjectId `bson:"_id,omitempty"`
+	GuildID   string
+	ChannelID string
+	AuthorID  string
+	Content   string // plain text or embed code
+	Cron      string // empty for messages posted once
+	NextRunAt time.Time
+	LastRunAt time.Time
+	Paused    bool
+	CreatedAt time.Time
+}
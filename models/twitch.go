@@ -1,11 +1,25 @@
 package models
 
-import "github.com/globalsign/mgo/bson"
+import (
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
 
 const (
 	TwitchTable MongoDbCollection = "twitch"
 )
 
+const (
+	TwitchPostModeEdit TwitchPostMode = iota // turns the live message into the offline summary
+	TwitchPostModeNew                        // posts the offline summary as a new message
+)
+
+type TwitchPostMode int
+
+// TwitchEntry is a feed before the feeds framework, moved to FeedsTable by migration 59
+//
+// Deprecated: use FeedEntry
 type TwitchEntry struct {
 	ID                bson.ObjectId `bson:"_id,omitempty"`
 	GuildID           string        // renamed from serverid
@@ -13,4 +27,17 @@ type TwitchEntry struct {
 	TwitchChannelName string
 	IsLive            bool
 	MentionRoleID     string
+	Template          string
+	PostMode          TwitchPostMode
+	PostVOD           bool // link the VOD in the offline summary
+	Filter            FeedFilter
+	// the current stream, reset when it ends
+	StreamID        int64
+	StreamStartedAt time.Time
+	StreamTitle     string // as shown in the live message
+	StreamGame      string
+	PeakViewers     int
+	LiveMessageID   string
+	LiveFiltered    bool      // the stream did not pass the filter yet, checked again while it runs
+	LiveUpdatedAt   time.Time // last edit of the live message
 }
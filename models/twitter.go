@@ -12,6 +12,9 @@ const (
 
 type TwitterPostMode int
 
+// TwitterEntry is a feed before the feeds framework, moved to FeedsTable by migration 59
+//
+// Deprecated: use FeedEntry
 type TwitterEntry struct {
 	ID                bson.ObjectId `bson:"_id,omitempty"`
 	GuildID           string
@@ -23,6 +26,7 @@ type TwitterEntry struct {
 	PostMode          TwitterPostMode
 	ExcludeRTs        bool
 	ExcludeMentions   bool
+	Template          string
 }
 
 type TwitterTweetEntry struct {
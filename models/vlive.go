@@ -6,6 +6,9 @@ const (
 	VliveTable MongoDbCollection = "vlive"
 )
 
+// VliveEntry is a feed before the feeds framework, moved to FeedsTable by migration 59
+//
+// Deprecated: use FeedEntry
 type VliveEntry struct {
 	ID             bson.ObjectId `bson:"_id,omitempty"`
 	GuildID        string        // renamed from server ID
@@ -17,6 +20,8 @@ type VliveEntry struct {
 	PostedNotices  []VliveNoticeInfo
 	PostedCelebs   []VliveCelebInfo
 	MentionRoleID  string
+	Template       string
+	Filter         FeedFilter
 }
 
 type VliveChannelInfo struct {
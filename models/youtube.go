@@ -1,12 +1,20 @@
 package models
 
-import "github.com/globalsign/mgo/bson"
+import (
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
 
 const (
 	YoutubeChannelTable  MongoDbCollection = "youtube_channels"
+	YoutubeWebSubTable   MongoDbCollection = "youtube_websub_subscriptions"
 	YoutubeQuotaRedisKey                   = "robyul2-discord:youtube:quota"
 )
 
+// YoutubeChannelEntry is a feed before the feeds framework, moved to FeedsTable by migration 59
+//
+// Deprecated: use FeedEntry
 type YoutubeChannelEntry struct {
 	// Discord related fields.
 	ID                      bson.ObjectId `bson:"_id,omitempty"`
@@ -14,6 +22,9 @@ type YoutubeChannelEntry struct {
 	ChannelID               string
 	NextCheckTime           int64
 	LastSuccessfulCheckTime int64
+	MentionRoleID           string
+	Template                string
+	Filter                  FeedFilter
 
 	// Youtube channel specific fields.
 	YoutubeChannelID    string
@@ -26,3 +37,13 @@ type YoutubeQuota struct {
 	Left      int64
 	ResetTime int64
 }
+
+// YoutubeWebSubSubscription is the WebSub subscription to the feed of a YouTube channel, shared by all entries of the channel
+type YoutubeWebSubSubscription struct {
+	ID               bson.ObjectId `bson:"_id,omitempty"`
+	YoutubeChannelID string
+	RequestedAt      time.Time // zero once the hub verified the subscription
+	LeaseExpiresAt   time.Time // zero until the hub verified the subscription
+	// set when the unsubscription was requested, the hub verifies it asynchronously
+	UnsubscribeRequestedAt time.Time
+}
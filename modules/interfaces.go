@@ -1,6 +1,11 @@
 package modules
 
-import "github.com/bwmarrin/discordgo"
+import (
+	"context"
+
+	"github.com/Seklfreak/Robyul2/commands"
+	"github.com/bwmarrin/discordgo"
+)
 
 type BaseModule interface{}
 
@@ -19,6 +24,21 @@ type Plugin interface {
 	)
 }
 
+// CommandPlugin declares its commands with the commands framework, which parses the arguments and checks the permissions
+type CommandPlugin interface {
+	BaseModule
+
+	Commands() []*commands.Command
+
+	Init(session *discordgo.Session)
+}
+
+// GracefulPlugin is implemented by plugins which have to finish their work before the bot stops.
+// Shutdown is called before Uninit, once helpers.ShutdownContext is done, ctx is done at the shutdown deadline.
+type GracefulPlugin interface {
+	Shutdown(ctx context.Context)
+}
+
 type ExtendedPlugin interface {
 	BaseModule
 
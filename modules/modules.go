@@ -16,6 +16,7 @@ import (
 var (
 	pluginCache         map[string]*Plugin
 	extendedPluginCache map[string]*ExtendedPlugin
+	commandPluginCache  map[string]*CommandPlugin
 
 	PluginList = []Plugin{
 		&notifications.Handler{},
@@ -71,8 +72,17 @@ var (
 		&plugins.Imgur{},
 		&plugins.Steam{},
 		&plugins.Config{},
-		&plugins.Storage{},
 		&plugins.Mirror{},
+		&plugins.Schedule{},
+		&plugins.ApiTokens{},
+	}
+
+	PluginCommandList = []CommandPlugin{
+		&plugins.Storage{},
+		&plugins.CommandSettings{},
+		&plugins.Language{},
+		&plugins.Feeds{},
+		&plugins.RSS{},
 	}
 
 	PluginExtendedList = []ExtendedPlugin{
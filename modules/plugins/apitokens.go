@@ -0,0 +1,219 @@
+package plugins
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+type ApiTokens struct{}
+
+const (
+	apiTokensMaxPerGuild = 10
+	apiTokensTimeFormat  = "Mon, 02 Jan 2006 15:04 MST"
+)
+
+func (a *ApiTokens) Commands() []string {
+	return []string{
+		"apitoken",
+		"apitokens",
+	}
+}
+
+func (a *ApiTokens) Init(session *discordgo.Session) {
+}
+
+func (a *ApiTokens) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
+	args := strings.Fields(content)
+	if len(args) < 1 {
+		args = []string{"list"}
+	}
+
+	switch args[0] {
+	case "create", "add": // [p]apitoken create <name> <scope> [<scope>…]
+		helpers.RequireAdmin(msg, func() {
+			session.ChannelTyping(msg.ChannelID)
+
+			if len(args) < 3 {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+				return
+			}
+
+			channel, err := helpers.GetChannel(msg.ChannelID)
+			helpers.Relax(err)
+
+			scopes := make([]models.ApiTokenScope, 0)
+			for _, scopeText := range args[2:] {
+				scope, ok := helpers.ParseApiTokenScope(scopeText)
+				if !ok {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.apitokens.scope-invalid", a.scopesText()))
+					return
+				}
+				scopes = append(scopes, scope)
+			}
+
+			count, err := helpers.MdbCount(models.ApiTokensTable, bson.M{"guildid": channel.GuildID})
+			helpers.Relax(err)
+			if count >= apiTokensMaxPerGuild {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.apitokens.create-too-many", apiTokensMaxPerGuild))
+				return
+			}
+
+			token, tokenHash, err := helpers.NewApiToken()
+			helpers.Relax(err)
+
+			// the token is only sent by DM, so it doesn't end up in the server's chat history
+			dmChannel, err := session.UserChannelCreate(msg.Author.ID)
+			if err != nil {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.apitokens.create-dm-failed"))
+				return
+			}
+
+			entry := models.ApiTokenEntry{
+				GuildID:   channel.GuildID,
+				UserID:    msg.Author.ID,
+				Name:      args[1],
+				TokenHash: tokenHash,
+				Scopes:    scopes,
+				CreatedAt: time.Now(),
+			}
+			newID, err := helpers.MDbInsert(models.ApiTokensTable, entry)
+			helpers.Relax(err)
+
+			guild, err := helpers.GetGuild(channel.GuildID)
+			helpers.Relax(err)
+
+			_, err = helpers.SendMessage(dmChannel.ID, helpers.GetMessageTextF(msg, "plugins.apitokens.create-dm",
+				entry.Name, guild.Name, a.entryScopesText(entry), token))
+			if err != nil {
+				helpers.RelaxLog(helpers.MDbDelete(models.ApiTokensTable, newID))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.apitokens.create-dm-failed"))
+				return
+			}
+
+			_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(newID),
+				models.EventlogTargetTypeRobyulApiToken, msg.Author.ID,
+				models.EventlogTypeRobyulApiTokenCreate, "",
+				nil,
+				[]models.ElasticEventlogOption{
+					{
+						Key:   "apitoken_name",
+						Value: entry.Name,
+					},
+					{
+						Key:   "apitoken_scopes",
+						Value: a.entryScopesText(entry),
+					},
+				}, false)
+			helpers.RelaxLog(err)
+
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.apitokens.create-success",
+				helpers.MdbIdToHuman(newID)))
+			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+		})
+		return
+	case "list": // [p]apitoken list
+		helpers.RequireAdmin(msg, func() {
+			session.ChannelTyping(msg.ChannelID)
+
+			channel, err := helpers.GetChannel(msg.ChannelID)
+			helpers.Relax(err)
+
+			var entries []models.ApiTokenEntry
+			err = helpers.MDbIter(helpers.MdbCollection(models.ApiTokensTable).Find(
+				bson.M{"guildid": channel.GuildID},
+			).Sort("createdat")).All(&entries)
+			helpers.Relax(err)
+
+			if len(entries) <= 0 {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.apitokens.list-empty"))
+				return
+			}
+
+			listText := ":key: API tokens on this server:\n"
+			for _, entry := range entries {
+				lastUsedText := "never used"
+				if !entry.LastUsedAt.IsZero() {
+					lastUsedText = "last used " + entry.LastUsedAt.UTC().Format(apiTokensTimeFormat)
+				}
+				listText += fmt.Sprintf(":arrow_forward: `%s`: `%s` by <@%s>, scopes %s, %s\n",
+					helpers.MdbIdToHuman(entry.ID), entry.Name, entry.UserID, a.entryScopesText(entry), lastUsedText)
+			}
+			listText += fmt.Sprintf("Found **%d** API tokens in total.", len(entries))
+
+			for _, page := range helpers.Pagify(listText, "\n") {
+				_, err = helpers.SendMessage(msg.ChannelID, page)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			}
+		})
+		return
+	case "revoke", "delete", "remove": // [p]apitoken revoke <id>
+		helpers.RequireAdmin(msg, func() {
+			session.ChannelTyping(msg.ChannelID)
+
+			if len(args) < 2 {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+				return
+			}
+
+			channel, err := helpers.GetChannel(msg.ChannelID)
+			helpers.Relax(err)
+
+			var entry models.ApiTokenEntry
+			err = helpers.MdbOne(
+				helpers.MdbCollection(models.ApiTokensTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(args[1])}),
+				&entry,
+			)
+			if helpers.IsMdbNotFound(err) {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.apitokens.not-found"))
+				return
+			}
+			helpers.Relax(err)
+
+			err = helpers.MDbDelete(models.ApiTokensTable, entry.ID)
+			helpers.Relax(err)
+
+			_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entry.ID),
+				models.EventlogTargetTypeRobyulApiToken, msg.Author.ID,
+				models.EventlogTypeRobyulApiTokenRevoke, "",
+				nil,
+				[]models.ElasticEventlogOption{
+					{
+						Key:   "apitoken_name",
+						Value: entry.Name,
+					},
+					{
+						Key:   "apitoken_scopes",
+						Value: a.entryScopesText(entry),
+					},
+				}, false)
+			helpers.RelaxLog(err)
+
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.apitokens.revoke-success"))
+		})
+		return
+	}
+
+	helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+}
+
+func (a *ApiTokens) scopesText() (text string) {
+	scopeNames := make([]string, 0, len(models.ApiTokenScopes))
+	for _, scope := range models.ApiTokenScopes {
+		scopeNames = append(scopeNames, "`"+string(scope)+"`")
+	}
+	return strings.Join(scopeNames, ", ")
+}
+
+func (a *ApiTokens) entryScopesText(entry models.ApiTokenEntry) (text string) {
+	scopeNames := make([]string, 0, len(entry.Scopes))
+	for _, scope := range entry.Scopes {
+		scopeNames = append(scopeNames, "`"+string(scope)+"`")
+	}
+	return strings.Join(scopeNames, ", ")
+}
@@ -29,8 +29,8 @@ func (a *Autoleaver) Commands() []string {
 }
 
 func (a *Autoleaver) Init(session *discordgo.Session) {
-	session.AddHandler(a.OnGuildCreate)
-	session.AddHandler(a.OnGuildDelete)
+	cache.AddHandler(a.OnGuildCreate)
+	cache.AddHandler(a.OnGuildDelete)
 
 	go func() {
 		defer helpers.Recover()
@@ -127,7 +127,7 @@ func (a *Autoleaver) actionStart(args []string, in *discordgo.Message, out **dis
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = a.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return a.actionFinish
 	}
 
@@ -144,18 +144,18 @@ func (a *Autoleaver) actionStart(args []string, in *discordgo.Message, out **dis
 		return a.actionSetLog
 	}
 
-	*out = a.newMsg(helpers.GetText("bot.arguments.invalid"))
+	*out = a.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 	return a.actionFinish
 }
 
 func (a *Autoleaver) actionAdd(args []string, in *discordgo.Message, out **discordgo.MessageSend) autoleaverAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = a.newMsg(helpers.GetText("robyulmod.no_permission"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "robyulmod.no_permission"))
 		return a.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = a.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return a.actionFinish
 	}
 
@@ -170,7 +170,7 @@ func (a *Autoleaver) actionAdd(args []string, in *discordgo.Message, out **disco
 		if err == nil && invite != nil && invite.Guild != nil && invite.Guild.ID != "" {
 			guildID = invite.Guild.ID
 		} else {
-			*out = a.newMsg(helpers.GetText("bot.arguments.invalid"))
+			*out = a.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 			return a.actionFinish
 		}
 	}
@@ -181,7 +181,7 @@ func (a *Autoleaver) actionAdd(args []string, in *discordgo.Message, out **disco
 	if len(args) >= 3 {
 		until, err = tparse.AddDuration(time.Now(), args[2])
 		if err != nil {
-			*out = a.newMsg("bot.arguments.invalid")
+			*out = a.newMsg(in, "bot.arguments.invalid")
 			return a.actionFinish
 		}
 	}
@@ -200,7 +200,7 @@ func (a *Autoleaver) actionAdd(args []string, in *discordgo.Message, out **disco
 		}
 
 		if entryBucket.Until.IsZero() {
-			*out = a.newMsg(helpers.GetTextF("plugins.autoleaver.add-error-duplicate", guildFound.Name, guildFound.ID))
+			*out = a.newMsg(in, helpers.GetMessageTextF(in, "plugins.autoleaver.add-error-duplicate", guildFound.Name, guildFound.ID))
 			return a.actionFinish
 		}
 	}
@@ -226,23 +226,23 @@ func (a *Autoleaver) actionAdd(args []string, in *discordgo.Message, out **disco
 		guildAdded.Name = "N/A"
 	}
 
-	message := helpers.GetTextF("plugins.autoleaver.add-success", guildAdded.Name, guildAdded.ID)
+	message := helpers.GetMessageTextF(in, "plugins.autoleaver.add-success", guildAdded.Name, guildAdded.ID)
 	if !until.IsZero() {
 		message += "\nWhitelisted until " + until.Format(time.ANSIC)
 	}
 
-	*out = a.newMsg(message)
+	*out = a.newMsg(in, message)
 	return a.actionFinish
 }
 
 func (a *Autoleaver) actionImport(args []string, in *discordgo.Message, out **discordgo.MessageSend) autoleaverAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = a.newMsg(helpers.GetText("robyulmod.no_permission"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "robyulmod.no_permission"))
 		return a.actionFinish
 	}
 
 	if len(in.Attachments) < 1 {
-		*out = a.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return a.actionFinish
 	}
 
@@ -250,7 +250,7 @@ func (a *Autoleaver) actionImport(args []string, in *discordgo.Message, out **di
 	guildIDs = bytes.TrimPrefix(guildIDs, []byte("\xef\xbb\xbf")) // removes BOM
 	guildIDLines := strings.Split(string(guildIDs), "\n")
 
-	resultText := helpers.GetText("plugins.autoleaver.bulk-title") + "\n"
+	resultText := helpers.GetMessageText(in, "plugins.autoleaver.bulk-title") + "\n"
 
 	var err error
 	var guildID string
@@ -301,7 +301,7 @@ func (a *Autoleaver) actionImport(args []string, in *discordgo.Message, out **di
 
 		guildsAdded++
 	}
-	resultText += helpers.GetTextF("plugins.autoleaver.bulk-footer", guildsAdded) + "\n"
+	resultText += helpers.GetMessageTextF(in, "plugins.autoleaver.bulk-footer", guildsAdded) + "\n"
 
 	for _, page := range helpers.Pagify(resultText, "\n") {
 		_, err = helpers.SendMessage(in.ChannelID, page)
@@ -313,12 +313,12 @@ func (a *Autoleaver) actionImport(args []string, in *discordgo.Message, out **di
 
 func (a *Autoleaver) actionRemove(args []string, in *discordgo.Message, out **discordgo.MessageSend) autoleaverAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = a.newMsg(helpers.GetText("robyulmod.no_permission"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "robyulmod.no_permission"))
 		return a.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = a.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return a.actionFinish
 	}
 
@@ -341,7 +341,7 @@ func (a *Autoleaver) actionRemove(args []string, in *discordgo.Message, out **di
 			guildFound.Name = "N/A"
 		}
 
-		*out = a.newMsg(helpers.GetTextF("plugins.autoleaver.remove-error-not-found", guildFound.Name, guildFound.ID))
+		*out = a.newMsg(in, helpers.GetMessageTextF(in, "plugins.autoleaver.remove-error-not-found", guildFound.Name, guildFound.ID))
 		return a.actionFinish
 	}
 
@@ -355,13 +355,13 @@ func (a *Autoleaver) actionRemove(args []string, in *discordgo.Message, out **di
 		guildRemoved.Name = "N/A"
 	}
 
-	*out = a.newMsg(helpers.GetTextF("plugins.autoleaver.remove-success", guildRemoved.Name, guildRemoved.ID))
+	*out = a.newMsg(in, helpers.GetMessageTextF(in, "plugins.autoleaver.remove-success", guildRemoved.Name, guildRemoved.ID))
 	return a.actionFinish
 }
 
 func (a *Autoleaver) actionCheck(args []string, in *discordgo.Message, out **discordgo.MessageSend) autoleaverAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = a.newMsg(helpers.GetText("robyulmod.no_permission"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "robyulmod.no_permission"))
 		return a.actionFinish
 	}
 
@@ -372,7 +372,7 @@ func (a *Autoleaver) actionCheck(args []string, in *discordgo.Message, out **dis
 	err = helpers.MDbIter(helpers.MdbCollection(models.AutoleaverWhitelistTable).Find(nil)).All(&entryBucket)
 	helpers.Relax(err)
 	if entryBucket == nil || len(entryBucket) < 1 {
-		*out = a.newMsg(helpers.GetText("plugins.autoleaver.check-no-entries"))
+		*out = a.newMsg(in, helpers.GetMessageText(in, "plugins.autoleaver.check-no-entries"))
 		return a.actionFinish
 	}
 
@@ -389,25 +389,25 @@ func (a *Autoleaver) actionCheck(args []string, in *discordgo.Message, out **dis
 	}
 
 	if len(notWhitelistedGuilds) <= 0 {
-		*out = a.newMsg(helpers.GetTextF("plugins.autoleaver.check-no-not-whitelisted", len(cache.GetSession().State.Guilds)))
+		*out = a.newMsg(in, helpers.GetMessageTextF(in, "plugins.autoleaver.check-no-not-whitelisted", len(cache.GetSession().State.Guilds)))
 		return a.actionFinish
 	}
 
-	notWhitelistedGuildsMessage := helpers.GetTextF("plugins.autoleaver.check-not-whitelisted-title", len(notWhitelistedGuilds)) + "\n"
+	notWhitelistedGuildsMessage := helpers.GetMessageTextF(in, "plugins.autoleaver.check-not-whitelisted-title", len(notWhitelistedGuilds)) + "\n"
 	for _, notWhitelistedGuild := range notWhitelistedGuilds {
 		notWhitelistedGuildsMessage += fmt.Sprintf("`%s` (`#%s`): Channels `%d`, Members: `%d`, Region: `%s`\n",
 			notWhitelistedGuild.Name, notWhitelistedGuild.ID, len(notWhitelistedGuild.Channels), len(notWhitelistedGuild.Members), notWhitelistedGuild.Region)
 	}
-	notWhitelistedGuildsMessage += helpers.GetTextF("plugins.autoleaver.check-not-whitelisted-footer", len(notWhitelistedGuilds), len(cache.GetSession().State.Guilds)) + "\n"
+	notWhitelistedGuildsMessage += helpers.GetMessageTextF(in, "plugins.autoleaver.check-not-whitelisted-footer", len(notWhitelistedGuilds), len(cache.GetSession().State.Guilds)) + "\n"
 
-	*out = a.newMsg(notWhitelistedGuildsMessage)
+	*out = a.newMsg(in, notWhitelistedGuildsMessage)
 	return a.actionFinish
 }
 
 // [p]autoleaver set-log <#channel or channel id>
 func (a *Autoleaver) actionSetLog(args []string, in *discordgo.Message, out **discordgo.MessageSend) autoleaverAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = a.newMsg("robyulmod.no_permission")
+		*out = a.newMsg(in, "robyulmod.no_permission")
 		return a.actionFinish
 	}
 
@@ -424,7 +424,7 @@ func (a *Autoleaver) actionSetLog(args []string, in *discordgo.Message, out **di
 		err = helpers.SetBotConfigString(models.AutoleaverLogChannelKey, "")
 	}
 
-	*out = a.newMsg("plugins.autoleaver.setlog-success")
+	*out = a.newMsg(in, "plugins.autoleaver.setlog-success")
 	return a.actionFinish
 }
 
@@ -455,8 +455,8 @@ func (a *Autoleaver) actionFinish(args []string, in *discordgo.Message, out **di
 	return nil
 }
 
-func (a *Autoleaver) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (a *Autoleaver) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (a *Autoleaver) Relax(err error) {
@@ -531,7 +531,7 @@ func (a *Autoleaver) OnGuildCreate(session *discordgo.Session, guild *discordgo.
 func (a *Autoleaver) sendAutoleaveMessage(guildID string) (err error) {
 	targetChannelID, err := helpers.GetGuildDefaultChannel(guildID)
 	if err == nil {
-		helpers.SendMessage(targetChannelID, helpers.GetText("plugins.autoleaver.non-whitelisted-leave-message"))
+		helpers.SendMessage(targetChannelID, helpers.GetTextL(helpers.GetLocale(guildID, ""), "plugins.autoleaver.non-whitelisted-leave-message"))
 		return nil
 	}
 
@@ -541,7 +541,7 @@ func (a *Autoleaver) sendAutoleaveMessage(guildID string) (err error) {
 func (a *Autoleaver) sendAllowedJoinMessage(guildID string) (err error) {
 	targetChannelID, err := helpers.GetGuildDefaultChannel(guildID)
 	if err == nil {
-		helpers.SendMessage(targetChannelID, helpers.GetTextF("plugins.autoleaver.yes-whitelisted-join-message", guildID))
+		helpers.SendMessage(targetChannelID, helpers.GetTextLF(helpers.GetLocale(guildID, ""), "plugins.autoleaver.yes-whitelisted-join-message", guildID))
 		return nil
 	}
 
@@ -51,7 +51,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 			session.ChannelTyping(msg.ChannelID)
 			helpers.RequireAdmin(msg, func() {
 				if len(args) < 2 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 				channel, err := helpers.GetChannel(msg.ChannelID)
@@ -95,7 +95,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 					}
 				}
 				if targetRole == nil || targetRole.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 
@@ -103,14 +103,14 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 
 				for _, role := range settings.AutoRoleIDs {
 					if role == targetRole.ID {
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.autorole.role-add-error-duplicate"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.autorole.role-add-error-duplicate"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
 				}
 				for _, delayedRole := range settings.DelayedAutoRoles {
 					if delayedRole.RoleID == targetRole.ID {
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.autorole.role-add-error-duplicate"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.autorole.role-add-error-duplicate"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -119,13 +119,13 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 				var successText string
 				if delay <= 0 {
 					settings.AutoRoleIDs = append(settings.AutoRoleIDs, targetRole.ID)
-					successText = helpers.GetTextF("plugins.autorole.role-add-success", targetRole.Name)
+					successText = helpers.GetMessageTextF(msg, "plugins.autorole.role-add-success", targetRole.Name)
 				} else {
 					settings.DelayedAutoRoles = append(settings.DelayedAutoRoles, models.DelayedAutoRole{
 						RoleID: targetRole.ID,
 						Delay:  delay,
 					})
-					successText = helpers.GetTextF("plugins.autorole.delayed-role-add-success", targetRole.Name, delay.String())
+					successText = helpers.GetMessageTextF(msg, "plugins.autorole.delayed-role-add-success", targetRole.Name, delay.String())
 				}
 
 				err = helpers.GuildSettingsSet(channel.GuildID, settings)
@@ -158,7 +158,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 			settings := helpers.GuildSettingsGetCached(channel.GuildID)
 
 			if len(settings.AutoRoleIDs) <= 0 && len(settings.DelayedAutoRoles) <= 0 {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.autorole.role-list-none"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.autorole.role-list-none"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			}
@@ -192,7 +192,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 			session.ChannelTyping(msg.ChannelID)
 			helpers.RequireAdmin(msg, func() {
 				if len(args) < 2 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 				channel, err := helpers.GetChannel(msg.ChannelID)
@@ -256,7 +256,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 				}
 
 				if !roleWasInList {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.autorole.role-remove-error-not-found"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.autorole.role-remove-error-not-found"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -282,7 +282,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 					options, false)
 				helpers.RelaxLog(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.autorole.role-remove-success"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.autorole.role-remove-success"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			})
@@ -291,7 +291,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 			session.ChannelTyping(msg.ChannelID)
 			helpers.RequireAdmin(msg, func() {
 				if len(args) < 2 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 				channel, err := helpers.GetChannel(msg.ChannelID)
@@ -321,7 +321,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 					}
 				}
 				if targetRole == nil || targetRole.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 
@@ -334,9 +334,9 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 					}
 				}
 
-				if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetTextF("plugins.autorole.apply-confirm",
+				if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetMessageTextF(msg, "plugins.autorole.apply-confirm",
 					targetRole.Name, targetRole.ID, len(users)), "✅", "🚫") {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.autorole.apply-started"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.autorole.apply-started"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 
 					addedSuccess := 0
@@ -367,7 +367,7 @@ func (a *AutoRoles) Action(command string, content string, msg *discordgo.Messag
 						}, false)
 					helpers.RelaxLog(err)
 
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.autorole.apply-done",
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.autorole.apply-done",
 						msg.Author.ID, addedSuccess, addedError))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
@@ -420,26 +420,21 @@ func AutoroleApply(guildID string, userID string, roleID string) (err error) {
 	return
 }
 func AutoroleApplySignature(guildID string, userID string, roleID string) (signature *tasks.Signature) {
-	signature = &tasks.Signature{
-		Name: "apply_autorole",
-		Args: []tasks.Arg{
-			{
-				Type:  "string",
-				Value: guildID,
-			},
-			{
-				Type:  "string",
-				Value: userID,
-			},
-			{
-				Type:  "string",
-				Value: roleID,
-			},
+	return helpers.NewMachinerySignature(
+		"apply_autorole",
+		tasks.Arg{
+			Type:  "string",
+			Value: guildID,
 		},
-	}
-	signature.RetryCount = 3
-	signature.OnError = []*tasks.Signature{{Name: "log_error"}}
-	return signature
+		tasks.Arg{
+			Type:  "string",
+			Value: userID,
+		},
+		tasks.Arg{
+			Type:  "string",
+			Value: roleID,
+		},
+	)
 }
 
 func (a *AutoRoles) OnGuildMemberRemove(member *discordgo.Member, session *discordgo.Session) {
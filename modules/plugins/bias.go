@@ -88,7 +88,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 								biasListText += fmt.Sprintf(" (**`%s Roles`** Max)", strings.Title(helpers.HumanizeNumber(calculatedLimit)))
 							}
 						}
-						for _, page := range helpers.Pagify(helpers.GetTextF("plugins.bias.bias-help-message",
+						for _, page := range helpers.Pagify(helpers.GetMessageTextF(msg, "plugins.bias.bias-help-message",
 							biasListText, exampleRoleName, exampleRoleName), ",") {
 							helpers.SendMessage(msg.ChannelID, page)
 						}
@@ -96,7 +96,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 					}
 				}
 
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.no-bias-config"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.no-bias-config"))
 				helpers.Relax(err)
 			})
 		case "refresh":
@@ -107,7 +107,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 				err := helpers.MDbIter(helpers.MdbCollection(models.BiasTable).Find(nil)).All(&biasChannels)
 				helpers.Relax(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.refreshed-config"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.refreshed-config"))
 				helpers.Relax(err)
 			})
 		case "set-config":
@@ -115,20 +115,20 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 				session.ChannelTyping(msg.ChannelID)
 
 				if len(args) < 2 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.Relax(err)
 					return
 				}
 
 				if len(msg.Attachments) <= 0 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					helpers.Relax(err)
 					return
 				}
 
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 
@@ -137,7 +137,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 				channelConfigJson = bytes.TrimPrefix(channelConfigJson, []byte("\xef\xbb\xbf")) // removes BOM
 				err = json.Unmarshal(channelConfigJson, &channelConfig)
 				if err != nil {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.set-config-error-invalid"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.set-config-error-invalid"))
 					helpers.Relax(err)
 					return
 				}
@@ -198,7 +198,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 				err = helpers.MDbIter(helpers.MdbCollection(models.BiasTable).Find(nil)).All(&biasChannels)
 				helpers.Relax(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.updated-config"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.updated-config"))
 				helpers.Relax(err)
 				return
 			})
@@ -207,14 +207,14 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 				session.ChannelTyping(msg.ChannelID)
 
 				if len(args) < 2 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.Relax(err)
 					return
 				}
 
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				targetGuild, err := helpers.GetGuild(targetChannel.GuildID)
@@ -226,7 +226,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 					&channelConfig,
 				)
 				if helpers.IsMdbNotFound(err) {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.no-bias-config"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.no-bias-config"))
 					helpers.Relax(err)
 					return
 				}
@@ -244,14 +244,14 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 				session.ChannelTyping(msg.ChannelID)
 
 				if len(args) < 2 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.Relax(err)
 					return
 				}
 
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 
@@ -261,7 +261,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 					&channelConfig,
 				)
 				if helpers.IsMdbNotFound(err) {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.no-bias-config"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.no-bias-config"))
 					helpers.Relax(err)
 					return
 				}
@@ -288,7 +288,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 					}, false)
 				helpers.RelaxLog(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.delete-config-success"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.delete-config-success"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			})
@@ -358,7 +358,7 @@ func (m *Bias) Action(command string, content string, msg *discordgo.Message, se
 			}
 
 			if statsPrinted <= 0 {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.no-stats"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.no-stats"))
 				helpers.Relax(err)
 			} else {
 				for _, page := range helpers.Pagify(statsText, "\n") {
@@ -385,7 +385,7 @@ func (m *Bias) OnMessage(content string, msg *discordgo.Message, session *discor
 				guildRoles, err := session.GuildRoles(guild.ID)
 				if err != nil {
 					if err, ok := err.(*discordgo.RESTError); ok && err.Message.Code == 50013 {
-						newMessages, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.bias.generic-error"))
+						newMessages, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.bias.generic-error"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						// Delete messages after ten seconds
 						time.Sleep(10 * time.Second)
@@ -466,16 +466,16 @@ func (m *Bias) OnMessage(content string, msg *discordgo.Message, session *discor
 											memberHasRole := m.MemberHasRole(member, discordRole)
 											//fmt.Println("member has role", discordRole.Name, "?", memberHasRole)
 											if requestIsAddRole == true && memberHasRole == true {
-												errorText = helpers.GetText("plugins.bias.add-role-already")
+												errorText = helpers.GetMessageText(msg, "plugins.bias.add-role-already")
 												continue TryRoleLoop
 											}
 											if requestIsAddRole == false && memberHasRole == false {
-												errorText = helpers.GetText("plugins.bias.remove-role-not-found")
+												errorText = helpers.GetMessageText(msg, "plugins.bias.remove-role-not-found")
 												continue TryRoleLoop
 											}
 											categoryRolesAssigned := m.CategoryRolesAssigned(member, guildRoles, category)
 											if requestIsAddRole == true && (category.Limit >= 0 && len(categoryRolesAssigned) >= category.Limit) {
-												errorText = helpers.GetText("plugins.bias.role-limit-reached")
+												errorText = helpers.GetMessageText(msg, "plugins.bias.role-limit-reached")
 												continue TryRoleLoop
 											}
 											if requestIsAddRole == true && category.Pool != "" {
@@ -485,7 +485,7 @@ func (m *Bias) OnMessage(content string, msg *discordgo.Message, session *discor
 															if poolRole.Print == role.Print {
 																poolDiscordRole := m.GetDiscordRole(poolRole, guild)
 																if poolDiscordRole != nil && poolDiscordRole.ID != "" && m.MemberHasRole(member, poolDiscordRole) {
-																	errorText = helpers.GetText("plugins.bias.add-role-already")
+																	errorText = helpers.GetMessageText(msg, "plugins.bias.add-role-already")
 																	continue TryRoleLoop
 																}
 															}
@@ -500,7 +500,7 @@ func (m *Bias) OnMessage(content string, msg *discordgo.Message, session *discor
 													err = session.GuildMemberRoleAdd(guild.ID, msg.Author.ID, discordRole.ID)
 													if err != nil {
 														//fmt.Println("failed to add role", discordRole.Name)
-														errorText = helpers.GetText("plugins.bias.generic-error")
+														errorText = helpers.GetMessageText(msg, "plugins.bias.generic-error")
 													} else {
 														//fmt.Println("added role", discordRole.Name)
 														rolesAdded = append(rolesAdded, role.Print)
@@ -511,7 +511,7 @@ func (m *Bias) OnMessage(content string, msg *discordgo.Message, session *discor
 													err = session.GuildMemberRoleRemove(guild.ID, msg.Author.ID, discordRole.ID)
 													if err != nil {
 														//fmt.Println("failed to remove role", discordRole.Name)
-														errorText = helpers.GetText("plugins.bias.generic-error")
+														errorText = helpers.GetMessageText(msg, "plugins.bias.generic-error")
 													} else {
 														//fmt.Println("removed role", discordRole.Name)
 														rolesRemoved = append(rolesRemoved, role.Print)
@@ -539,16 +539,16 @@ func (m *Bias) OnMessage(content string, msg *discordgo.Message, session *discor
 					//fmt.Printf("removed: %+v\n", rolesRemoved)
 					//fmt.Printf("errors: %+v\n", rolesErrors)
 					if len(rolesAdded) <= 0 && len(rolesRemoved) <= 0 && len(rolesErrors) <= 0 {
-						newMessage, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> %s", msg.Author.ID, helpers.GetText("plugins.bias.role-not-found")))
+						newMessage, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> %s", msg.Author.ID, helpers.GetMessageText(msg, "plugins.bias.role-not-found")))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						messagesToDelete = append(messagesToDelete, newMessage...)
 					} else {
 						if len(rolesAdded) == 1 && len(rolesRemoved) == 0 && len(rolesErrors) == 0 {
-							newMessage, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> %s", msg.Author.ID, helpers.GetText("plugins.bias.role-added")))
+							newMessage, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> %s", msg.Author.ID, helpers.GetMessageText(msg, "plugins.bias.role-added")))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							messagesToDelete = append(messagesToDelete, newMessage...)
 						} else if len(rolesAdded) == 0 && len(rolesRemoved) == 1 && len(rolesErrors) == 0 {
-							newMessage, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> %s", msg.Author.ID, helpers.GetText("plugins.bias.role-removed")))
+							newMessage, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> %s", msg.Author.ID, helpers.GetMessageText(msg, "plugins.bias.role-removed")))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							messagesToDelete = append(messagesToDelete, newMessage...)
 						} else if len(rolesAdded) == 0 && len(rolesRemoved) == 0 && len(rolesErrors) == 1 {
@@ -557,7 +557,7 @@ func (m *Bias) OnMessage(content string, msg *discordgo.Message, session *discor
 							messagesToDelete = append(messagesToDelete, newMessage...)
 						} else {
 							newMessage, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> %s", msg.Author.ID,
-								helpers.GetTextF(
+								helpers.GetMessageTextF(msg,
 									"plugins.bias.roles-batch",
 									len(rolesAdded), len(rolesRemoved), len(rolesErrors),
 								)))
@@ -646,10 +646,11 @@ func (m *Bias) OnReactionAdd(reaction *discordgo.MessageReactionAdd, session *di
 				if member.User.Bot {
 					return
 				}
+				locale := helpers.GetLocale(guild.ID, reaction.UserID)
 				guildRoles, err := session.GuildRoles(guild.ID)
 				if err != nil {
 					if err, ok := err.(*discordgo.RESTError); ok && err.Message.Code == 50013 {
-						newMessages, err := helpers.SendMessage(reaction.ChannelID, helpers.GetText("plugins.bias.generic-error"))
+						newMessages, err := helpers.SendMessage(reaction.ChannelID, helpers.GetTextL(locale, "plugins.bias.generic-error"))
 						if err != nil {
 							if errD, ok := err.(*discordgo.RESTError); ok {
 								if errD.Message.Code == discordgo.ErrCodeMissingPermissions {
@@ -683,12 +684,12 @@ func (m *Bias) OnReactionAdd(reaction *discordgo.MessageReactionAdd, session *di
 									memberHasRole := m.MemberHasRole(member, discordRole)
 									//fmt.Println("member has role", discordRole.Name, "?", memberHasRole)
 									if memberHasRole == true {
-										errorText = helpers.GetText("plugins.bias.add-role-already")
+										errorText = helpers.GetTextL(locale, "plugins.bias.add-role-already")
 										continue TryRoleLoop
 									}
 									categoryRolesAssigned := m.CategoryRolesAssigned(member, guildRoles, category)
 									if category.Limit >= 0 && len(categoryRolesAssigned) >= category.Limit {
-										errorText = helpers.GetText("plugins.bias.role-limit-reached")
+										errorText = helpers.GetTextL(locale, "plugins.bias.role-limit-reached")
 										continue TryRoleLoop
 									}
 									if category.Pool != "" {
@@ -698,7 +699,7 @@ func (m *Bias) OnReactionAdd(reaction *discordgo.MessageReactionAdd, session *di
 													if poolRole.Print == role.Print {
 														poolDiscordRole := m.GetDiscordRole(poolRole, guild)
 														if poolDiscordRole != nil && poolDiscordRole.ID != "" && m.MemberHasRole(member, poolDiscordRole) {
-															errorText = helpers.GetText("plugins.bias.add-role-already")
+															errorText = helpers.GetTextL(locale, "plugins.bias.add-role-already")
 															continue TryRoleLoop
 														}
 													}
@@ -711,7 +712,7 @@ func (m *Bias) OnReactionAdd(reaction *discordgo.MessageReactionAdd, session *di
 										err = session.GuildMemberRoleAdd(guild.ID, reaction.UserID, discordRole.ID)
 										if err != nil {
 											//fmt.Println("failed to add role", discordRole.Name)
-											errorText = helpers.GetText("plugins.bias.generic-error")
+											errorText = helpers.GetTextL(locale, "plugins.bias.generic-error")
 										} else {
 											//fmt.Println("added role", discordRole.Name)
 											roleAdded = true
@@ -728,7 +729,7 @@ func (m *Bias) OnReactionAdd(reaction *discordgo.MessageReactionAdd, session *di
 				var newMessages []*discordgo.Message
 
 				if roleAdded {
-					newMessages, err = helpers.SendMessage(reaction.ChannelID, fmt.Sprintf("<@%s> %s", reaction.UserID, helpers.GetText("plugins.bias.role-added")))
+					newMessages, err = helpers.SendMessage(reaction.ChannelID, fmt.Sprintf("<@%s> %s", reaction.UserID, helpers.GetTextL(locale, "plugins.bias.role-added")))
 					helpers.RelaxMessage(err, reaction.ChannelID, "")
 				} else if errorText != "" {
 					newMessages, err = helpers.SendMessage(reaction.ChannelID, fmt.Sprintf("<@%s> %s", reaction.UserID, errorText))
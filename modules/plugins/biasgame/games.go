@@ -41,6 +41,7 @@ var currentSinglePlayerGames map[string]*singleBiasGame
 var currentSinglePlayerGamesMutex sync.RWMutex
 var currentMultiPlayerGames []*multiBiasGame
 var currentMultiPlayerGamesMutex sync.RWMutex
+var runningMultiGames sync.WaitGroup
 
 // game configs
 var allowedGameSizes map[int]bool
@@ -96,13 +97,13 @@ func createOrGetSinglePlayerGame(msg *discordgo.Message, commandArgs []string) *
 						continue
 					} else {
 
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.game.invalid-game-size"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.game.invalid-game-size"))
 						return nil
 					}
 				}
 
 				// if a arg was passed that didn't match any check, send invalid args message
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return nil
 			}
 		}
@@ -121,14 +122,14 @@ func createOrGetSinglePlayerGame(msg *discordgo.Message, commandArgs []string) *
 
 		// confirm we have enough biases to choose from for the game size this should be
 		if len(biasChoices) < gameSize {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.game.not-enough-idols"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.game.not-enough-idols"))
 			return nil
 		}
 
 		// show a warning if the game size is >= 256, wait for confirm
 		if gameSize >= 256 {
 
-			if !helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetText("plugins.biasgame.game.size-warning"), "✅", "🚫") {
+			if !helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetMessageText(msg, "plugins.biasgame.game.size-warning"), "✅", "🚫") {
 				return nil
 			}
 
@@ -273,7 +274,7 @@ func (g *singleBiasGame) sendBiasGameRound() {
 	if err != nil {
 
 		if checkPermissionError(err, g.ChannelID) {
-			helpers.SendMessage(g.ChannelID, helpers.GetText("bot.errors.no-file"))
+			helpers.SendMessage(g.ChannelID, helpers.GetTextL(helpers.GetChannelLocale(g.ChannelID, g.User.ID), "bot.errors.no-file"))
 		}
 
 		return
@@ -403,11 +404,11 @@ func startMultiPlayerGame(msg *discordgo.Message, commandArgs []string) {
 
 		// resume game if it was stopped
 		if game.GameIsRunning == false {
-			game.processMultiGame()
+			game.runMultiGame()
 			return
 		}
 
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.game.multi-game-running"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.game.multi-game-running"))
 		return
 	}
 
@@ -434,13 +435,13 @@ func startMultiPlayerGame(msg *discordgo.Message, commandArgs []string) {
 					continue
 				} else {
 
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.game.invalid-game-size-multi"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.game.invalid-game-size-multi"))
 					return
 				}
 			}
 
 			// if a arg was passed that didn't match any check, send invalid args message
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
@@ -461,7 +462,7 @@ func startMultiPlayerGame(msg *discordgo.Message, commandArgs []string) {
 
 	// confirm we have enough biases for a multiplayer game
 	if len(biasChoices) < multiGameSize {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.game.not-enough-idols"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.game.not-enough-idols"))
 		return
 	}
 
@@ -493,7 +494,7 @@ func startMultiPlayerGame(msg *discordgo.Message, commandArgs []string) {
 	// save game to current running games
 	multiGame.saveGame()
 
-	multiGame.processMultiGame()
+	multiGame.runMultiGame()
 }
 
 // sendMultiBiasGameRound sends the next round for the multi game
@@ -535,7 +536,7 @@ func (g *multiBiasGame) sendMultiBiasGameRound() error {
 		// check if error is a permissions error, if not retry send the round
 		if checkPermissionError(err, g.ChannelID) {
 
-			helpers.SendMessage(g.ChannelID, helpers.GetText("bot.errors.no-file"))
+			helpers.SendMessage(g.ChannelID, helpers.GetTextL(helpers.GetChannelLocale(g.ChannelID, ""), "bot.errors.no-file"))
 			return errors.New("Could not send round")
 		} else {
 
@@ -566,10 +567,26 @@ func (g *multiBiasGame) sendMultiBiasGameRound() error {
 	return nil
 }
 
-// start multi game loop. every 10 seconds count the number of arrow reactions. whichever side has most wins
-func (g *multiBiasGame) processMultiGame() {
+// runMultiGame processes a multi game and keeps it in runningMultiGames until it stopped,
+// games are not started anymore once the bot shuts down, they are resumed after the restart
+func (g *multiBiasGame) runMultiGame() {
+	if helpers.IsShuttingDown() {
+		return
+	}
+	runningMultiGames.Add(1)
+	defer runningMultiGames.Done()
+
+	g.processMultiGame()
+}
 
+// start multi game loop. every 10 seconds count the number of arrow reactions. whichever side has most wins.
+// the loop stops between rounds when the bot shuts down, the game is saved and resumed after the restart
+// callers have to add the game to runningMultiGames, see runMultiGame
+func (g *multiBiasGame) processMultiGame() {
 	for g.IdolsRemaining != 1 {
+		if helpers.IsShuttingDown() {
+			return
+		}
 
 		// send next rounds and sleep
 		err := g.sendMultiBiasGameRound()
@@ -577,7 +594,10 @@ func (g *multiBiasGame) processMultiGame() {
 			g.GameIsRunning = false
 			return
 		}
-		time.Sleep(time.Second * time.Duration(g.RoundDelay))
+		// the round is sent again after the restart
+		if !helpers.SleepUnlessShuttingDown(time.Second * time.Duration(g.RoundDelay)) {
+			return
+		}
 		g.GameIsRunning = true
 
 		// get current round message
@@ -674,7 +694,7 @@ func (g *multiBiasGame) processMultiGame() {
 // removes game from current multi games
 func (g *multiBiasGame) deleteGame() {
 	currentMultiPlayerGamesMutex.Lock()
-	currentMultiPlayerGamesMutex.Unlock()
+	defer currentMultiPlayerGamesMutex.Unlock()
 
 	for i, game := range currentMultiPlayerGames {
 		if game.CurrentRoundMessageId == g.CurrentRoundMessageId {
@@ -686,7 +706,7 @@ func (g *multiBiasGame) deleteGame() {
 // saveGame save to currently running multi games
 func (g *multiBiasGame) saveGame() {
 	currentMultiPlayerGamesMutex.Lock()
-	currentMultiPlayerGamesMutex.Unlock()
+	defer currentMultiPlayerGamesMutex.Unlock()
 
 	currentMultiPlayerGames = append(currentMultiPlayerGames, g)
 }
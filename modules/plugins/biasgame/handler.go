@@ -2,6 +2,7 @@ package biasgame
 
 // Init when the bot starts up
 import (
+	"context"
 	"fmt"
 	"image"
 	"strconv"
@@ -93,9 +94,15 @@ func (m *Module) Init(session *discordgo.Session) {
 		bgLog().Infof("restored %d singleplayer biasgames on launch", len(getCurrentSinglePlayerGames()))
 		bgLog().Infof("restored %d multiplayer biasgames on launch", len(getCurrentMultiPlayerGames()))
 
-		// start any multi games
+		// start any multi games, they are added to runningMultiGames before the goroutines start,
+		// so Shutdown waits for all of them
 		for _, multiGame := range getCurrentMultiPlayerGames() {
+			if helpers.IsShuttingDown() {
+				break
+			}
+			runningMultiGames.Add(1)
 			go func(multiGame *multiBiasGame) {
+				defer runningMultiGames.Done()
 				defer helpers.Recover()
 				multiGame.processMultiGame()
 			}(multiGame)
@@ -106,6 +113,14 @@ func (m *Module) Init(session *discordgo.Session) {
 	}()
 }
 
+// Shutdown waits until the running multi games stopped at the end of their round, so Uninit saves them in a
+// consistent state
+func (m *Module) Shutdown(ctx context.Context) {
+	if !helpers.WaitWithContext(ctx, &runningMultiGames) {
+		bgLog().Warn("multiplayer biasgames did not stop before the shutdown deadline")
+	}
+}
+
 // Uninit called when bot is shutting down
 func (m *Module) Uninit(session *discordgo.Session) {
 
@@ -137,7 +152,7 @@ func (m *Module) Action(command string, content string, msg *discordgo.Message,
 	// images, suggestions, and stat set up are done async when bot starts up
 	//   make sure game is ready before trying to process any commands
 	if moduleIsReady == false {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.game.game-not-ready"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.game.game-not-ready"))
 		return
 	}
 
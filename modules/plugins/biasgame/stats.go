@@ -69,7 +69,7 @@ func displayBiasGameStats(msg *discordgo.Message, statsMessage string) {
 	// check if any stats were returned
 	totalGames := len(games)
 	if totalGames == 0 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-stats"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-stats"))
 		return
 	}
 
@@ -199,7 +199,7 @@ func showRankings(msg *discordgo.Message, commandArgs []string, isServerRanks bo
 	re := regexp.MustCompile("[0-9]+")
 	if userEnteredNum, err := strconv.Atoi(re.FindString(msg.Content)); err == nil {
 		if !allowedGameSizes[userEnteredNum] {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.game.invalid-game-size"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.game.invalid-game-size"))
 			return
 		}
 
@@ -224,7 +224,7 @@ func showRankings(msg *discordgo.Message, commandArgs []string, isServerRanks bo
 	// check if any stats were returned
 	totalGames := len(games)
 	if totalGames == 0 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-stats"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-stats"))
 		return
 	}
 
@@ -390,7 +390,7 @@ func displayCurrentGameStats(msg *discordgo.Message) {
 		if len(embed.Fields) == 0 {
 			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
 				Name:   "No Rounds",
-				Value:  helpers.GetText("plugins.biasgame.current.no-rounds-played"),
+				Value:  helpers.GetMessageText(msg, "plugins.biasgame.current.no-rounds-played"),
 				Inline: true,
 			})
 		}
@@ -405,7 +405,7 @@ func displayCurrentGameStats(msg *discordgo.Message) {
 
 		helpers.SendPagedMessage(msg, embed, 12)
 	} else {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.current.no-running-game"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.current.no-running-game"))
 	}
 }
 
@@ -618,20 +618,20 @@ func displayIdolStats(msg *discordgo.Message, content string) {
 
 	commandArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	commandArgs = commandArgs[1:]
 
 	if len(commandArgs) < 2 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
 	// find matching idol
 	_, _, targetIdol := idols.GetMatchingIdolAndGroup(commandArgs[0], commandArgs[1], true)
 	if targetIdol == nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-matching-idol"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-matching-idol"))
 		return
 	}
 
@@ -768,20 +768,20 @@ func displayGroupStats(msg *discordgo.Message, content string) {
 
 	commandArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	commandArgs = commandArgs[1:]
 
 	if len(commandArgs) < 1 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
 	// find matching group
 	groupMatched, targetGroupName := idols.GetMatchingGroup(commandArgs[0], false)
 	if !groupMatched {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-matching-group"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-matching-group"))
 		return
 	}
 
@@ -959,16 +959,16 @@ func updateGameStatsFromMsg(msg *discordgo.Message, content string) {
 	// validate arguments
 	commandArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	commandArgs = commandArgs[1:]
 	if len(commandArgs) != 5 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	if commandArgs[4] != "boy" && commandArgs[4] != "girl" {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
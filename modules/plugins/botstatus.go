@@ -60,7 +60,7 @@ func (bs *BotStatus) gameStatusRotationLoop() {
 
 		newStatus = bs.replaceText(entryBucket.Text)
 
-		err = cache.GetSession().UpdateStatusComplex(discordgo.UpdateStatusData{
+		err = helpers.UpdateStatusComplex(discordgo.UpdateStatusData{
 			Game: &discordgo.Game{
 				Name: newStatus,
 				Type: entryBucket.Type,
@@ -91,7 +91,7 @@ func (bs *BotStatus) actionStart(args []string, in *discordgo.Message, out **dis
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = bs.newMsg("bot.arguments.too-few")
+		*out = bs.newMsg(in, "bot.arguments.too-few")
 		return bs.actionFinish
 	}
 
@@ -106,7 +106,7 @@ func (bs *BotStatus) actionStart(args []string, in *discordgo.Message, out **dis
 		return bs.actionList
 	}
 
-	*out = bs.newMsg("bot.arguments.invalid")
+	*out = bs.newMsg(in, "bot.arguments.invalid")
 	return bs.actionFinish
 }
 
@@ -132,12 +132,12 @@ func (bs *BotStatus) replaceText(text string) (result string) {
 // [p]bot-status set <status text>
 func (bs *BotStatus) actionSet(args []string, in *discordgo.Message, out **discordgo.MessageSend) botStatusAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = bs.newMsg("robyulmod.no_permission")
+		*out = bs.newMsg(in, "robyulmod.no_permission")
 		return bs.actionFinish
 	}
 
 	if len(args) < 3 {
-		*out = bs.newMsg("bot.arguments.too-few")
+		*out = bs.newMsg(in, "bot.arguments.too-few")
 		return bs.actionFinish
 	}
 
@@ -146,7 +146,7 @@ func (bs *BotStatus) actionSet(args []string, in *discordgo.Message, out **disco
 
 	newStatus := bs.replaceText(statusMessage)
 
-	err := cache.GetSession().UpdateStatusComplex(discordgo.UpdateStatusData{
+	err := helpers.UpdateStatusComplex(discordgo.UpdateStatusData{
 		Game: &discordgo.Game{
 			Name: newStatus,
 			Type: statusType,
@@ -157,19 +157,19 @@ func (bs *BotStatus) actionSet(args []string, in *discordgo.Message, out **disco
 
 	bs.logger().WithField("UserID", in.Author.ID).Infof("Set the Bot Status to: \"%s\" using the set command", newStatus)
 
-	*out = bs.newMsg(helpers.GetTextF("plugins.botstatus.set-success", newStatus))
+	*out = bs.newMsg(in, helpers.GetMessageTextF(in, "plugins.botstatus.set-success", newStatus))
 	return bs.actionFinish
 }
 
 // [p]bot-status add <status text>
 func (bs *BotStatus) actionAdd(args []string, in *discordgo.Message, out **discordgo.MessageSend) botStatusAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = bs.newMsg("robyulmod.no_permission")
+		*out = bs.newMsg(in, "robyulmod.no_permission")
 		return bs.actionFinish
 	}
 
 	if len(args) < 3 {
-		*out = bs.newMsg("bot.arguments.too-few")
+		*out = bs.newMsg(in, "bot.arguments.too-few")
 		return bs.actionFinish
 	}
 
@@ -187,19 +187,19 @@ func (bs *BotStatus) actionAdd(args []string, in *discordgo.Message, out **disco
 	)
 	helpers.Relax(err)
 
-	*out = bs.newMsg(helpers.GetTextF("plugins.botstatus.add-success", statusMessage))
+	*out = bs.newMsg(in, helpers.GetMessageTextF(in, "plugins.botstatus.add-success", statusMessage))
 	return bs.actionFinish
 }
 
 // [p]bot-status remove <status id>
 func (bs *BotStatus) actionRemove(args []string, in *discordgo.Message, out **discordgo.MessageSend) botStatusAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = bs.newMsg("robyulmod.no_permission")
+		*out = bs.newMsg(in, "robyulmod.no_permission")
 		return bs.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = bs.newMsg("bot.arguments.too-few")
+		*out = bs.newMsg(in, "bot.arguments.too-few")
 		return bs.actionFinish
 	}
 
@@ -212,21 +212,21 @@ func (bs *BotStatus) actionRemove(args []string, in *discordgo.Message, out **di
 		if !helpers.IsMdbNotFound(err) {
 			helpers.RelaxLog(err)
 		}
-		*out = bs.newMsg("bot.arguments.invalid")
+		*out = bs.newMsg(in, "bot.arguments.invalid")
 		return bs.actionFinish
 	}
 
 	err = helpers.MDbDelete(models.BotStatusTable, entryBucket.ID)
 	helpers.Relax(err)
 
-	*out = bs.newMsg(helpers.GetTextF("plugins.botstatus.remove-success", entryBucket.Text))
+	*out = bs.newMsg(in, helpers.GetMessageTextF(in, "plugins.botstatus.remove-success", entryBucket.Text))
 	return bs.actionFinish
 }
 
 // [p]bot-status list
 func (bs *BotStatus) actionList(args []string, in *discordgo.Message, out **discordgo.MessageSend) botStatusAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = bs.newMsg("robyulmod.no_permission")
+		*out = bs.newMsg(in, "robyulmod.no_permission")
 		return bs.actionFinish
 	}
 
@@ -235,7 +235,7 @@ func (bs *BotStatus) actionList(args []string, in *discordgo.Message, out **disc
 	helpers.Relax(err)
 
 	if entryBucket == nil || len(entryBucket) <= 0 {
-		*out = bs.newMsg("plugins.botstatus.list-empty")
+		*out = bs.newMsg(in, "plugins.botstatus.list-empty")
 		return bs.actionFinish
 	}
 
@@ -246,7 +246,7 @@ func (bs *BotStatus) actionList(args []string, in *discordgo.Message, out **disc
 	}
 	message += fmt.Sprintf("_found %d statuses in total_\n", len(entryBucket))
 
-	*out = bs.newMsg(message)
+	*out = bs.newMsg(in, message)
 	return bs.actionFinish
 }
 
@@ -281,8 +281,8 @@ func (bs *BotStatus) actionFinish(args []string, in *discordgo.Message, out **di
 	return nil
 }
 
-func (bs *BotStatus) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (bs *BotStatus) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (bs *BotStatus) logger() *logrus.Entry {
@@ -217,11 +217,11 @@ func (m *Charts) Action(command string, content string, msg *discordgo.Message,
 				session.ChannelTyping(msg.ChannelID)
 				time, realtimeStats := m.GetMelonRealtimeStats()
 				chartsEmbed := &discordgo.MessageEmbed{
-					Title:  helpers.GetTextF("plugins.charts.realtime-melon-embed-title", time),
+					Title:  helpers.GetMessageTextF(msg, "plugins.charts.realtime-melon-embed-title", time),
 					URL:    melonFriendlyRealtimeStats,
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.charts.melon-embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.charts.melon-embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{},
-					Color:  helpers.GetDiscordColorFromHex(helpers.GetText("plugins.charts.melon-embed-hex-color")),
+					Color:  helpers.GetDiscordColorFromHex(helpers.GetMessageText(msg, "plugins.charts.melon-embed-hex-color")),
 				}
 				for _, song := range realtimeStats {
 					rankChange := ""
@@ -252,11 +252,11 @@ func (m *Charts) Action(command string, content string, msg *discordgo.Message,
 				session.ChannelTyping(msg.ChannelID)
 				time, dailyStats := m.GetMelonDailyStats()
 				chartsEmbed := &discordgo.MessageEmbed{
-					Title:  helpers.GetTextF("plugins.charts.daily-melon-embed-title", time),
+					Title:  helpers.GetMessageTextF(msg, "plugins.charts.daily-melon-embed-title", time),
 					URL:    melonFriendlyDailyStats,
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.charts.melon-embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.charts.melon-embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{},
-					Color:  helpers.GetDiscordColorFromHex(helpers.GetText("plugins.charts.melon-embed-hex-color")),
+					Color:  helpers.GetDiscordColorFromHex(helpers.GetMessageText(msg, "plugins.charts.melon-embed-hex-color")),
 				}
 				for _, song := range dailyStats {
 					rankChange := ""
@@ -293,22 +293,22 @@ func (m *Charts) Action(command string, content string, msg *discordgo.Message,
 				time, songRanks, maintenance, overloaded := m.GetIChartRealtimeStats()
 
 				if maintenance == true {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.charts.ichart-maintenance"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.charts.ichart-maintenance"))
 					helpers.Relax(err)
 					return
 				}
 				if overloaded == true {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.charts.ichart-overloaded"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.charts.ichart-overloaded"))
 					helpers.Relax(err)
 					return
 				}
 
 				chartsEmbed := &discordgo.MessageEmbed{
-					Title:  helpers.GetTextF("plugins.charts.realtime-ichart-embed-title", time),
+					Title:  helpers.GetMessageTextF(msg, "plugins.charts.realtime-ichart-embed-title", time),
 					URL:    ichartFriendlyRealtimeStats,
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.charts.ichart-embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.charts.ichart-embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{},
-					Color:  helpers.GetDiscordColorFromHex(helpers.GetText("plugins.charts.ichart-embed-hex-color")),
+					Color:  helpers.GetDiscordColorFromHex(helpers.GetMessageText(msg, "plugins.charts.ichart-embed-hex-color")),
 				}
 				for _, song := range songRanks {
 					rankChange := ""
@@ -339,22 +339,22 @@ func (m *Charts) Action(command string, content string, msg *discordgo.Message,
 				time, songRanks, maintenance, overloaded := m.GetIChartWeekStats()
 
 				if maintenance == true {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.charts.ichart-maintenance"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.charts.ichart-maintenance"))
 					helpers.Relax(err)
 					return
 				}
 				if overloaded == true {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.charts.ichart-overloaded"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.charts.ichart-overloaded"))
 					helpers.Relax(err)
 					return
 				}
 
 				chartsEmbed := &discordgo.MessageEmbed{
-					Title:  helpers.GetTextF("plugins.charts.week-ichart-embed-title", time),
+					Title:  helpers.GetMessageTextF(msg, "plugins.charts.week-ichart-embed-title", time),
 					URL:    ichartFriendlyWeeklyStats,
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.charts.ichart-embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.charts.ichart-embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{},
-					Color:  helpers.GetDiscordColorFromHex(helpers.GetText("plugins.charts.ichart-embed-hex-color")),
+					Color:  helpers.GetDiscordColorFromHex(helpers.GetMessageText(msg, "plugins.charts.ichart-embed-hex-color")),
 				}
 				for _, song := range songRanks {
 					rankChange := ""
@@ -388,11 +388,11 @@ func (m *Charts) Action(command string, content string, msg *discordgo.Message,
 				session.ChannelTyping(msg.ChannelID)
 				time, albumRanks := m.GetGaonWeekStats()
 				chartsEmbed := &discordgo.MessageEmbed{
-					Title:  helpers.GetTextF("plugins.charts.week-gaon-embed-title", time),
+					Title:  helpers.GetMessageTextF(msg, "plugins.charts.week-gaon-embed-title", time),
 					URL:    gaonFriendlyWeeklyCharts,
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.charts.gaon-embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.charts.gaon-embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{},
-					Color:  helpers.GetDiscordColorFromHex(helpers.GetText("plugins.charts.gaon-embed-hex-color")),
+					Color:  helpers.GetDiscordColorFromHex(helpers.GetMessageText(msg, "plugins.charts.gaon-embed-hex-color")),
 				}
 				for _, album := range albumRanks {
 					rankChange := ""
@@ -417,11 +417,11 @@ func (m *Charts) Action(command string, content string, msg *discordgo.Message,
 				session.ChannelTyping(msg.ChannelID)
 				time, albumRanks := m.GetGaonMonthStats()
 				chartsEmbed := &discordgo.MessageEmbed{
-					Title:  helpers.GetTextF("plugins.charts.month-gaon-embed-title", time),
+					Title:  helpers.GetMessageTextF(msg, "plugins.charts.month-gaon-embed-title", time),
 					URL:    gaonFriendlyMonthlyCharts,
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.charts.gaon-embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.charts.gaon-embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{},
-					Color:  helpers.GetDiscordColorFromHex(helpers.GetText("plugins.charts.gaon-embed-hex-color")),
+					Color:  helpers.GetDiscordColorFromHex(helpers.GetMessageText(msg, "plugins.charts.gaon-embed-hex-color")),
 				}
 				for _, album := range albumRanks {
 					rankChange := ""
@@ -446,11 +446,11 @@ func (m *Charts) Action(command string, content string, msg *discordgo.Message,
 				session.ChannelTyping(msg.ChannelID)
 				time, albumRanks := m.GetGaonYearStats()
 				chartsEmbed := &discordgo.MessageEmbed{
-					Title:  helpers.GetTextF("plugins.charts.year-gaon-embed-title", time),
+					Title:  helpers.GetMessageTextF(msg, "plugins.charts.year-gaon-embed-title", time),
 					URL:    gaonFriendlyYearlyCharts,
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.charts.gaon-embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.charts.gaon-embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{},
-					Color:  helpers.GetDiscordColorFromHex(helpers.GetText("plugins.charts.gaon-embed-hex-color")),
+					Color:  helpers.GetDiscordColorFromHex(helpers.GetMessageText(msg, "plugins.charts.gaon-embed-hex-color")),
 				}
 				for _, album := range albumRanks {
 					rankChange := ""
@@ -40,7 +40,7 @@ func (c *Choice) Action(command string, content string, msg *discordgo.Message,
 		choices := splitChooseRegex.FindAllString(content, -1)
 
 		if len(choices) <= 1 {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			helpers.Relax(err)
 			return
 		}
@@ -58,7 +58,7 @@ func (c *Choice) Action(command string, content string, msg *discordgo.Message,
 		if content != "" {
 			maxN, err = strconv.Atoi(content)
 			if err != nil || maxN < 1 {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				helpers.Relax(err)
 				return
 			}
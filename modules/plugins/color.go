@@ -38,7 +38,7 @@ func (c *Color) Action(command string, content string, msg *discordgo.Message, s
 
 	args := strings.Fields(content)
 	if len(args) <= 0 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
@@ -49,7 +49,7 @@ func (c *Color) Action(command string, content string, msg *discordgo.Message, s
 
 	color, err := colorful.Hex(colorText)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
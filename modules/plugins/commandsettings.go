@@ -0,0 +1,219 @@
+package plugins
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/commands"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSettings lets guild admins disable single commands and create aliases, the settings are applied in BotOnMessageCreate
+type CommandSettings struct{}
+
+func (m *CommandSettings) Commands() []*commands.Command {
+	return []*commands.Command{
+		{
+			Name:        "commandsettings",
+			Aliases:     []string{"cmdsettings"},
+			Description: "Disables single commands and creates command aliases on this server.",
+			Permission:  commands.PermissionAdmin,
+			Subcommands: []*commands.Command{
+				{
+					Name:        "list",
+					Description: "Lists all disabled commands and aliases.",
+					Handler:     m.actionList,
+				},
+				{
+					Name:        "disable",
+					Description: "Disables a command on this server.",
+					Arguments: []commands.Argument{
+						{Name: "command", Type: commands.ArgumentString},
+					},
+					Handler: m.actionDisable,
+				},
+				{
+					Name:        "enable",
+					Description: "Enables a disabled command again.",
+					Arguments: []commands.Argument{
+						{Name: "command", Type: commands.ArgumentString},
+					},
+					Handler: m.actionEnable,
+				},
+				{
+					Name:        "alias",
+					Description: "Creates an alias for a command, removes the alias if no command is given.",
+					Arguments: []commands.Argument{
+						{Name: "alias", Type: commands.ArgumentString},
+						{Name: "command", Type: commands.ArgumentString, Optional: true},
+					},
+					Handler: m.actionAlias,
+				},
+			},
+		},
+	}
+}
+
+func (m *CommandSettings) Init(session *discordgo.Session) {
+}
+
+// [p]commandsettings list
+func (m *CommandSettings) actionList(ctx *commands.Context) {
+	settings := helpers.GuildSettingsGetCached(ctx.GuildID)
+
+	if len(settings.DisabledCommands) <= 0 && len(settings.CommandAliases) <= 0 {
+		ctx.SendText("plugins.commandsettings.list-empty")
+		return
+	}
+
+	disabledText := ctx.Text("plugins.commandsettings.list-none")
+	if len(settings.DisabledCommands) > 0 {
+		disabledText = ""
+		for _, disabledCommand := range settings.DisabledCommands {
+			disabledText += ctx.Prefix + strings.Join(helpers.GuildCommandSet(disabledCommand), ", "+ctx.Prefix) + "\n"
+		}
+	}
+
+	var aliasesText string
+	for _, alias := range settings.CommandAliases {
+		aliasesText += ctx.Prefix + alias.Alias + " → " + ctx.Prefix + alias.Command + "\n"
+	}
+	if aliasesText == "" {
+		aliasesText = ctx.Text("plugins.commandsettings.list-none")
+	}
+
+	ctx.SendComplex(&discordgo.MessageSend{Embed: &discordgo.MessageEmbed{
+		Color: 0x0FADED,
+		Title: ctx.Text("plugins.commandsettings.list-title"),
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: ctx.Text("plugins.commandsettings.list-disabled"), Value: disabledText},
+			{Name: ctx.Text("plugins.commandsettings.list-aliases"), Value: aliasesText},
+		},
+	}})
+}
+
+// [p]commandsettings disable <command>
+func (m *CommandSettings) actionDisable(ctx *commands.Context) {
+	command := strings.ToLower(strings.TrimPrefix(ctx.String("command"), ctx.Prefix))
+	if !helpers.CommandExists(command) || helpers.GuildCommandIsProtected(command) {
+		ctx.SendText("plugins.commandsettings.command-invalid")
+		return
+	}
+	// aliases of the command are disabled with it
+	command = helpers.GuildCommandCanonical(command)
+
+	settings := helpers.GuildSettingsGetCached(ctx.GuildID)
+	if helpers.GuildCommandIsDisabled(ctx.GuildID, command) {
+		ctx.SendText("plugins.commandsettings.disable-already-disabled")
+		return
+	}
+
+	settings.DisabledCommands = append(settings.DisabledCommands, command)
+	err := helpers.GuildSettingsSet(ctx.GuildID, settings)
+	helpers.Relax(err)
+
+	m.log(ctx, models.EventlogTypeRobyulCommandDisable, []models.ElasticEventlogOption{
+		{Key: "command", Value: command},
+	})
+
+	ctx.SendText("plugins.commandsettings.disable-success", ctx.Prefix, command)
+}
+
+// [p]commandsettings enable <command>
+func (m *CommandSettings) actionEnable(ctx *commands.Context) {
+	command := helpers.GuildCommandCanonical(strings.TrimPrefix(ctx.String("command"), ctx.Prefix))
+
+	settings := helpers.GuildSettingsGetCached(ctx.GuildID)
+	if !helpers.GuildCommandIsDisabled(ctx.GuildID, command) {
+		ctx.SendText("plugins.commandsettings.enable-not-disabled")
+		return
+	}
+
+	// settings stored before commands were canonicalised might contain an alias
+	disabledCommands := make([]string, 0)
+	for _, disabledCommand := range settings.DisabledCommands {
+		if helpers.GuildCommandCanonical(disabledCommand) != command {
+			disabledCommands = append(disabledCommands, disabledCommand)
+		}
+	}
+	settings.DisabledCommands = disabledCommands
+	err := helpers.GuildSettingsSet(ctx.GuildID, settings)
+	helpers.Relax(err)
+
+	m.log(ctx, models.EventlogTypeRobyulCommandEnable, []models.ElasticEventlogOption{
+		{Key: "command", Value: command},
+	})
+
+	ctx.SendText("plugins.commandsettings.enable-success", ctx.Prefix, command)
+}
+
+// [p]commandsettings alias <alias> [<command>]
+func (m *CommandSettings) actionAlias(ctx *commands.Context) {
+	alias := strings.ToLower(strings.TrimPrefix(ctx.String("alias"), ctx.Prefix))
+	command := strings.ToLower(strings.TrimPrefix(ctx.String("command"), ctx.Prefix))
+
+	settings := helpers.GuildSettingsGetCached(ctx.GuildID)
+
+	// remove existing alias
+	var oldCommand string
+	aliases := make([]models.CommandAlias, 0)
+	for _, existingAlias := range settings.CommandAliases {
+		if existingAlias.Alias == alias {
+			oldCommand = existingAlias.Command
+			continue
+		}
+		aliases = append(aliases, existingAlias)
+	}
+
+	if !ctx.Has("command") {
+		if oldCommand == "" {
+			ctx.SendText("plugins.commandsettings.alias-not-found")
+			return
+		}
+
+		settings.CommandAliases = aliases
+		err := helpers.GuildSettingsSet(ctx.GuildID, settings)
+		helpers.Relax(err)
+
+		m.log(ctx, models.EventlogTypeRobyulCommandAliasRemove, []models.ElasticEventlogOption{
+			{Key: "alias", Value: alias},
+			{Key: "command", Value: oldCommand},
+		})
+
+		ctx.SendText("plugins.commandsettings.alias-remove-success", ctx.Prefix, alias)
+		return
+	}
+
+	if helpers.CommandExists(alias) || helpers.GuildCommandIsProtected(alias) {
+		ctx.SendText("plugins.commandsettings.alias-is-command")
+		return
+	}
+	if !helpers.CommandExists(command) {
+		ctx.SendText("plugins.commandsettings.command-invalid")
+		return
+	}
+
+	settings.CommandAliases = append(aliases, models.CommandAlias{Alias: alias, Command: command})
+	err := helpers.GuildSettingsSet(ctx.GuildID, settings)
+	helpers.Relax(err)
+
+	m.log(ctx, models.EventlogTypeRobyulCommandAliasAdd, []models.ElasticEventlogOption{
+		{Key: "alias", Value: alias},
+		{Key: "command", Value: command},
+	})
+
+	ctx.SendText("plugins.commandsettings.alias-add-success", ctx.Prefix, alias, ctx.Prefix, command)
+}
+
+func (m *CommandSettings) log(ctx *commands.Context, actionType string, options []models.ElasticEventlogOption) {
+	_, err := helpers.EventlogLog(time.Now(), ctx.GuildID, ctx.GuildID,
+		models.EventlogTargetTypeGuild, ctx.Msg.Author.ID,
+		actionType, "",
+		nil,
+		options,
+		false,
+	)
+	helpers.RelaxLog(err)
+}
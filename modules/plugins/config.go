@@ -5,6 +5,8 @@ import (
 
 	"fmt"
 
+	"time"
+
 	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/helpers/dgwidgets"
@@ -46,7 +48,7 @@ func (m *Config) actionStart(args []string, in *discordgo.Message, out **discord
 		switch args[0] {
 		case "set":
 			if len(args) < 2 {
-				*out = m.newMsg(helpers.GetText("bot.arguments.too-few"))
+				*out = m.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 				return m.actionFinish
 			}
 			switch args[1] {
@@ -54,6 +56,8 @@ func (m *Config) actionStart(args []string, in *discordgo.Message, out **discord
 				return m.actionSetAdmin
 			case "mod":
 				return m.actionSetMod
+			case "timezone":
+				return m.actionSetTimezone
 			}
 			break
 		}
@@ -65,12 +69,12 @@ func (m *Config) actionStart(args []string, in *discordgo.Message, out **discord
 // [p]config set admin <role name or id>
 func (m *Config) actionSetAdmin(args []string, in *discordgo.Message, out **discordgo.MessageSend) configAction {
 	if !helpers.IsAdmin(in) {
-		*out = m.newMsg("admin.no_permission")
+		*out = m.newMsg(in, "admin.no_permission")
 		return m.actionFinish
 	}
 
 	if len(args) < 3 {
-		*out = m.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = m.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return m.actionFinish
 	}
 
@@ -124,7 +128,7 @@ func (m *Config) actionSetAdmin(args []string, in *discordgo.Message, out **disc
 	}
 
 	if !roleAdded && !roleRemoved {
-		*out = m.newMsg("bot.arguments.invalid")
+		*out = m.newMsg(in, "bot.arguments.invalid")
 		return m.actionFinish
 	}
 
@@ -135,11 +139,11 @@ func (m *Config) actionSetAdmin(args []string, in *discordgo.Message, out **disc
 	// TODO: eventlog
 
 	if roleAdded {
-		*out = m.newMsg("plugins.config.admin-role-added")
+		*out = m.newMsg(in, "plugins.config.admin-role-added")
 		return m.actionFinish
 	}
 	if roleRemoved {
-		*out = m.newMsg("plugins.config.admin-role-removed")
+		*out = m.newMsg(in, "plugins.config.admin-role-removed")
 		return m.actionFinish
 	}
 	return nil
@@ -148,12 +152,12 @@ func (m *Config) actionSetAdmin(args []string, in *discordgo.Message, out **disc
 // [p]config set mod <role name or id>
 func (m *Config) actionSetMod(args []string, in *discordgo.Message, out **discordgo.MessageSend) configAction {
 	if !helpers.IsAdmin(in) {
-		*out = m.newMsg("admin.no_permission")
+		*out = m.newMsg(in, "admin.no_permission")
 		return m.actionFinish
 	}
 
 	if len(args) < 3 {
-		*out = m.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = m.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return m.actionFinish
 	}
 
@@ -207,7 +211,7 @@ func (m *Config) actionSetMod(args []string, in *discordgo.Message, out **discor
 	}
 
 	if !roleAdded && !roleRemoved {
-		*out = m.newMsg("bot.arguments.invalid")
+		*out = m.newMsg(in, "bot.arguments.invalid")
 		return m.actionFinish
 	}
 
@@ -216,17 +220,51 @@ func (m *Config) actionSetMod(args []string, in *discordgo.Message, out **discor
 	helpers.Relax(err)
 
 	if roleAdded {
-		*out = m.newMsg("plugins.config.mod-role-added")
+		*out = m.newMsg(in, "plugins.config.mod-role-added")
 		return m.actionFinish
 	}
 	if roleRemoved {
-		*out = m.newMsg("plugins.config.mod-role-removed")
+		*out = m.newMsg(in, "plugins.config.mod-role-removed")
 		return m.actionFinish
 	}
 	return nil
 }
 
 // [p]config
+// [p]config set timezone [<timezone, e.g. Europe/Berlin>]
+func (m *Config) actionSetTimezone(args []string, in *discordgo.Message, out **discordgo.MessageSend) configAction {
+	if !helpers.IsAdmin(in) {
+		*out = m.newMsg(in, "admin.no_permission")
+		return m.actionFinish
+	}
+
+	channel, err := helpers.GetChannel(in.ChannelID)
+	helpers.Relax(err)
+
+	var timezone string
+	if len(args) >= 3 {
+		location, err := time.LoadLocation(args[2])
+		if err != nil || args[2] == "" {
+			*out = m.newMsg(in, "plugins.config.timezone-invalid")
+			return m.actionFinish
+		}
+		timezone = location.String()
+	}
+
+	guildConfig := helpers.GuildSettingsGetCached(channel.GuildID)
+	guildConfig.Timezone = timezone
+	err = helpers.GuildSettingsSet(channel.GuildID, guildConfig)
+	helpers.Relax(err)
+
+	if timezone == "" {
+		*out = m.newMsg(in, "plugins.config.timezone-reset")
+		return m.actionFinish
+	}
+	*out = &discordgo.MessageSend{Content: helpers.GetMessageTextF(in, "plugins.config.timezone-set", timezone,
+		time.Now().In(helpers.GetLocationForServer(channel.GuildID)).Format("15:04"))}
+	return m.actionFinish
+}
+
 func (m *Config) actionStatus(args []string, in *discordgo.Message, out **discordgo.MessageSend) configAction {
 	channel, err := helpers.GetChannel(in.ChannelID)
 	helpers.Relax(err)
@@ -242,7 +280,7 @@ func (m *Config) actionStatus(args []string, in *discordgo.Message, out **discor
 	}
 
 	if !helpers.IsModByID(targetGuild.ID, in.Author.ID) && !helpers.IsRobyulMod(in.Author.ID) {
-		*out = m.newMsg("mod.no_permission")
+		*out = m.newMsg(in, "mod.no_permission")
 		return m.actionFinish
 	}
 
@@ -376,6 +414,11 @@ func (m *Config) actionStatus(args []string, in *discordgo.Message, out **discor
 		customCommandsText = "<@&" + guildConfig.CustomCommandsAddRoleID + "> and Moderators can add commands"
 	}
 
+	timezoneText := "UTC"
+	if guildConfig.Timezone != "" {
+		timezoneText = guildConfig.Timezone
+	}
+
 	// TODO: info if blacklisted, or limited guild
 
 	pages = append(pages, &discordgo.MessageEmbed{
@@ -434,6 +477,10 @@ func (m *Config) actionStatus(args []string, in *discordgo.Message, out **discor
 				Name:  "Custom Commands",
 				Value: customCommandsText,
 			},
+			{
+				Name:  "Timezone",
+				Value: timezoneText + fmt.Sprintf("\n`%sconfig set timezone <timezone>`", prefix),
+			},
 		},
 	})
 
@@ -467,8 +514,8 @@ func (m *Config) actionFinish(args []string, in *discordgo.Message, out **discor
 	return nil
 }
 
-func (m *Config) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (m *Config) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (m *Config) Relax(err error) {
@@ -94,7 +94,7 @@ func (m *Crypto) actionExchange(args []string, in *discordgo.Message, out **disc
 	})
 	if err != nil {
 		if strings.Contains(err.Error(), "There is no data for any of the") {
-			*out = m.newMsg(helpers.GetText("bot.arguments.invalid"))
+			*out = m.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 			return m.actionFinish
 		}
 	}
@@ -102,12 +102,12 @@ func (m *Crypto) actionExchange(args []string, in *discordgo.Message, out **disc
 
 	// setup embed
 	exchangeEmbed := &discordgo.MessageEmbed{
-		Title:     helpers.GetText("plugins.crypto.embed-exchange-title"),
+		Title:     helpers.GetMessageText(in, "plugins.crypto.embed-exchange-title"),
 		Timestamp: time.Now().Format(time.RFC3339),
 		Color:     helpers.GetDiscordColorFromHex("2b5a98"),
 		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.crypto.embed-footer"),
-			IconURL: helpers.GetText("plugins.crypto.embed-footer-imageurl"),
+			Text:    helpers.GetMessageText(in, "plugins.crypto.embed-footer"),
+			IconURL: helpers.GetMessageText(in, "plugins.crypto.embed-footer-imageurl"),
 		},
 		Fields: []*discordgo.MessageEmbedField{},
 	}
@@ -199,8 +199,8 @@ func (m *Crypto) actionFinish(args []string, in *discordgo.Message, out **discor
 	return nil
 }
 
-func (m *Crypto) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (m *Crypto) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (m *Crypto) Relax(err error) {
@@ -2,6 +2,7 @@ package plugins
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -40,6 +41,9 @@ var (
 	customCommandsCache            []models.CustomCommandsEntry
 	customCommandsCacheLock        sync.Mutex
 	customCommandsAllowedFiletypes = []string{"image/jpeg", "image/png", "image/gif", "video/mp4", "video/webm"}
+
+	ErrCustomCommandExists    = errors.New("a custom command with this keyword exists already")
+	ErrCustomCommandIsCommand = errors.New("the keyword is a bot command")
 )
 
 func (cc *CustomCommands) Init(session *discordgo.Session) {
@@ -92,16 +96,16 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 					if guildConfig.CustomCommandsEveryoneCanAdd {
 						guildConfig.CustomCommandsEveryoneCanAdd = false
 						guildConfig.CustomCommandsAddRoleID = ""
-						message = helpers.GetText("plugins.customcommands.disabled-everyone-canadd")
+						message = helpers.GetMessageText(msg, "plugins.customcommands.disabled-everyone-canadd")
 					} else {
 						guildConfig.CustomCommandsEveryoneCanAdd = true
 						guildConfig.CustomCommandsAddRoleID = ""
-						message = helpers.GetText("plugins.customcommands.enabled-everyone-canadd")
+						message = helpers.GetMessageText(msg, "plugins.customcommands.enabled-everyone-canadd")
 					}
 				} else {
 					guildConfig.CustomCommandsEveryoneCanAdd = false
 					guildConfig.CustomCommandsAddRoleID = targetRole.ID
-					message = helpers.GetTextF("plugins.customcommands.role-canadd", targetRole.Name)
+					message = helpers.GetMessageTextF(msg, "plugins.customcommands.role-canadd", targetRole.Name)
 				}
 
 				err = helpers.GuildSettingsSet(channel.GuildID, guildConfig)
@@ -119,17 +123,17 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 			helpers.Relax(err)
 
 			if !cc.canAddCommand(channel.GuildID, msg.Author.ID, nil) {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("mod.no_permission"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "mod.no_permission"))
 				return
 			}
 
 			if len(args) < 3 && (len(msg.Attachments) <= 0 && len(args) < 2) {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 
 			if helpers.CommandExists(args[1]) {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.add-command-already-exists"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.add-command-already-exists"))
 				helpers.Relax(err)
 				return
 			}
@@ -140,7 +144,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				&entryBucket,
 			)
 			if err == nil {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.add-keyword-already-exists"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.add-keyword-already-exists"))
 				helpers.Relax(err)
 				return
 			} else {
@@ -161,12 +165,12 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				if cc.isAllowedFiletype(filetype) {
 					// user is allowed to upload files?
 					if helpers.UseruploadsIsDisabled(msg.Author.ID) {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.useruploads-disabled"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.useruploads-disabled"))
 						return
 					}
 					// <= 20 MB
 					if msg.Attachments[0].Size > 20e+6 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.fileupload-too-big"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.fileupload-too-big"))
 						return
 					}
 					// upload file
@@ -176,6 +180,10 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 						UserID:             msg.Author.ID,
 						AdditionalMetadata: nil,
 					}, "customcommands", true)
+					if err == helpers.ErrStorageQuotaExceeded {
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.errors.storage-quota-exceeded", helpers.GetPrefixForServer(channel.GuildID)))
+						return
+					}
 					helpers.Relax(err)
 				}
 			}
@@ -183,47 +191,14 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 			content := strings.TrimSpace(strings.Replace(content, strings.Join(args[:2], " "), "", 1))
 
 			if content == "" && objectName == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 
-			newEntry := models.CustomCommandsEntry{
-				GuildID:           channel.GuildID,
-				CreatedByUserID:   msg.Author.ID,
-				CreatedAt:         time.Now(),
-				Triggered:         0,
-				Keyword:           args[1],
-				StorageObjectName: objectName,
-				Content:           content,
-			}
-			_, err = helpers.MDbInsert(
-				models.CustomCommandsTable,
-				newEntry,
-			)
+			_, err = CustomCommandsAdd(channel.GuildID, msg.Author.ID, args[1], content, objectName)
 			helpers.Relax(err)
 
-			addedContent, _, _ := cc.getCommandContent(newEntry)
-			_, err = helpers.EventlogLog(time.Now(), channel.GuildID, channel.GuildID,
-				models.EventlogTargetTypeGuild, msg.Author.ID,
-				models.EventlogTypeRobyulCommandsAdd, "",
-				nil,
-				[]models.ElasticEventlogOption{
-					{
-						Key:   "command_keyword",
-						Value: args[1],
-					},
-					{
-						Key:   "command_content",
-						Value: addedContent,
-					},
-				}, false)
-			helpers.RelaxLog(err)
-
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.add-success"))
-			helpers.Relax(err)
-			customCommandsCacheLock.Lock()
-			defer customCommandsCacheLock.Unlock()
-			customCommandsCache, err = cc.getAllCustomCommands()
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.add-success"))
 			helpers.Relax(err)
 			return
 		case "random": // [p]commands random
@@ -237,7 +212,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				[]bson.M{{"$match": bson.M{"guildid": channel.GuildID}}, {"$sample": bson.M{"size": 1}}},
 				&entryBucket)
 			if helpers.IsMdbNotFound(err) {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.list-empty"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.list-empty"))
 				helpers.Relax(err)
 				return
 			}
@@ -297,7 +272,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 									[]bson.M{{"$match": bson.M{"guildid": channel.GuildID}}, {"$sample": bson.M{"size": 1}}},
 									&entryBucket)
 								if helpers.IsMdbNotFound(err) {
-									_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.list-empty"))
+									_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.list-empty"))
 									helpers.Relax(err)
 									return
 								}
@@ -359,7 +334,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 			}
 
 			if len(entryBucket) <= 0 {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.list-empty"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.list-empty"))
 				helpers.Relax(err)
 				return
 			} else if err != nil {
@@ -376,7 +351,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 			}
 			commandListText += fmt.Sprintf("There are **%s** custom commands on this server.", humanize.Comma(int64(len(entryBucket))))
 
-			helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.check-your-dms", msg.Author.ID))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.check-your-dms", msg.Author.ID))
 
 			for _, page := range helpers.Pagify(commandListText, "\n") {
 				_, err = helpers.SendMessage(dmChannel.ID, page)
@@ -386,7 +361,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 		case "delete", "del", "remove": // [p]commands delete <command name>
 			session.ChannelTyping(msg.ChannelID)
 			if len(args) < 2 {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				helpers.Relax(err)
 				return
 			}
@@ -399,53 +374,27 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				&entryBucket,
 			)
 			if helpers.IsMdbNotFound(err) {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.delete-not-found"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.delete-not-found"))
 				helpers.Relax(err)
 				return
 			}
 			helpers.Relax(err)
 
 			if !cc.canAddCommand(channel.GuildID, msg.Author.ID, &entryBucket) {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("mod.no_permission"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "mod.no_permission"))
 				return
 			}
 
-			err = helpers.MDbDelete(models.CustomCommandsTable, entryBucket.ID)
+			err = CustomCommandsDelete(entryBucket, msg.Author.ID)
 			helpers.Relax(err)
 
-			if entryBucket.StorageObjectName != "" {
-				err = helpers.DeleteFile(entryBucket.StorageObjectName)
-				helpers.Relax(err)
-			}
-
-			removedContent, _, _ := cc.getCommandContent(entryBucket)
-			_, err = helpers.EventlogLog(time.Now(), channel.GuildID, channel.GuildID,
-				models.EventlogTargetTypeGuild, msg.Author.ID,
-				models.EventlogTypeRobyulCommandsDelete, "",
-				nil,
-				[]models.ElasticEventlogOption{
-					{
-						Key:   "command_keyword",
-						Value: entryBucket.Keyword,
-					},
-					{
-						Key:   "command_content",
-						Value: removedContent,
-					},
-				}, false)
-			helpers.RelaxLog(err)
-
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.delete-success"))
-			helpers.Relax(err)
-			customCommandsCacheLock.Lock()
-			defer customCommandsCacheLock.Unlock()
-			customCommandsCache, err = cc.getAllCustomCommands()
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.delete-success"))
 			helpers.Relax(err)
 			return
 		case "replace", "edit": // [p]commands edit <command name> <new content>
 			session.ChannelTyping(msg.ChannelID)
 			if len(args) < 3 && (len(msg.Attachments) <= 0 && len(args) < 2) {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 			channel, err := helpers.GetChannel(msg.ChannelID)
@@ -457,14 +406,14 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				&entryBucket,
 			)
 			if helpers.IsMdbNotFound(err) {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.edit-not-found"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.edit-not-found"))
 				helpers.Relax(err)
 				return
 			}
 			helpers.Relax(err)
 
 			if !cc.canAddCommand(channel.GuildID, msg.Author.ID, &entryBucket) {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("mod.no_permission"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "mod.no_permission"))
 				return
 			}
 
@@ -487,12 +436,12 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				if cc.isAllowedFiletype(filetype) {
 					// user is allowed to upload files?
 					if helpers.UseruploadsIsDisabled(msg.Author.ID) {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.useruploads-disabled"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.useruploads-disabled"))
 						return
 					}
 					// <= 20 MB
 					if msg.Attachments[0].Size > 20e+6 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.fileupload-too-big"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.fileupload-too-big"))
 						return
 					}
 					// upload file
@@ -502,6 +451,10 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 						UserID:             msg.Author.ID,
 						AdditionalMetadata: nil,
 					}, "customcommands", true)
+					if err == helpers.ErrStorageQuotaExceeded {
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.errors.storage-quota-exceeded", helpers.GetPrefixForServer(channel.GuildID)))
+						return
+					}
 					helpers.Relax(err)
 				}
 			}
@@ -514,7 +467,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 			content := strings.TrimSpace(strings.Replace(content, strings.Join(args[:2], " "), "", 1))
 
 			if content == "" && objectName == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 
@@ -549,7 +502,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				}, false)
 			helpers.RelaxLog(err)
 
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.edit-success"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.edit-success"))
 			helpers.Relax(err)
 			customCommandsCacheLock.Lock()
 			defer customCommandsCacheLock.Unlock()
@@ -564,14 +517,14 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				defer customCommandsCacheLock.Unlock()
 				customCommandsCache, err = cc.getAllCustomCommands()
 				helpers.Relax(err)
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.refreshed-commands"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.refreshed-commands"))
 				helpers.Relax(err)
 			})
 			return
 		case "search": // [p]commands search <text>
 			session.ChannelTyping(msg.ChannelID)
 			if len(args) < 2 {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				helpers.Relax(err)
 				return
 			}
@@ -582,7 +535,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 			err = helpers.MDbIter(helpers.MdbCollection(models.CustomCommandsTable).Find(bson.M{"guildid": channel.GuildID, "keyword": bson.M{"$regex": bson.RegEx{Pattern: `.*` + args[1] + `.*`, Options: "i"}}}).Sort("keyword")).All(&entryBucket)
 			helpers.Relax(err)
 			if len(entryBucket) <= 0 {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.customcommands.search-empty", args[1]))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.customcommands.search-empty", args[1]))
 				helpers.Relax(err)
 				return
 			}
@@ -606,7 +559,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 		case "info": // [p]commands info <command name>
 			session.ChannelTyping(msg.ChannelID)
 			if len(args) < 2 {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				helpers.Relax(err)
 				return
 			}
@@ -620,7 +573,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				&entryBucket,
 			)
 			if helpers.IsMdbNotFound(err) {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.customcommands.info-not-found"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.customcommands.info-not-found"))
 				helpers.Relax(err)
 				return
 			}
@@ -661,7 +614,7 @@ func (cc *CustomCommands) Action(command string, content string, msg *discordgo.
 				session.ChannelTyping(msg.ChannelID)
 
 				if len(msg.Attachments) <= 0 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					helpers.Relax(err)
 					return
 				}
@@ -818,6 +771,104 @@ func (cc *CustomCommands) canAddCommand(guildID, userID string, editCommand *mod
 	return false
 }
 
+// CustomCommandsCanEdit checks if the user may add custom commands, or edit the given command
+func CustomCommandsCanEdit(guildID, userID string, editCommand *models.CustomCommandsEntry) (allowed bool) {
+	return new(CustomCommands).canAddCommand(guildID, userID, editCommand)
+}
+
+// CustomCommandsAdd adds a custom command, used by the commands command and the REST API
+func CustomCommandsAdd(guildID, userID, keyword, content, objectName string) (entry models.CustomCommandsEntry, err error) {
+	if helpers.CommandExists(keyword) {
+		return entry, ErrCustomCommandIsCommand
+	}
+
+	count, err := helpers.MdbCount(models.CustomCommandsTable, bson.M{"guildid": guildID, "keyword": keyword})
+	if err != nil {
+		return entry, err
+	}
+	if count > 0 {
+		return entry, ErrCustomCommandExists
+	}
+
+	entry = models.CustomCommandsEntry{
+		GuildID:           guildID,
+		CreatedByUserID:   userID,
+		CreatedAt:         time.Now(),
+		Triggered:         0,
+		Keyword:           keyword,
+		StorageObjectName: objectName,
+		Content:           content,
+	}
+	entry.ID, err = helpers.MDbInsert(
+		models.CustomCommandsTable,
+		entry,
+	)
+	if err != nil {
+		return entry, err
+	}
+
+	addedContent, _, _ := new(CustomCommands).getCommandContent(entry)
+	_, err = helpers.EventlogLog(time.Now(), guildID, guildID,
+		models.EventlogTargetTypeGuild, userID,
+		models.EventlogTypeRobyulCommandsAdd, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "command_keyword",
+				Value: keyword,
+			},
+			{
+				Key:   "command_content",
+				Value: addedContent,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return entry, customCommandsRefreshCache()
+}
+
+// CustomCommandsDelete deletes a custom command and its file, used by the commands command and the REST API
+func CustomCommandsDelete(entry models.CustomCommandsEntry, userID string) (err error) {
+	err = helpers.MDbDelete(models.CustomCommandsTable, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	if entry.StorageObjectName != "" {
+		err = helpers.DeleteFile(entry.StorageObjectName)
+		if err != nil {
+			return err
+		}
+	}
+
+	removedContent, _, _ := new(CustomCommands).getCommandContent(entry)
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, entry.GuildID,
+		models.EventlogTargetTypeGuild, userID,
+		models.EventlogTypeRobyulCommandsDelete, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "command_keyword",
+				Value: entry.Keyword,
+			},
+			{
+				Key:   "command_content",
+				Value: removedContent,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return customCommandsRefreshCache()
+}
+
+func customCommandsRefreshCache() (err error) {
+	customCommandsCacheLock.Lock()
+	defer customCommandsCacheLock.Unlock()
+
+	customCommandsCache, err = new(CustomCommands).getAllCustomCommands()
+	return err
+}
+
 // checks if a filetype is allowed for uploads
 // filetype	: the filetype to check
 func (cc *CustomCommands) isAllowedFiletype(filetype string) (allowed bool) {
@@ -74,7 +74,7 @@ func (d *Debug) Action(command string, content string, msg *discordgo.Message, s
 				imageUrl = msg.Attachments[0].URL
 			}
 			if imageUrl == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 
@@ -142,7 +142,7 @@ func (d *Debug) Action(command string, content string, msg *discordgo.Message, s
 			session.ChannelTyping(msg.ChannelID)
 
 			if len(args) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 
@@ -166,6 +166,30 @@ func (d *Debug) Action(command string, content string, msg *discordgo.Message, s
 			))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
+		case "deadletters", "dead-letters": // [p]debug deadletters [<count>]
+			session.ChannelTyping(msg.ChannelID)
+
+			limit := 10
+			if len(args) >= 2 {
+				if count, err := strconv.Atoi(args[1]); err == nil && count > 0 && count <= 50 {
+					limit = count
+				}
+			}
+
+			total, err := helpers.CountMachineryDeadLetters()
+			helpers.Relax(err)
+
+			deadLetters, err := helpers.GetMachineryDeadLetters(limit)
+			helpers.Relax(err)
+
+			text := fmt.Sprintf("__**Dead letters:**__ latest %d of %d\n", len(deadLetters), total)
+			for _, deadLetter := range deadLetters {
+				text += "`" + deadLetter.String() + "`\n"
+			}
+
+			_, err = helpers.SendMessage(msg.ChannelID, text)
+			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			return
 		case "mock-discord-500-error":
 			session.ChannelTyping(msg.ChannelID)
 
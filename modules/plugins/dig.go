@@ -31,7 +31,7 @@ func (d *Dig) Action(command string, content string, msg *discordgo.Message, ses
 	args := strings.Fields(content)
 
 	if len(args) < 2 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 		return
 	}
 	dnsIp := "8.8.8.8"
@@ -56,7 +56,7 @@ func (d *Dig) Action(command string, content string, msg *discordgo.Message, ses
 	in, err := dns.Exchange(m, dnsIp+":53")
 	if err != nil {
 		if err, ok := err.(*net.OpError); ok {
-			helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.errors.general", err.Err.Error()))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.errors.general", err.Err.Error()))
 			return
 		} else {
 			helpers.Relax(err)
@@ -32,7 +32,7 @@ func (dm *DM) Commands() []string {
 }
 
 func (dm *DM) Init(session *discordgo.Session) {
-	session.AddHandler(dm.OnMessage)
+	cache.AddHandler(dm.OnMessage)
 }
 
 func (dm *DM) Uninit(session *discordgo.Session) {
@@ -55,7 +55,7 @@ func (dm *DM) actionStart(args []string, in *discordgo.Message, out **discordgo.
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = dm.newMsg("bot.arguments.too-few")
+		*out = dm.newMsg(in, "bot.arguments.too-few")
 		return dm.actionFinish
 	}
 
@@ -66,24 +66,24 @@ func (dm *DM) actionStart(args []string, in *discordgo.Message, out **discordgo.
 		return dm.actionReceive
 	}
 
-	*out = dm.newMsg("bot.arguments.invalid")
+	*out = dm.newMsg(in, "bot.arguments.invalid")
 	return dm.actionFinish
 }
 
 func (dm *DM) actionSend(args []string, in *discordgo.Message, out **discordgo.MessageSend) dmAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = dm.newMsg("robyulmod.no_permission")
+		*out = dm.newMsg(in, "robyulmod.no_permission")
 		return dm.actionFinish
 	}
 
 	if !(len(args) >= 3 || (len(args) >= 2 && len(in.Attachments) > 0)) {
-		*out = dm.newMsg("bot.arguments.too-few")
+		*out = dm.newMsg(in, "bot.arguments.too-few")
 		return dm.actionFinish
 	}
 
 	targetUser, err := helpers.GetUserFromMention(args[1])
 	if err != nil {
-		*out = dm.newMsg("bot.arguments.invalid")
+		*out = dm.newMsg(in, "bot.arguments.invalid")
 		return dm.actionFinish
 	}
 
@@ -92,7 +92,7 @@ func (dm *DM) actionSend(args []string, in *discordgo.Message, out **discordgo.M
 
 	parts := strings.Split(in.Content, args[1])
 	if len(parts) < 2 {
-		*out = dm.newMsg("bot.arguments.too-few")
+		*out = dm.newMsg(in, "bot.arguments.too-few")
 		return dm.actionFinish
 	}
 	dmMessage := strings.TrimSpace(strings.Join(parts[1:], args[1]))
@@ -110,7 +110,7 @@ func (dm *DM) actionSend(args []string, in *discordgo.Message, out **discordgo.M
 	_, err = helpers.SendComplex(dmChannel.ID, dmMessageSend)
 	if err != nil {
 		if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeCannotSendMessagesToThisUser {
-			*out = dm.newMsg("plugins.dm.send-error-cannot-dm")
+			*out = dm.newMsg(in, "plugins.dm.send-error-cannot-dm")
 			return dm.actionFinish
 		}
 	}
@@ -118,13 +118,13 @@ func (dm *DM) actionSend(args []string, in *discordgo.Message, out **discordgo.M
 	dm.logger().WithField("RecipientUserID", args[1]).WithField("AuthorUserID", in.Author.ID).
 		Info("send a DM: " + dmMessage + " Attachment: " + dmAttachmentUrl)
 
-	*out = dm.newMsg(helpers.GetTextF("plugins.dm.send-success", targetUser.Username))
+	*out = dm.newMsg(in, helpers.GetMessageTextF(in, "plugins.dm.send-success", targetUser.Username))
 	return dm.actionFinish
 }
 
 func (dm *DM) actionReceive(args []string, in *discordgo.Message, out **discordgo.MessageSend) dmAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = dm.newMsg("robyulmod.no_permission")
+		*out = dm.newMsg(in, "robyulmod.no_permission")
 		return dm.actionFinish
 	}
 
@@ -141,7 +141,7 @@ func (dm *DM) actionReceive(args []string, in *discordgo.Message, out **discordg
 		err = helpers.SetBotConfigString(DMReceiveChannelIDKey, "")
 	}
 
-	*out = dm.newMsg("plugins.dm.receive-success")
+	*out = dm.newMsg(in, "plugins.dm.receive-success")
 	return dm.actionFinish
 }
 
@@ -152,8 +152,8 @@ func (dm *DM) actionFinish(args []string, in *discordgo.Message, out **discordgo
 	return nil
 }
 
-func (dm *DM) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (dm *DM) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (dm *DM) logger() *logrus.Entry {
@@ -201,16 +201,16 @@ func (dm *DM) DmResponse(msg *discordgo.Message) (response *discordgo.MessageSen
 
 	switch {
 	case regexp.MustCompile("(?i)^(.)?(HELP|COMMAND).*").MatchString(msg.Content):
-		content = helpers.GetText("dm.help")
+		content = helpers.GetMessageText(msg, "dm.help")
 		break
 	case regexp.MustCompile("(?i)^(.)?INVITE.*").MatchString(msg.Content):
-		content = helpers.GetText("dm.invite")
+		content = helpers.GetMessageText(msg, "dm.invite")
 		break
 	case regexp.MustCompile("(?i)^(.)?ABOUT.*").MatchString(msg.Content):
-		content = helpers.GetText("dm.about")
+		content = helpers.GetMessageText(msg, "dm.about")
 		break
 	case regexp.MustCompile("(?i)^(.)?_.*").MatchString(msg.Content):
-		content = helpers.GetText("dm.commands")
+		content = helpers.GetMessageText(msg, "dm.commands")
 		break
 	}
 
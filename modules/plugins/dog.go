@@ -36,7 +36,7 @@ func (m *Dog) Action(command string, content string, msg *discordgo.Message, ses
 		case "add":
 			helpers.RequireRobyulMod(msg, func() {
 				if len(args) < 2 && (len(args) < 1 && len(msg.Attachments) <= 0) {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 					return
 				}
 
@@ -49,7 +49,7 @@ func (m *Dog) Action(command string, content string, msg *discordgo.Message, ses
 				}
 
 				if url == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 					return
 				}
 
@@ -75,7 +75,7 @@ func (m *Dog) Action(command string, content string, msg *discordgo.Message, ses
 				url, err = helpers.GetFileLink(objectName)
 				helpers.Relax(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.dog.add-success", url))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.dog.add-success", url))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			})
@@ -83,10 +83,10 @@ func (m *Dog) Action(command string, content string, msg *discordgo.Message, ses
 		}
 	}
 
-	content = helpers.GetText("plugins.dog.none")
+	content = helpers.GetMessageText(msg, "plugins.dog.none")
 	link := m.getRandomDogLink()
 	if link != "" {
-		content = helpers.GetTextF("plugins.dog.result", link)
+		content = helpers.GetMessageTextF(msg, "plugins.dog.result", link)
 	}
 
 	messages, err := helpers.SendMessage(
@@ -114,7 +114,7 @@ func (m *Dog) Action(command string, content string, msg *discordgo.Message, ses
 						link = m.getRandomDogLink()
 						if link != "" {
 							helpers.EditMessage(messages[0].ChannelID, messages[0].ID,
-								helpers.GetTextF("plugins.dog.result", link))
+								helpers.GetMessageTextF(msg, "plugins.dog.result", link))
 						}
 						session.MessageReactionRemove(reaction.ChannelID, reaction.MessageID, reaction.Emoji.Name, reaction.UserID)
 					}
@@ -42,7 +42,7 @@ func (d *Donators) Action(command string, content string, msg *discordgo.Message
 		case "add":
 			helpers.RequireRobyulMod(msg, func() {
 				if len(args) < 2 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 					return
 				}
 
@@ -58,7 +58,7 @@ func (d *Donators) Action(command string, content string, msg *discordgo.Message
 				)
 				helpers.Relax(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.donators.add-success", name))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.donators.add-success", name))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			})
@@ -73,7 +73,7 @@ func (d *Donators) Action(command string, content string, msg *discordgo.Message
 	if donators == nil || len(donators) <= 0 {
 		helpers.SendMessage(
 			msg.ChannelID,
-			helpers.GetText("plugins.donators.none"),
+			helpers.GetMessageText(msg, "plugins.donators.none"),
 		)
 		return
 	}
@@ -91,7 +91,7 @@ func (d *Donators) Action(command string, content string, msg *discordgo.Message
 		donatorsListText += "\n"
 	}
 
-	donatorsText := helpers.GetTextF("plugins.donators.list", donatorsListText)
+	donatorsText := helpers.GetMessageTextF(msg, "plugins.donators.list", donatorsListText)
 
 	for _, page := range helpers.Pagify(donatorsText, "\n") {
 		helpers.SendMessage(msg.ChannelID, page)
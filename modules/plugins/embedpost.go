@@ -37,14 +37,14 @@ func (m *EmbedPost) Action(command string, content string, msg *discordgo.Messag
 		args := strings.Fields(content)
 
 		if len(args) < 2 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			return
 		}
 
 		var targetMessage *discordgo.Message
 		targetChannel, err := helpers.GetChannelFromMention(msg, args[0])
 		if err != nil {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 
@@ -52,7 +52,7 @@ func (m *EmbedPost) Action(command string, content string, msg *discordgo.Messag
 
 		if command == "edit-embed" || command == "embed-edit" || command == "get-embed" || command == "embed-get" {
 			if len(args) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 
@@ -64,7 +64,7 @@ func (m *EmbedPost) Action(command string, content string, msg *discordgo.Messag
 			if err != nil {
 				if errD, ok := err.(*discordgo.RESTError); ok {
 					if errD.Message.Code == discordgo.ErrCodeUnknownMessage || strings.Contains(err.Error(), "is not snowflake") {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 						return
 					} else {
 						helpers.Relax(err)
@@ -76,7 +76,7 @@ func (m *EmbedPost) Action(command string, content string, msg *discordgo.Messag
 
 			if command == "get-embed" || command == "embed-get" {
 				if targetMessage.Embeds == nil || len(targetMessage.Embeds) <= 0 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 
@@ -88,13 +88,13 @@ func (m *EmbedPost) Action(command string, content string, msg *discordgo.Messag
 		}
 
 		if len(args) < 3 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			return
 		}
 
 		ptext, embed, err := helpers.ParseEmbedCode(embedText)
 		if err != nil {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			return
 		}
 
@@ -6,6 +6,7 @@ import (
 
 	"encoding/json"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/metrics"
@@ -26,7 +27,7 @@ func auditlogBackfillLoop() {
 	for {
 		time.Sleep(time.Minute * 1)
 
-		if !cache.HasElastic() {
+		if !cache.HasElastic() || !cache.HasMachineryServer() {
 			continue
 		}
 
@@ -46,7 +47,6 @@ func auditlogBackfillLoop() {
 			helpers.Relax(err)
 		}
 		helpers.AuditLogBackfillRequestsLock.Unlock()
-		var successfulBackfills int
 
 		backfills := make([]models.AuditLogBackfillRequest, 0)
 
@@ -79,10 +79,60 @@ func auditlogBackfillLoop() {
 				continue
 			}
 
-			if !shouldBackfill(backfill.GuildID) {
-				continue
-			}
+			_, err = cache.GetMachineryServer().SendTask(AuditLogBackfillSignature(backfill))
+			helpers.RelaxLog(err)
+		}
+
+		logger().Infof("queued %d audit log backfills, took %s", len(backfills), time.Since(start))
+	}
+}
+
+// AuditLogBackfillSignature creates the task to run the backfill on the bulk queue
+func AuditLogBackfillSignature(backfill models.AuditLogBackfillRequest) (signature *tasks.Signature) {
+	return helpers.NewMachinerySignature(
+		"audit_log_backfill",
+		tasks.Arg{
+			Type:  "string",
+			Value: backfill.GuildID,
+		},
+		tasks.Arg{
+			Type:  "int",
+			Value: int(backfill.Type),
+		},
+		tasks.Arg{
+			Type:  "string",
+			Value: backfill.UserID,
+		},
+		tasks.Arg{
+			Type:  "int",
+			Value: backfill.Count,
+		},
+	)
+}
+
+// AuditLogBackfill adds the authors and reasons from the audit log to the pending eventlog entries, run by machinery
+func AuditLogBackfill(guildID string, backfillType int, userID string, count int) (err error) {
+	return backfillAuditLog(models.AuditLogBackfillRequest{
+		GuildID: guildID,
+		Type:    models.AuditLogBackfillType(backfillType),
+		UserID:  userID,
+		Count:   count,
+	})
+}
 
+// backfillAuditLog runs a backfill, guilds without eventlog or audit log permissions are skipped
+func backfillAuditLog(backfill models.AuditLogBackfillRequest) (err error) {
+	start := time.Now()
+	var successfulBackfills int
+	defer func() {
+		elapsed := time.Since(start)
+		logger().Infof("did audit log backfill for guild #%s, %d entries backfilled, took %s",
+			backfill.GuildID, successfulBackfills, elapsed)
+		metrics.EventlogAuditLogBackfillTime.Set(elapsed.Seconds())
+	}()
+
+	if cache.HasElastic() {
+		if shouldBackfill(backfill.GuildID) {
 			// enforce API limits
 			if backfill.Count > 100 {
 				backfill.Count = 100
@@ -97,10 +147,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionChannelCreate, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -134,10 +184,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionChannelDelete, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -180,10 +230,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", backfillRequestType, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -217,10 +267,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionMemberRoleUpdate, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -254,10 +304,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionMemberUpdate, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -291,10 +341,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionRoleCreate, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -328,10 +378,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionRoleDelete, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -405,10 +455,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionMemberBanAdd, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -467,10 +517,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionMemberBanRemove, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -504,10 +554,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionMemberKick, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -544,10 +594,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionEmojiCreate, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -581,10 +631,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionEmojiDelete, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -618,10 +668,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionEmojiUpdate, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -655,10 +705,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionGuildUpdate, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -692,10 +742,10 @@ func auditlogBackfillLoop() {
 				results, err := cache.GetSession().GuildAuditLog(backfill.GuildID, "", "", discordgo.AuditLogActionRoleUpdate, backfill.Count)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						continue
+						return nil
 					}
+					return err
 				}
-				helpers.Relax(err)
 				metrics.EventlogAuditLogRequests.Add(1)
 
 				for _, result := range results.AuditLogEntries {
@@ -725,15 +775,10 @@ func auditlogBackfillLoop() {
 				}
 				break
 			}
-
 		}
-
-		elapsed := time.Since(start)
-		logger().Infof("did %d audit log backfills, %d entries backfilled, took %s",
-			len(backfills),
-			successfulBackfills, elapsed)
-		metrics.EventlogAuditLogBackfillTime.Set(elapsed.Seconds())
 	}
+
+	return nil
 }
 
 func shouldBackfill(guildID string) (do bool) {
@@ -28,10 +28,10 @@ func (h *Handler) Init(session *discordgo.Session) {
 
 	Container.Init()
 
-	session.AddHandler(h.OnChannelCreate)
-	session.AddHandler(h.OnChannelDelete)
-	session.AddHandler(h.OnGuildRoleCreate)
-	session.AddHandler(h.OnGuildRoleDelete)
+	cache.AddHandler(h.OnChannelCreate)
+	cache.AddHandler(h.OnChannelDelete)
+	cache.AddHandler(h.OnGuildRoleCreate)
+	cache.AddHandler(h.OnGuildRoleDelete)
 
 	go auditlogBackfillLoop()
 	logger().Info("started auditlogBackfillLoop loop (1m)")
@@ -62,7 +62,7 @@ func (h *Handler) actionStart(args []string, in *discordgo.Message, out **discor
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
@@ -71,14 +71,14 @@ func (h *Handler) actionStart(args []string, in *discordgo.Message, out **discor
 		return h.actionSetLogChannel
 	}
 
-	*out = h.newMsg("bot.arguments.invalid")
+	*out = h.newMsg(in, "bot.arguments.invalid")
 	return nil
 }
 
 // [p]eventlog set-log [<#channel or channel id>]
 func (h *Handler) actionSetLogChannel(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	if !helpers.IsMod(in) {
-		*out = h.newMsg("mod.no_permission")
+		*out = h.newMsg(in, "mod.no_permission")
 		return h.actionFinish
 	}
 
@@ -100,7 +100,7 @@ func (h *Handler) actionSetLogChannel(args []string, in *discordgo.Message, out
 	for _, currentLogChannelID := range settings.EventlogChannelIDs {
 		if currentLogChannelID == targetChannel.ID {
 			removed = true
-			setMessage = helpers.GetTextF("plugins.eventlog.channel-removed", targetChannel.ID)
+			setMessage = helpers.GetMessageTextF(in, "plugins.eventlog.channel-removed", targetChannel.ID)
 			continue
 		}
 		newLogChannelIDs = append(newLogChannelIDs, currentLogChannelID)
@@ -108,7 +108,7 @@ func (h *Handler) actionSetLogChannel(args []string, in *discordgo.Message, out
 
 	if !removed {
 		newLogChannelIDs = append(newLogChannelIDs, targetChannel.ID)
-		setMessage = helpers.GetTextF("plugins.eventlog.channel-added", targetChannel.ID)
+		setMessage = helpers.GetMessageTextF(in, "plugins.eventlog.channel-added", targetChannel.ID)
 	}
 
 	_, err = helpers.EventlogLog(time.Now(), sourceChannel.GuildID, sourceChannel.GuildID,
@@ -129,7 +129,7 @@ func (h *Handler) actionSetLogChannel(args []string, in *discordgo.Message, out
 	err = helpers.GuildSettingsSet(sourceChannel.GuildID, settings)
 	helpers.Relax(err)
 
-	*out = h.newMsg(setMessage)
+	*out = h.newMsg(in, setMessage)
 	return h.actionFinish
 }
 
@@ -137,7 +137,7 @@ func (h *Handler) actionSetLogChannel(args []string, in *discordgo.Message, out
 func (h *Handler) actionToggleEventlog(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	cache.GetSession().ChannelTyping(in.ChannelID)
 	if !helpers.IsAdmin(in) {
-		*out = h.newMsg("admin.no_permission")
+		*out = h.newMsg(in, "admin.no_permission")
 		return h.actionFinish
 	}
 
@@ -193,7 +193,7 @@ func (h *Handler) actionToggleEventlog(args []string, in *discordgo.Message, out
 		helpers.RelaxLog(err)
 	}
 
-	*out = h.newMsg(setMessage)
+	*out = h.newMsg(in, setMessage)
 	return h.actionFinish
 }
 
@@ -204,9 +204,9 @@ func (h *Handler) actionFinish(args []string, in *discordgo.Message, out **disco
 	return nil
 }
 
-func (h *Handler) newMsg(content string, replacements ...interface{}) *discordgo.MessageSend {
+func (h *Handler) newMsg(in *discordgo.Message, content string, replacements ...interface{}) *discordgo.MessageSend {
 	if len(replacements) < 1 {
-		return &discordgo.MessageSend{Content: helpers.GetText(content)}
+		return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 	}
-	return &discordgo.MessageSend{Content: helpers.GetTextF(content, replacements...)}
+	return &discordgo.MessageSend{Content: helpers.GetMessageTextF(in, content, replacements...)}
 }
@@ -3,15 +3,14 @@ package plugins
 import (
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/feeds"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
 	humanize "github.com/dustin/go-humanize"
-	"github.com/globalsign/mgo/bson"
 	fb "github.com/huandu/facebook"
 	"github.com/pkg/errors"
 )
@@ -38,14 +37,14 @@ type Facebook_Post struct {
 	Url        string
 }
 
-type Facebook_Safe_Entries struct {
-	entries []models.FacebookEntry
-	mux     sync.Mutex
-}
-
 const (
 	facebookHexColor     = "#3b5998"
 	FacebookFriendlyPage = "https://facebook.com/%s/"
+	facebookTimeLayout   = "2006-01-02T15:04:05-0700"
+)
+
+var (
+	ErrFacebookPageNotFound = errors.New("facebook page not found")
 )
 
 func (m *Facebook) Commands() []string {
@@ -55,101 +54,63 @@ func (m *Facebook) Commands() []string {
 }
 
 func (m *Facebook) Init(session *discordgo.Session) {
-	go m.checkFacebookFeedsLoop()
-	cache.GetLogger().WithField("module", "facebook").Info("Started Facebook loop (10m)")
+	err := feeds.Register(&facebookSource{m: m})
+	if err != nil {
+		cache.GetLogger().WithField("module", "facebook").WithError(err).Error("failed to register the facebook feed source")
+	}
 }
-func (m *Facebook) checkFacebookFeedsLoop() {
-	log := cache.GetLogger()
-
-	defer helpers.Recover()
-	defer func() {
-		go func() {
-			log.WithField("module", "facebook").Error("The checkFacebookFeedsLoop died. Please investigate! Will be restarted in 60 seconds")
-			time.Sleep(60 * time.Second)
-			m.checkFacebookFeedsLoop()
-		}()
-	}()
-
-	var entries []models.FacebookEntry
-	var bundledEntries map[string][]models.FacebookEntry
-
-	for {
-		err := helpers.MDbIter(helpers.MdbCollection(models.FacebookTable).Find(nil)).All(&entries)
-		helpers.Relax(err)
-
-		bundledEntries = make(map[string][]models.FacebookEntry, 0)
-
-		for _, entry := range entries {
-			channel, err := helpers.GetChannelWithoutApi(entry.ChannelID)
-			if err != nil || channel == nil || channel.ID == "" {
-				//cache.GetLogger().WithField("module", "facebook").Warn(fmt.Sprintf("skipped facebook @%s for Channel #%s on Guild #%s: channel not found!",
-				//	entry.Username, entry.ChannelID, entry.ServerID))
-				continue
-			}
 
-			if _, ok := bundledEntries[entry.Username]; ok {
-				bundledEntries[entry.Username] = append(bundledEntries[entry.Username], entry)
-			} else {
-				bundledEntries[entry.Username] = []models.FacebookEntry{entry}
-			}
-		}
+// facebookSource is the feeds.Source for the posts of Facebook pages
+type facebookSource struct {
+	m *Facebook
+}
 
-		cache.GetLogger().WithField("module", "facebook").Infof("checking %d pages for %d feeds", len(bundledEntries), len(entries))
+// facebookRawPost is the raw post of a feeds.Post, the page is needed to render it
+type facebookRawPost struct {
+	page Facebook_Page
+	post Facebook_Post
+}
 
-		for facebookUsername, entries := range bundledEntries {
-			//log.WithField("module", "facebook").Debug(fmt.Sprintf("checking Facebook Page %s", facebookUsername))
+func (s *facebookSource) Name() string {
+	return "facebook"
+}
 
-			facebookPage, err := m.lookupFacebookPage(facebookUsername)
-			if err != nil {
-				if strings.Contains(err.Error(), "Application request limit reached") {
-					log.WithField("module", "facebook").Infoln("facebook api limit reached, retrying in one minute")
-					time.Sleep(time.Minute)
-					continue
-				}
-				log.WithField("module", "facebook").Warnf("updating facebook account %s failed: %s", facebookUsername, err.Error())
-				continue
-			}
+func (s *facebookSource) Interval(target string) time.Duration {
+	return 10 * time.Minute
+}
 
-			// https://github.com/golang/go/wiki/SliceTricks#reversing
-			for i := len(facebookPage.Posts)/2 - 1; i >= 0; i-- {
-				opp := len(facebookPage.Posts) - 1 - i
-				facebookPage.Posts[i], facebookPage.Posts[opp] = facebookPage.Posts[opp], facebookPage.Posts[i]
+// Resolve looks the page up and returns its username as normalised target
+func (s *facebookSource) Resolve(target string) (normalised, name string, err error) {
+	facebookPage, err := s.m.lookupFacebookPage(target)
+	if err != nil {
+		if e, ok := err.(*fb.Error); ok {
+			if e.Code == 803 || e.Code == 100 || strings.Contains(err.Error(), "Unknown path components") {
+				return "", "", ErrFacebookPageNotFound
 			}
+		}
+		return "", "", err
+	}
+	return facebookPage.Username, facebookPage.Username, nil
+}
 
-			for _, entry := range entries {
-				changes := false
-
-				for _, post := range facebookPage.Posts {
-					postAlreadyPosted := false
-					for _, postedPost := range entry.PostedPosts {
-						if postedPost.ID == post.ID {
-							postAlreadyPosted = true
-						}
-					}
-					if postAlreadyPosted == false {
-						log.WithField("module", "facebook").Info(fmt.Sprintf("Posting Post: #%s", post.ID))
-						entry.PostedPosts = append(entry.PostedPosts, models.FacebookPostEntry{ID: post.ID, CreatedAt: post.CreatedAt})
-						changes = true
-						go m.postPostToChannel(entry.ChannelID, post, facebookPage)
-					}
+func (s *facebookSource) Fetch(target string, state map[string]string) (posts []feeds.Post, newState map[string]string, err error) {
+	facebookPage, err := s.m.lookupFacebookPage(target)
+	if err != nil {
+		return nil, state, err
+	}
 
-				}
-				if changes == true {
-					err = helpers.MDbUpsertID(
-						models.FacebookTable,
-						entry.ID,
-						entry,
-					)
-					helpers.Relax(err)
-				}
-			}
-			time.Sleep(10 * time.Second)
-		}
+	for _, post := range facebookPage.Posts {
+		posts = append(posts, s.m.facebookPost(facebookPage, post))
+	}
+	return posts, state, nil
+}
 
-		if len(entries) <= 10 {
-			time.Sleep(1 * time.Minute)
-		}
+func (s *facebookSource) Render(entry models.FeedEntry, post feeds.Post) *discordgo.MessageEmbed {
+	raw, ok := post.Raw.(facebookRawPost)
+	if !ok {
+		return feeds.Embed(post)
 	}
+	return s.m.facebookPostEmbed(raw.page, raw.post)
 }
 
 func (m *Facebook) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
@@ -170,46 +131,32 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 				if len(args) >= 3 {
 					targetChannel, err = helpers.GetChannelFromMention(msg, args[2])
 					if err != nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 					return
 				}
 				targetGuild, err = helpers.GetGuild(targetChannel.GuildID)
 				helpers.Relax(err)
-				// get facebook account and tweets
-				facebookPage, err := m.lookupFacebookPage(args[1])
-				if err != nil {
-					if e, ok := err.(*fb.Error); ok {
-						if e.Code == 803 || e.Code == 100 || strings.Contains(err.Error(), "Unknown path components") {
-							helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.facebook.page-not-found"))
-							return
-						}
-					}
-					helpers.Relax(err)
+				entry, err := feeds.AddEntry("facebook", args[1], models.FeedEntry{
+					GuildID:       targetChannel.GuildID,
+					ChannelID:     targetChannel.ID,
+					AddedByUserID: msg.Author.ID,
+					PostMode:      models.FeedPostModeEmbed,
+				})
+				if err == ErrFacebookPageNotFound {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.facebook.page-not-found"))
+					return
 				}
-				// Create DB Entries
-				var dbPosts []models.FacebookPostEntry
-				for _, facebookPost := range facebookPage.Posts {
-					facebookPostEntry := models.FacebookPostEntry{ID: facebookPost.ID, CreatedAt: facebookPost.CreatedAt}
-					dbPosts = append(dbPosts, facebookPostEntry)
-
+				if err == feeds.ErrAlreadyAdded {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.feeds.add-already-added", targetChannel.ID))
+					return
 				}
-				// create new entry in db
-				_, err = helpers.MDbInsert(
-					models.FacebookTable,
-					models.FacebookEntry{
-						GuildID:     targetChannel.GuildID,
-						ChannelID:   targetChannel.ID,
-						Username:    facebookPage.Username,
-						PostedPosts: dbPosts,
-					},
-				)
 				helpers.Relax(err)
 
-				_, err = helpers.EventlogLog(time.Now(), targetChannel.GuildID, targetChannel.GuildID,
+				_, err = helpers.EventlogLog(time.Now(), targetChannel.GuildID, helpers.MdbIdToHuman(entry.ID),
 					models.EventlogTargetTypeRobyulFacebookFeed, msg.Author.ID,
 					models.EventlogTypeRobyulFacebookFeedAdd, "",
 					nil,
@@ -221,13 +168,13 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 						},
 						{
 							Key:   "facebook_facebookusername",
-							Value: facebookPage.Username,
+							Value: entry.Target,
 						},
 					}, false)
 				helpers.RelaxLog(err)
 
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.facebook.account-added-success", facebookPage.Username, targetChannel.ID))
-				cache.GetLogger().WithField("module", "facebook").Info(fmt.Sprintf("Added Facebook Account %s to Channel %s (#%s) on Guild %s (#%s)", facebookPage.Username, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.facebook.account-added-success", entry.Target, targetChannel.ID))
+				cache.GetLogger().WithField("module", "facebook").Info(fmt.Sprintf("Added Facebook Account %s to Channel %s (#%s) on Guild %s (#%s)", entry.Target, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID))
 			})
 		case "delete", "del", "remove": // [p]facebook delete <id>
 			helpers.RequireMod(msg, func() {
@@ -237,14 +184,10 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 					channel, err := helpers.GetChannel(msg.ChannelID)
 					helpers.Relax(err)
 
-					entryId := args[1]
-					var entryBucket models.FacebookEntry
-					err = helpers.MdbOne(
-						helpers.MdbCollection(models.FacebookTable).Find(bson.M{"_id": helpers.HumanToMdbId(entryId), "guildid": channel.GuildID}),
-						&entryBucket,
-					)
-					if err == nil {
-						err = helpers.MDbDelete(models.FacebookTable, entryBucket.ID)
+					entryBucket, err := feeds.Get(channel.GuildID, args[1])
+					if err == nil && entryBucket.Source == "facebook" {
+						err = feeds.Remove(entryBucket)
+						helpers.Relax(err)
 
 						_, err := helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
 							models.EventlogTargetTypeRobyulFacebookFeed, msg.Author.ID,
@@ -257,31 +200,89 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 								},
 								{
 									Key:   "facebook_facebookusername",
-									Value: entryBucket.Username,
+									Value: entryBucket.Target,
 								},
 							}, false)
 						helpers.RelaxLog(err)
 
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.facebook.account-delete-success", entryBucket.Username))
-						cache.GetLogger().WithField("module", "facebook").Info(fmt.Sprintf("Deleted Facebook Page `%s`", entryBucket.Username))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.facebook.account-delete-success", entryBucket.Target))
+						cache.GetLogger().WithField("module", "facebook").Info(fmt.Sprintf("Deleted Facebook Page `%s`", entryBucket.Target))
 					} else {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.facebook.account-delete-not-found-error"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.facebook.account-delete-not-found-error"))
 						return
 					}
 				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 			})
+		case "template", "mention": // [p]facebook template <id> [<template>|reset] or [p]facebook mention <id> [<role>]
+			helpers.RequireMod(msg, func() {
+				if len(args) < 2 {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+
+				channel, err := helpers.GetChannel(msg.ChannelID)
+				helpers.Relax(err)
+
+				entryBucket, err := feeds.Get(channel.GuildID, args[1])
+				if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "facebook") {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.facebook.account-delete-not-found-error"))
+					return
+				}
+				helpers.Relax(err)
+
+				var reply, key, beforeValue, afterValue string
+				var changed bool
+				if args[0] == "template" {
+					key, beforeValue = "facebook_template", entryBucket.Template
+					reply, changed = feeds.TemplateCommand(helpers.GetMessageLocale(msg), &entryBucket.Template,
+						entryBucket.TargetName, strings.Join(args[2:], " "))
+					afterValue = entryBucket.Template
+				} else {
+					key, beforeValue = "facebook_mentionroleid", entryBucket.MentionRoleID
+					reply, changed = feeds.MentionCommand(helpers.GetMessageLocale(msg), channel.GuildID, &entryBucket.MentionRoleID,
+						entryBucket.TargetName, strings.Join(args[2:], " "))
+					afterValue = entryBucket.MentionRoleID
+				}
+				if changed {
+					err = feeds.Update(entryBucket)
+					helpers.Relax(err)
+
+					_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
+						models.EventlogTargetTypeRobyulFacebookFeed, msg.Author.ID,
+						models.EventlogTypeRobyulFacebookFeedUpdate, "",
+						[]models.ElasticEventlogChange{
+							{
+								Key:      key,
+								OldValue: beforeValue,
+								NewValue: afterValue,
+							},
+						},
+						[]models.ElasticEventlogOption{
+							{
+								Key:   "facebook_channelid",
+								Value: entryBucket.ChannelID,
+							},
+							{
+								Key:   "facebook_facebookusername",
+								Value: entryBucket.Target,
+							},
+						}, false)
+					helpers.RelaxLog(err)
+				}
+
+				helpers.SendMessage(msg.ChannelID, reply)
+			})
 		case "list": // [p]facebook list
 			currentChannel, err := helpers.GetChannel(msg.ChannelID)
 			helpers.Relax(err)
-			var entryBucket []models.FacebookEntry
-			err = helpers.MDbIter(helpers.MdbCollection(models.FacebookTable).Find(bson.M{"guildid": currentChannel.GuildID})).All(&entryBucket)
+			entryBucket, err := feeds.GetAll(currentChannel.GuildID, "facebook")
 			helpers.Relax(err)
 
 			if len(entryBucket) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.facebook.account-list-no-accounts-error"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.facebook.account-list-no-accounts-error"))
 				return
 			} else if err != nil {
 				helpers.Relax(err)
@@ -289,7 +290,14 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 
 			resultMessage := ""
 			for _, entry := range entryBucket {
-				resultMessage += fmt.Sprintf("`%s`: Facebook Page `%s` posting to <#%s>\n", helpers.MdbIdToHuman(entry.ID), entry.Username, entry.ChannelID)
+				var specialText string
+				if entry.MentionRoleID != "" {
+					specialText += fmt.Sprintf(" mentioning <@&%s>", entry.MentionRoleID)
+				}
+				if entry.Template != "" {
+					specialText += " using a template"
+				}
+				resultMessage += fmt.Sprintf("`%s`: Facebook Page `%s` posting to <#%s>%s\n", helpers.MdbIdToHuman(entry.ID), entry.TargetName, entry.ChannelID, specialText)
 			}
 			resultMessage += fmt.Sprintf("Found **%d** Facebook Pages in total.", len(entryBucket))
 			for _, resultPage := range helpers.Pagify(resultMessage, "\n") {
@@ -300,7 +308,7 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 			session.ChannelTyping(msg.ChannelID)
 
 			if args[0] == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.facebook.page-not-found"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.facebook.page-not-found"))
 				return
 			}
 
@@ -308,7 +316,7 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 			if err != nil {
 				if e, ok := err.(*fb.Error); ok {
 					if e.Code == 803 || e.Code == 100 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.facebook.page-not-found"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.facebook.page-not-found"))
 						return
 					}
 				}
@@ -321,12 +329,12 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 			}
 
 			accountEmbed := &discordgo.MessageEmbed{
-				Title:     helpers.GetTextF("plugins.facebook.page-embed-title", facebookPage.Name, facebookPage.Username, facebookNameModifier),
+				Title:     helpers.GetMessageTextF(msg, "plugins.facebook.page-embed-title", facebookPage.Name, facebookPage.Username, facebookNameModifier),
 				URL:       fmt.Sprintf(FacebookFriendlyPage, facebookPage.Username),
 				Thumbnail: &discordgo.MessageEmbedThumbnail{URL: facebookPage.ProfilePictureUrl},
 				Footer: &discordgo.MessageEmbedFooter{
-					Text:    helpers.GetText("plugins.facebook.embed-footer"),
-					IconURL: helpers.GetText("plugins.facebook.embed-footer-imageurl"),
+					Text:    helpers.GetMessageText(msg, "plugins.facebook.embed-footer"),
+					IconURL: helpers.GetMessageText(msg, "plugins.facebook.embed-footer-imageurl"),
 				},
 				Description: facebookPage.About,
 				Fields: []*discordgo.MessageEmbedField{
@@ -349,7 +357,7 @@ func (m *Facebook) Action(command string, content string, msg *discordgo.Message
 			return
 		}
 	} else {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 	}
 }
 
@@ -436,7 +444,26 @@ func (m *Facebook) lookupFacebookPage(siteName string) (Facebook_Page, error) {
 	return facebookPage, nil
 }
 
-func (m *Facebook) postPostToChannel(channelID string, post Facebook_Post, facebookPage Facebook_Page) {
+// facebookPost converts a post of a page for the feeds framework
+func (m *Facebook) facebookPost(facebookPage Facebook_Page, post Facebook_Post) feeds.Post {
+	feedPost := feeds.Post{
+		ID:            post.ID,
+		URL:           post.Url,
+		Text:          post.Message,
+		Author:        facebookPage.Name,
+		AuthorURL:     fmt.Sprintf(FacebookFriendlyPage, facebookPage.Username),
+		AuthorIconURL: facebookPage.ProfilePictureUrl,
+		Type:          "post",
+		Raw:           facebookRawPost{page: facebookPage, post: post},
+	}
+	feedPost.Time, _ = time.Parse(facebookTimeLayout, post.CreatedAt)
+	if post.PictureUrl != "" {
+		feedPost.ImageURLs = []string{post.PictureUrl}
+	}
+	return feedPost
+}
+
+func (m *Facebook) facebookPostEmbed(facebookPage Facebook_Page, post Facebook_Post) *discordgo.MessageEmbed {
 	facebookNameModifier := ""
 	if facebookPage.Verified {
 		facebookNameModifier += " ☑"
@@ -458,11 +485,5 @@ func (m *Facebook) postPostToChannel(channelID string, post Facebook_Post, faceb
 		channelEmbed.Image = &discordgo.MessageEmbedImage{URL: post.PictureUrl}
 	}
 
-	_, err := helpers.SendComplex(channelID, &discordgo.MessageSend{
-		Content: fmt.Sprintf("<%s>", post.Url),
-		Embed:   channelEmbed,
-	})
-	if err != nil {
-		cache.GetLogger().WithField("module", "facebook").Warnf("posting post: #%s to channel: #%s failed: %s", post.ID, channelID, err)
-	}
+	return channelEmbed
 }
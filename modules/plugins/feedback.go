@@ -73,7 +73,7 @@ func (f *Feedback) actionStart(command string, args []string, in *discordgo.Mess
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = f.newMsg("plugins.feedback.arguments-too-few")
+		*out = f.newMsg(in, "plugins.feedback.arguments-too-few")
 		return f.actionFinish
 	}
 
@@ -87,7 +87,7 @@ func (f *Feedback) actionStart(command string, args []string, in *discordgo.Mess
 		return f.actionIssue
 	}
 
-	*out = f.newMsg("bot.arguments.invalid")
+	*out = f.newMsg(in, "bot.arguments.invalid")
 	return f.actionFinish
 }
 
@@ -119,7 +119,7 @@ func (f *Feedback) actionSuggestion(command string, args []string, in *discordgo
 		helpers.RelaxLog(err)
 	}
 
-	*out = f.newMsg("plugins.feedback.suggestion-received")
+	*out = f.newMsg(in, "plugins.feedback.suggestion-received")
 	return f.actionFinish
 }
 
@@ -151,13 +151,13 @@ func (f *Feedback) actionIssue(command string, args []string, in *discordgo.Mess
 		helpers.RelaxLog(err)
 	}
 
-	*out = f.newMsg("plugins.feedback.issue-received")
+	*out = f.newMsg(in, "plugins.feedback.issue-received")
 	return f.actionFinish
 }
 
 func (f *Feedback) actionSetLog(command string, args []string, in *discordgo.Message, out **discordgo.MessageSend) feedbackAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = f.newMsg("robyulmod.no_permission")
+		*out = f.newMsg(in, "robyulmod.no_permission")
 		return f.actionFinish
 	}
 
@@ -174,7 +174,7 @@ func (f *Feedback) actionSetLog(command string, args []string, in *discordgo.Mes
 		err = helpers.SetBotConfigString(models.FeedbackLogChannelKey, "")
 	}
 
-	*out = f.newMsg("plugins.feedback.setlog-success")
+	*out = f.newMsg(in, "plugins.feedback.setlog-success")
 	return f.actionFinish
 }
 
@@ -198,8 +198,8 @@ func (f *Feedback) actionFinish(command string, args []string, in *discordgo.Mes
 	return nil
 }
 
-func (f *Feedback) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (f *Feedback) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (f *Feedback) logger() *logrus.Entry {
@@ -0,0 +1,283 @@
+package plugins
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/commands"
+	"github.com/Seklfreak/Robyul2/feeds"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feeds lists and manages the feeds of all sources of the feeds framework
+type Feeds struct{}
+
+func (m *Feeds) Commands() []*commands.Command {
+	return []*commands.Command{
+		{
+			Name:        "feeds",
+			Description: "Lists and configures the feeds of all social media integrations on this server.",
+			Permission:  commands.PermissionMod,
+			Subcommands: []*commands.Command{
+				{
+					Name:        "list",
+					Description: "Lists all feeds on this server, optionally only for one channel.",
+					Arguments: []commands.Argument{
+						{Name: "channel", Type: commands.ArgumentChannel, Optional: true},
+					},
+					Handler: m.actionList,
+				},
+				{
+					Name:        "mode",
+					Description: "Sets how posts are posted: embed, link or text.",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+						{Name: "mode", Type: commands.ArgumentString},
+					},
+					Handler: m.actionMode,
+				},
+				{
+					Name:        "mention",
+					Description: "Sets the role to mention in every post, removes it if no role is given.",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+						{Name: "role", Type: commands.ArgumentRole, Optional: true},
+					},
+					Handler: m.actionMention,
+				},
+				{
+					Name:        "template",
+					Description: "Shows or sets the text or embed code to post posts with, reset removes it. Placeholders: " + feeds.TemplatePlaceholders + ".",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+						{Name: "template", Type: commands.ArgumentText, Optional: true},
+					},
+					Handler: m.actionTemplate,
+				},
+				{
+					Name:        "filter",
+					Description: "Shows or changes which posts of a feed are posted.",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+						{Name: "setting", Type: commands.ArgumentText, Optional: true},
+					},
+					Handler: m.actionFilter,
+				},
+				{
+					Name:        "remove",
+					Aliases:     []string{"delete"},
+					Description: "Removes a feed.",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+					},
+					Handler: m.actionRemove,
+				},
+			},
+		},
+	}
+}
+
+func (m *Feeds) Init(session *discordgo.Session) {
+}
+
+// [p]feeds list [<#channel>]
+func (m *Feeds) actionList(ctx *commands.Context) {
+	items, err := feeds.List(ctx.GuildID)
+	helpers.Relax(err)
+
+	var listText string
+	var count int
+	for _, item := range items {
+		if ctx.Has("channel") && item.ChannelID != ctx.ChannelArg("channel").ID {
+			continue
+		}
+		count++
+
+		listText += fmt.Sprintf("`%s`: **%s** `%s` posting to <#%s>", item.ID, strings.Title(item.Source), item.Name, item.ChannelID)
+		if item.Details != "" {
+			listText += " (" + item.Details + ")"
+		}
+		listText += "\n"
+	}
+
+	if count <= 0 {
+		ctx.SendText("plugins.feeds.list-none")
+		return
+	}
+
+	listText += ctx.Text("plugins.feeds.list-total", count)
+	ctx.Send(listText)
+}
+
+// [p]feeds mode <feed id> <embed|link|text>
+func (m *Feeds) actionMode(ctx *commands.Context) {
+	entry, err := feeds.Get(ctx.GuildID, ctx.String("feed id"))
+	if helpers.IsMdbNotFound(err) {
+		ctx.SendText("plugins.feeds.feed-not-found")
+		return
+	}
+	helpers.Relax(err)
+
+	oldMode := entry.PostMode
+	switch strings.ToLower(ctx.String("mode")) {
+	case "embed":
+		entry.PostMode = models.FeedPostModeEmbed
+	case "link":
+		entry.PostMode = models.FeedPostModeLink
+	case "text":
+		entry.PostMode = models.FeedPostModeText
+	default:
+		ctx.SendText("bot.arguments.invalid")
+		return
+	}
+
+	err = feeds.Update(entry)
+	helpers.Relax(err)
+
+	m.logUpdate(ctx, entry, "feed_postmode", strconv.Itoa(int(oldMode)), strconv.Itoa(int(entry.PostMode)))
+
+	ctx.SendText("plugins.feeds.mode-set-success", entry.TargetName, strings.ToLower(ctx.String("mode")))
+}
+
+// [p]feeds mention <feed id> [<role>]
+func (m *Feeds) actionMention(ctx *commands.Context) {
+	entry, err := feeds.Get(ctx.GuildID, ctx.String("feed id"))
+	if helpers.IsMdbNotFound(err) {
+		ctx.SendText("plugins.feeds.feed-not-found")
+		return
+	}
+	helpers.Relax(err)
+
+	oldMentionRoleID := entry.MentionRoleID
+	entry.MentionRoleID = ""
+	if ctx.Has("role") {
+		entry.MentionRoleID = ctx.Role("role").ID
+	}
+
+	err = feeds.Update(entry)
+	helpers.Relax(err)
+
+	m.logUpdate(ctx, entry, "feed_mentionroleid", oldMentionRoleID, entry.MentionRoleID)
+
+	if entry.MentionRoleID == "" {
+		ctx.SendText("plugins.feeds.mention-removed-success", entry.TargetName)
+		return
+	}
+	ctx.SendText("plugins.feeds.mention-set-success", entry.TargetName, ctx.Role("role").Name)
+}
+
+// [p]feeds template <feed id> [<embed code>|reset]
+func (m *Feeds) actionTemplate(ctx *commands.Context) {
+	entry, err := feeds.Get(ctx.GuildID, ctx.String("feed id"))
+	if helpers.IsMdbNotFound(err) {
+		ctx.SendText("plugins.feeds.feed-not-found")
+		return
+	}
+	helpers.Relax(err)
+
+	oldTemplate := entry.Template
+	reply, changed := feeds.TemplateCommand(ctx.Locale, &entry.Template, entry.TargetName, ctx.String("template"))
+	if changed {
+		err = feeds.Update(entry)
+		helpers.Relax(err)
+
+		m.logUpdate(ctx, entry, "feed_template", oldTemplate, entry.Template)
+	}
+
+	ctx.Send(reply)
+}
+
+// [p]feeds filter <feed id> [<setting> [<value>]]
+func (m *Feeds) actionFilter(ctx *commands.Context) {
+	entry, err := feeds.Get(ctx.GuildID, ctx.String("feed id"))
+	if helpers.IsMdbNotFound(err) {
+		ctx.SendText("plugins.feeds.feed-not-found")
+		return
+	}
+	helpers.Relax(err)
+
+	oldFilter := feeds.DescribeFilter(entry.Filter)
+	reply, changed := feeds.FilterCommand(ctx.Locale, &entry.Filter, feeds.SourceFilterOptions(entry.Source), entry.TargetName, strings.Fields(ctx.String("setting")))
+	if changed {
+		err = feeds.Update(entry)
+		helpers.Relax(err)
+
+		m.logUpdate(ctx, entry, "feed_filter", oldFilter, feeds.DescribeFilter(entry.Filter))
+	}
+
+	ctx.Send(reply)
+}
+
+// [p]feeds remove <feed id>
+func (m *Feeds) actionRemove(ctx *commands.Context) {
+	entry, err := feeds.Get(ctx.GuildID, ctx.String("feed id"))
+	if helpers.IsMdbNotFound(err) {
+		ctx.SendText("plugins.feeds.feed-not-found")
+		return
+	}
+	helpers.Relax(err)
+
+	err = FeedsRemove(entry, ctx.Msg.Author.ID)
+	helpers.Relax(err)
+
+	ctx.SendText("plugins.feeds.remove-success", entry.TargetName, entry.ChannelID)
+}
+
+// FeedsRemove removes a feed of the feeds framework, used by the feeds command and the REST API
+func FeedsRemove(entry models.FeedEntry, userID string) (err error) {
+	err = feeds.Remove(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulFeed, userID,
+		models.EventlogTypeRobyulFeedRemove, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "feed_source",
+				Value: entry.Source,
+			},
+			{
+				Key:   "feed_target",
+				Value: entry.Target,
+			},
+			{
+				Key:   "feed_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return nil
+}
+
+func (m *Feeds) logUpdate(ctx *commands.Context, entry models.FeedEntry, key, oldValue, newValue string) {
+	_, err := helpers.EventlogLog(time.Now(), ctx.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulFeed, ctx.Msg.Author.ID,
+		models.EventlogTypeRobyulFeedUpdate, "",
+		[]models.ElasticEventlogChange{
+			{
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
+			},
+		},
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "feed_source",
+				Value: entry.Source,
+			},
+			{
+				Key:   "feed_target",
+				Value: entry.Target,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+}
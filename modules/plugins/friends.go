@@ -40,7 +40,7 @@ func (f *Friend) Action(command string, content string, msg *discordgo.Message,
 
 func (f *Friend) actionStart(args []string, in *discordgo.Message, out **discordgo.MessageSend) friendAction {
 	if len(args) < 1 {
-		*out = f.newMsg("bot.arguments.too-few")
+		*out = f.newMsg(in, "bot.arguments.too-few")
 		return f.actionFinish
 	}
 
@@ -51,13 +51,13 @@ func (f *Friend) actionStart(args []string, in *discordgo.Message, out **discord
 		return f.actionInvite
 	}
 
-	*out = f.newMsg("bot.arguments.invalid")
+	*out = f.newMsg(in, "bot.arguments.invalid")
 	return f.actionFinish
 }
 
 func (f *Friend) actionInvite(args []string, in *discordgo.Message, out **discordgo.MessageSend) friendAction {
 	if helpers.IsRobyulMod(in.Author.ID) == false {
-		*out = f.newMsg(helpers.GetText("robyulmod.no_permission"))
+		*out = f.newMsg(in, helpers.GetMessageText(in, "robyulmod.no_permission"))
 		return f.actionFinish
 	}
 
@@ -65,7 +65,7 @@ func (f *Friend) actionInvite(args []string, in *discordgo.Message, out **discor
 	f.Relax(err)
 
 	if cache.GetFriend(channel.GuildID) != nil {
-		*out = f.newMsg(helpers.GetText("plugins.friends.invite-error-already-on-server"))
+		*out = f.newMsg(in, helpers.GetMessageText(in, "plugins.friends.invite-error-already-on-server"))
 		return f.actionFinish
 	}
 
@@ -73,7 +73,7 @@ func (f *Friend) actionInvite(args []string, in *discordgo.Message, out **discor
 	if err != nil {
 		if strings.Contains(err.Error(), "No friend with free slots available, please add more friends!") {
 			f.logger().Error(err.Error())
-			*out = f.newMsg(helpers.GetText("plugins.friends.invite-error-no-friend-available"))
+			*out = f.newMsg(in, helpers.GetMessageText(in, "plugins.friends.invite-error-no-friend-available"))
 			return f.actionFinish
 		} else {
 			f.Relax(err)
@@ -96,20 +96,20 @@ func (f *Friend) actionInvite(args []string, in *discordgo.Message, out **discor
 	}
 
 	if invite == nil {
-		*out = f.newMsg(helpers.GetText("plugins.friends.invite-error-invite-creation-failed"))
+		*out = f.newMsg(in, helpers.GetMessageText(in, "plugins.friends.invite-error-invite-creation-failed"))
 		return f.actionFinish
 	}
 
 	_, err = helpers.FriendRequest(friend, "POST", "invites/"+invite.Code)
 	f.Relax(err)
 
-	*out = f.newMsg(helpers.GetTextF("plugins.friends.invite-success", friend.State.User.Username))
+	*out = f.newMsg(in, helpers.GetMessageTextF(in, "plugins.friends.invite-success", friend.State.User.Username))
 	return f.actionFinish
 }
 
 func (f *Friend) actionList(args []string, in *discordgo.Message, out **discordgo.MessageSend) friendAction {
 	if helpers.IsRobyulMod(in.Author.ID) == false {
-		*out = f.newMsg(helpers.GetText("robyulmod.no_permission"))
+		*out = f.newMsg(in, helpers.GetMessageText(in, "robyulmod.no_permission"))
 		return f.actionFinish
 	}
 
@@ -126,7 +126,7 @@ func (f *Friend) actionList(args []string, in *discordgo.Message, out **discordg
 
 	message += fmt.Sprintf("_in total %d friends on %d guilds_\n", len(friends), totalGuilds)
 
-	*out = f.newMsg(message)
+	*out = f.newMsg(in, message)
 	return f.actionFinish
 }
 
@@ -137,8 +137,8 @@ func (f *Friend) actionFinish(args []string, in *discordgo.Message, out **discor
 	return nil
 }
 
-func (f *Friend) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (f *Friend) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (f *Friend) Relax(err error) {
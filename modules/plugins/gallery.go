@@ -1,8 +1,12 @@
 package plugins
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"path"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"time"
@@ -32,6 +36,11 @@ const (
 var (
 	galleryUrlRegex *regexp.Regexp
 	galleries       []models.GalleryEntry
+
+	galleryMediaTypes = []string{
+		models.GalleryMediaTypeImages, models.GalleryMediaTypeVideos, models.GalleryMediaTypeLinks, models.GalleryMediaTypeFiles,
+	}
+	errGalleryOptionInvalid = errors.New("invalid gallery option")
 )
 
 func (g *Gallery) Init(session *discordgo.Session) {
@@ -53,10 +62,10 @@ func (g *Gallery) Action(command string, content string, msg *discordgo.Message,
 	args := strings.Fields(content)
 	if len(args) >= 1 {
 		switch args[0] {
-		case "add": // [p]gallery add <source channel> <target channel>
+		case "add": // [p]gallery add <source channel> <target channel> [<target channel>...] [types=<types>] [domains=<domains>] [reactions=<n>] [caption=<caption>]
 			helpers.RequireMod(msg, func() {
 				if len(args) < 3 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 
@@ -68,44 +77,72 @@ func (g *Gallery) Action(command string, content string, msg *discordgo.Message,
 				helpers.Relax(err)
 				sourceChannel, err := helpers.GetChannelFromMention(msg, args[1])
 				if err != nil || sourceChannel.ID == "" || sourceChannel.GuildID != channel.GuildID {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
-					return
-				}
-				targetChannel, err := helpers.GetChannelFromMention(msg, args[2])
-				if err != nil || targetChannel.ID == "" || targetChannel.GuildID != channel.GuildID {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 
-				newID, err := helpers.MDbInsert(models.GalleryTable, models.GalleryEntry{
+				newEntry := models.GalleryEntry{
 					SourceChannelID: sourceChannel.ID,
-					TargetChannelID: targetChannel.ID,
 					GuildID:         channel.GuildID,
 					AddedByUserID:   msg.Author.ID,
-				})
+				}
+
+				// targets are followed by the options
+				i := 2
+				for ; i < len(args) && !strings.Contains(args[i], "="); i++ {
+					targetChannel, err := helpers.GetChannelFromMention(msg, args[i])
+					if err != nil || targetChannel.ID == "" || targetChannel.GuildID != channel.GuildID ||
+						targetChannel.ID == sourceChannel.ID {
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+						return
+					}
+					if !helpers.StringSliceContains(targetChannel.ID, newEntry.TargetChannelIDs) {
+						newEntry.TargetChannelIDs = append(newEntry.TargetChannelIDs, targetChannel.ID)
+					}
+				}
+				if len(newEntry.TargetChannelIDs) <= 0 {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+				newEntry.TargetChannelID = newEntry.TargetChannelIDs[0]
+
+				err = g.applyOptions(&newEntry, args[i:])
+				if err != nil {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.gallery.add-options-invalid"))
+					return
+				}
+
+				newID, err := helpers.MDbInsert(models.GalleryTable, newEntry)
 				helpers.Relax(err)
 
+				options := []models.ElasticEventlogOption{
+					{
+						Key:   "gallery_sourcechannelid",
+						Value: sourceChannel.ID,
+						Type:  models.EventlogTargetTypeChannel,
+					},
+					{
+						Key:   "gallery_targetchannelid",
+						Value: strings.Join(newEntry.TargetChannelIDs, ","),
+						Type:  models.EventlogTargetTypeChannel,
+					},
+				}
+				if description := g.describeOptions(newEntry); description != "" {
+					options = append(options, models.ElasticEventlogOption{
+						Key:   "gallery_options",
+						Value: description,
+					})
+				}
 				_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(newID),
 					models.EventlogTargetTypeRobyulGallery, msg.Author.ID,
 					models.EventlogTypeRobyulGalleryAdd, "",
 					nil,
-					[]models.ElasticEventlogOption{
-						{
-							Key:   "gallery_sourcechannelid",
-							Value: sourceChannel.ID,
-							Type:  models.EventlogTargetTypeChannel,
-						},
-						{
-							Key:   "gallery_targetchannelid",
-							Value: targetChannel.ID,
-							Type:  models.EventlogTargetTypeChannel,
-						},
-					}, false)
+					options, false)
 				helpers.RelaxLog(err)
 
-				cache.GetLogger().WithField("module", "galleries").Info(fmt.Sprintf("Added Gallery on Server %s (%s) posting from #%s (%s) to #%s (%s)",
-					guild.Name, guild.ID, sourceChannel.Name, sourceChannel.ID, targetChannel.Name, targetChannel.ID))
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.gallery.add-success"))
+				cache.GetLogger().WithField("module", "galleries").Info(fmt.Sprintf("Added Gallery on Server %s (%s) posting from #%s (%s) to %s",
+					guild.Name, guild.ID, sourceChannel.Name, sourceChannel.ID, strings.Join(newEntry.TargetChannelIDs, ", ")))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.gallery.add-success"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 
 				galleries, err = g.GetGalleries()
@@ -121,14 +158,18 @@ func (g *Gallery) Action(command string, content string, msg *discordgo.Message,
 			helpers.Relax(err)
 
 			if entryBucket == nil || len(entryBucket) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.gallery.list-empty"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.gallery.list-empty"))
 				return
 			}
 
 			resultMessage := ":frame_photo: Galleries on this server:\n"
 			for _, entry := range entryBucket {
-				resultMessage += fmt.Sprintf("`%s`: posting from <#%s> to <#%s>\n",
-					helpers.MdbIdToHuman(entry.ID), entry.SourceChannelID, entry.TargetChannelID)
+				resultMessage += fmt.Sprintf("`%s`: posting from <#%s> to <#%s>",
+					helpers.MdbIdToHuman(entry.ID), entry.SourceChannelID, strings.Join(entry.Targets(), ">, <#"))
+				if description := g.describeOptions(entry); description != "" {
+					resultMessage += " (" + description + ")"
+				}
+				resultMessage += "\n"
 			}
 			resultMessage += fmt.Sprintf("Found **%d** Galleries in total.", len(entryBucket))
 
@@ -139,7 +180,7 @@ func (g *Gallery) Action(command string, content string, msg *discordgo.Message,
 			helpers.RequireAdmin(msg, func() {
 				session.ChannelTyping(msg.ChannelID)
 				if len(args) < 2 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.Relax(err)
 					return
 				}
@@ -153,7 +194,7 @@ func (g *Gallery) Action(command string, content string, msg *discordgo.Message,
 					&entryBucket,
 				)
 				if helpers.IsMdbNotFound(err) {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.gallery.delete-not-found"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.gallery.delete-not-found"))
 					return
 				}
 				helpers.Relax(err)
@@ -172,14 +213,14 @@ func (g *Gallery) Action(command string, content string, msg *discordgo.Message,
 						},
 						{
 							Key:   "gallery_targetchannelid",
-							Value: entryBucket.TargetChannelID,
+							Value: strings.Join(entryBucket.Targets(), ","),
 						},
 					}, false)
 				helpers.RelaxLog(err)
 
-				cache.GetLogger().WithField("module", "galleries").Info(fmt.Sprintf("Deleted Gallery on Server #%s posting from #%s to #%s",
-					channel.GuildID, entryBucket.SourceChannelID, entryBucket.TargetChannelID))
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.gallery.delete-success"))
+				cache.GetLogger().WithField("module", "galleries").Info(fmt.Sprintf("Deleted Gallery on Server #%s posting from #%s to %s",
+					channel.GuildID, entryBucket.SourceChannelID, strings.Join(entryBucket.Targets(), ", ")))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.gallery.delete-success"))
 				helpers.Relax(err)
 
 				galleries, err = g.GetGalleries()
@@ -192,7 +233,7 @@ func (g *Gallery) Action(command string, content string, msg *discordgo.Message,
 				var err error
 				galleries, err = g.GetGalleries()
 				helpers.RelaxLog(err)
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.gallery.refreshed-config"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.gallery.refreshed-config"))
 				helpers.Relax(err)
 			})
 		}
@@ -202,85 +243,222 @@ func (g *Gallery) Action(command string, content string, msg *discordgo.Message,
 func (g *Gallery) OnMessage(content string, msg *discordgo.Message, session *discordgo.Session) {
 	go func() {
 		defer helpers.Recover()
-	TryNextGallery:
 		for _, gallery := range galleries {
-			if gallery.SourceChannelID == msg.ChannelID {
-				// ignore bot messages
-				if msg.Author.Bot == true {
-					continue TryNextGallery
-				}
-				sourceChannel, err := helpers.GetChannel(msg.ChannelID)
-				helpers.Relax(err)
-				// ignore commands
-				prefix := helpers.GetPrefixForServer(sourceChannel.GuildID)
-				if prefix != "" {
-					if strings.HasPrefix(content, prefix) {
-						return
-					}
-				}
-				var linksToRepost []string
-				// get mirror attachements
-				if len(msg.Attachments) > 0 {
-					for _, attachement := range msg.Attachments {
-						linksToRepost = append(linksToRepost, attachement.URL)
-					}
-				}
-				// get mirror links
-				if strings.Contains(msg.Content, "http") {
-					linksFound := galleryUrlRegex.FindAllString(msg.Content, -1)
-					if len(linksFound) > 0 {
-						for _, linkFound := range linksFound {
-							if strings.HasPrefix(linkFound, "<") == false && strings.HasSuffix(linkFound, ">") == false {
-								linksToRepost = append(linksToRepost, linkFound)
-							}
-						}
-					}
+			if gallery.SourceChannelID != msg.ChannelID || gallery.MinReactions > 0 {
+				continue
+			}
+
+			g.repost(gallery, msg)
+		}
+	}()
+}
+
+// repost copies the media of a message to all targets of the gallery
+func (g *Gallery) repost(gallery models.GalleryEntry, msg *discordgo.Message) {
+	// ignore bot messages
+	if msg.Author == nil || msg.Author.Bot == true {
+		return
+	}
+	// ignore commands
+	prefix := helpers.GetPrefixForServer(gallery.GuildID)
+	if prefix != "" {
+		if strings.HasPrefix(msg.Content, prefix) {
+			return
+		}
+	}
+
+	linksToRepost := g.linksToRepost(gallery, msg)
+	if len(linksToRepost) <= 0 {
+		return
+	}
+
+	for _, targetChannelID := range gallery.Targets() {
+		// check if we have target channel
+		_, err := helpers.GetChannelWithoutApi(targetChannelID)
+		if err != nil {
+			continue
+		}
+		// get webhook
+		webhook, err := helpers.GetWebhook(gallery.GuildID, targetChannelID)
+		if err != nil && !strings.Contains(err.Error(), "no permission to manage webhooks") {
+			helpers.Relax(err)
+		}
+		// post mirror links
+		for _, linkToRepost := range linksToRepost {
+			var newMessage *discordgo.Message
+			if webhook != nil && webhook.ID != "" && webhook.Token != "" {
+				newMessage, err = helpers.WebhookExecuteWithResult(
+					webhook.ID,
+					webhook.Token,
+					&discordgo.WebhookParams{
+						Content:   g.caption(gallery, msg, linkToRepost, true),
+						Username:  msg.Author.Username,
+						AvatarURL: helpers.GetAvatarUrl(msg.Author),
+					},
+				)
+				if err != nil {
+					helpers.RelaxLog(err)
+					continue
 				}
-				// check if we have target channel
-				_, err = helpers.GetChannelWithoutApi(gallery.TargetChannelID)
+			} else {
+				newMessages, err := helpers.SendMessage(targetChannelID, g.caption(gallery, msg, linkToRepost, false))
 				if err != nil {
-					continue TryNextGallery
+					helpers.RelaxLog(err)
+					continue
 				}
-				// get webhook
-				webhook, err := helpers.GetWebhook(gallery.GuildID, gallery.TargetChannelID)
-				if err != nil && !strings.Contains(err.Error(), "no permission to manage webhooks") {
-					helpers.Relax(err)
+				newMessage = newMessages[0]
+			}
+			err = g.rememberPostedMessage(msg, newMessage)
+			helpers.RelaxLog(err)
+			metrics.GalleryPostsSent.Add(1)
+		}
+	}
+}
+
+// linksToRepost returns the attachments and links of a message which pass the filters of the gallery
+func (g *Gallery) linksToRepost(gallery models.GalleryEntry, msg *discordgo.Message) (linksToRepost []string) {
+	// get mirror attachements
+	for _, attachement := range msg.Attachments {
+		mediaType := galleryMediaType(attachement.URL)
+		if mediaType == models.GalleryMediaTypeLinks {
+			mediaType = models.GalleryMediaTypeFiles
+		}
+		if len(gallery.MediaTypes) > 0 && !helpers.StringSliceContains(mediaType, gallery.MediaTypes) {
+			continue
+		}
+		linksToRepost = append(linksToRepost, attachement.URL)
+	}
+	// get mirror links
+	if strings.Contains(msg.Content, "http") {
+		for _, linkFound := range galleryUrlRegex.FindAllString(msg.Content, -1) {
+			if strings.HasPrefix(linkFound, "<") || strings.HasSuffix(linkFound, ">") {
+				continue
+			}
+			if len(gallery.MediaTypes) > 0 && !helpers.StringSliceContains(galleryMediaType(linkFound), gallery.MediaTypes) {
+				continue
+			}
+			if len(gallery.LinkDomains) > 0 && !galleryLinkHasDomain(linkFound, gallery.LinkDomains) {
+				continue
+			}
+			linksToRepost = append(linksToRepost, linkFound)
+		}
+	}
+	return linksToRepost
+}
+
+// caption returns the message for a reposted link, webhook messages already show the author as their name
+func (g *Gallery) caption(gallery models.GalleryEntry, msg *discordgo.Message, link string, webhook bool) string {
+	if gallery.Caption == "" {
+		if webhook {
+			return fmt.Sprintf("posted %s in <#%s>", link, gallery.SourceChannelID)
+		}
+		return fmt.Sprintf("%s posted %s in <#%s>", msg.Author.Username, link, gallery.SourceChannelID)
+	}
+
+	caption := strings.NewReplacer(
+		"{AUTHOR}", msg.Author.Username,
+		"{CHANNEL}", "<#"+gallery.SourceChannelID+">",
+		"{JUMP_URL}", helpers.MessageDeeplink(msg.ChannelID, msg.ID),
+		"{LINK}", link,
+	).Replace(gallery.Caption)
+	if !strings.Contains(gallery.Caption, "{LINK}") {
+		caption += "\n" + link
+	}
+	return caption
+}
+
+// applyOptions sets the options of a gallery, for example types=images,videos reactions=3
+// caption takes the rest of the arguments
+func (g *Gallery) applyOptions(gallery *models.GalleryEntry, args []string) (err error) {
+	for i, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			return errGalleryOptionInvalid
+		}
+
+		switch strings.ToLower(parts[0]) {
+		case "types", "type":
+			gallery.MediaTypes = nil
+			for _, mediaType := range strings.Split(strings.ToLower(parts[1]), ",") {
+				if mediaType == "" {
+					continue
 				}
-				// post mirror links
-				if len(linksToRepost) > 0 {
-					for _, linkToRepost := range linksToRepost {
-						var newMessage *discordgo.Message
-						if webhook != nil && webhook.ID != "" && webhook.Token != "" {
-							newMessage, err = helpers.WebhookExecuteWithResult(
-								webhook.ID,
-								webhook.Token,
-								&discordgo.WebhookParams{
-									Content:   fmt.Sprintf("posted %s in <#%s>", linkToRepost, gallery.SourceChannelID),
-									Username:  msg.Author.Username,
-									AvatarURL: helpers.GetAvatarUrl(msg.Author),
-								},
-							)
-							if err != nil {
-								helpers.RelaxLog(err)
-								continue
-							}
-						} else {
-							newMessages, err := helpers.SendMessage(gallery.TargetChannelID,
-								fmt.Sprintf("%s posted %s in <#%s>", msg.Author.Username, linkToRepost, gallery.SourceChannelID))
-							if err != nil {
-								helpers.RelaxLog(err)
-								continue
-							}
-							newMessage = newMessages[0]
-						}
-						err = g.rememberPostedMessage(msg, newMessage)
-						helpers.RelaxLog(err)
-						metrics.GalleryPostsSent.Add(1)
-					}
+				if !helpers.StringSliceContains(mediaType, galleryMediaTypes) {
+					return errGalleryOptionInvalid
 				}
+				gallery.MediaTypes = append(gallery.MediaTypes, mediaType)
 			}
+		case "domains", "domain":
+			gallery.LinkDomains = nil
+			for _, domain := range strings.Split(strings.ToLower(parts[1]), ",") {
+				domain = strings.TrimPrefix(strings.TrimSpace(domain), "www.")
+				if domain != "" {
+					gallery.LinkDomains = append(gallery.LinkDomains, domain)
+				}
+			}
+		case "reactions":
+			gallery.MinReactions, err = strconv.Atoi(parts[1])
+			if err != nil || gallery.MinReactions < 0 {
+				return errGalleryOptionInvalid
+			}
+		case "caption":
+			gallery.Caption = strings.TrimSpace(strings.Join(append([]string{parts[1]}, args[i+1:]...), " "))
+			return nil
+		default:
+			return errGalleryOptionInvalid
 		}
-	}()
+	}
+	return nil
+}
+
+// describeOptions returns the options of a gallery in the format of the add command
+func (g *Gallery) describeOptions(gallery models.GalleryEntry) string {
+	var options []string
+	if len(gallery.MediaTypes) > 0 {
+		options = append(options, "types="+strings.Join(gallery.MediaTypes, ","))
+	}
+	if len(gallery.LinkDomains) > 0 {
+		options = append(options, "domains="+strings.Join(gallery.LinkDomains, ","))
+	}
+	if gallery.MinReactions > 0 {
+		options = append(options, "reactions="+strconv.Itoa(gallery.MinReactions))
+	}
+	if gallery.Caption != "" {
+		options = append(options, "caption="+gallery.Caption)
+	}
+	return strings.Join(options, " ")
+}
+
+// galleryMediaType returns images or videos for links to them by their file extension, otherwise links
+func galleryMediaType(link string) string {
+	parsedURL, err := url.Parse(strings.Trim(link, "<>"))
+	if err != nil {
+		return models.GalleryMediaTypeLinks
+	}
+
+	switch strings.ToLower(path.Ext(parsedURL.Path)) {
+	case ".jpg", ".jpeg", ".png", ".gif", ".webp", ".bmp":
+		return models.GalleryMediaTypeImages
+	case ".mp4", ".webm", ".mov", ".m4v":
+		return models.GalleryMediaTypeVideos
+	}
+	return models.GalleryMediaTypeLinks
+}
+
+// galleryLinkHasDomain returns true if the link is on one of the domains or their subdomains
+func galleryLinkHasDomain(link string, domains []string) bool {
+	parsedURL, err := url.Parse(strings.Trim(link, "<>"))
+	if err != nil {
+		return false
+	}
+
+	host := strings.ToLower(parsedURL.Hostname())
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
 }
 
 type Gallery_PostedMessage struct {
@@ -353,7 +531,48 @@ func (g *Gallery) GetGalleries() (galleries []models.GalleryEntry, err error) {
 }
 
 func (g *Gallery) OnReactionAdd(reaction *discordgo.MessageReactionAdd, session *discordgo.Session) {
+	go func() {
+		defer helpers.Recover()
+
+		var message *discordgo.Message
+		for _, gallery := range galleries {
+			if gallery.SourceChannelID != reaction.ChannelID || gallery.MinReactions <= 0 {
+				continue
+			}
+
+			// the state does not keep the reactions up to date
+			if message == nil {
+				var err error
+				message, err = session.ChannelMessage(reaction.ChannelID, reaction.MessageID)
+				if err != nil {
+					helpers.RelaxLog(err)
+					return
+				}
+			}
+
+			var reactions int
+			for _, messageReaction := range message.Reactions {
+				reactions += messageReaction.Count
+			}
+			if reactions < gallery.MinReactions {
+				continue
+			}
+
+			// every post is only copied once per gallery
+			set, err := cache.GetRedisClient().SetNX(
+				fmt.Sprintf("robyul2-discord:gallery:reposted:%s:%s", gallery.ID.Hex(), message.ID), true, time.Hour*24*7,
+			).Result()
+			if err != nil {
+				helpers.RelaxLog(err)
+				continue
+			}
+			if !set {
+				continue
+			}
 
+			g.repost(gallery, message)
+		}
+	}()
 }
 func (g *Gallery) OnReactionRemove(reaction *discordgo.MessageReactionRemove, session *discordgo.Session) {
 
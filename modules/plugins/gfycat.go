@@ -40,7 +40,7 @@ func (m *Gfycat) Action(command string, content string, msg *discordgo.Message,
 	session.ChannelTyping(msg.ChannelID)
 
 	if len(content) <= 0 && len(msg.Attachments) <= 0 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
@@ -77,7 +77,7 @@ func (m *Gfycat) Action(command string, content string, msg *discordgo.Message,
 	)))
 	if err != nil {
 		if strings.Contains(err.Error(), "unexpected end of JSON input") {
-			helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetTextF("bot.errors.general", "Gfycat Error")+"\nPlease check the link or try again later.")
+			helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetMessageTextF(msg, "bot.errors.general", "Gfycat Error")+"\nPlease check the link or try again later.")
 			cache.GetLogger().WithField("module", "gfycat").Errorf("Gfycat Error: %s", err.Error())
 			return
 		}
@@ -113,7 +113,7 @@ func (m *Gfycat) Action(command string, content string, msg *discordgo.Message,
 			}
 		}
 		if errorMessage == "" {
-			_, err = helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetTextF("bot.errors.general", "Gfycat Error")+"\nPlease check the link or try again later.")
+			_, err = helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetMessageTextF(msg, "bot.errors.general", "Gfycat Error")+"\nPlease check the link or try again later.")
 			cache.GetLogger().WithField("module", "gfycat").Errorf("Gfycat Error: %s", jsonResult.String())
 		} else {
 			_, err = helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+fmt.Sprintf("Error: `%s`.", errorMessage))
@@ -135,7 +135,7 @@ CheckGfycatStatusLoop:
 		rawResult, err := helpers.NetGetUAWithError(statusGfycatEndpoint, helpers.DEFAULT_UA)
 		if err != nil {
 			if strings.Contains(err.Error(), "Expected status 200; Got 504") {
-				_, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetTextF("bot.errors.general", "Gfycat Status Error")+"\nPlease check the link or try again later.")
+				_, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetMessageTextF(msg, "bot.errors.general", "Gfycat Status Error")+"\nPlease check the link or try again later.")
 				helpers.Relax(err)
 
 				if processMessages != nil && len(processMessages) > 0 {
@@ -149,7 +149,7 @@ CheckGfycatStatusLoop:
 		result, err := gabs.ParseJSON(rawResult)
 		if err != nil {
 			if strings.Contains(err.Error(), "unexpected end of JSON input") {
-				_, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetTextF("bot.errors.general", "Gfycat Parsing Error")+"\nPlease check the link or try again later.")
+				_, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetMessageTextF(msg, "bot.errors.general", "Gfycat Parsing Error")+"\nPlease check the link or try again later.")
 				helpers.Relax(err)
 
 				if processMessages != nil && len(processMessages) > 0 {
@@ -173,7 +173,7 @@ CheckGfycatStatusLoop:
 			break CheckGfycatStatusLoop
 		default:
 			cache.GetLogger().WithField("module", "gfycat").Errorf("Gfycat Status Error: %s (ID: %s)", result.String(), gfyName)
-			_, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetTextF("bot.errors.general", "Gfycat Status Error")+"\nPlease check the link or try again later.")
+			_, err := helpers.SendMessage(msg.ChannelID, fmt.Sprintf("<@%s> ", msg.Author.ID)+helpers.GetMessageTextF(msg, "bot.errors.general", "Gfycat Status Error")+"\nPlease check the link or try again later.")
 			helpers.Relax(err)
 
 			if processMessages != nil && len(processMessages) > 0 {
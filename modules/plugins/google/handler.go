@@ -57,7 +57,7 @@ func (h *Handler) actionSearch(args []string, in *discordgo.Message, out **disco
 
 	parts := strings.Split(in.Content, " ")
 	if len(parts) < 2 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
@@ -71,14 +71,14 @@ func (h *Handler) actionSearch(args []string, in *discordgo.Message, out **disco
 	results, err := search(query, nsfw, nil)
 	if err != nil {
 		if strings.Contains(err.Error(), "no search results") {
-			*out = h.newMsg("plugins.google.search-no-results")
+			*out = h.newMsg(in, "plugins.google.search-no-results")
 			return h.actionFinish
 		}
 	}
 	helpers.Relax(err)
 
 	if len(results) <= 0 {
-		*out = h.newMsg("plugins.google.search-no-results")
+		*out = h.newMsg(in, "plugins.google.search-no-results")
 		return h.actionFinish
 	}
 
@@ -87,7 +87,7 @@ func (h *Handler) actionSearch(args []string, in *discordgo.Message, out **disco
 	quitChannel <- 0
 
 	*out = &discordgo.MessageSend{
-		Content: helpers.GetText("<" + GoogleFriendlyUrl + "?" + getSearchQueries(query, nsfw, true) + ">"),
+		Content: helpers.GetMessageText(in, "<"+GoogleFriendlyUrl+"?"+getSearchQueries(query, nsfw, true)+">"),
 		Embed:   embed,
 	}
 	return h.actionFinish
@@ -100,7 +100,7 @@ func (h *Handler) actionImageSearch(args []string, in *discordgo.Message, out **
 
 	parts := strings.Split(in.Content, " ")
 	if len(parts) < 2 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
@@ -114,14 +114,14 @@ func (h *Handler) actionImageSearch(args []string, in *discordgo.Message, out **
 	results, err := imageSearch(query, nsfw, nil)
 	if err != nil {
 		if strings.Contains(err.Error(), "no search results") {
-			*out = h.newMsg("plugins.google.search-no-results")
+			*out = h.newMsg(in, "plugins.google.search-no-results")
 			return h.actionFinish
 		}
 	}
 	helpers.Relax(err)
 
 	if len(results) <= 0 {
-		*out = h.newMsg("plugins.google.search-no-results")
+		*out = h.newMsg(in, "plugins.google.search-no-results")
 		return h.actionFinish
 	}
 
@@ -130,7 +130,7 @@ func (h *Handler) actionImageSearch(args []string, in *discordgo.Message, out **
 	quitChannel <- 0
 
 	*out = &discordgo.MessageSend{
-		Content: helpers.GetText("<" + GoogleFriendlyUrl + "?" + getImageSearchQuries(query, nsfw, true) + ">"),
+		Content: helpers.GetMessageText(in, "<"+GoogleFriendlyUrl+"?"+getImageSearchQuries(query, nsfw, true)+">"),
 		Embed:   embed,
 	}
 	return h.actionFinish
@@ -143,9 +143,9 @@ func (h *Handler) actionFinish(args []string, in *discordgo.Message, out **disco
 	return nil
 }
 
-func (h *Handler) newMsg(content string, replacements ...interface{}) *discordgo.MessageSend {
+func (h *Handler) newMsg(in *discordgo.Message, content string, replacements ...interface{}) *discordgo.MessageSend {
 	if len(replacements) < 1 {
-		return &discordgo.MessageSend{Content: helpers.GetText(content)}
+		return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 	}
-	return &discordgo.MessageSend{Content: helpers.GetTextF(content, replacements...)}
+	return &discordgo.MessageSend{Content: helpers.GetMessageTextF(in, content, replacements...)}
 }
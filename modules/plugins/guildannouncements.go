@@ -40,7 +40,7 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 
 	args := strings.Fields(content)
 	if len(args) < 1 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
@@ -49,13 +49,13 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 	case "guild_join", "join":
 		helpers.RequireAdmin(msg, func() {
 			if len(args) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 
 			targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
 			if err != nil || targetChannel.ID == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 
@@ -77,7 +77,7 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 					helpers.MDbDelete(models.GreeterTable, entryBucket.Id)
 				}
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.guildannouncements.message-disabled"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.guildannouncements.message-disabled"))
 				helpers.Relax(err)
 				return
 			}
@@ -106,20 +106,20 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 				}, false)
 			helpers.RelaxLog(err)
 
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.guildannouncements.message-edited"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.guildannouncements.message-edited"))
 			helpers.Relax(err)
 		})
 		// [p]greeter leave <#channel or channel id> <embed code>
 	case "guild_leave", "leave":
 		helpers.RequireAdmin(msg, func() {
 			if len(args) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 
 			targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
 			if err != nil || targetChannel.ID == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 
@@ -141,7 +141,7 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 					helpers.MDbDelete(models.GreeterTable, entryBucket.Id)
 				}
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.guildannouncements.message-disabled"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.guildannouncements.message-disabled"))
 				helpers.Relax(err)
 				return
 			}
@@ -170,19 +170,19 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 				}, false)
 			helpers.RelaxLog(err)
 
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.guildannouncements.message-edited"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.guildannouncements.message-edited"))
 			helpers.Relax(err)
 		})
 	case "ban": // [p]greeter ban <#channel or channel id> <embed code>
 		helpers.RequireAdmin(msg, func() {
 			if len(args) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 
 			targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
 			if err != nil || targetChannel.ID == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 
@@ -204,7 +204,7 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 					helpers.MDbDelete(models.GreeterTable, entryBucket.Id)
 				}
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.guildannouncements.message-disabled"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.guildannouncements.message-disabled"))
 				helpers.Relax(err)
 				return
 			}
@@ -233,7 +233,7 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 				}, false)
 			helpers.RelaxLog(err)
 
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.guildannouncements.message-edited"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.guildannouncements.message-edited"))
 			helpers.Relax(err)
 		})
 	case "list":
@@ -248,7 +248,7 @@ func (m *GuildAnnouncements) Action(command string, content string, msg *discord
 			helpers.Relax(err)
 
 			if entryBucket == nil || len(entryBucket) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.guildannouncements.list-none")) // TODO
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.guildannouncements.list-none")) // TODO
 				return
 			}
 
@@ -45,7 +45,7 @@ func addAlias(msg *discordgo.Message, content string) {
 	// validate arguments
 	commandArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
@@ -61,7 +61,7 @@ func addAlias(msg *discordgo.Message, content string) {
 		return
 	}
 
-	helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+	helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 }
 
 // addIdolAlias will add an alias for a idol
@@ -166,7 +166,7 @@ func deleteIdolAlias(msg *discordgo.Message, commandArgs []string) {
 
 	var targetIdol *Idol
 	if _, _, targetIdol = GetMatchingIdolAndGroup(targetGroup, targetName, false); targetIdol == nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-matching-idol"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-matching-idol"))
 		return
 	}
 
@@ -267,7 +267,7 @@ func listAliases(msg *discordgo.Message, content string) {
 		listNameAliases(msg, contentArgs[2], contentArgs[3])
 		break
 	default:
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 
 	}
 }
@@ -278,7 +278,7 @@ func listNameAliases(msg *discordgo.Message, targetGroup string, targetName stri
 
 	var targetIdol *Idol
 	if _, _, targetIdol = GetMatchingIdolAndGroup(targetGroup, targetName, true); targetIdol == nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-matching-idol"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-matching-idol"))
 		return
 	}
 
@@ -312,7 +312,7 @@ func listNameAliasesByGroup(msg *discordgo.Message, targetGroup string) {
 
 	var realGroupName string
 	if _, realGroupName = GetMatchingGroup(targetGroup, true); realGroupName == "" {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-matching-group"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-matching-group"))
 		return
 	}
 
@@ -131,7 +131,7 @@ func (i *Module) Action(command string, content string, msg *discordgo.Message,
 		case "alias":
 
 			if len(commandArgs) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 
@@ -150,7 +150,7 @@ func (i *Module) Action(command string, content string, msg *discordgo.Message,
 					// validate arguments
 					commandArgs, err := helpers.ToArgv(content)
 					if err != nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 						return
 					}
 
@@ -163,11 +163,11 @@ func (i *Module) Action(command string, content string, msg *discordgo.Message,
 						deleteIdolAlias(msg, commandArgs)
 						return
 					}
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				})
 				break
 			default:
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			}
 		}
 	} else if command == "sug-edit" || command == "s-edit" { // edit is used for changing details of suggestions
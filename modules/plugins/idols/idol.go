@@ -372,14 +372,14 @@ func updateGroupInfo(msg *discordgo.Message, content string) {
 
 	contentArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	contentArgs = contentArgs[1:]
 
 	// confirm amount of args
 	if len(contentArgs) != 2 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
@@ -424,14 +424,14 @@ func updateIdolInfoFromMsg(msg *discordgo.Message, content string) {
 
 	contentArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	contentArgs = contentArgs[1:]
 
 	// confirm amount of args
 	if len(contentArgs) < 5 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
@@ -564,14 +564,14 @@ func updateImageInfo(msg *discordgo.Message, content string) {
 
 	contentArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	contentArgs = contentArgs[1:]
 
 	// confirm amount of args
 	if len(contentArgs) < 4 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
@@ -724,14 +724,14 @@ IdolsLoop:
 func deleteImage(msg *discordgo.Message, content string) {
 	contentArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	contentArgs = contentArgs[1:]
 
 	// confirm amount of args
 	if len(contentArgs) != 1 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
@@ -843,13 +843,13 @@ func showImagesForIdol(msg *discordgo.Message, msgContent string, showObjectName
 
 	commandArgs, err := helpers.ToArgv(msgContent)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	commandArgs = commandArgs[1:]
 
 	if len(commandArgs) < 2 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
@@ -857,7 +857,7 @@ func showImagesForIdol(msg *discordgo.Message, msgContent string, showObjectName
 	//  if we can't get one display an error
 	groupMatch, nameMatch, matchIdol := GetMatchingIdolAndGroup(commandArgs[0], commandArgs[1], true)
 	if matchIdol == nil || groupMatch == false || nameMatch == false {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-matching-idol"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-matching-idol"))
 		return
 	}
 
@@ -57,7 +57,7 @@ func validateImages(msg *discordgo.Message, content string) {
 
 	contentArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
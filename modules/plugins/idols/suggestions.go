@@ -94,7 +94,7 @@ func processImageSuggestion(msg *discordgo.Message, msgContent string) {
 
 	suggestionArgs, err := helpers.ToArgv(msgContent)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 	suggestionArgs = suggestionArgs[1:]
@@ -104,14 +104,14 @@ func processImageSuggestion(msg *discordgo.Message, msgContent string) {
 	// validate suggestion arg amount.
 	if len(msg.Attachments) == 1 {
 		if len(suggestionArgs) != 3 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.biasgame.suggestion.invalid-suggestion",
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.biasgame.suggestion.invalid-suggestion",
 				helpers.GetPrefixForServer(channel.GuildID), helpers.GetPrefixForServer(channel.GuildID)))
 			return
 		}
 		suggestedImageUrl = msg.Attachments[0].URL
 	} else {
 		if len(suggestionArgs) != 4 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.biasgame.suggestion.invalid-suggestion",
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.biasgame.suggestion.invalid-suggestion",
 				helpers.GetPrefixForServer(channel.GuildID), helpers.GetPrefixForServer(channel.GuildID)))
 			return
 		}
@@ -121,21 +121,21 @@ func processImageSuggestion(msg *discordgo.Message, msgContent string) {
 	// set gender to lowercase and check if its valid
 	suggestionArgs[0] = strings.ToLower(suggestionArgs[0])
 	if suggestionArgs[0] != "girl" && suggestionArgs[0] != "boy" {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.biasgame.suggestion.invalid-suggestion",
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.biasgame.suggestion.invalid-suggestion",
 			helpers.GetPrefixForServer(channel.GuildID), helpers.GetPrefixForServer(channel.GuildID)))
 		return
 	}
 
 	// confirm user can upload pictures
 	if helpers.UseruploadsIsDisabled(msg.Author.ID) {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.useruploads-disabled"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.useruploads-disabled"))
 		return
 	}
 
 	// validate url image
 	resp, err := pester.Get(suggestedImageUrl)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.suggestion.invalid-url"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.suggestion.invalid-url"))
 		return
 	}
 
@@ -145,32 +145,32 @@ func processImageSuggestion(msg *discordgo.Message, msgContent string) {
 
 	// make sure image is png or jpeg
 	if resp.Header.Get("Content-type") != "image/png" && resp.Header.Get("Content-type") != "image/jpeg" {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.suggestion.not-png-or-jpeg"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.suggestion.not-png-or-jpeg"))
 		return
 	}
 
 	// attempt to decode the image, if we can't there may be something wrong with the image submitted
 	suggestedImage, _, errr := image.Decode(resp.Body)
 	if errr != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.suggestion.invalid-url"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.suggestion.invalid-url"))
 		return
 	}
 
 	// Check height and width are equal
 	if suggestedImage.Bounds().Dy() != suggestedImage.Bounds().Dx() {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.suggestion.image-not-square"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.suggestion.image-not-square"))
 		return
 	}
 
 	// Validate size of image
 	if suggestedImage.Bounds().Dy() > MAX_IMAGE_SIZE || suggestedImage.Bounds().Dy() < MIN_IMAGE_SIZE {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.suggestion.invalid-image-size"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.suggestion.invalid-image-size"))
 		return
 	}
 
 	// validate group and idol name have no double quotes or underscores
 	if strings.ContainsAny(suggestionArgs[1]+suggestionArgs[2], "\"_") {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.suggestion.invalid-group-or-idol"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.suggestion.invalid-group-or-idol"))
 		return
 	}
 
@@ -191,7 +191,7 @@ func processImageSuggestion(msg *discordgo.Message, msgContent string) {
 
 			// if the difference is 1 or less let the user know the image already exists
 			if compareVal <= 1 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.suggestion.suggested-image-exists"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.suggestion.suggested-image-exists"))
 				return
 			}
 		}
@@ -208,7 +208,7 @@ func processImageSuggestion(msg *discordgo.Message, msgContent string) {
 
 		// if the difference is 1 or less let the user know the image already exists
 		if compareVal <= 1 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.suggestion.image-is-suggested"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.suggestion.image-is-suggested"))
 			return
 		}
 	}
@@ -230,7 +230,7 @@ func processImageSuggestion(msg *discordgo.Message, msgContent string) {
 	helpers.Relax(err)
 
 	// send ty message
-	helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.biasgame.suggestion.thanks-for-suggestion", msg.Author.Mention()))
+	helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.biasgame.suggestion.thanks-for-suggestion", msg.Author.Mention()))
 
 	// create suggetion
 	suggestion := &models.IdolSuggestionEntry{
@@ -738,7 +738,7 @@ func getSuggestionDenialInput(channelID string) (int, error) {
 
 	for {
 		userInputChan := make(chan *discordgo.MessageCreate)
-		cache.GetSession().AddHandlerOnce(func(_ *discordgo.Session, e *discordgo.MessageCreate) {
+		cache.AddHandlerOnce(func(_ *discordgo.Session, e *discordgo.MessageCreate) {
 			userInputChan <- e
 		})
 
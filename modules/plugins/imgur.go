@@ -37,14 +37,14 @@ func (i *Imgur) Action(command string, content string, msg *discordgo.Message, s
 	}
 
 	if sourceUrl == "" {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
 	sourceData, err := helpers.NetGetUAWithError(sourceUrl, helpers.DEFAULT_UA)
 	if err != nil {
 		if strings.Contains(err.Error(), "unsupported protocol scheme") {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
@@ -53,12 +53,12 @@ func (i *Imgur) Action(command string, content string, msg *discordgo.Message, s
 	newLink, err := helpers.UploadImage(sourceData)
 	if err != nil {
 		if strings.Contains(err.Error(), "Invalid URL") {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
 	helpers.Relax(err)
 
-	_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.imgur.success", newLink))
+	_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.imgur.success", newLink))
 	helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 }
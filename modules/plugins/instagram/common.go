@@ -1,5 +1,10 @@
 package instagram
 
+import (
+	"net/url"
+	"strings"
+)
+
 func (m *Handler) getBestDisplayResource(imageCandidates []InstagramDisplayResource) string {
 	var lastBestCandidate InstagramDisplayResource
 	if imageCandidates != nil && len(imageCandidates) > 0 {
@@ -16,3 +21,22 @@ func (m *Handler) getBestDisplayResource(imageCandidates []InstagramDisplayResou
 
 	return lastBestCandidate.Src
 }
+
+func (m *Handler) retryOnError(err error) (retry bool) {
+	if err != nil {
+		if _, ok := err.(*url.Error); ok ||
+			strings.Contains(err.Error(), "net/http") ||
+			strings.Contains(err.Error(), "expected status 200; got 429") ||
+			strings.Contains(err.Error(), "Please wait a few minutes before you try again.") ||
+			strings.Contains(err.Error(), "expected status 200; got 500") ||
+			strings.Contains(err.Error(), "expected status 200; got 502") ||
+			strings.Contains(err.Error(), "expected status 200; got 503") ||
+			strings.Contains(err.Error(), "tls: bad record MAC") ||
+			strings.Contains(err.Error(), "unexpected EOF") ||
+			strings.Contains(err.Error(), "page was load incorrectly") ||
+			strings.Contains(err.Error(), "read: connection reset by peer") {
+			return true
+		}
+	}
+	return false
+}
@@ -9,11 +9,12 @@ import (
 	"time"
 
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/feeds"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
 	humanize "github.com/dustin/go-humanize"
-	"github.com/globalsign/mgo/bson"
+	"github.com/globalsign/mgo"
 )
 
 type Handler struct{}
@@ -37,15 +38,10 @@ func (m *Handler) Commands() []string {
 }
 
 func (m *Handler) Init(session *discordgo.Session) {
-	go func() {
-		defer helpers.Recover()
-
-		go func() {
-			defer helpers.Recover()
-			m.checkInstagramPublicFeedLoop()
-		}()
-		cache.GetLogger().WithField("module", "instagram").Info("Started Instagram GraphQl Feed loop")
-	}()
+	err := feeds.Register(&instagramSource{m: m})
+	if err != nil {
+		cache.GetLogger().WithField("module", "instagram").WithError(err).Error("failed to register the instagram feed source")
+	}
 }
 
 func (m *Handler) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
@@ -66,108 +62,43 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 				if len(args) >= 3 {
 					targetChannel, err = helpers.GetChannelFromMention(msg, args[2])
 					if err != nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 					return
 				}
 				targetGuild, err = helpers.GetGuild(targetChannel.GuildID)
 				helpers.Relax(err)
-				// proxy
-				proxy, err := helpers.GetRandomProxy()
-				helpers.Relax(err)
-				// get instagram account
-				instagramUsername := strings.Replace(args[1], "@", "", 1)
-			RetryUserInfo:
-				instagramUser, _, err := m.getInformationAndPosts(instagramUsername, proxy)
-				if err != nil || instagramUser.IsPrivate {
-					if err != nil && m.retryOnError(err) {
-						proxy, err = helpers.GetRandomProxy()
-						helpers.Relax(err)
-						goto RetryUserInfo
-					}
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.instagram.account-not-found"))
-					return
-				}
-				// Create DB Entries
-				dbPosts := make([]models.InstagramPostEntry, 0)
-				/*
-					// gather story if logged in
-					if instagramClient != nil && instagramClient.IsLoggedIn {
-						accoundIdInt, err := strconv.Atoi(instagramUser.ID)
-						helpers.Relax(err)
-						story, err := instagramClient.GetUserStories(int64(accoundIdInt))
-						if err != nil {
-							if err != nil && strings.Contains(err.Error(), "Please wait a few minutes before you try again.") {
-								helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.instagram.ratelimited"))
-								return
-							}
-						}
-						helpers.Relax(err)
-						for _, reelMedia := range story.Reel.Items {
-							postEntry := models.InstagramPostEntry{
-								ID:            reelMedia.ID,
-								Type:          models.InstagramPostTypeReel,
-								CreatedAtTime: time.Unix(int64(reelMedia.DeviceTimestamp), 0),
-							}
-							dbPosts = append(dbPosts, postEntry)
-						}
-					}
-				*/
 				// create new entry in db
 				var specialText string
-				postMode := models.InstagramSendPostTypeRobyulEmbed
+				postMode := models.FeedPostModeEmbed
 				if strings.HasSuffix(content, " direct link mode") ||
 					strings.HasSuffix(content, " link mode") ||
 					strings.HasSuffix(content, " links") {
-					postMode = models.InstagramSendPostTypeDirectLinks
+					postMode = models.FeedPostModeText
 					specialText += " using direct links"
 				}
 
-				newID, err := helpers.MDbInsert(
-					models.InstagramTable,
-					models.InstagramEntry{
-						GuildID:               targetChannel.GuildID,
-						ChannelID:             targetChannel.ID,
-						Username:              instagramUser.Username,
-						InstagramUserIDString: instagramUser.ID,
-						PostedPosts:           dbPosts,
-						IsLive:                false,
-						SendPostType:          postMode,
-						LastPostCheck:         time.Now(),
-					},
-				)
+				entry, err := AddFeed(models.FeedEntry{
+					GuildID:       targetChannel.GuildID,
+					ChannelID:     targetChannel.ID,
+					AddedByUserID: msg.Author.ID,
+					PostMode:      postMode,
+				}, args[1])
+				if err == ErrInstagramAccountNotFound {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.instagram.account-not-found"))
+					return
+				}
+				if err == feeds.ErrAlreadyAdded {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.feeds.add-already-added", targetChannel.ID))
+					return
+				}
 				helpers.Relax(err)
 
-				_, err = helpers.EventlogLog(time.Now(), targetChannel.GuildID, helpers.MdbIdToHuman(newID),
-					models.EventlogTargetTypeRobyulInstagramFeed, msg.Author.ID,
-					models.EventlogTypeRobyulInstagramFeedAdd, "",
-					nil,
-					[]models.ElasticEventlogOption{
-						{
-							Key:   "instagram_channelid",
-							Value: targetChannel.ID,
-							Type:  models.EventlogTargetTypeChannel,
-						},
-						{
-							Key:   "instagram_sendposttype",
-							Value: strconv.Itoa(int(postMode)),
-						},
-						{
-							Key:   "instagram_instagramuserid",
-							Value: instagramUser.ID,
-						},
-						{
-							Key:   "instagram_instagramusername",
-							Value: instagramUser.Username,
-						},
-					}, false)
-				helpers.RelaxLog(err)
-
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.instagram.account-added-success", instagramUser.Username, targetChannel.ID, specialText))
-				cache.GetLogger().WithField("module", "instagram").Info(fmt.Sprintf("Added Instagram Account @%s to Channel %s (#%s) on Guild %s (#%s)", instagramUser.Username, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.instagram.account-added-success", entry.Target, targetChannel.ID, specialText))
+				cache.GetLogger().WithField("module", "instagram").Info(fmt.Sprintf("Added Instagram Account @%s to Channel %s (#%s) on Guild %s (#%s)", entry.Target, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID))
 			})
 		case "delete", "del", "remove": // [p]instagram delete <id>
 			helpers.RequireMod(msg, func() {
@@ -178,64 +109,38 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 					helpers.Relax(err)
 
 					entryId := args[1]
-					var entryBucket models.InstagramEntry
-					err = helpers.MdbOne(
-						helpers.MdbCollection(models.InstagramTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(entryId)}),
-						&entryBucket,
-					)
+					entryBucket, err := feeds.Get(channel.GuildID, entryId)
+					if err == nil && entryBucket.Source != "instagram" {
+						err = mgo.ErrNotFound
+					}
 
 					if err != nil {
 						if helpers.IsMdbNotFound(err) {
-							helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.instagram.account-delete-not-found-error"))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.instagram.account-delete-not-found-error"))
 							return
 						}
 						helpers.Relax(err)
 					}
 
-					err = helpers.MDbDelete(models.InstagramTable, entryBucket.ID)
-
-					_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
-						models.EventlogTargetTypeRobyulInstagramFeed, msg.Author.ID,
-						models.EventlogTypeRobyulInstagramFeedRemove, "",
-						nil,
-						[]models.ElasticEventlogOption{
-							{
-								Key:   "instagram_channelid",
-								Value: entryBucket.ChannelID,
-								Type:  models.EventlogTargetTypeChannel,
-							},
-							{
-								Key:   "instagram_sendposttype",
-								Value: strconv.Itoa(int(entryBucket.SendPostType)),
-							},
-							{
-								Key:   "instagram_instagramuserid",
-								Value: entryBucket.InstagramUserIDString,
-							},
-							{
-								Key:   "instagram_instagramusername",
-								Value: entryBucket.Username,
-							},
-						}, false)
-					helpers.RelaxLog(err)
+					err = RemoveFeed(entryBucket, msg.Author.ID)
+					helpers.Relax(err)
 
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.instagram.account-delete-success", entryBucket.Username))
-					cache.GetLogger().WithField("module", "instagram").Info(fmt.Sprintf("Deleted Instagram Account @%s", entryBucket.Username))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.instagram.account-delete-success", entryBucket.Target))
+					cache.GetLogger().WithField("module", "instagram").Info(fmt.Sprintf("Deleted Instagram Account @%s", entryBucket.Target))
 
 				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 			})
 		case "list": // [p]instagram list
 			currentChannel, err := helpers.GetChannel(msg.ChannelID)
 			helpers.Relax(err)
-			var entryBucket []models.InstagramEntry
-			err = helpers.MDbIter(helpers.MdbCollection(models.InstagramTable).Find(bson.M{"guildid": currentChannel.GuildID})).All(&entryBucket)
+			entryBucket, err := feeds.GetAll(currentChannel.GuildID, "instagram")
 			helpers.Relax(err)
 
 			if entryBucket == nil || len(entryBucket) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.instagram.account-list-no-accounts-error"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.instagram.account-list-no-accounts-error"))
 				return
 			} else if err != nil {
 				helpers.Relax(err)
@@ -244,12 +149,18 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 			resultMessage := ""
 			for _, entry := range entryBucket {
 				var directLinkModeText string
-				if entry.SendPostType == models.InstagramSendPostTypeDirectLinks {
+				if entry.PostMode == models.FeedPostModeText {
 					directLinkModeText = " (direct link mode)"
 				}
+				if entry.MentionRoleID != "" {
+					directLinkModeText += fmt.Sprintf(" mentioning <@&%s>", entry.MentionRoleID)
+				}
+				if entry.Template != "" {
+					directLinkModeText += " using a template"
+				}
 
 				resultMessage += fmt.Sprintf("`%s`: Instagram Account `@%s` posting to <#%s>%s\n",
-					helpers.MdbIdToHuman(entry.ID), entry.Username, entry.ChannelID, directLinkModeText)
+					helpers.MdbIdToHuman(entry.ID), entry.Target, entry.ChannelID, directLinkModeText)
 			}
 			resultMessage += fmt.Sprintf("Found **%d** Instagram Accounts in total.", len(entryBucket))
 			for _, resultPage := range helpers.Pagify(resultMessage, "\n") {
@@ -264,34 +175,33 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 				helpers.Relax(err)
 
 				if len(args) < 2 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 
 				entryId := args[1]
-				var entryBucket models.InstagramEntry
-				err = helpers.MdbOne(
-					helpers.MdbCollection(models.InstagramTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(entryId)}),
-					&entryBucket,
-				)
+				entryBucket, err := feeds.Get(channel.GuildID, entryId)
+				if err == nil && entryBucket.Source != "instagram" {
+					err = mgo.ErrNotFound
+				}
 
 				if err != nil {
 					if helpers.IsMdbNotFound(err) {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 						return
 					}
 					helpers.Relax(err)
 				}
 
-				beforeValue := entryBucket.SendPostType
+				beforeValue := sendPostType(entryBucket)
 
 				var messageText string
-				if entryBucket.SendPostType == models.InstagramSendPostTypeRobyulEmbed {
-					entryBucket.SendPostType = models.InstagramSendPostTypeDirectLinks
-					messageText = helpers.GetText("plugins.instagram.post-direct-links-enabled")
+				if entryBucket.PostMode != models.FeedPostModeText {
+					entryBucket.PostMode = models.FeedPostModeText
+					messageText = helpers.GetMessageText(msg, "plugins.instagram.post-direct-links-enabled")
 				} else {
-					entryBucket.SendPostType = models.InstagramSendPostTypeRobyulEmbed
-					messageText = helpers.GetText("plugins.instagram.post-direct-links-disabled")
+					entryBucket.PostMode = models.FeedPostModeEmbed
+					messageText = helpers.GetMessageText(msg, "plugins.instagram.post-direct-links-disabled")
 				}
 
 				_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
@@ -301,7 +211,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 						{
 							Key:      "instagram_sendposttype",
 							OldValue: strconv.Itoa(int(beforeValue)),
-							NewValue: strconv.Itoa(int(entryBucket.SendPostType)),
+							NewValue: strconv.Itoa(int(sendPostType(entryBucket))),
 						},
 					},
 					[]models.ElasticEventlogOption{
@@ -312,25 +222,151 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 						},
 						{
 							Key:   "instagram_sendposttype",
-							Value: strconv.Itoa(int(entryBucket.SendPostType)),
+							Value: strconv.Itoa(int(sendPostType(entryBucket))),
 						},
 						{
 							Key:   "instagram_instagramuserid",
-							Value: entryBucket.InstagramUserIDString,
+							Value: entryBucket.Options[instagramOptionUserID],
 						},
 						{
 							Key:   "instagram_instagramusername",
-							Value: entryBucket.Username,
+							Value: entryBucket.Target,
 						},
 					}, false)
 				helpers.RelaxLog(err)
 
-				err = helpers.MDbUpdate(models.InstagramTable, entryBucket.ID, entryBucket)
+				err = feeds.Update(entryBucket)
 				helpers.Relax(err)
 
 				helpers.SendMessage(msg.ChannelID, messageText)
 				return
 			})
+		case "filter": // [p]instagram filter <id> [<setting> [<value>]]
+			helpers.RequireMod(msg, func() {
+				if len(args) < 2 {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+
+				channel, err := helpers.GetChannel(msg.ChannelID)
+				helpers.Relax(err)
+
+				entryBucket, err := feeds.Get(channel.GuildID, args[1])
+				if err == nil && entryBucket.Source != "instagram" {
+					err = mgo.ErrNotFound
+				}
+				if helpers.IsMdbNotFound(err) {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.instagram.account-delete-not-found-error"))
+					return
+				}
+				helpers.Relax(err)
+
+				beforeValue := feeds.DescribeFilter(entryBucket.Filter)
+				reply, changed := feeds.FilterCommand(helpers.GetMessageLocale(msg), &entryBucket.Filter,
+					feeds.SourceFilterOptions("instagram"), entryBucket.TargetName, args[2:])
+				if changed {
+					err = feeds.Update(entryBucket)
+					helpers.Relax(err)
+
+					_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
+						models.EventlogTargetTypeRobyulInstagramFeed, msg.Author.ID,
+						models.EventlogTypeRobyulInstagramFeedUpdate, "",
+						[]models.ElasticEventlogChange{
+							{
+								Key:      "instagram_filter",
+								OldValue: beforeValue,
+								NewValue: feeds.DescribeFilter(entryBucket.Filter),
+							},
+						},
+						[]models.ElasticEventlogOption{
+							{
+								Key:   "instagram_channelid",
+								Value: entryBucket.ChannelID,
+								Type:  models.EventlogTargetTypeChannel,
+							},
+							{
+								Key:   "instagram_instagramuserid",
+								Value: entryBucket.Options[instagramOptionUserID],
+							},
+							{
+								Key:   "instagram_instagramusername",
+								Value: entryBucket.Target,
+							},
+						}, false)
+					helpers.RelaxLog(err)
+				}
+
+				_, err = helpers.SendMessage(msg.ChannelID, reply)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			})
+		case "template", "mention": // [p]instagram template <id> [<template>|reset] or [p]instagram mention <id> [<role>]
+			helpers.RequireMod(msg, func() {
+				if len(args) < 2 {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+
+				channel, err := helpers.GetChannel(msg.ChannelID)
+				helpers.Relax(err)
+
+				entryBucket, err := feeds.Get(channel.GuildID, args[1])
+				if err == nil && entryBucket.Source != "instagram" {
+					err = mgo.ErrNotFound
+				}
+				if helpers.IsMdbNotFound(err) {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.instagram.account-delete-not-found-error"))
+					return
+				}
+				helpers.Relax(err)
+
+				var reply, key, beforeValue, afterValue string
+				var changed bool
+				if args[0] == "template" {
+					key, beforeValue = "instagram_template", entryBucket.Template
+					reply, changed = feeds.TemplateCommand(helpers.GetMessageLocale(msg), &entryBucket.Template,
+						entryBucket.TargetName, strings.Join(args[2:], " "))
+					afterValue = entryBucket.Template
+				} else {
+					key, beforeValue = "instagram_mentionroleid", entryBucket.MentionRoleID
+					reply, changed = feeds.MentionCommand(helpers.GetMessageLocale(msg), channel.GuildID, &entryBucket.MentionRoleID,
+						entryBucket.TargetName, strings.Join(args[2:], " "))
+					afterValue = entryBucket.MentionRoleID
+				}
+				if changed {
+					err = feeds.Update(entryBucket)
+					helpers.Relax(err)
+
+					_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
+						models.EventlogTargetTypeRobyulInstagramFeed, msg.Author.ID,
+						models.EventlogTypeRobyulInstagramFeedUpdate, "",
+						[]models.ElasticEventlogChange{
+							{
+								Key:      key,
+								OldValue: beforeValue,
+								NewValue: afterValue,
+							},
+						},
+						[]models.ElasticEventlogOption{
+							{
+								Key:   "instagram_channelid",
+								Value: entryBucket.ChannelID,
+								Type:  models.EventlogTargetTypeChannel,
+							},
+							{
+								Key:   "instagram_instagramuserid",
+								Value: entryBucket.Options[instagramOptionUserID],
+							},
+							{
+								Key:   "instagram_instagramusername",
+								Value: entryBucket.Target,
+							},
+						}, false)
+					helpers.RelaxLog(err)
+				}
+
+				_, err = helpers.SendMessage(msg.ChannelID, reply)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			})
 		default:
 			session.ChannelTyping(msg.ChannelID)
 			instagramUsername := strings.Replace(args[0], "@", "", 1)
@@ -340,7 +376,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 
 			instagramUser, _, err := m.getInformationAndPosts(instagramUsername, proxy)
 			if err != nil {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.instagram.account-not-found"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.instagram.account-not-found"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			}
@@ -362,13 +398,13 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 			*/
 
 			accountEmbed := &discordgo.MessageEmbed{
-				Title:     helpers.GetTextF("plugins.instagram.account-embed-title", instagramUser.FullName, instagramUser.Username, instagramNameModifier),
+				Title:     helpers.GetMessageTextF(msg, "plugins.instagram.account-embed-title", instagramUser.FullName, instagramUser.Username, instagramNameModifier),
 				URL:       fmt.Sprintf(instagramFriendlyUser, instagramUser.Username),
 				Thumbnail: &discordgo.MessageEmbedThumbnail{URL: instagramUser.ProfilePicUrl},
 				Footer: &discordgo.MessageEmbedFooter{
-					Text: helpers.GetTextF("plugins.instagram.account-embed-footer", instagramUser.ID) + " | " +
-						helpers.GetText("plugins.instagram.embed-footer"),
-					IconURL: helpers.GetText("plugins.instagram.embed-footer-imageurl"),
+					Text: helpers.GetMessageTextF(msg, "plugins.instagram.account-embed-footer", instagramUser.ID) + " | " +
+						helpers.GetMessageText(msg, "plugins.instagram.embed-footer"),
+					IconURL: helpers.GetMessageText(msg, "plugins.instagram.embed-footer-imageurl"),
 				},
 				Description: instagramUser.Biography,
 				Fields: []*discordgo.MessageEmbedField{
@@ -393,6 +429,82 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 			return
 		}
 	} else {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
+	}
+}
+
+// AddFeed adds an Instagram feed, used by the instagram command and the REST API
+func AddFeed(entry models.FeedEntry, username string) (models.FeedEntry, error) {
+	instagramUser, err := new(Handler).lookupAccount(username)
+	if err != nil {
+		return entry, err
 	}
+
+	entry.Options = map[string]string{instagramOptionUserID: instagramUser.ID}
+	entry, err = feeds.AddEntry("instagram", instagramUser.Username, entry)
+	if err != nil {
+		return entry, err
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulInstagramFeed, entry.AddedByUserID,
+		models.EventlogTypeRobyulInstagramFeedAdd, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "instagram_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "instagram_sendposttype",
+				Value: strconv.Itoa(int(sendPostType(entry))),
+			},
+			{
+				Key:   "instagram_instagramuserid",
+				Value: instagramUser.ID,
+			},
+			{
+				Key:   "instagram_instagramusername",
+				Value: entry.Target,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return entry, nil
+}
+
+// RemoveFeed removes an Instagram feed, used by the instagram command and the REST API
+func RemoveFeed(entry models.FeedEntry, userID string) (err error) {
+	err = feeds.Remove(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulInstagramFeed, userID,
+		models.EventlogTypeRobyulInstagramFeedRemove, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "instagram_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "instagram_sendposttype",
+				Value: strconv.Itoa(int(sendPostType(entry))),
+			},
+			{
+				Key:   "instagram_instagramuserid",
+				Value: entry.Options[instagramOptionUserID],
+			},
+			{
+				Key:   "instagram_instagramusername",
+				Value: entry.Target,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return nil
 }
@@ -1,16 +1,10 @@
 package instagram
 
 import (
-	"sync"
-
 	"strings"
 
 	"time"
 
-	"github.com/Seklfreak/Robyul2/cache"
-	"github.com/Seklfreak/Robyul2/helpers"
-	"github.com/Seklfreak/Robyul2/models"
-	"github.com/bwmarrin/discordgo"
 	"github.com/pkg/errors"
 )
 
@@ -156,11 +150,6 @@ type Instagram_Broadcast struct {
 	ViewerCount          int    `json:"viewer_count"`
 }
 
-type Instagram_Safe_Entries struct {
-	entries []models.InstagramEntry
-	mux     sync.Mutex
-}
-
 type InstagramPublicProfileFeed struct {
 	EntryData struct {
 		ProfilePage []struct {
@@ -337,51 +326,6 @@ type InstagramAuthorInformations struct {
 	Biography     string
 }
 
-func (m *Handler) getBundledEntries() (bundledEntries map[string][]models.InstagramEntry, entriesCount int, err error) {
-	var entries []models.InstagramEntry
-
-	err = helpers.MDbIter(helpers.MdbCollection(models.InstagramTable).Find(nil)).All(&entries)
-
-	bundledEntries = make(map[string][]models.InstagramEntry, 0)
-
-	for _, entry := range entries {
-		if entry.Username == "" {
-			continue
-		}
-
-		channel, err := helpers.GetChannelWithoutApi(entry.ChannelID)
-		if err != nil || channel == nil || channel.ID == "" {
-			//cache.GetLogger().WithField("module", "instagram").Infof("skipped instagram @%s for Channel #%s on Guild #%s: channel not found!",
-			//	entry.Username, entry.ChannelID, entry.ServerID)
-			continue
-		}
-
-		// check if we can send messages
-		channelPermission, err := cache.GetSession().State.UserChannelPermissions(cache.GetSession().State.User.ID, channel.ID)
-		if err != nil {
-			continue
-		}
-
-		if channelPermission&discordgo.PermissionSendMessages != discordgo.PermissionSendMessages {
-			continue
-		}
-
-		if entry.SendPostType == models.InstagramSendPostTypeRobyulEmbed {
-			if channelPermission&discordgo.PermissionEmbedLinks != discordgo.PermissionEmbedLinks {
-				continue
-			}
-		}
-
-		if _, ok := bundledEntries[entry.Username]; ok {
-			bundledEntries[entry.Username] = append(bundledEntries[entry.Username], entry)
-		} else {
-			bundledEntries[entry.Username] = []models.InstagramEntry{entry}
-		}
-	}
-
-	return bundledEntries, len(entries), nil
-}
-
 func (m *Handler) extractInstagramSharedData(pageContent string) (sharedData string, err error) {
 	parts := strings.Split(pageContent, "window._sharedData = ")
 
@@ -2,16 +2,38 @@ package instagram
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 
 	"github.com/Seklfreak/Robyul2/emojis"
+	"github.com/Seklfreak/Robyul2/feeds"
 	"github.com/Seklfreak/Robyul2/helpers"
-	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
 )
 
-func (m *Handler) postPostToChannel(channelID string, post InstagramPostInformation, postType models.InstagramSendPostType) {
+// postToFeedPost converts a post for the feeds framework
+func (m *Handler) postToFeedPost(post InstagramPostInformation) (feedPost feeds.Post) {
+	feedPost = feeds.Post{
+		ID:     post.ID,
+		URL:    fmt.Sprintf(instagramFriendlyPost, post.Shortcode),
+		Text:   post.Caption,
+		Author: post.Author.Username,
+		Type:   "picture",
+		Time:   post.TakentAt,
+		Raw:    post,
+	}
+	if post.IsVideo {
+		feedPost.Type = "video"
+		if len(post.MediaUrls) > 0 {
+			feedPost.VideoURL = post.MediaUrls[0]
+		}
+	} else {
+		feedPost.ImageURLs = post.MediaUrls
+	}
+	return feedPost
+}
+
+// postEmbed returns the embed of a post
+func (m *Handler) postEmbed(post InstagramPostInformation) *discordgo.MessageEmbed {
 	instagramNameModifier := ""
 	if post.Author.IsVerified {
 		instagramNameModifier += " ☑"
@@ -28,7 +50,6 @@ func (m *Handler) postPostToChannel(channelID string, post InstagramPostInformat
 		mediaModifier = fmt.Sprintf("Album (%d items)", len(post.MediaUrls))
 	}
 
-	content := []string{""}
 	channelEmbed := &discordgo.MessageEmbed{
 		Title:     helpers.GetTextF("plugins.instagram.post-embed-title", post.Author.FullName, post.Author.Username, instagramNameModifier, mediaModifier),
 		URL:       fmt.Sprintf(instagramFriendlyPost, post.Shortcode),
@@ -40,59 +61,17 @@ func (m *Handler) postPostToChannel(channelID string, post InstagramPostInformat
 		Description: post.Caption,
 		Color:       helpers.GetDiscordColorFromHex(hexColor),
 	}
-	if postType == models.InstagramSendPostTypeDirectLinks {
-		content[0] += "**" + helpers.GetTextF("plugins.instagram.post-embed-title", post.Author.FullName, post.Author.Username, instagramNameModifier, mediaModifier) + "** _" + helpers.GetText("plugins.instagram.embed-footer") + "_\n"
-		if post.Caption != "" {
-			content[0] += post.Caption + "\n"
-		}
-	}
-
-	channelEmbed.Image = &discordgo.MessageEmbedImage{URL: post.MediaUrls[0]}
-
-	mediaUrls := make([]string, 0)
-	for _, mediaUrl := range post.MediaUrls {
-		mediaUrls = append(mediaUrls, mediaUrl)
-	}
 
-	content[0] += fmt.Sprintf("<%s>", fmt.Sprintf(instagramFriendlyPost, post.Shortcode))
+	if len(post.MediaUrls) > 0 {
+		channelEmbed.Image = &discordgo.MessageEmbedImage{URL: post.MediaUrls[0]}
 
-	if len(mediaUrls) > 0 {
 		channelEmbed.Description += "\n\n`Links:` "
-		for i, mediaUrl := range mediaUrls {
-			if postType == models.InstagramSendPostTypeDirectLinks {
-				index := int(math.Floor((float64(i+1) / 5.0) - 0.01))
-
-				if index >= len(content) {
-					content = append(content, "")
-				}
-
-				fmt.Println("adding " + mediaUrl + " to content at " + strconv.Itoa(index))
-				content[index] += "\n" + mediaUrl
-			}
+		for i, mediaUrl := range post.MediaUrls {
 			channelEmbed.Description += fmt.Sprintf("[%s](%s) ", emojis.From(strconv.Itoa(i+1)), mediaUrl)
 		}
 	}
 
-	messageSend := &discordgo.MessageSend{
-		Content: content[0],
-	}
-	if postType != models.InstagramSendPostTypeDirectLinks {
-		messageSend.Embed = channelEmbed
-	}
-
-	_, err := helpers.SendComplex(channelID, messageSend)
-	if err != nil {
-		return
-	}
-
-	if len(content) > 1 {
-		for _, text := range content[1:] {
-			_, err = helpers.SendMessage(channelID, text)
-			if err != nil {
-				return
-			}
-		}
-	}
+	return channelEmbed
 }
 
 /*
@@ -112,12 +91,12 @@ func (m *Handler) postLiveToChannel(channelID string, instagramUser Instagram_Us
 	}
 
 	channelEmbed := &discordgo.MessageEmbed{
-		Title:     helpers.GetTextF("plugins.instagram.live-embed-title", instagramUser.FullName, instagramUser.Username, instagramNameModifier),
+		Title:     helpers.GetMessageTextF(msg, "plugins.instagram.live-embed-title", instagramUser.FullName, instagramUser.Username, instagramNameModifier),
 		URL:       fmt.Sprintf(instagramFriendlyUser, instagramUser.Username),
 		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: instagramUser.ProfilePic.URL},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.instagram.embed-footer"),
-			IconURL: helpers.GetText("plugins.instagram.embed-footer-imageurl"),
+			Text:    helpers.GetMessageText(msg, "plugins.instagram.embed-footer"),
+			IconURL: helpers.GetMessageText(msg, "plugins.instagram.embed-footer-imageurl"),
 		},
 		Image: &discordgo.MessageEmbedImage{URL: instagramUser.Broadcast.CoverFrameURL},
 		Color: helpers.GetDiscordColorFromHex(hexColor),
@@ -167,18 +146,18 @@ func (m *Handler) postReelMediaToChannel(channelID string, story goinstaResponse
 
 	var content string
 	channelEmbed := &discordgo.MessageEmbed{
-		Title:     helpers.GetTextF("plugins.instagram.reelmedia-embed-title", story.Reel.User.FullName, story.Reel.User.Username, instagramNameModifier, mediaModifier),
+		Title:     helpers.GetMessageTextF(msg, "plugins.instagram.reelmedia-embed-title", story.Reel.User.FullName, story.Reel.User.Username, instagramNameModifier, mediaModifier),
 		URL:       fmt.Sprintf(instagramFriendlyUser, story.Reel.User.Username),
 		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: story.Reel.User.ProfilePicURL},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.instagram.embed-footer"),
-			IconURL: helpers.GetText("plugins.instagram.embed-footer-imageurl"),
+			Text:    helpers.GetMessageText(msg, "plugins.instagram.embed-footer"),
+			IconURL: helpers.GetMessageText(msg, "plugins.instagram.embed-footer-imageurl"),
 		},
 		Description: caption,
 		Color:       helpers.GetDiscordColorFromHex(hexColor),
 	}
 	if postMode == models.InstagramSendPostTypeDirectLinks {
-		content += "**" + helpers.GetTextF("plugins.instagram.reelmedia-embed-title", story.Reel.User.FullName, story.Reel.User.Username, instagramNameModifier, mediaModifier) + "** _" + helpers.GetText("plugins.instagram.embed-footer") + "_\n"
+		content += "**" + helpers.GetMessageTextF(msg, "plugins.instagram.reelmedia-embed-title", story.Reel.User.FullName, story.Reel.User.Username, instagramNameModifier, mediaModifier) + "** _" + helpers.GetMessageText(msg, "plugins.instagram.embed-footer") + "_\n"
 		if caption != "" {
 			content += caption + "\n"
 		}
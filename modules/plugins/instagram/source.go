@@ -0,0 +1,172 @@
+package instagram
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/feeds"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	InstagramGraphQlWorkers = 15
+	// requests failing because of the proxy are retried with another proxy
+	instagramRetries = 5
+	// the time of the newest post of the last check, only newer posts are downloaded
+	instagramStateLastPost = "lastpost"
+	// the Instagram ID of the account, used for the eventlog
+	instagramOptionUserID = "userid"
+)
+
+var (
+	ErrInstagramAccountNotFound = errors.New("instagram account not found")
+)
+
+// instagramSource is the feeds.Source for the posts of public Instagram accounts
+type instagramSource struct {
+	m *Handler
+}
+
+func (s *instagramSource) Name() string {
+	return "instagram"
+}
+
+func (s *instagramSource) Interval(target string) time.Duration {
+	return time.Minute
+}
+
+func (s *instagramSource) Workers() int {
+	return InstagramGraphQlWorkers
+}
+
+func (s *instagramSource) FilterOptions() feeds.FilterOptions {
+	return feeds.FilterOptions{Types: []string{"picture", "video"}}
+}
+
+// Resolve looks the account up and returns its username as normalised target
+func (s *instagramSource) Resolve(target string) (normalised, name string, err error) {
+	instagramUser, err := s.m.lookupAccount(target)
+	if err != nil {
+		return "", "", err
+	}
+	return instagramUser.Username, "@" + instagramUser.Username, nil
+}
+
+func (s *instagramSource) Fetch(target string, state map[string]string) (posts []feeds.Post, newState map[string]string, err error) {
+	proxy, err := helpers.GetRandomProxy()
+	if err != nil {
+		return nil, state, err
+	}
+
+	var receivedPosts []InstagramShortPostInformation
+	for try := 1; ; try++ {
+		_, receivedPosts, err = s.m.getInformationAndPosts(target, proxy)
+		if err == nil || !s.m.retryOnError(err) || try >= instagramRetries {
+			break
+		}
+		time.Sleep(5 * time.Second)
+		proxy, err = helpers.GetRandomProxy()
+		if err != nil {
+			return nil, state, err
+		}
+	}
+	if err != nil {
+		return nil, state, err
+	}
+
+	var lastPost int64
+	if state != nil {
+		lastPost, _ = strconv.ParseInt(state[instagramStateLastPost], 10, 64)
+	}
+	newLastPost := lastPost
+	for _, receivedPost := range receivedPosts {
+		if receivedPost.CreatedAt.Unix() > newLastPost {
+			newLastPost = receivedPost.CreatedAt.Unix()
+		}
+	}
+	newState = map[string]string{instagramStateLastPost: strconv.FormatInt(newLastPost, 10)}
+
+	for _, receivedPost := range receivedPosts {
+		// the first check only remembers the posts, the details are not needed
+		if state == nil {
+			posts = append(posts, feeds.Post{
+				ID:   receivedPost.ID,
+				URL:  fmt.Sprintf(instagramFriendlyPost, receivedPost.Shortcode),
+				Time: receivedPost.CreatedAt,
+			})
+			continue
+		}
+		if receivedPost.CreatedAt.Unix() <= lastPost {
+			continue
+		}
+
+		// download specific post data
+		var post InstagramPostInformation
+		for try := 1; ; try++ {
+			post, err = s.m.getPostInformation(receivedPost.Shortcode, proxy)
+			if err == nil || !s.m.retryOnError(err) || try >= instagramRetries {
+				break
+			}
+			time.Sleep(5 * time.Second)
+			proxy, err = helpers.GetRandomProxy()
+			if err != nil {
+				return nil, state, err
+			}
+		}
+		if err != nil {
+			if strings.Contains(err.Error(), "expected status 200; got 404") {
+				// post got deleted
+				continue
+			}
+			// keep the old state, so the post is downloaded again on the next check
+			return nil, state, err
+		}
+		posts = append(posts, s.m.postToFeedPost(post))
+	}
+	return posts, newState, nil
+}
+
+func (s *instagramSource) Render(entry models.FeedEntry, post feeds.Post) *discordgo.MessageEmbed {
+	instagramPost, ok := post.Raw.(InstagramPostInformation)
+	if !ok {
+		return feeds.Embed(post)
+	}
+	return s.m.postEmbed(instagramPost)
+}
+
+// lookupAccount returns the information of a public account, private accounts are not found
+func (m *Handler) lookupAccount(username string) (instagramUser InstagramAuthorInformations, err error) {
+	username = strings.Replace(username, "@", "", 1)
+
+	proxy, err := helpers.GetRandomProxy()
+	if err != nil {
+		return instagramUser, err
+	}
+	for try := 1; ; try++ {
+		instagramUser, _, err = m.getInformationAndPosts(username, proxy)
+		if err == nil || !m.retryOnError(err) || try >= instagramRetries {
+			break
+		}
+		proxy, err = helpers.GetRandomProxy()
+		if err != nil {
+			return instagramUser, err
+		}
+	}
+	if err != nil || instagramUser.IsPrivate {
+		return instagramUser, ErrInstagramAccountNotFound
+	}
+	return instagramUser, nil
+}
+
+// sendPostType returns the legacy post type of a feed, used for the eventlog
+func sendPostType(entry models.FeedEntry) models.InstagramSendPostType {
+	if entry.PostMode == models.FeedPostModeText {
+		return models.InstagramSendPostTypeDirectLinks
+	}
+	return models.InstagramSendPostTypeRobyulEmbed
+}
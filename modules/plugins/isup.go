@@ -30,23 +30,23 @@ func (iu *Isup) Action(command string, content string, msg *discordgo.Message, s
 	args := strings.Fields(content)
 
 	if len(args) < 1 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 		return
 	}
 
 	quitChannel := helpers.StartTypingLoop(msg.ChannelID)
 	defer func() { quitChannel <- 0 }()
 
-	text := helpers.GetText("plugins.isup.isnotup")
+	text := helpers.GetMessageText(msg, "plugins.isup.isnotup")
 	status, err := iu.isup(args[0])
 	if err != nil {
 		helpers.RelaxLog(err)
-		text = helpers.GetText("plugins.isup.error")
+		text = helpers.GetMessageText(msg, "plugins.isup.error")
 	} else {
 		if status {
-			text = helpers.GetText("plugins.isup.isup")
+			text = helpers.GetMessageText(msg, "plugins.isup.isup")
 		}
-		text += "\n" + helpers.GetText("plugins.isup.credits")
+		text += "\n" + helpers.GetMessageText(msg, "plugins.isup.credits")
 	}
 
 	quitChannel <- 0
@@ -0,0 +1,141 @@
+package plugins
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/commands"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Language sets the locale Robyul uses for a user or a guild
+type Language struct{}
+
+func (m *Language) Commands() []*commands.Command {
+	return []*commands.Command{
+		{
+			Name:        "language",
+			Aliases:     []string{"lang"},
+			Description: "Sets the language Robyul uses to reply to you, reset uses the language of the server again.",
+			Arguments: []commands.Argument{
+				{Name: "locale", Type: commands.ArgumentString, Optional: true},
+			},
+			Handler: m.actionUser,
+			Subcommands: []*commands.Command{
+				{
+					Name:        "server",
+					Aliases:     []string{"guild"},
+					Description: "Sets the default language for this server.",
+					Arguments: []commands.Argument{
+						{Name: "locale", Type: commands.ArgumentString},
+					},
+					Permission: commands.PermissionAdmin,
+					Handler:    m.actionGuild,
+				},
+				{
+					Name:        "missing",
+					Description: "Lists all texts that are not translated to a language yet.",
+					Arguments: []commands.Argument{
+						{Name: "locale", Type: commands.ArgumentString},
+					},
+					Permission: commands.PermissionBotAdmin,
+					Handler:    m.actionMissing,
+				},
+			},
+		},
+	}
+}
+
+func (m *Language) Init(session *discordgo.Session) {
+}
+
+// [p]language [<locale>|reset]
+func (m *Language) actionUser(ctx *commands.Context) {
+	if !ctx.Has("locale") {
+		guildLocale := helpers.GuildSettingsGetCached(ctx.GuildID).Locale
+		if guildLocale == "" {
+			guildLocale = helpers.DefaultLocale
+		}
+		ctx.SendText("plugins.language.status", ctx.Locale, guildLocale, strings.Join(helpers.SupportedLocales, ", "), ctx.Prefix)
+		return
+	}
+
+	locale := strings.ToLower(ctx.String("locale"))
+	if locale == "reset" {
+		locale = ""
+	}
+	if locale != "" && !helpers.IsSupportedLocale(locale) {
+		ctx.SendText("plugins.language.locale-invalid", strings.Join(helpers.SupportedLocales, ", "))
+		return
+	}
+
+	err := helpers.SetUserLocale(ctx.Msg.Author.ID, locale)
+	helpers.Relax(err)
+
+	newLocale := helpers.GetLocale(ctx.GuildID, ctx.Msg.Author.ID)
+	ctx.Send(helpers.GetTextLF(newLocale, "plugins.language.user-set-success", newLocale))
+}
+
+// [p]language server <locale|reset>
+func (m *Language) actionGuild(ctx *commands.Context) {
+	locale := strings.ToLower(ctx.String("locale"))
+	if locale == "reset" {
+		locale = ""
+	}
+	if locale != "" && !helpers.IsSupportedLocale(locale) {
+		ctx.SendText("plugins.language.locale-invalid", strings.Join(helpers.SupportedLocales, ", "))
+		return
+	}
+
+	settings := helpers.GuildSettingsGetCached(ctx.GuildID)
+	oldLocale := settings.Locale
+	settings.Locale = locale
+	err := helpers.GuildSettingsSet(ctx.GuildID, settings)
+	helpers.Relax(err)
+
+	_, err = helpers.EventlogLog(time.Now(), ctx.GuildID, ctx.GuildID,
+		models.EventlogTargetTypeGuild, ctx.Msg.Author.ID,
+		models.EventlogTypeRobyulLocaleUpdate, "",
+		[]models.ElasticEventlogChange{
+			{
+				Key:      "locale",
+				OldValue: oldLocale,
+				NewValue: settings.Locale,
+			},
+		},
+		nil, false)
+	helpers.RelaxLog(err)
+
+	if locale == "" {
+		locale = helpers.DefaultLocale
+	}
+	ctx.Send(helpers.GetTextLF(locale, "plugins.language.guild-set-success", locale))
+}
+
+// [p]language missing <locale>
+func (m *Language) actionMissing(ctx *commands.Context) {
+	locale := strings.ToLower(ctx.String("locale"))
+	if !helpers.IsSupportedLocale(locale) {
+		ctx.SendText("plugins.language.locale-invalid", strings.Join(helpers.SupportedLocales, ", "))
+		return
+	}
+
+	missing := helpers.MissingTranslations(locale)
+	if len(missing) <= 0 {
+		ctx.SendText("plugins.language.missing-none", locale)
+		return
+	}
+
+	ctx.SendComplex(&discordgo.MessageSend{
+		Content: helpers.GetTextPluralLF(ctx.Locale, "plugins.language.missing-result", len(missing), len(missing), locale),
+		Files: []*discordgo.File{
+			{
+				Name:   "missing-" + locale + ".txt",
+				Reader: bytes.NewReader([]byte(strings.Join(missing, "\n"))),
+			},
+		},
+	})
+}
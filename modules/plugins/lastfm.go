@@ -335,9 +335,9 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				)
 				helpers.Relax(err)
 
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.lastfm.set-username-success", lastfmUsername))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.lastfm.set-username-success", lastfmUsername))
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 		case "np", "nowplaying":
@@ -355,7 +355,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			helpers.Relax(err)
 
 			if lastfmUsername == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
 				return
 			}
 			session.ChannelTyping(msg.ChannelID)
@@ -393,9 +393,9 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			}
 			if lastfmRecentTracks.Total > 0 {
 				lastTrack := lastfmRecentTracks.Tracks[0]
-				lastTrackEmbedTitle := helpers.GetTextF("plugins.lastfm.lasttrack-embed-title-last", lastfmUsername)
+				lastTrackEmbedTitle := helpers.GetMessageTextF(msg, "plugins.lastfm.lasttrack-embed-title-last", lastfmUsername)
 				if lastTrack.NowPlaying == "true" {
-					lastTrackEmbedTitle = helpers.GetTextF("plugins.lastfm.lasttrack-embed-title-np", lastfmUsername)
+					lastTrackEmbedTitle = helpers.GetMessageTextF(msg, "plugins.lastfm.lasttrack-embed-title-np", lastfmUsername)
 				}
 				var heartText string
 				if lastTrack.Loved == "1" {
@@ -408,8 +408,8 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 						helpers.EscapeLinkForMarkdown(lastTrack.Url),
 						heartText),
 					Footer: &discordgo.MessageEmbedFooter{
-						Text:    helpers.GetText("plugins.lastfm.embed-footer"),
-						IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+						Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
+						IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 					},
 					Author: &discordgo.MessageEmbedAuthor{
 						URL:     fmt.Sprintf(lastfmFriendlyUser, lastfmUsername),
@@ -459,12 +459,12 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				_, err = helpers.SendEmbed(msg.ChannelID, lastTrackEmbed)
 				helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lastfm.no-recent-tracks"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lastfm.no-recent-tracks"))
 				return
 			}
 		case "yt", "youtube":
 			if !youtube.HasYouTubeService() {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("lastfm.no-youtube"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "lastfm.no-youtube"))
 				return
 			}
 			if len(args) >= 2 {
@@ -479,7 +479,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			helpers.Relax(err)
 
 			if lastfmUsername == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
 				return
 			}
 			session.ChannelTyping(msg.ChannelID)
@@ -500,7 +500,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 					[]string{lastTrack.Artist.Name, lastTrack.Name}, "video")
 				helpers.RelaxLog(err)
 				if err != nil || searchResult == nil || searchResult.Snippet == nil {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("lastfm.no-youtube"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "lastfm.no-youtube"))
 					return
 				}
 				messageContent := "**" + searchResult.Snippet.Title + "** on " + searchResult.Snippet.ChannelTitle + "\n"
@@ -508,7 +508,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				_, err = helpers.SendMessage(msg.ChannelID, messageContent)
 				helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lastfm.no-recent-tracks"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lastfm.no-recent-tracks"))
 				return
 			}
 		case "topalbums", "topalbum", "tal":
@@ -561,7 +561,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			helpers.Relax(err)
 
 			if lastfmUsername == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
 				return
 			}
 			session.ChannelTyping(msg.ChannelID)
@@ -608,12 +608,12 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 					topAlbumsEmbed := &discordgo.MessageEmbed{
 						Description: description,
 						Footer: &discordgo.MessageEmbedFooter{
-							Text:    helpers.GetText("plugins.lastfm.embed-footer"),
-							IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+							Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
+							IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 						},
 						Color: helpers.GetDiscordColorFromHex(lastfmHexColor),
 						Author: &discordgo.MessageEmbedAuthor{
-							Name: helpers.GetTextF("plugins.lastfm.topalbums-embed-title", lastfmUsername) + " of " + timeString,
+							Name: helpers.GetMessageTextF(msg, "plugins.lastfm.topalbums-embed-title", lastfmUsername) + " of " + timeString,
 							URL:  fmt.Sprintf(lastfmFriendlyUser, lastfmTopAlbums.User),
 						},
 						Image: &discordgo.MessageEmbedImage{
@@ -640,13 +640,13 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				topAlbumsEmbed := &discordgo.MessageEmbed{
 					Description: "of **" + timeString + "**",
 					Footer: &discordgo.MessageEmbedFooter{
-						Text:    helpers.GetText("plugins.lastfm.embed-footer"),
-						IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+						Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
+						IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 					},
 					Fields: []*discordgo.MessageEmbedField{},
 					Color:  helpers.GetDiscordColorFromHex(lastfmHexColor),
 					Author: &discordgo.MessageEmbedAuthor{
-						Name: helpers.GetTextF("plugins.lastfm.topalbums-embed-title", lastfmUsername),
+						Name: helpers.GetMessageTextF(msg, "plugins.lastfm.topalbums-embed-title", lastfmUsername),
 						URL:  fmt.Sprintf(lastfmFriendlyUser, lastfmTopAlbums.User),
 					},
 				}
@@ -667,7 +667,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				_, err = helpers.SendEmbed(msg.ChannelID, topAlbumsEmbed)
 				helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lastfm.no-recent-tracks"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lastfm.no-recent-tracks"))
 				return
 			}
 		case "topartists", "topartist", "top", "ta":
@@ -720,7 +720,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			helpers.Relax(err)
 
 			if lastfmUsername == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
 				return
 			}
 			session.ChannelTyping(msg.ChannelID)
@@ -764,13 +764,13 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 
 					topArtistsEmbed := &discordgo.MessageEmbed{
 						Footer: &discordgo.MessageEmbedFooter{
-							Text:    helpers.GetText("plugins.lastfm.embed-footer"),
-							IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+							Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
+							IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 						},
 						Fields: []*discordgo.MessageEmbedField{},
 						Color:  helpers.GetDiscordColorFromHex(lastfmHexColor),
 						Author: &discordgo.MessageEmbedAuthor{
-							Name: helpers.GetTextF("plugins.lastfm.topartists-embed-title", lastfmUsername) + " of " + timeString,
+							Name: helpers.GetMessageTextF(msg, "plugins.lastfm.topartists-embed-title", lastfmUsername) + " of " + timeString,
 							URL:  fmt.Sprintf(lastfmFriendlyUser, lastfmTopArtists.User),
 						},
 						Image: &discordgo.MessageEmbedImage{
@@ -798,13 +798,13 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				topArtistsEmbed := &discordgo.MessageEmbed{
 					Description: "of **" + timeString + "**",
 					Footer: &discordgo.MessageEmbedFooter{
-						Text:    helpers.GetText("plugins.lastfm.embed-footer"),
-						IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+						Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
+						IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 					},
 					Fields: []*discordgo.MessageEmbedField{},
 					Color:  helpers.GetDiscordColorFromHex(lastfmHexColor),
 					Author: &discordgo.MessageEmbedAuthor{
-						Name: helpers.GetTextF("plugins.lastfm.topartists-embed-title", lastfmUsername),
+						Name: helpers.GetMessageTextF(msg, "plugins.lastfm.topartists-embed-title", lastfmUsername),
 						URL:  fmt.Sprintf(lastfmFriendlyUser, lastfmTopArtists.User),
 					},
 				}
@@ -825,7 +825,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				_, err = helpers.SendEmbed(msg.ChannelID, topArtistsEmbed)
 				helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lastfm.no-recent-tracks"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lastfm.no-recent-tracks"))
 				return
 			}
 		case "toptracks", "topsongs", "toptrack", "topsong", "tt", "ts":
@@ -878,7 +878,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			helpers.Relax(err)
 
 			if lastfmUsername == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
 				return
 			}
 			session.ChannelTyping(msg.ChannelID)
@@ -922,12 +922,12 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 
 					topTracksEmbed := &discordgo.MessageEmbed{
 						Footer: &discordgo.MessageEmbedFooter{
-							Text:    helpers.GetText("plugins.lastfm.embed-footer"),
-							IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+							Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
+							IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 						},
 						Color: helpers.GetDiscordColorFromHex(lastfmHexColor),
 						Author: &discordgo.MessageEmbedAuthor{
-							Name: helpers.GetTextF("plugins.lastfm.toptracks-embed-title", lastfmUsername) + " of " + timeString,
+							Name: helpers.GetMessageTextF(msg, "plugins.lastfm.toptracks-embed-title", lastfmUsername) + " of " + timeString,
 							URL:  fmt.Sprintf(lastfmFriendlyUser, lastfmTopTracks.User),
 						},
 						Image: &discordgo.MessageEmbedImage{
@@ -955,13 +955,13 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				topTracksEmbed := &discordgo.MessageEmbed{
 					Description: "of **" + timeString + "**",
 					Footer: &discordgo.MessageEmbedFooter{
-						Text:    helpers.GetText("plugins.lastfm.embed-footer"),
-						IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+						Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
+						IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 					},
 					Fields: []*discordgo.MessageEmbedField{},
 					Color:  helpers.GetDiscordColorFromHex(lastfmHexColor),
 					Author: &discordgo.MessageEmbedAuthor{
-						Name: helpers.GetTextF("plugins.lastfm.toptracks-embed-title", lastfmUsername),
+						Name: helpers.GetMessageTextF(msg, "plugins.lastfm.toptracks-embed-title", lastfmUsername),
 						URL:  fmt.Sprintf(lastfmFriendlyUser, lastfmTopTracks.User),
 					},
 				}
@@ -982,7 +982,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				_, err = helpers.SendEmbed(msg.ChannelID, topTracksEmbed)
 				helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lastfm.no-recent-tracks"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lastfm.no-recent-tracks"))
 				return
 			}
 		case "discord-top", "server-top", "servertop", "discordtop":
@@ -999,7 +999,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			}
 
 			if combinedStats.GuildID == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lastfm.no-stats-available"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lastfm.no-stats-available"))
 				return
 			}
 
@@ -1036,14 +1036,14 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			}
 
 			topTracksEmbed := &discordgo.MessageEmbed{
-				Title:       helpers.GetTextF("plugins.lastfm.toptracks-embed-title", fmt.Sprintf("%s Server", guild.Name)),
+				Title:       helpers.GetMessageTextF(msg, "plugins.lastfm.toptracks-embed-title", fmt.Sprintf("%s Server", guild.Name)),
 				Description: fmt.Sprintf("of **%s**", timeString),
 				Footer: &discordgo.MessageEmbedFooter{
 					Text: fmt.Sprintf(
 						"%s | %d last.fm users on this server",
-						helpers.GetText("plugins.lastfm.embed-footer"),
+						helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
 						combinedStats.NumberOfUsers),
-					IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+					IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 				},
 				Fields: []*discordgo.MessageEmbedField{},
 				Color:  helpers.GetDiscordColorFromHex(lastfmHexColor),
@@ -1083,7 +1083,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			helpers.Relax(err)
 
 			if lastfmUsername == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.lastfm.too-few", helpers.GetPrefixForServer(channel.GuildID)))
 				return
 			}
 			session.ChannelTyping(msg.ChannelID)
@@ -1101,7 +1101,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			}
 
 			if len(lastfmRecentTracks.Tracks) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lastfm.no-recent-tracks"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lastfm.no-recent-tracks"))
 				return
 			}
 
@@ -1129,12 +1129,12 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 
 			recentsEmbed := &discordgo.MessageEmbed{
 				Footer: &discordgo.MessageEmbedFooter{
-					Text:    helpers.GetText("plugins.lastfm.embed-footer") + playcountText,
-					IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+					Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer") + playcountText,
+					IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 				},
 				Author: &discordgo.MessageEmbedAuthor{
 					URL:     fmt.Sprintf(lastfmFriendlyUser, lastfmUsername),
-					Name:    helpers.GetTextF("plugins.lastfm.recents-embed-title", lastfmUsername),
+					Name:    helpers.GetMessageTextF(msg, "plugins.lastfm.recents-embed-title", lastfmUsername),
 					IconURL: lastfmAvatar,
 				},
 				//Fields: []*discordgo.MessageEmbedField{},
@@ -1197,14 +1197,14 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 				scrobblesCount, err = strconv.Atoi(lastfmUser.PlayCount)
 				helpers.Relax(err)
 			}
-			embedTitle := helpers.GetTextF("plugins.lastfm.profile-embed-title", lastfmUser.Name)
+			embedTitle := helpers.GetMessageTextF(msg, "plugins.lastfm.profile-embed-title", lastfmUser.Name)
 			if lastfmUser.RealName != "" {
-				embedTitle = helpers.GetTextF("plugins.lastfm.profile-embed-title-realname", lastfmUser.RealName, lastfmUser.Name)
+				embedTitle = helpers.GetMessageTextF(msg, "plugins.lastfm.profile-embed-title-realname", lastfmUser.RealName, lastfmUser.Name)
 			}
 			accountEmbed := &discordgo.MessageEmbed{
 				Footer: &discordgo.MessageEmbedFooter{
-					Text:    helpers.GetText("plugins.lastfm.embed-footer"),
-					IconURL: helpers.GetText("plugins.lastfm.embed-footer-imageurl"),
+					Text:    helpers.GetMessageText(msg, "plugins.lastfm.embed-footer"),
+					IconURL: helpers.GetMessageText(msg, "plugins.lastfm.embed-footer-imageurl"),
 				},
 				Fields: []*discordgo.MessageEmbedField{
 					{Name: "Scrobbles", Value: humanize.Comma(int64(scrobblesCount)), Inline: true}},
@@ -1250,7 +1250,7 @@ func (m *LastFm) Action(command string, content string, msg *discordgo.Message,
 			helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 		}
 	} else {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 		return
 	}
 
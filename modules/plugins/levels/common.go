@@ -193,20 +193,39 @@ func cacheTopLoop() {
 	}
 }
 
+// closeExpStackDrained tells Levels.Shutdown that the stack will not be processed anymore
+func closeExpStackDrained() {
+	expStackDrainedOnce.Do(func() {
+		close(expStackDrained)
+	})
+}
+
 func processExpStackLoop() {
 	log := cache.GetLogger()
 
 	defer helpers.Recover()
 	defer func() {
+		// Levels.Shutdown must not wait for a loop which died while shutting down
+		if helpers.IsShuttingDown() {
+			closeExpStackDrained()
+			return
+		}
 		go func() {
+			defer helpers.Recover()
 			log.WithField("module", "levels").Info("The processExpStackLoop died. Please investigate! Will be restarted in 60 seconds")
-			time.Sleep(60 * time.Second)
+			// restarted right away when the bot starts to shut down, so the stack is still drained
+			helpers.SleepUnlessShuttingDown(60 * time.Second)
 			processExpStackLoop()
 		}()
 	}()
 
 	for {
 		metrics.LevelsStackSize.Set(int64(expStack.Size()))
+		// the stack is drained before stopping, Levels.Shutdown waits for it
+		if helpers.IsShuttingDown() && expStack.Empty() {
+			closeExpStackDrained()
+			return
+		}
 		if !expStack.Empty() {
 			expItem := expStack.Pop().(ProcessExpInfo)
 			levelsServerUser, err := getLevelsServerUserOrCreateNewWithoutLogging(expItem.GuildID, expItem.UserID)
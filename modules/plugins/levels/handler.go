@@ -2,6 +2,7 @@ package levels
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"html"
@@ -69,6 +70,9 @@ var (
 	temporaryIgnoredGuilds []string
 
 	expStack = lane.NewStack()
+	// closed once the stack has been processed after the bot started to shut down
+	expStackDrained     = make(chan bool)
+	expStackDrainedOnce sync.Once
 )
 
 func (m *Levels) Commands() []string {
@@ -139,6 +143,15 @@ func (l *Levels) Uninit(session *discordgo.Session) {
 
 }
 
+// Shutdown waits until the EXP of all queued messages has been saved
+func (l *Levels) Shutdown(ctx context.Context) {
+	select {
+	case <-expStackDrained:
+	case <-ctx.Done():
+		cache.GetLogger().WithField("module", "levels").Warnf("dropped EXP of %d messages on shutdown", expStack.Size())
+	}
+}
+
 func (m *Levels) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
 	if !helpers.ModuleIsAllowed(msg.ChannelID, msg.ID, msg.Author.ID, helpers.ModulePermLevels) {
 		return
@@ -160,15 +173,15 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				timeUntil := time.Until(userData.LastRepped.Add(time.Hour * 12))
 				if timeUntil.Minutes() < 1 {
 					helpers.SendMessage(msg.ChannelID,
-						helpers.GetTextF("plugins.levels.rep-next-rep-seconds", int(math.Floor(timeUntil.Seconds()))))
+						helpers.GetMessageTextF(msg, "plugins.levels.rep-next-rep-seconds", int(math.Floor(timeUntil.Seconds()))))
 				} else {
 					helpers.SendMessage(msg.ChannelID,
-						helpers.GetTextF("plugins.levels.rep-next-rep",
+						helpers.GetMessageTextF(msg, "plugins.levels.rep-next-rep",
 							int(math.Floor(timeUntil.Hours())),
 							int(math.Floor(timeUntil.Minutes()))-(int(math.Floor(timeUntil.Hours()))*60)))
 				}
 			} else {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.rep-target"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.rep-target"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			}
 			return
@@ -178,10 +191,10 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 			timeUntil := time.Until(userData.LastRepped.Add(time.Hour * 12))
 			if timeUntil.Minutes() < 1 {
 				helpers.SendMessage(msg.ChannelID,
-					helpers.GetTextF("plugins.levels.rep-error-timelimit-seconds", int(math.Floor(timeUntil.Seconds()))))
+					helpers.GetMessageTextF(msg, "plugins.levels.rep-error-timelimit-seconds", int(math.Floor(timeUntil.Seconds()))))
 			} else {
 				helpers.SendMessage(msg.ChannelID,
-					helpers.GetTextF("plugins.levels.rep-error-timelimit",
+					helpers.GetMessageTextF(msg, "plugins.levels.rep-error-timelimit",
 						int(math.Floor(timeUntil.Hours())),
 						int(math.Floor(timeUntil.Minutes()))-(int(math.Floor(timeUntil.Hours()))*60)))
 			}
@@ -190,24 +203,24 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 		targetUser, err := helpers.GetUserFromMention(args[0])
 		if err != nil || targetUser == nil || targetUser.ID == "" {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
 
 		// Don't rep this bot account, other bots, or oneself
 		if targetUser.ID == session.State.User.ID {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.rep-error-session"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.rep-error-session"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
 		if targetUser.ID == msg.Author.ID {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.rep-error-self"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.rep-error-self"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
 		if targetUser.Bot == true {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.rep-error-bot"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.rep-error-bot"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
@@ -223,7 +236,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 		helpers.Relax(err)
 
 		_, err = helpers.SendMessage(msg.ChannelID,
-			helpers.GetTextF("plugins.levels.rep-success", targetUser.Username))
+			helpers.GetMessageTextF(msg, "plugins.levels.rep-success", targetUser.Username))
 		helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		return
 	case "profile", "gif-profile": // [p]profile
@@ -232,7 +245,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 		if _, ok := activeBadgePickerUserIDs[msg.Author.ID]; ok {
 			if activeBadgePickerUserIDs[msg.Author.ID] != msg.ChannelID {
 				_, err := helpers.SendMessage(
-					msg.ChannelID, helpers.GetTextF("plugins.levels.badge-picker-session-duplicate", helpers.GetPrefixForServer(channel.GuildID)))
+					msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.badge-picker-session-duplicate", helpers.GetPrefixForServer(channel.GuildID)))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			}
 			return
@@ -252,10 +265,10 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				var message string
 				if userUserdata.HideLastFm {
 					userUserdata.HideLastFm = false
-					message = helpers.GetText("plugins.levels.profile-lastfm-shown")
+					message = helpers.GetMessageText(msg, "plugins.levels.profile-lastfm-shown")
 				} else {
 					userUserdata.HideLastFm = true
-					message = helpers.GetText("plugins.levels.profile-lastfm-hidden")
+					message = helpers.GetMessageText(msg, "plugins.levels.profile-lastfm-hidden")
 				}
 				err = helpers.MDbUpdate(models.ProfileUserdataTable, userUserdata.ID, userUserdata)
 				helpers.Relax(err)
@@ -275,7 +288,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				err = helpers.MDbUpdate(models.ProfileUserdataTable, userUserdata.ID, userUserdata)
 				helpers.Relax(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-title-set-success"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-title-set-success"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			case "bio":
@@ -292,9 +305,9 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				err = helpers.MDbUpdate(models.ProfileUserdataTable, userUserdata.ID, userUserdata)
 				helpers.Relax(err)
 
-				message := helpers.GetText("plugins.levels.profile-bio-set-success")
+				message := helpers.GetMessageText(msg, "plugins.levels.profile-bio-set-success")
 				if oldBioText != "" && oldBioText != " " && bioText == " " {
-					message = helpers.GetTextF("plugins.levels.profile-bio-reset-success", oldBioText)
+					message = helpers.GetMessageTextF(msg, "plugins.levels.profile-bio-reset-success", oldBioText)
 				}
 
 				_, err = helpers.SendMessage(msg.ChannelID, message)
@@ -306,17 +319,17 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						userUserdata, err := helpers.GetUserUserdata(msg.Author.ID)
 
 						if userUserdata.Background != "" {
-							helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.new-profile-background-help-withbackground", userUserdata.Background))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.new-profile-background-help-withbackground", userUserdata.Background))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
 						if userUserdata.BackgroundObjectName != "" {
-							helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.new-profile-background-help-withbackground", m.GetProfileBackgroundUrl(userUserdata)))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.new-profile-background-help-withbackground", m.GetProfileBackgroundUrl(userUserdata)))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.new-profile-background-help"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.new-profile-background-help"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -326,7 +339,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 					if helpers.UseruploadsIsDisabled(msg.Author.ID) {
 						quitChannel <- 0
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.useruploads-disabled"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.useruploads-disabled"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -334,7 +347,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					// <= 2 MB, 400x300px?
 					if msg.Attachments[0].Size > 2e+6 || msg.Attachments[0].Width < 400 || msg.Attachments[0].Height < 300 {
 						quitChannel <- 0
-						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.user-background-wrong-dimensions"))
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.user-background-wrong-dimensions"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -348,7 +361,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					// check 400x300px again on Robyul
 					if imageConfig.Width < 400 || imageConfig.Height < 300 {
 						quitChannel <- 0
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.user-background-wrong-dimensions"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.user-background-wrong-dimensions"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -370,7 +383,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								helpers.RelaxLog(err)
 							}
 						}()
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.user-background-not-safe"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.user-background-not-safe"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -381,7 +394,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					}, "levels", true)
 					if err != nil {
 						helpers.RelaxLog(err)
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.user-background-upload-failed"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.user-background-upload-failed"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -413,7 +426,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 					quitChannel <- 0
 					_, err = helpers.SendMessage(msg.ChannelID,
-						helpers.GetTextF("plugins.levels.user-background-success",
+						helpers.GetMessageTextF(msg, "plugins.levels.user-background-success",
 							helpers.GetPrefixForServer(channel.GuildID)))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
@@ -423,7 +436,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				case "force":
 					helpers.RequireRobyulMod(msg, func() {
 						if !((len(args) >= 3 && len(msg.Attachments) > 0) || len(args) >= 4) {
-							helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							return
 						}
 
@@ -443,7 +456,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 						// reject smaller than 400x300px
 						if imageConfig.Width < 400 || imageConfig.Height < 300 {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.user-background-wrong-dimensions"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.user-background-wrong-dimensions"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -460,7 +473,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						}, "levels", true)
 						if err != nil {
 							helpers.RelaxLog(err)
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.user-background-upload-failed"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.user-background-upload-failed"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -479,7 +492,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						helpers.Relax(err)
 
 						_, err = helpers.SendMessage(msg.ChannelID,
-							helpers.GetTextF("plugins.levels.user-force-background-success",
+							helpers.GetMessageTextF(msg, "plugins.levels.user-force-background-success",
 								userToChange.Username))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
@@ -488,7 +501,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				case "reset":
 					helpers.RequireRobyulMod(msg, func() {
 						if len(args) < 3 {
-							helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							return
 						}
 
@@ -502,7 +515,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						helpers.Relax(err)
 
 						_, err = helpers.SendMessage(msg.ChannelID,
-							helpers.GetTextF("plugins.levels.user-reset-success",
+							helpers.GetMessageTextF(msg, "plugins.levels.user-reset-success",
 								userToReset.Username))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
@@ -525,7 +538,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						}
 
 						_, err = helpers.SendMessage(msg.ChannelID,
-							helpers.GetText("plugins.levels.background-setlog-success"))
+							helpers.GetMessageText(msg, "plugins.levels.background-setlog-success"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					})
@@ -533,7 +546,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				case "add":
 					helpers.RequireRobyulMod(msg, func() {
 						if len(args) < 5 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -557,13 +570,13 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						}
 
 						if len(tags) <= 0 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
 
 						if helpers.UseruploadsIsDisabled(msg.Author.ID) {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.useruploads-disabled"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.useruploads-disabled"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -590,7 +603,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							&entryBucket,
 						)
 						if !helpers.IsMdbNotFound(err) {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.new-profile-background-add-error-duplicate"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.new-profile-background-add-error-duplicate"))
 							return
 						}
 
@@ -605,7 +618,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						)
 						helpers.Relax(err)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.new-profile-background-add-success",
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.new-profile-background-add-success",
 							backgroundName, strings.Join(tags, ", ")))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
@@ -614,7 +627,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				case "delete":
 					helpers.RequireRobyulMod(msg, func() {
 						if len(args) < 3 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -626,19 +639,19 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							&entryBucket,
 						)
 						if helpers.IsMdbNotFound(err) {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-background-delete-error-not-found"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-background-delete-error-not-found"))
 							return
 						}
 						backgroundUrl := m.GetProfileBackgroundUrlByName(backgroundName)
 
 						if helpers.ConfirmEmbed(
-							msg.ChannelID, msg.Author, helpers.GetTextF("plugins.levels.profile-background-delete-confirm",
+							msg.ChannelID, msg.Author, helpers.GetMessageTextF(msg, "plugins.levels.profile-background-delete-confirm",
 								backgroundName, backgroundUrl),
 							"✅", "🚫") == true {
 							err = helpers.MDbDelete(models.ProfileBackgroundsTable, entryBucket.ID)
 							helpers.Relax(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-background-delete-success"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-background-delete-success"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						}
 						return
@@ -654,7 +667,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						searchResult := m.ProfileBackgroundSearch(args[1])
 
 						if len(searchResult) <= 0 {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-background-set-error-not-found"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-background-set-error-not-found"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						} else {
 							backgroundNamesText := ""
@@ -662,7 +675,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								backgroundNamesText += "`" + entry.Name + "` "
 							}
 							backgroundNamesText = strings.TrimSpace(backgroundNamesText)
-							resultText := helpers.GetText("plugins.levels.profile-background-set-error-not-found") + "\n"
+							resultText := helpers.GetMessageText(msg, "plugins.levels.profile-background-set-error-not-found") + "\n"
 							resultText += fmt.Sprintf("Maybe I can interest you in one of these backgrounds: %s", backgroundNamesText)
 
 							_, err = helpers.SendMessage(msg.ChannelID, resultText)
@@ -684,7 +697,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					err = helpers.MDbUpdate(models.ProfileUserdataTable, userUserdata.ID, userUserdata)
 					helpers.Relax(err)
 
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-background-set-success"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-background-set-success"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -695,12 +708,12 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						helpers.RequireAdmin(msg, func() {
 							session.ChannelTyping(msg.ChannelID)
 							if len(args) < 7 {
-								helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+								helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 								return
 							}
 
 							if helpers.UseruploadsIsDisabled(msg.Author.ID) {
-								_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.useruploads-disabled"))
+								_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.useruploads-disabled"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -714,7 +727,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							badgeData, err := helpers.NetGetUAWithError(args[4], helpers.DEFAULT_UA)
 							if err != nil {
 								if strings.Contains(err.Error(), "expected status 200; got 404") {
-									helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+									helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 									return
 								}
 							}
@@ -730,7 +743,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							}, "levels", true)
 							if err != nil {
 								if _, ok := err.(*url.Error); ok {
-									helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+									helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 									return
 								}
 							}
@@ -757,14 +770,14 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 									}
 
 									if matchedRole == nil || matchedRole.ID == "" {
-										_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+										_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 										helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 										return
 									}
 
 									newBadge.RoleRequirement = matchedRole.ID
 								} else {
-									_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+									_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 									helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 									return
 								}
@@ -774,7 +787,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 										if helpers.IsBotAdmin(msg.Author.ID) {
 											newBadge.GuildID = "global"
 										} else {
-											_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+											_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 											helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 											return
 										}
@@ -784,7 +797,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 							badgeFound := getBadge(newBadge.Category, newBadge.Name, channel.GuildID)
 							if badgeFound.ID != "" {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.create-badge-error-duplicate"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.create-badge-error-duplicate"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -792,7 +805,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							serverBadges := getServerOnlyBadges(channel.GuildID)
 							badgeLimit := helpers.GetMaxBadgesForGuild(channel.GuildID)
 							if len(serverBadges) >= badgeLimit {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.create-badge-error-too-many", helpers.GetStaffUsernamesText()))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.create-badge-error-too-many", helpers.GetStaffUsernamesText()))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -850,7 +863,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								}, false)
 							helpers.RelaxLog(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.create-badge-success"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.create-badge-success"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						})
@@ -859,7 +872,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						helpers.RequireAdmin(msg, func() {
 							session.ChannelTyping(msg.ChannelID)
 							if len(args) < 4 {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -868,12 +881,12 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 							badgeFound := getBadge(args[2], args[3], channel.GuildID)
 							if badgeFound.ID == "" {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.badge-error-not-found"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.badge-error-not-found"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
 							if badgeFound.GuildID == "global" && !helpers.IsBotAdmin(msg.Author.ID) {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.delete-badge-error-not-allowed"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.delete-badge-error-not-allowed"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -928,7 +941,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								}, false)
 							helpers.RelaxLog(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.delete-badge-success"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.delete-badge-success"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						})
@@ -944,7 +957,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							categoryBadges := getCategoryBadges(categoryName, channel.GuildID)
 
 							if categoryBadges == nil || len(categoryBadges) <= 0 {
-								helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.list-category-badge-error-none"))
+								helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.list-category-badge-error-none"))
 								return
 							}
 
@@ -989,7 +1002,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						serverBadges := getServerBadges(channel.GuildID)
 
 						if len(serverBadges) <= 0 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.list-badge-error-none"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.list-badge-error-none"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -1027,14 +1040,14 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						helpers.RequireMod(msg, func() {
 							session.ChannelTyping(msg.ChannelID)
 							if len(args) < 5 {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
 
 							targetUser, err := helpers.GetUserFromMention(args[2])
 							if err != nil || targetUser.ID == "" {
-								helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+								helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 								return
 							}
 
@@ -1043,13 +1056,13 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 							badgeToAllow := getBadge(args[3], args[4], channel.GuildID)
 							if badgeToAllow.ID == "" {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.badge-error-not-found"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.badge-error-not-found"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
 
 							if badgeToAllow.GuildID == "global" && !helpers.IsBotAdmin(msg.Author.ID) {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.edit-badge-error-not-allowed"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.edit-badge-error-not-allowed"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -1117,7 +1130,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 									}, false)
 								helpers.RelaxLog(err)
 
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.allow-badge-success-allowed",
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.allow-badge-success-allowed",
 									targetUser.Username, badgeToAllow.Name, badgeToAllow.Category))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
@@ -1182,7 +1195,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 									}, false)
 								helpers.RelaxLog(err)
 
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.allow-badge-success-not-allowed",
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.allow-badge-success-not-allowed",
 									targetUser.Username, badgeToAllow.Name, badgeToAllow.Category))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
@@ -1193,14 +1206,14 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						helpers.RequireMod(msg, func() {
 							session.ChannelTyping(msg.ChannelID)
 							if len(args) < 5 {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
 
 							targetUser, err := helpers.GetUserFromMention(args[2])
 							if err != nil || targetUser.ID == "" {
-								helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+								helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 								return
 							}
 
@@ -1209,13 +1222,13 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 							badgeToDeny := getBadge(args[3], args[4], channel.GuildID)
 							if badgeToDeny.ID == "" {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.badge-error-not-found"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.badge-error-not-found"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
 
 							if badgeToDeny.GuildID == "global" && !helpers.IsBotAdmin(msg.Author.ID) {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.edit-badge-error-not-allowed"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.edit-badge-error-not-allowed"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -1283,7 +1296,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 									}, false)
 								helpers.RelaxLog(err)
 
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.deny-badge-success-denied",
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.deny-badge-success-denied",
 									targetUser.Username, badgeToDeny.Name, badgeToDeny.Category))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
@@ -1348,7 +1361,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 									}, false)
 								helpers.RelaxLog(err)
 
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.deny-badge-success-not-denied",
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.deny-badge-success-not-denied",
 									targetUser.Username, badgeToDeny.Name, badgeToDeny.Category))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
@@ -1358,7 +1371,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					case "move": // [p]profile badge move <category name> <badge name> <#>
 						session.ChannelTyping(msg.ChannelID)
 						if len(args) < 5 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -1366,7 +1379,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						badgeName := args[3]
 						newSpot, err := strconv.Atoi(args[4])
 						if err != nil {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -1383,7 +1396,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						}
 
 						if idToMove == "" {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.badge-error-not-found"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.badge-error-not-found"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -1406,7 +1419,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						err = helpers.MDbUpdate(models.ProfileUserdataTable, userData.ID, userData)
 						helpers.Relax(err)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.move-badge-success"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.move-badge-success"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 
 						return
@@ -1417,7 +1430,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				availableBadges := getBadgesAvailable(msg.Author, channel.GuildID)
 
 				if len(availableBadges) <= 0 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.badge-error-none"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.badge-error-none"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -1590,7 +1603,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 			case "color", "colour":
 				session.ChannelTyping(msg.ChannelID)
 				if len(args) < 2 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -1618,20 +1631,20 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						userUserdata.TextColor = ""
 					}
 				default:
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
 				err = helpers.MDbUpdate(models.ProfileUserdataTable, userUserdata.ID, userUserdata)
 				helpers.Relax(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-color-set-success"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-color-set-success"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			case "opacity":
 				session.ChannelTyping(msg.ChannelID)
 				if len(args) < 2 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -1647,7 +1660,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				if len(args) >= 3 {
 					opacity, err := strconv.ParseFloat(args[2], 64)
 					if err != nil {
-						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -1666,7 +1679,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				case "avatar":
 					userUserdata.AvatarOpacity = opacityText
 				default:
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -1674,7 +1687,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				err = helpers.MDbUpdate(models.ProfileUserdataTable, userUserdata.ID, userUserdata)
 				helpers.Relax(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-opacity-set-success"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-opacity-set-success"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			case "timezone":
@@ -1685,14 +1698,14 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				timeInTimezone := ""
 				if len(args) < 2 {
 					if userUserdata.Timezone == "" {
-						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-timezone-list"))
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-timezone-list"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
 				} else {
 					loc, err := time.LoadLocation(args[1])
 					if err != nil {
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-timezone-set-error")+"\n"+helpers.GetText("plugins.levels.profile-timezone-list"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-timezone-set-error")+"\n"+helpers.GetMessageText(msg, "plugins.levels.profile-timezone-list"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -1704,10 +1717,10 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				helpers.Relax(err)
 
 				if timeInTimezone != "" {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.profile-timezone-set-success",
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.profile-timezone-set-success",
 						newTimezoneString, timeInTimezone))
 				} else {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.profile-timezone-reset-success"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.profile-timezone-reset-success"))
 				}
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
@@ -1718,7 +1731,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				if len(args) >= 2 {
 					_, err = time.Parse(TimeBirthdayFormat, args[1])
 					if err != nil {
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-birthday-set-error-format"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-birthday-set-error-format"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -1731,14 +1744,14 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				err = helpers.MDbUpdate(models.ProfileUserdataTable, userUserdata.ID, userUserdata)
 				helpers.Relax(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-birthday-set-success"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-birthday-set-success"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			}
 
 			targetUser, err = helpers.GetUserFromMention(args[0])
 			if targetUser == nil || targetUser.ID == "" {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			}
@@ -1747,7 +1760,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 		targetMember, err := helpers.GetGuildMember(channel.GuildID, targetUser.ID)
 		if errD, ok := err.(*discordgo.RESTError); ok {
 			if errD.Message.Code == 10007 {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			} else {
@@ -1765,7 +1778,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 		jpgBytes, ext, err := m.GetProfile(targetMember, guild, gifP)
 		if err != nil {
 			cache.GetLogger().WithField("module", "levels").Error(fmt.Sprintf("Profile generation failed: %#v", err))
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-error-exit1"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-error-exit1"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
@@ -1782,7 +1795,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 			})
 		if err != nil {
 			if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == 20009 {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.profile-error-sending"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.profile-error-sending"))
 				return
 			}
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
@@ -1810,7 +1823,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				helpers.Relax(err)
 
 				if levelsServersUsers == nil || len(levelsServersUsers) <= 0 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.top-server-no-stats"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.top-server-no-stats"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				} else if err != nil {
@@ -1820,7 +1833,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				rankingUrl := helpers.GetConfig().Path("website.ranking_base_url").Data().(string) + "/" + channel.GuildID
 				topLevelEmbed := &discordgo.MessageEmbed{
 					Color:       0x0FADED,
-					Title:       helpers.GetTextF("plugins.levels.top-server-embed-title", guild.Name),
+					Title:       helpers.GetMessageTextF(msg, "plugins.levels.top-server-embed-title", guild.Name),
 					Description: "View the leaderboard for this server [here](" + rankingUrl + ").",
 					Fields:      []*discordgo.MessageEmbedField{},
 					URL:         rankingUrl,
@@ -1908,7 +1921,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				}
 
 				if len(rankedTotalExpMap) <= 0 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.no-stats-available-yet"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.no-stats-available-yet"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -1916,9 +1929,9 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				rankingUrl := helpers.GetConfig().Path("website.ranking_base_url").Data().(string)
 				globalTopLevelEmbed := &discordgo.MessageEmbed{
 					Color:       0x0FADED,
-					Title:       helpers.GetText("plugins.levels.global-top-server-embed-title"),
+					Title:       helpers.GetMessageText(msg, "plugins.levels.global-top-server-embed-title"),
 					Description: "View the global leaderboard [here](" + rankingUrl + ").",
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetTextF("plugins.levels.embed-footer",
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageTextF(msg, "plugins.levels.embed-footer",
 						len(session.State.Guilds),
 					)},
 					Fields: []*discordgo.MessageEmbedField{},
@@ -1980,7 +1993,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					switch args[1] {
 					case "user": // [p]levels reset user <user>
 						if len(args) < 3 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -1988,7 +2001,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						helpers.RequireAdmin(msg, func() {
 							targetUser, err = helpers.GetUserFromMention(args[2])
 							if targetUser == nil || targetUser.ID == "" {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -2014,7 +2027,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								nil, false)
 							helpers.RelaxLog(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.user-resetted"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.user-resetted"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						})
@@ -2067,7 +2080,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						return
 					case "user": // [p]levels ignore user <user>
 						if len(args) < 3 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2075,7 +2088,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						helpers.RequireAdmin(msg, func() {
 							targetUser, err = helpers.GetUserFromMention(args[2])
 							if targetUser == nil || targetUser.ID == "" {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -2110,7 +2123,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 										}, false)
 									helpers.RelaxLog(err)
 
-									_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.ignore-user-removed"))
+									_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.ignore-user-removed"))
 									helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 									return
 								}
@@ -2140,14 +2153,14 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								}, false)
 							helpers.RelaxLog(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.ignore-user-added", helpers.GetPrefixForServer(channel.GuildID)))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.ignore-user-added", helpers.GetPrefixForServer(channel.GuildID)))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						})
 						return
 					case "channel": // [p]levels ignore channel <channel>
 						if len(args) < 3 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2156,7 +2169,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							targetChannel, err := helpers.GetChannelFromMention(msg, args[2])
 							helpers.Relax(err)
 							if targetChannel == nil || targetChannel.ID == "" {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -2191,7 +2204,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 										}, false)
 									helpers.RelaxLog(err)
 
-									_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.ignore-channel-removed"))
+									_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.ignore-channel-removed"))
 									helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 									return
 								}
@@ -2221,7 +2234,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								}, false)
 							helpers.RelaxLog(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.ignore-channel-added"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.ignore-channel-added"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						})
@@ -2350,7 +2363,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 				return
 			case "role", "roles":
 				if len(args) < 2 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -2360,12 +2373,12 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					helpers.RequireMod(msg, func() {
 						// [p]levels role add <role name or id> <start level> [<last level>]
 						if len(args) < 4 {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
 						if _, err = strconv.Atoi(args[len(args)-1]); err != nil {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2373,7 +2386,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						serverRoles, err := session.GuildRoles(channel.GuildID)
 						if err != nil {
 							if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-								_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.permissions.required", "Manage Roles"))
+								_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.permissions.required", "Manage Roles"))
 								helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 								return
 							}
@@ -2407,7 +2420,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						}
 
 						if targetRole == nil || targetRole.ID == "" || startLevel < 0 || (lastLevel < 0 && lastLevel != -1) || (lastLevel != -1 && startLevel > lastLevel) {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2436,21 +2449,21 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							options, false)
 						helpers.RelaxLog(err)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.levels-role-add-success", targetRole.Name))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.levels-role-add-success", targetRole.Name))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					})
 					return
 				case "apply":
 					// [p]levels role apply
 					helpers.RequireMod(msg, func() {
-						if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetText("plugins.levels.levels-role-apply-confirm"), "✅", "🚫") {
+						if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetMessageText(msg, "plugins.levels.levels-role-apply-confirm"), "✅", "🚫") {
 							errors := make([]error, 0)
 							var success int
 
 							guild, err := helpers.GetGuild(channel.GuildID)
 							helpers.Relax(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.levels-role-apply-start"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.levels-role-apply-start"))
 
 							for _, member := range guild.Members {
 								if member.User.Bot == true {
@@ -2481,7 +2494,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								}, false)
 							helpers.RelaxLog(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.levels-role-apply-result", msg.Author.ID, success, len(errors)))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.levels-role-apply-result", msg.Author.ID, success, len(errors)))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2499,7 +2512,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						}
 
 						if len(entries) <= 0 {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.levels-role-list-empty"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.levels-role-list-empty"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						}
 
@@ -2561,7 +2574,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					// levels role remove <connection id>
 					helpers.RequireMod(msg, func() {
 						if len(args) < 3 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2571,7 +2584,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							if !strings.Contains(err.Error(), "no levels role entry") {
 								helpers.Relax(err)
 							}
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2606,7 +2619,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							options, false)
 						helpers.RelaxLog(err)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.levels-role-delete-success",
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.levels-role-delete-success",
 							role.Name, entry.RoleID))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
@@ -2616,7 +2629,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					// TODO: apply roles on join, show overwrites in list
 					helpers.RequireMod(msg, func() {
 						if len(args) < 4 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2626,7 +2639,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 						targetUser, err := helpers.GetUserFromMention(args[2])
 						if err != nil || targetUser == nil || targetUser.ID == "" {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2641,7 +2654,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						}
 
 						if targetRole == nil || targetRole.ID == "" {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2649,7 +2662,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						previousGrant, previousDeny, grant := m.getLevelsRolesUserRoleOverwrite(guild.ID, targetRole.ID, targetUser.ID)
 
 						if previousDeny {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.roles-grant-error-denying"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.roles-grant-error-denying"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2673,7 +2686,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								}, false)
 							helpers.RelaxLog(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.roles-grant-remove-success",
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.roles-grant-remove-success",
 								targetUser.Username, targetUser.ID, targetRole.Name, targetRole.ID))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
@@ -2698,7 +2711,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							}, false)
 						helpers.RelaxLog(err)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.roles-grant-create-success",
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.roles-grant-create-success",
 							targetUser.Username, targetUser.ID, targetRole.Name, targetRole.ID))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
@@ -2708,7 +2721,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					// [p]levels roles deny <@user or user id> <role name or id>
 					helpers.RequireMod(msg, func() {
 						if len(args) < 4 {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2718,7 +2731,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 						targetUser, err := helpers.GetUserFromMention(args[2])
 						if err != nil || targetUser == nil || targetUser.ID == "" {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2733,7 +2746,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						}
 
 						if targetRole == nil || targetRole.ID == "" {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2741,7 +2754,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						previousGrant, previousDeny, grant := m.getLevelsRolesUserRoleOverwrite(guild.ID, targetRole.ID, targetUser.ID)
 
 						if previousGrant {
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.roles-deny-error-granting"))
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.roles-deny-error-granting"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -2765,7 +2778,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 								}, false)
 							helpers.RelaxLog(err)
 
-							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.roles-deny-remove-success",
+							_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.roles-deny-remove-success",
 								targetUser.Username, targetUser.ID, targetRole.Name, targetRole.ID))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
@@ -2790,7 +2803,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 							}, false)
 						helpers.RelaxLog(err)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.roles-deny-create-success",
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.roles-deny-create-success",
 							targetUser.Username, targetUser.ID, targetRole.Name, targetRole.ID))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
@@ -2798,7 +2811,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 					return
 				}
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			case "set-level-notification", "set-level-notifications", "set-level-noti", "set-level-notis":
@@ -2814,13 +2827,13 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						embedCode := strings.TrimSpace(strings.Replace(content, strings.Join(args[:1], " "), "", 1))
 						if embedCode != "" {
 							guildConfig.LevelsNotificationCode = embedCode
-							message = helpers.GetText("plugins.levels.level-notification-enabled")
+							message = helpers.GetMessageText(msg, "plugins.levels.level-notification-enabled")
 						}
 					}
 
 					if message == "" {
 						guildConfig.LevelsNotificationCode = ""
-						message = helpers.GetText("plugins.levels.level-notification-disabled")
+						message = helpers.GetMessageText(msg, "plugins.levels.level-notification-disabled")
 					}
 
 					err = helpers.GuildSettingsSet(channel.GuildID, guildConfig)
@@ -2844,13 +2857,13 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 						deleteAfterN, err := strconv.Atoi(args[1])
 						if err == nil && deleteAfterN > 0 {
 							guildConfig.LevelsNotificationDeleteAfter = deleteAfterN
-							message = helpers.GetTextF("plugins.levels.level-notification-autodelete-enabled", deleteAfterN)
+							message = helpers.GetMessageTextF(msg, "plugins.levels.level-notification-autodelete-enabled", deleteAfterN)
 						}
 					}
 
 					if message == "" {
 						guildConfig.LevelsNotificationDeleteAfter = 0
-						message = helpers.GetText("plugins.levels.level-notification-autodelete-disabled")
+						message = helpers.GetMessageText(msg, "plugins.levels.level-notification-autodelete-disabled")
 					}
 
 					err = helpers.GuildSettingsSet(channel.GuildID, guildConfig)
@@ -2864,7 +2877,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 			}
 			targetUser, err = helpers.GetUserFromMention(args[0])
 			if targetUser == nil || targetUser.ID == "" {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			}
@@ -2875,7 +2888,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 		helpers.Relax(err)
 
 		if levelsServersUser == nil {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.level-no-stats"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.level-no-stats"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		} else if err != nil {
@@ -2892,14 +2905,14 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 		}
 
 		if totalExp <= 0 {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.levels.level-no-stats"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.levels.level-no-stats"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
 
 		currentMember, _ := helpers.GetGuildMember(channel.GuildID, targetUser.ID)
 		if currentMember == nil || currentMember.User == nil || currentMember.User.ID == "" {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
@@ -2917,9 +2930,9 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 		userLevelEmbed := &discordgo.MessageEmbed{
 			Color:       0x0FADED,
-			Title:       helpers.GetTextF("plugins.levels.user-embed-title", fullUsername),
+			Title:       helpers.GetMessageTextF(msg, "plugins.levels.user-embed-title", fullUsername),
 			Description: "View the leaderboard for this server [here](" + helpers.GetConfig().Path("website.ranking_base_url").Data().(string) + "/" + channel.GuildID + ").",
-			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetTextF("plugins.levels.embed-footer",
+			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageTextF(msg, "plugins.levels.embed-footer",
 				len(session.State.Guilds),
 			)},
 			Fields: []*discordgo.MessageEmbedField{
@@ -2971,7 +2984,7 @@ func (m *Levels) Action(command string, content string, msg *discordgo.Message,
 
 		link := helpers.GetConfig().Path("website.ranking_base_url").Data().(string) + "/" + channel.GuildID
 
-		_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.levels.ranking-text", link))
+		_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.levels.ranking-text", link))
 		helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		return
 	}
@@ -98,13 +98,13 @@ func (l *Lyrics) Action(command string, content string, msg *discordgo.Message,
 	helpers.Relax(err)
 
 	if searchResult.Meta.Status != 200 {
-		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lyrics.genius-api-error"))
+		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lyrics.genius-api-error"))
 		helpers.Relax(err)
 		return
 	}
 
 	if len(searchResult.Response.Hits) <= 0 {
-		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lyrics.genius-no-results"))
+		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lyrics.genius-no-results"))
 		helpers.Relax(err)
 		return
 	}
@@ -118,7 +118,7 @@ func (l *Lyrics) Action(command string, content string, msg *discordgo.Message,
 	}
 
 	if hitI == -1 {
-		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.lyrics.genius-no-results"))
+		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.lyrics.genius-no-results"))
 		helpers.Relax(err)
 		return
 	}
@@ -134,8 +134,8 @@ func (l *Lyrics) Action(command string, content string, msg *discordgo.Message,
 			}
 		}
 		songListEmbed := &discordgo.MessageEmbed{
-			Title:       helpers.GetTextF("plugins.lyrics.song-list-embed-title", content),
-			Footer:      &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.lyrics.powered-by")},
+			Title:       helpers.GetMessageTextF(msg, "plugins.lyrics.song-list-embed-title", content),
+			Footer:      &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.lyrics.powered-by")},
 			Description: songList,
 		}
 
@@ -149,7 +149,7 @@ func (l *Lyrics) Action(command string, content string, msg *discordgo.Message,
 	helpers.Relax(err)
 
 	result := fmt.Sprintf("__Lyrics for: **%s**__\n%s\n_%s_",
-		hit.Result.FullTitle, lyrics, helpers.GetText("plugins.lyrics.powered-by"))
+		hit.Result.FullTitle, lyrics, helpers.GetMessageText(msg, "plugins.lyrics.powered-by"))
 
 	for i, page := range helpers.Pagify(result, "\n") {
 		if i >= 2 {
@@ -23,10 +23,10 @@ func (m8 *M8ball) Action(command string, content string, msg *discordgo.Message,
 	}
 
 	if len(content) < 3 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.8ball.ask_a_question"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.8ball.ask_a_question"))
 		return
 	}
 
-	_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.8ball"))
+	_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.8ball"))
 	helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 }
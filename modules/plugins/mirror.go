@@ -39,13 +39,21 @@ var (
 	}
 )
 
+const (
+	// mirrored messages are edited and deleted with their source message for this long
+	mirrorRememberDuration = time.Hour * 24
+)
+
 func (m *Mirror) Init(session *discordgo.Session) {
 	var err error
 	mirrors, err = m.GetMirrors()
 	helpers.Relax(err)
 
-	session.AddHandler(m.OnMessage)
-	session.AddHandler(m.OnMessageDelete)
+	cache.AddHandler(m.OnMessage)
+	cache.AddHandler(m.OnMessageUpdate)
+	cache.AddHandler(m.OnMessageDelete)
+	cache.AddHandler(m.OnReactionAdd)
+	cache.AddHandler(m.OnReactionRemove)
 }
 
 func (m *Mirror) Uninit(session *discordgo.Session) {
@@ -82,7 +90,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 				helpers.RelaxLog(err)
 
 				cache.GetLogger().WithField("module", "mirror").Info(fmt.Sprintf("Created new Mirror by %s (#%s)", msg.Author.Username, msg.Author.ID))
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mirror.create-success",
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mirror.create-success",
 					helpers.GetPrefixForServer(channel.GuildID), helpers.MdbIdToHuman(newID)))
 				helpers.Relax(err)
 				return
@@ -92,7 +100,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 			session.ChannelTyping(msg.ChannelID)
 			helpers.RequireRobyulMod(msg, func() {
 				if len(args) < 2 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 
@@ -105,7 +113,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 					&mirrorEntry,
 				)
 				if helpers.IsMdbNotFound(err) {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				helpers.Relax(err)
@@ -143,7 +151,72 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 					nil, false)
 				helpers.RelaxLog(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mirror.toggle-success", typeText))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mirror.toggle-success", typeText))
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+				return
+			})
+			return
+		case "webhooks": // [p]mirror webhooks <mirror id> [on|off]
+			session.ChannelTyping(msg.ChannelID)
+			helpers.RequireRobyulMod(msg, func() {
+				if len(args) < 2 {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+
+				channel, err := helpers.GetChannel(msg.ChannelID)
+				helpers.Relax(err)
+
+				var mirrorEntry models.MirrorEntry
+				err = helpers.MdbOne(
+					helpers.MdbCollection(models.MirrorsTable).Find(bson.M{"_id": helpers.HumanToMdbId(args[1])}),
+					&mirrorEntry,
+				)
+				if helpers.IsMdbNotFound(err) {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+					return
+				}
+				helpers.Relax(err)
+
+				beforePostMode := mirrorEntry.PostMode
+				if len(args) >= 3 {
+					switch strings.ToLower(args[2]) {
+					case "on", "enable", "yes":
+						mirrorEntry.PostMode = models.MirrorPostModeWebhook
+					case "off", "disable", "no":
+						mirrorEntry.PostMode = models.MirrorPostModeBot
+					default:
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+						return
+					}
+				}
+
+				if mirrorEntry.PostMode != beforePostMode {
+					err = helpers.MDbUpdate(models.MirrorsTable, mirrorEntry.ID, mirrorEntry)
+					helpers.Relax(err)
+
+					mirrors, err = m.GetMirrors()
+					helpers.Relax(err)
+
+					_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(mirrorEntry.ID),
+						models.EventlogTargetTypeRobyulMirror, msg.Author.ID,
+						models.EventlogTypeRobyulMirrorUpdate, "",
+						[]models.ElasticEventlogChange{
+							{
+								Key:      "mirror_postmode",
+								OldValue: strconv.Itoa(int(beforePostMode)),
+								NewValue: strconv.Itoa(int(mirrorEntry.PostMode)),
+							},
+						},
+						nil, false)
+					helpers.RelaxLog(err)
+				}
+
+				resultText := helpers.GetMessageText(msg, "plugins.mirror.webhooks-enabled")
+				if mirrorEntry.PostMode == models.MirrorPostModeBot {
+					resultText = helpers.GetMessageText(msg, "plugins.mirror.webhooks-disabled")
+				}
+				_, err = helpers.SendMessage(msg.ChannelID, resultText)
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			})
@@ -152,15 +225,15 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 			session.ChannelTyping(msg.ChannelID)
 			// @TODO: more secure way to exchange token: create own webhook if no arguments passed
 			helpers.RequireRobyulMod(msg, func() {
-				progressMessages, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mirror.add-channel-progress"))
+				progressMessages, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mirror.add-channel-progress"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				if len(progressMessages) <= 0 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 					return
 				}
 				progressMessage := progressMessages[0]
 				if len(args) < 3 {
-					_, err := helpers.EditMessage(msg.ChannelID, progressMessage.ID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.EditMessage(msg.ChannelID, progressMessage.ID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.Relax(err)
 					return
 				}
@@ -175,14 +248,14 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 					&mirrorEntry,
 				)
 				if helpers.IsMdbNotFound(err) {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				helpers.Relax(err)
 
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[2])
 				if err != nil || targetChannel.ID == "" || targetChannel.GuildID != channel.GuildID {
-					_, err := helpers.EditMessage(msg.ChannelID, progressMessage.ID, helpers.GetText("bot.arguments.invalid"))
+					_, err := helpers.EditMessage(msg.ChannelID, progressMessage.ID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					helpers.Relax(err)
 					return
 				}
@@ -215,7 +288,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 
 				cache.GetLogger().WithField("module", "mirror").Info(fmt.Sprintf("Added Channel %s (#%s) on Server %s (#%s) to Mirror %s by %s (#%s)",
 					targetChannel.Name, targetChannel.ID, guild.Name, guild.ID, mirrorEntry.ID, msg.Author.Username, msg.Author.ID))
-				_, err = helpers.EditMessage(msg.ChannelID, progressMessage.ID, helpers.GetText("plugins.mirror.add-channel-success"))
+				_, err = helpers.EditMessage(msg.ChannelID, progressMessage.ID, helpers.GetMessageText(msg, "plugins.mirror.add-channel-success"))
 				helpers.Relax(err)
 				return
 			})
@@ -229,7 +302,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 				helpers.Relax(err)
 
 				if len(entryBucket) <= 0 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mirror.list-empty"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mirror.list-empty"))
 					return
 				}
 
@@ -242,8 +315,12 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 					case models.MirrorTypeText:
 						entryTypeText = "text"
 					}
-					resultMessage += fmt.Sprintf(":satellite: Mirror `%s` (Mode: `%s`, %d channels):\n",
-						helpers.MdbIdToHuman(entry.ID), entryTypeText, len(entry.ConnectedChannels))
+					entryPostModeText := "webhooks"
+					if entry.PostMode == models.MirrorPostModeBot {
+						entryPostModeText = "bot"
+					}
+					resultMessage += fmt.Sprintf(":satellite: Mirror `%s` (Mode: `%s`, Posting as: `%s`, %d channels):\n",
+						helpers.MdbIdToHuman(entry.ID), entryTypeText, entryPostModeText, len(entry.ConnectedChannels))
 					for _, mirroredChannelEntry := range entry.ConnectedChannels {
 						mirroredChannel, err := helpers.GetChannel(mirroredChannelEntry.ChannelID)
 						if err != nil {
@@ -276,7 +353,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 			helpers.RequireRobyulMod(msg, func() {
 				session.ChannelTyping(msg.ChannelID)
 				if len(args) < 2 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					helpers.Relax(err)
 					return
 				}
@@ -290,7 +367,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 					&mirrorEntry,
 				)
 				if helpers.IsMdbNotFound(err) {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				helpers.Relax(err)
@@ -310,7 +387,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 
 				cache.GetLogger().WithField("module", "mirror").Info(fmt.Sprintf("Deleted Mirror %s by %s (#%s)",
 					helpers.MdbIdToHuman(mirrorEntry.ID), msg.Author.Username, msg.Author.ID))
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mirror.delete-success"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mirror.delete-success"))
 				helpers.Relax(err)
 				return
 			})
@@ -322,7 +399,7 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 				session.ChannelTyping(msg.ChannelID)
 				mirrors, err = m.GetMirrors()
 				helpers.Relax(err)
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mirror.refreshed-config"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mirror.refreshed-config"))
 				helpers.Relax(err)
 				return
 			})
@@ -334,17 +411,9 @@ func (m *Mirror) Action(command string, content string, msg *discordgo.Message,
 func (m *Mirror) OnMessage(session *discordgo.Session, msg *discordgo.MessageCreate) {
 	defer helpers.Recover()
 
-	// ignore bot messages except whitelisted bots
-	if msg.Author.Bot {
-		var isWhitelisted bool
-		for _, whitelistedBotID := range whitelistedBotIDs {
-			if msg.Author.ID == whitelistedBotID {
-				isWhitelisted = true
-			}
-		}
-		if !isWhitelisted {
-			return
-		}
+	// ignoreMessage looks the message up in redis, so other channels are skipped first
+	if !m.isMirroredChannel(msg.ChannelID) || m.ignoreMessage(msg.Message) {
+		return
 	}
 
 	for _, mirrorEntry := range mirrors {
@@ -359,49 +428,8 @@ func (m *Mirror) OnMessage(session *discordgo.Session, msg *discordgo.MessageCre
 						return
 					}
 				}
-				var linksToRepost []string
-				// get mirror attachements
-				if len(msg.Attachments) > 0 {
-					for _, attachement := range msg.Attachments {
-						linksToRepost = append(linksToRepost, attachement.URL)
-					}
-				}
-				// get mirror links
-				if strings.Contains(msg.Content, "http") {
-					linksFound := galleryUrlRegex.FindAllString(msg.Content, -1)
-					if len(linksFound) > 0 {
-						for _, linkFound := range linksFound {
-							if strings.HasPrefix(linkFound, "<") == false && strings.HasSuffix(linkFound, ">") == false {
-								linksToRepost = append(linksToRepost, linkFound)
-							}
-						}
-					}
-				}
-				// get full content message
-				newContent := msg.Content
-				if len(msg.Attachments) > 0 {
-					for _, attachement := range msg.Attachments {
-						newContent += "\n" + attachement.URL
-					}
-				}
-				switch mirrorEntry.Type {
-				case models.MirrorTypeText:
-					m.postMirrorMessage(mirrorEntry, msg.Message, msg.Author, newContent)
-					break
-				default:
-					// post mirror links
-					if len(linksToRepost) > 0 {
-						sourceGuild, err := helpers.GetGuild(sourceChannel.GuildID)
-						helpers.Relax(err)
-						for _, linkToRepost := range linksToRepost {
-							m.postMirrorMessage(mirrorEntry, msg.Message, msg.Author,
-								fmt.Sprintf("posted %s in `#%s` on the `%s` server (<#%s>)",
-									linkToRepost, sourceChannel.Name, sourceGuild.Name, sourceChannel.ID,
-								),
-							)
-						}
-					}
-					break
+				for _, content := range m.getMirrorContents(mirrorEntry, msg.Message, sourceChannel) {
+					m.postMirrorMessage(mirrorEntry, msg.Message, msg.Author, content)
 				}
 			}
 		}
@@ -409,6 +437,86 @@ func (m *Mirror) OnMessage(session *discordgo.Session, msg *discordgo.MessageCre
 
 }
 
+// ignoreMessage returns true for messages which should not be mirrored
+// mirrored messages are ignored to prevent echo loops between connected channels
+func (m *Mirror) ignoreMessage(msg *discordgo.Message) bool {
+	if msg.Author == nil || msg.Author.ID == cache.GetSession().State.User.ID {
+		return true
+	}
+
+	// ignore bot messages except whitelisted bots
+	if msg.Author.Bot {
+		var isWhitelisted bool
+		for _, whitelistedBotID := range whitelistedBotIDs {
+			if msg.Author.ID == whitelistedBotID {
+				isWhitelisted = true
+			}
+		}
+		if !isWhitelisted {
+			return true
+		}
+	}
+
+	if m.isMirroredMessage(msg.ID) {
+		return true
+	}
+
+	// the create event of a mirrored message can arrive before it is remembered
+	if msg.WebhookID != "" {
+		channel, err := helpers.GetChannelWithoutApi(msg.ChannelID)
+		if err == nil && channel != nil {
+			webhook, err := helpers.GetWebhook(channel.GuildID, channel.ID)
+			if err == nil && webhook != nil && webhook.ID == msg.WebhookID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// getMirrorContents returns the messages to post for a message, one for text mirrors and one for every link for link mirrors
+func (m *Mirror) getMirrorContents(mirrorEntry models.MirrorEntry, msg *discordgo.Message, sourceChannel *discordgo.Channel) (contents []string) {
+	switch mirrorEntry.Type {
+	case models.MirrorTypeText:
+		// get full content message
+		newContent := msg.Content
+		for _, attachement := range msg.Attachments {
+			newContent += "\n" + attachement.URL
+		}
+		if strings.TrimSpace(newContent) == "" {
+			return nil
+		}
+		return []string{newContent}
+	default:
+		var linksToRepost []string
+		// get mirror attachements
+		for _, attachement := range msg.Attachments {
+			linksToRepost = append(linksToRepost, attachement.URL)
+		}
+		// get mirror links
+		if strings.Contains(msg.Content, "http") {
+			for _, linkFound := range galleryUrlRegex.FindAllString(msg.Content, -1) {
+				if strings.HasPrefix(linkFound, "<") == false && strings.HasSuffix(linkFound, ">") == false {
+					linksToRepost = append(linksToRepost, linkFound)
+				}
+			}
+		}
+		if len(linksToRepost) <= 0 {
+			return nil
+		}
+
+		sourceGuild, err := helpers.GetGuild(sourceChannel.GuildID)
+		helpers.Relax(err)
+		for _, linkToRepost := range linksToRepost {
+			contents = append(contents, fmt.Sprintf("posted %s in `#%s` on the `%s` server (<#%s>)",
+				linkToRepost, sourceChannel.Name, sourceGuild.Name, sourceChannel.ID,
+			))
+		}
+		return contents
+	}
+}
+
 func (m *Mirror) postMirrorMessage(mirrorEntry models.MirrorEntry, sourceMessage *discordgo.Message, author *discordgo.User, message string) {
 	for _, channelToMirrorToEntry := range mirrorEntry.ConnectedChannels {
 		if channelToMirrorToEntry.ChannelID != sourceMessage.ChannelID {
@@ -419,26 +527,163 @@ func (m *Mirror) postMirrorMessage(mirrorEntry models.MirrorEntry, sourceMessage
 				}
 			}
 			if robyulIsOnTargetGuild {
-				webhook, err := helpers.GetWebhook(channelToMirrorToEntry.GuildID, channelToMirrorToEntry.ChannelID)
-				if err != nil {
-					continue
+				var result *discordgo.Message
+				var webhookID string
+				switch mirrorEntry.PostMode {
+				case models.MirrorPostModeBot:
+					results, err := helpers.SendMessage(channelToMirrorToEntry.ChannelID, m.getBotContent(author, message))
+					if err != nil || len(results) <= 0 {
+						helpers.RelaxLog(err)
+						continue
+					}
+					result = results[0]
+				default:
+					webhook, err := helpers.GetWebhook(channelToMirrorToEntry.GuildID, channelToMirrorToEntry.ChannelID)
+					if err != nil {
+						continue
+					}
+					result, err = helpers.WebhookExecuteWithResult(
+						webhook.ID, webhook.Token,
+						&discordgo.WebhookParams{
+							Content:   message,
+							Username:  author.Username,
+							AvatarURL: helpers.GetAvatarUrl(author),
+						})
+					if err != nil {
+						helpers.RelaxLog(err)
+						continue
+					}
+					webhookID = webhook.ID
 				}
-				result, err := helpers.WebhookExecuteWithResult(
-					webhook.ID, webhook.Token,
-					&discordgo.WebhookParams{
-						Content:   message,
-						Username:  author.Username,
-						AvatarURL: helpers.GetAvatarUrl(author),
-					})
-				helpers.RelaxLog(err)
 				metrics.MirrorsPostsSent.Add(1)
-				err = m.rememberPostedMessage(sourceMessage, result)
+				err := m.rememberPostedMessage(sourceMessage, result, webhookID, message)
 				helpers.RelaxLog(err)
 			}
 		}
 	}
 }
 
+// getBotContent returns the content of a message posted as the bot, which has to name the author
+func (m *Mirror) getBotContent(author *discordgo.User, message string) string {
+	return fmt.Sprintf("**%s**: %s", author.Username, message)
+}
+
+func (m *Mirror) OnMessageUpdate(session *discordgo.Session, msg *discordgo.MessageUpdate) {
+	defer helpers.Recover()
+
+	// embeds of links are added with updates without an edit
+	if msg.EditedTimestamp == "" || !m.isMirroredChannel(msg.ChannelID) || m.ignoreMessage(msg.Message) {
+		return
+	}
+
+	for _, mirrorEntry := range mirrors {
+		for _, mirrorChannel := range mirrorEntry.ConnectedChannels {
+			if mirrorChannel.ChannelID != msg.ChannelID {
+				continue
+			}
+
+			rememberedMessages, err := m.getRememberedMessages(msg.Message)
+			helpers.Relax(err)
+			if len(rememberedMessages) <= 0 {
+				return
+			}
+
+			sourceChannel, err := helpers.GetChannel(msg.ChannelID)
+			helpers.Relax(err)
+			contents := m.getMirrorContents(mirrorEntry, msg.Message, sourceChannel)
+
+			switch mirrorEntry.Type {
+			case models.MirrorTypeText:
+				if len(contents) <= 0 {
+					return
+				}
+				for _, messageData := range rememberedMessages {
+					if messageData.Content == contents[0] {
+						continue
+					}
+					err = m.editMirroredMessage(messageData, msg.Author, contents[0])
+					if err != nil {
+						m.logMirroredMessageError(msg.Message, messageData, "Editing mirrored message failed:", err)
+					}
+				}
+				err = m.rememberEditedMessages(msg.Message, rememberedMessages, contents[0])
+				helpers.RelaxLog(err)
+			default:
+				// the links of the message changed, repost them
+				var previousContents []string
+				for _, messageData := range rememberedMessages {
+					if !helpers.StringSliceContains(messageData.Content, previousContents) {
+						previousContents = append(previousContents, messageData.Content)
+					}
+				}
+				if m.sameContents(previousContents, contents) {
+					return
+				}
+
+				m.deleteRememberedMessages(session, msg.Message, rememberedMessages)
+				for _, content := range contents {
+					m.postMirrorMessage(mirrorEntry, msg.Message, msg.Author, content)
+				}
+			}
+			return
+		}
+	}
+}
+
+// editMirroredMessage changes the content of a mirrored message, with the webhook which sent it or as the bot
+func (m *Mirror) editMirroredMessage(messageData Mirror_PostedMessage, author *discordgo.User, content string) (err error) {
+	if messageData.WebhookID == "" {
+		_, err = helpers.EditMessage(messageData.ChannelID, messageData.MessageID, m.getBotContent(author, content))
+		return err
+	}
+
+	channel, err := helpers.GetChannelWithoutApi(messageData.ChannelID)
+	if err != nil {
+		return err
+	}
+	webhook, err := helpers.GetWebhook(channel.GuildID, channel.ID)
+	if err != nil {
+		return err
+	}
+	// the webhook of the channel has been replaced, it can not edit the message anymore
+	if webhook.ID != messageData.WebhookID {
+		return fmt.Errorf("webhook #%s of the mirrored message does not exist anymore", messageData.WebhookID)
+	}
+
+	_, err = helpers.WebhookMessageEdit(webhook.ID, webhook.Token, messageData.MessageID, content)
+	return err
+}
+
+func (m *Mirror) sameContents(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, content := range a {
+		if !helpers.StringSliceContains(content, b) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Mirror) logMirroredMessageError(sourceMessage *discordgo.Message, messageData Mirror_PostedMessage, text string, err error) {
+	sourceAuthorID := "N/A"
+	if sourceMessage.Author != nil {
+		sourceAuthorID = sourceMessage.Author.ID
+	}
+
+	cache.GetLogger().WithFields(logrus.Fields{
+		"module":            "mirror",
+		"sourceChannelID":   sourceMessage.ChannelID,
+		"sourceMessageID":   sourceMessage.ID,
+		"sourceAuthorID":    sourceAuthorID,
+		"mirroredChannelID": messageData.ChannelID,
+		"mirroredMessageID": messageData.MessageID,
+	}).Warn(
+		text, err.Error(),
+	)
+}
+
 func (m *Mirror) GetMirrors() (entryBucket []models.MirrorEntry, err error) {
 	err = helpers.MDbIter(helpers.MdbCollection(models.MirrorsTable).Find(nil)).All(&entryBucket)
 	return entryBucket, err
@@ -447,34 +692,79 @@ func (m *Mirror) GetMirrors() (entryBucket []models.MirrorEntry, err error) {
 type Mirror_PostedMessage struct {
 	ChannelID string
 	MessageID string
+	WebhookID string // empty if the message was posted as the bot
+	Content   string
 }
 
 func (m *Mirror) getRememberedMessageKey(sourceMessageID string) (key string) {
 	return fmt.Sprintf("robyul2-discord:mirror:postedmessage:%s", sourceMessageID)
 }
 
-func (m *Mirror) rememberPostedMessage(sourceMessage *discordgo.Message, mirroredMessage *discordgo.Message) error {
+func (m *Mirror) getMirroredMessageKey(mirroredMessageID string) (key string) {
+	return fmt.Sprintf("robyul2-discord:mirror:mirroredmessage:%s", mirroredMessageID)
+}
+
+// isMirroredMessage returns true if the message has been posted by a mirror
+func (m *Mirror) isMirroredMessage(messageID string) bool {
+	exists, err := cache.GetRedisClient().Exists(m.getMirroredMessageKey(messageID)).Result()
+	if err != nil {
+		helpers.RelaxLog(err)
+		return false
+	}
+	return exists > 0
+}
+
+func (m *Mirror) rememberPostedMessage(sourceMessage *discordgo.Message, mirroredMessage *discordgo.Message, webhookID, content string) error {
 	redis := cache.GetRedisClient()
 	key := m.getRememberedMessageKey(sourceMessage.ID)
 
 	item := new(Mirror_PostedMessage)
 	item.ChannelID = mirroredMessage.ChannelID
 	item.MessageID = mirroredMessage.ID
+	item.WebhookID = webhookID
+	item.Content = content
 
 	itemBytes, err := msgpack.Marshal(&item)
 	if err != nil {
 		return err
 	}
 
+	_, err = redis.Set(m.getMirroredMessageKey(mirroredMessage.ID), true, mirrorRememberDuration).Result()
+	if err != nil {
+		return err
+	}
+
 	_, err = redis.LPush(key, itemBytes).Result()
 	if err != nil {
 		return err
 	}
 
-	_, err = redis.Expire(key, time.Hour*1).Result()
+	_, err = redis.Expire(key, mirrorRememberDuration).Result()
 	return err
 }
 
+// rememberEditedMessages replaces the remembered content of the mirrored messages after they have been edited
+func (m *Mirror) rememberEditedMessages(sourceMessage *discordgo.Message, rememberedMessages []Mirror_PostedMessage, content string) error {
+	redis := cache.GetRedisClient()
+	key := m.getRememberedMessageKey(sourceMessage.ID)
+
+	for i, messageData := range rememberedMessages {
+		messageData.Content = content
+
+		itemBytes, err := msgpack.Marshal(&messageData)
+		if err != nil {
+			return err
+		}
+
+		// the indexes match the order of getRememberedMessages
+		_, err = redis.LSet(key, int64(i), itemBytes).Result()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *Mirror) getRememberedMessages(sourceMessage *discordgo.Message) ([]Mirror_PostedMessage, error) {
 	redis := cache.GetRedisClient()
 	key := m.getRememberedMessageKey(sourceMessage.ID)
@@ -509,31 +799,90 @@ func (m *Mirror) getRememberedMessages(sourceMessage *discordgo.Message) ([]Mirr
 func (m *Mirror) OnMessageDelete(session *discordgo.Session, msg *discordgo.MessageDelete) {
 	defer helpers.Recover()
 
-	var err error
-	var rememberedMessages []Mirror_PostedMessage
-
 	for _, mirror := range mirrors {
 		for _, mirrorChannel := range mirror.ConnectedChannels {
 			if mirrorChannel.ChannelID == msg.ChannelID {
-				rememberedMessages, err = m.getRememberedMessages(msg.Message)
+				rememberedMessages, err := m.getRememberedMessages(msg.Message)
 				helpers.Relax(err)
 
-				for _, messageData := range rememberedMessages {
-					err = session.ChannelMessageDelete(messageData.ChannelID, messageData.MessageID)
-					if err != nil {
-						cache.GetLogger().WithFields(logrus.Fields{
-							"module":            "mirror",
-							"sourceChannelID":   msg.ChannelID,
-							"sourceMessageID":   msg.ID,
-							"sourceAuthorID":    msg.Author.ID,
-							"mirroredChannelID": messageData.ChannelID,
-							"mirroredMessageID": messageData.MessageID,
-						}).Warn(
-							"Deleting mirrored message failed:", err.Error(),
-						)
-					}
-				}
+				m.deleteRememberedMessages(session, msg.Message, rememberedMessages)
+			}
+		}
+	}
+}
+
+func (m *Mirror) deleteRememberedMessages(session *discordgo.Session, sourceMessage *discordgo.Message, rememberedMessages []Mirror_PostedMessage) {
+	for _, messageData := range rememberedMessages {
+		err := session.ChannelMessageDelete(messageData.ChannelID, messageData.MessageID)
+		if err != nil {
+			m.logMirroredMessageError(sourceMessage, messageData, "Deleting mirrored message failed:", err)
+		}
+	}
+
+	_, err := cache.GetRedisClient().Del(m.getRememberedMessageKey(sourceMessage.ID)).Result()
+	helpers.RelaxLog(err)
+}
+
+func (m *Mirror) OnReactionAdd(session *discordgo.Session, reaction *discordgo.MessageReactionAdd) {
+	defer helpers.Recover()
+
+	if reaction.UserID == session.State.User.ID || !m.isMirroredChannel(reaction.ChannelID) {
+		return
+	}
+
+	rememberedMessages, err := m.getRememberedMessages(&discordgo.Message{ID: reaction.MessageID})
+	helpers.Relax(err)
+
+	for _, messageData := range rememberedMessages {
+		err = session.MessageReactionAdd(messageData.ChannelID, messageData.MessageID, reaction.Emoji.APIName())
+		if err != nil {
+			m.logMirroredMessageError(&discordgo.Message{ID: reaction.MessageID, ChannelID: reaction.ChannelID},
+				messageData, "Adding reaction to mirrored message failed:", err)
+		}
+	}
+}
+
+func (m *Mirror) OnReactionRemove(session *discordgo.Session, reaction *discordgo.MessageReactionRemove) {
+	defer helpers.Recover()
+
+	if reaction.UserID == session.State.User.ID || !m.isMirroredChannel(reaction.ChannelID) {
+		return
+	}
+
+	rememberedMessages, err := m.getRememberedMessages(&discordgo.Message{ID: reaction.MessageID})
+	helpers.Relax(err)
+	if len(rememberedMessages) <= 0 {
+		return
+	}
+
+	// only remove the reaction from the mirrored messages after nobody reacts with the emoji anymore
+	sourceMessage, err := session.ChannelMessage(reaction.ChannelID, reaction.MessageID)
+	if err != nil {
+		helpers.RelaxLog(err)
+		return
+	}
+	for _, messageReaction := range sourceMessage.Reactions {
+		if messageReaction.Emoji != nil && messageReaction.Emoji.APIName() == reaction.Emoji.APIName() && messageReaction.Count > 0 {
+			return
+		}
+	}
+
+	for _, messageData := range rememberedMessages {
+		err = session.MessageReactionRemove(messageData.ChannelID, messageData.MessageID, reaction.Emoji.APIName(), "@me")
+		if err != nil {
+			m.logMirroredMessageError(sourceMessage, messageData, "Removing reaction from mirrored message failed:", err)
+		}
+	}
+}
+
+// isMirroredChannel returns true if the channel is connected to a mirror
+func (m *Mirror) isMirroredChannel(channelID string) bool {
+	for _, mirror := range mirrors {
+		for _, mirrorChannel := range mirror.ConnectedChannels {
+			if mirrorChannel.ChannelID == channelID {
+				return true
 			}
 		}
 	}
+	return false
 }
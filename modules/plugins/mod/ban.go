@@ -13,13 +13,13 @@ import (
 // banHandler [p]ban <User> [<Days>] [<Reason>], checks for IsMod and Ban Permissions
 func banHandler(msg *discordgo.Message, content string, confirmation bool) {
 	if !helpers.IsMod(msg) {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("mod.no_permission"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "mod.no_permission"))
 		return
 	}
 
 	args := strings.Fields(content)
 	if len(args) < 1 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
@@ -45,7 +45,7 @@ func banHandler(msg *discordgo.Message, content string, confirmation bool) {
 	usersToBan = helpers.UniqueUsers(usersToBan)
 
 	if len(usersToBan) <= 0 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
@@ -59,11 +59,11 @@ func banHandler(msg *discordgo.Message, content string, confirmation bool) {
 		if regexNumberOnly.MatchString(dayArg) {
 			days, err = strconv.Atoi(dayArg)
 			if err != nil {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 			if days > 7 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.user-banned-error-too-many-days"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.user-banned-error-too-many-days"))
 				return
 			}
 
@@ -88,7 +88,7 @@ func banHandler(msg *discordgo.Message, content string, confirmation bool) {
 	}
 
 	if !botCanBan {
-		_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mod.bot-disallowed"))
+		_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mod.bot-disallowed"))
 		helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		return
 	}
@@ -111,7 +111,7 @@ func banHandler(msg *discordgo.Message, content string, confirmation bool) {
 	}
 
 	if !userCanBan {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.disallowed"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.disallowed"))
 		return
 	}
 
@@ -139,7 +139,7 @@ func banHandler(msg *discordgo.Message, content string, confirmation bool) {
 	if !confirmation ||
 		helpers.ConfirmEmbed(
 			msg.ChannelID, msg.Author,
-			helpers.GetTextF(
+			helpers.GetMessageTextF(msg,
 				"plugins.mod.confirm-ban",
 				usersToBanText,
 				days,
@@ -150,7 +150,7 @@ func banHandler(msg *discordgo.Message, content string, confirmation bool) {
 			if err != nil {
 				if err, ok := err.(*discordgo.RESTError); ok && err.Message != nil {
 					if err.Message.Code == 0 {
-						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mod.user-banned-failed-too-low"))
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mod.user-banned-failed-too-low"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					} else {
@@ -166,7 +166,7 @@ func banHandler(msg *discordgo.Message, content string, confirmation bool) {
 				"Banned User %s (#%s) on Guild %s (#%s) by %s (#%s)",
 				userToBan.Username, userToBan.ID, guild.Name, guild.ID, msg.Author.Username, msg.Author.ID,
 			))
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mod.user-banned-success", userToBan.Username, userToBan.ID))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mod.user-banned-success", userToBan.Username, userToBan.ID))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		}
 	}
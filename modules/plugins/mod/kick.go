@@ -12,13 +12,13 @@ import (
 // kickHander [p]kick <User> [<Reason>], checks for IsMod and Kick Permissions
 func kickHander(msg *discordgo.Message, content string, confirmation bool) {
 	if !helpers.IsMod(msg) {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("mod.no_permission"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "mod.no_permission"))
 		return
 	}
 
 	args := strings.Fields(content)
 	if len(args) < 1 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
@@ -44,7 +44,7 @@ func kickHander(msg *discordgo.Message, content string, confirmation bool) {
 	usersToKick = helpers.UniqueUsers(usersToKick)
 
 	if len(usersToKick) <= 0 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
@@ -65,7 +65,7 @@ func kickHander(msg *discordgo.Message, content string, confirmation bool) {
 	}
 
 	if !botCanKick {
-		_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mod.bot-disallowed"))
+		_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mod.bot-disallowed"))
 		helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		return
 	}
@@ -88,7 +88,7 @@ func kickHander(msg *discordgo.Message, content string, confirmation bool) {
 	}
 
 	if !userCanKick {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.disallowed"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.disallowed"))
 		return
 	}
 
@@ -116,7 +116,7 @@ func kickHander(msg *discordgo.Message, content string, confirmation bool) {
 	if !confirmation ||
 		helpers.ConfirmEmbed(
 			msg.ChannelID, msg.Author,
-			helpers.GetTextF(
+			helpers.GetMessageTextF(msg,
 				"plugins.mod.confirm-kick",
 				usersToKickText,
 				reasonText,
@@ -126,7 +126,7 @@ func kickHander(msg *discordgo.Message, content string, confirmation bool) {
 			if err != nil {
 				if err, ok := err.(*discordgo.RESTError); ok && err.Message != nil {
 					if err.Message.Code == 0 {
-						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mod.user-kicked-failed-too-low"))
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mod.user-kicked-failed-too-low"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					} else {
@@ -142,7 +142,7 @@ func kickHander(msg *discordgo.Message, content string, confirmation bool) {
 				"Kicked User %s (#%s) on Guild %s (#%s) by %s (#%s)",
 				userToKick.Username, userToKick.ID, guild.Name, guild.ID, msg.Author.Username, msg.Author.ID,
 			))
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mod.user-kicked-success", userToKick.Username, userToKick.ID))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mod.user-kicked-success", userToKick.Username, userToKick.ID))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		}
 	}
@@ -200,7 +200,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				switch args[0] {
 				case "after": // [p]cleanup after <after message id> [<until message id>]
 					if len(args) < 2 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 						return
 					} else {
 
@@ -210,13 +210,13 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 						afterMessageId := args[1]
 						untilMessageId := ""
 						if regexNumberOnly.MatchString(afterMessageId) == false {
-							helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 							return
 						}
 						if len(args) >= 3 {
 							untilMessageId = args[2]
 							if regexNumberOnly.MatchString(untilMessageId) == false {
-								helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+								helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 								return
 							}
 						}
@@ -260,11 +260,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 							if err != nil {
 								if errD, ok := err.(*discordgo.RESTError); ok {
 									if errD.Message.Code == discordgo.ErrCodeMessageProvidedTooOldForBulkDelete {
-										_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.deleting-messages-failed-too-old"))
+										_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.deleting-messages-failed-too-old"))
 										helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 										return
 									} else if errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-										_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.deleting-messages-failed-no-permissions"))
+										_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.deleting-messages-failed-no-permissions"))
 										helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 										return
 									} else {
@@ -298,7 +298,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 								helpers.RelaxLog(err)
 							}
 						} else {
-							if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetTextF("plugins.mod.deleting-message-bulkdelete-confirm", len(messagesToDeleteIds)), "✅", "🚫") == true {
+							if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetMessageTextF(msg, "plugins.mod.deleting-message-bulkdelete-confirm", len(messagesToDeleteIds)), "✅", "🚫") == true {
 								for i := 0; i < len(messagesToDeleteIds); i += 100 {
 									batch := messagesToDeleteIds[i:m.Min(i+100, len(messagesToDeleteIds))]
 									err := session.ChannelMessagesBulkDelete(msg.ChannelID, batch)
@@ -306,11 +306,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 									if err != nil {
 										if errD, ok := err.(*discordgo.RESTError); ok {
 											if errD.Message.Code == discordgo.ErrCodeMessageProvidedTooOldForBulkDelete {
-												_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.deleting-messages-failed-too-old"))
+												_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.deleting-messages-failed-too-old"))
 												helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 												return
 											} else if errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-												_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.deleting-messages-failed-no-permissions"))
+												_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.deleting-messages-failed-no-permissions"))
 												helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 												return
 											} else {
@@ -352,23 +352,23 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 					}
 				case "messages": // [p]cleanup messages <n>
 					if len(args) < 2 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 						return
 					} else {
 						channel, err := helpers.GetChannel(msg.ChannelID)
 						helpers.Relax(err)
 
 						if regexNumberOnly.MatchString(args[1]) == false {
-							helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 							return
 						}
 						numOfMessagesToDelete, err := strconv.Atoi(args[1])
 						if err != nil {
-							helpers.SendMessage(msg.ChannelID, fmt.Sprintf(helpers.GetTextF("bot.errors.general"), err.Error()))
+							helpers.SendMessage(msg.ChannelID, fmt.Sprintf(helpers.GetMessageTextF(msg, "bot.errors.general"), err.Error()))
 							return
 						}
 						if numOfMessagesToDelete < 1 {
-							helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 							return
 						}
 
@@ -405,11 +405,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 							if err != nil {
 								if errD, ok := err.(*discordgo.RESTError); ok {
 									if errD.Message.Code == discordgo.ErrCodeMessageProvidedTooOldForBulkDelete {
-										_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.deleting-messages-failed-too-old"))
+										_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.deleting-messages-failed-too-old"))
 										helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 										return
 									} else if errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-										_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.deleting-messages-failed-no-permissions"))
+										_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.deleting-messages-failed-no-permissions"))
 										helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 										return
 									} else {
@@ -433,7 +433,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 								helpers.RelaxLog(err)
 							}
 						} else {
-							if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetTextF("plugins.mod.deleting-message-bulkdelete-confirm", len(messagesToDeleteIds)-1), "✅", "🚫") == true {
+							if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetMessageTextF(msg, "plugins.mod.deleting-message-bulkdelete-confirm", len(messagesToDeleteIds)-1), "✅", "🚫") == true {
 								for i := 0; i < len(messagesToDeleteIds); i += 100 {
 									batch := messagesToDeleteIds[i:m.Min(i+100, len(messagesToDeleteIds))]
 									err := session.ChannelMessagesBulkDelete(msg.ChannelID, batch)
@@ -441,11 +441,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 									if err != nil {
 										if errD, ok := err.(*discordgo.RESTError); ok {
 											if errD.Message.Code == 50034 {
-												_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.deleting-messages-failed-too-old"))
+												_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.deleting-messages-failed-too-old"))
 												helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 												return
 											} else if errD.Message.Code == 50013 {
-												_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.deleting-messages-failed-too-old"))
+												_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.deleting-messages-failed-too-old"))
 												helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 												return
 											} else {
@@ -541,7 +541,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			if len(args) >= 1 {
 				targetUser, err := helpers.GetUserFromMention(args[0])
 				if err != nil {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 					return
 				}
 				var timeToUnmuteAt time.Time
@@ -550,7 +550,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 					timeText = strings.Replace(timeText, "for", "in", 1)
 					r, err := m.parser.Parse(timeText, time.Now())
 					if err != nil || r == nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 					timeToUnmuteAt = r.Time
@@ -561,11 +561,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 
 				err = helpers.MuteUser(channel.GuildID, targetUser.ID, timeToUnmuteAt)
 
-				successText := helpers.GetTextF("plugins.mod.user-muted-success", targetUser.Username, targetUser.ID)
+				successText := helpers.GetMessageTextF(msg, "plugins.mod.user-muted-success", targetUser.Username, targetUser.ID)
 
 				var options []models.ElasticEventlogOption
 				if time.Now().Before(timeToUnmuteAt) {
-					successText = helpers.GetTextF("plugins.mod.user-muted-success-timed", targetUser.Username, targetUser.ID, timeToUnmuteAt.Format(time.ANSIC)+" UTC")
+					successText = helpers.GetMessageTextF(msg, "plugins.mod.user-muted-success-timed", targetUser.Username, targetUser.ID, timeToUnmuteAt.Format(time.ANSIC)+" UTC")
 					options = []models.ElasticEventlogOption{
 						{
 							Key:   "mute_until",
@@ -585,7 +585,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 		})
@@ -608,7 +608,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message != nil {
 						if errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-							helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mod.user-unmuted-error-permissions"))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mod.user-unmuted-error-permissions"))
 							return
 						}
 					}
@@ -622,10 +622,10 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 					nil, false)
 				helpers.RelaxLog(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.mod.user-unmuted-success", targetUser.Username, targetUser.ID))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.mod.user-unmuted-success", targetUser.Username, targetUser.ID))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 		})
@@ -730,11 +730,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				helpers.Relax(err)
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[0])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				if sourceChannel.GuildID != targetChannel.GuildID {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.echo-error-wrong-server"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.echo-error-wrong-server"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -743,7 +743,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				newMessages, err := helpers.SendMessage(targetChannel.ID, newText)
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingAccess {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.echo-error-no-access"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.echo-error-no-access"))
 						return
 					}
 				}
@@ -773,7 +773,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 		})
@@ -786,11 +786,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				helpers.Relax(err)
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[0])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				if sourceChannel.GuildID != targetChannel.GuildID {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.echo-error-wrong-server"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.echo-error-wrong-server"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -798,7 +798,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok {
 						if errD.Message.Code == 10008 || strings.Contains(err.Error(), "is not snowflake") {
-							_, err = helpers.SendMessage(sourceChannel.ID, helpers.GetText("plugins.mod.edit-error-not-found"))
+							_, err = helpers.SendMessage(sourceChannel.ID, helpers.GetMessageText(msg, "plugins.mod.edit-error-not-found"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						} else {
@@ -833,7 +833,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				}
 
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 		})
@@ -847,11 +847,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				helpers.Relax(err)
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[0])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				if sourceChannel.GuildID != targetChannel.GuildID {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.echo-error-wrong-server"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.echo-error-wrong-server"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -880,7 +880,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				}
 
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 		})
@@ -893,11 +893,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				helpers.Relax(err)
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[0])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				if sourceChannel.GuildID != targetChannel.GuildID {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.echo-error-wrong-server"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.echo-error-wrong-server"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -905,7 +905,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				if err != nil {
 					if err, ok := err.(*discordgo.RESTError); ok {
 						if err.Message.Code == 10008 || err.Message.Code == 50001 {
-							_, err := helpers.SendMessage(sourceChannel.ID, helpers.GetText("plugins.mod.edit-error-not-found"))
+							_, err := helpers.SendMessage(sourceChannel.ID, helpers.GetMessageText(msg, "plugins.mod.edit-error-not-found"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						} else {
@@ -919,7 +919,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				_, err = helpers.SendMessage(msg.ChannelID, newMessage)
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 		})
@@ -932,11 +932,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				helpers.Relax(err)
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[0])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 				if sourceChannel.GuildID != targetChannel.GuildID {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.echo-error-wrong-server"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.echo-error-wrong-server"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -944,7 +944,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				if err != nil {
 					if errD, ok := err.(*discordgo.RESTError); ok {
 						if errD.Message.Code == 10008 || strings.Contains(err.Error(), "is not snowflake") {
-							_, err = helpers.SendMessage(sourceChannel.ID, helpers.GetText("plugins.mod.edit-error-not-found"))
+							_, err = helpers.SendMessage(sourceChannel.ID, helpers.GetMessageText(msg, "plugins.mod.edit-error-not-found"))
 							helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 							return
 						}
@@ -955,7 +955,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				}
 				session.MessageReactionAdd(targetChannel.ID, targetMessage.ID, strings.Replace(strings.Replace(args[2], ">", "", -1), "<", "", -1))
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 		})
@@ -966,7 +966,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 		isAllowedToInspectBasic := helpers.CanInspectBasic(msg)
 
 		if isMod == false && isAllowedToInspectExtended == false && isAllowedToInspectBasic == false {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("mod.no_permission"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "mod.no_permission"))
 			return
 		}
 
@@ -987,7 +987,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			targetUser, err = helpers.GetUserFromMention(args[0])
 			if err != nil {
 				if errD, ok := err.(*discordgo.RESTError); (ok && errD.Message.Code == discordgo.ErrCodeUnknownUser) || strings.Contains(err.Error(), "user not found") {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.user-not-found"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.user-not-found"))
 					return
 				} else {
 					helpers.Relax(err)
@@ -995,15 +995,15 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			}
 			helpers.Relax(err)
 			if targetUser.ID == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 		} else {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			return
 		}
 		if targetUser.ID == session.State.User.ID {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 		textVersion := false
@@ -1014,11 +1014,11 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 		helpers.Relax(err)
 
 		resultEmbed := &discordgo.MessageEmbed{
-			Title:       helpers.GetTextF("plugins.mod.inspect-embed-title", targetUser.Username, targetUser.Discriminator),
-			Description: helpers.GetText("plugins.mod.inspect-in-progress"),
+			Title:       helpers.GetMessageTextF(msg, "plugins.mod.inspect-embed-title", targetUser.Username, targetUser.Discriminator),
+			Description: helpers.GetMessageText(msg, "plugins.mod.inspect-in-progress"),
 			URL:         helpers.GetAvatarUrl(targetUser),
 			Thumbnail:   &discordgo.MessageEmbedThumbnail{URL: helpers.GetAvatarUrl(targetUser)},
-			Footer:      &discordgo.MessageEmbedFooter{Text: helpers.GetTextF("plugins.mod.inspect-embed-footer", targetUser.ID, len(session.State.Guilds))},
+			Footer:      &discordgo.MessageEmbedFooter{Text: helpers.GetMessageTextF(msg, "plugins.mod.inspect-embed-footer", targetUser.ID, len(session.State.Guilds))},
 			Color:       0x0FADED,
 		}
 		var resultMessages []*discordgo.Message
@@ -1027,19 +1027,19 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 		} else {
 			resultMessages, err = helpers.SendMessage(msg.ChannelID,
-				helpers.GetText("plugins.mod.inspect-in-progress"))
+				helpers.GetMessageText(msg, "plugins.mod.inspect-in-progress"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		}
 		if len(resultMessages) <= 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 			return
 		}
 		resultMessage := resultMessages[0]
 
 		bannedOnServerList, checkFailedServerList := m.inspectUserBans(targetUser)
 
-		resultEmbed.Description = helpers.GetTextF("plugins.mod.inspect-description-done", targetUser.ID)
-		resultText := helpers.GetTextF("plugins.mod.inspect-description-done", targetUser.ID)
+		resultEmbed.Description = helpers.GetMessageTextF(msg, "plugins.mod.inspect-description-done", targetUser.ID)
+		resultText := helpers.GetMessageTextF(msg, "plugins.mod.inspect-description-done", targetUser.ID)
 		resultText += fmt.Sprintf("Username: `%s#%s`, ID: `#%s`",
 			targetUser.Username, targetUser.Discriminator, targetUser.ID)
 		if helpers.GetAvatarUrl(targetUser) != "" {
@@ -1203,7 +1203,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			if len(args) >= 1 {
 				targetChannel, err := helpers.GetChannelFromMention(msg, args[0])
 				if err != nil || targetChannel.ID == "" {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					return
 				}
 
@@ -1216,7 +1216,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				chooseMessages, err := helpers.SendEmbed(msg.ChannelID, chooseEmbed)
 				helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 				if len(chooseMessages) <= 0 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 					return
 				}
 				chooseMessage := chooseMessages[0]
@@ -1431,7 +1431,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				chooseEmbed.Description = strings.Replace(chooseEmbed.Description, "Use :floppy_disk: to save and exit.", "Saved.", -1)
 				helpers.EditEmbed(msg.ChannelID, chooseMessage.ID, chooseEmbed)
 
-				successMessage = helpers.GetText("plugins.mod.inspects-channel-set")
+				successMessage = helpers.GetMessageText(msg, "plugins.mod.inspects-channel-set")
 			} else {
 				channelIDBefore := settings.InspectsChannel
 				settings.InspectsChannel = ""
@@ -1440,7 +1440,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				settings.InspectTriggersEnabled.UserNewlyCreatedAccount = false
 				settings.InspectTriggersEnabled.UserMultipleJoins = false
 				settings.InspectTriggersEnabled.UserJoins = false
-				successMessage = helpers.GetText("plugins.mod.inspects-channel-disabled")
+				successMessage = helpers.GetMessageText(msg, "plugins.mod.inspects-channel-disabled")
 
 				_, err = helpers.EventlogLog(time.Now(), channel.GuildID, "",
 					models.EventlogTargetTypeChannel, msg.Author.ID,
@@ -1521,7 +1521,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 					return
 				}
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 		})
@@ -1626,7 +1626,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			session.ChannelTyping(msg.ChannelID)
 			args := strings.Fields(content)
 			if len(args) < 1 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 
@@ -1644,7 +1644,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 		return
 	case "create-invite": // [p]create-invite <#channel or channel ID> <age> <uses> [<guild ID>]
 		if !helpers.IsMod(msg) {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("mod.no_permission"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "mod.no_permission"))
 			return
 		}
 
@@ -1652,7 +1652,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 
 		args := strings.Fields(msg.Content)
 		if len(args) < 4 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			return
 		}
 
@@ -1666,7 +1666,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 		helpers.Relax(err)
 
 		if targetChannel.GuildID != targetGuildID {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 
@@ -1675,14 +1675,14 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			if args[2] == "0" {
 				maxAge = 0
 			} else {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 		}
 
 		maxUses, err := strconv.Atoi(args[3])
 		if err != nil {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 
@@ -1734,7 +1734,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 				helpers.RelaxLog(err)
 
 				_, err = helpers.SendMessage(msg.ChannelID,
-					helpers.GetTextF(
+					helpers.GetMessageTextF(msg,
 						"plugins.mod.prefix-set-success",
 						helpers.GetPrefixForServer(channel.GuildID),
 					))
@@ -1745,7 +1745,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 		}
 
 		_, err = helpers.SendMessage(msg.ChannelID,
-			helpers.GetTextF(
+			helpers.GetMessageTextF(msg,
 				"plugins.mod.prefix-info",
 				helpers.GetPrefixForServer(channel.GuildID),
 				helpers.GetPrefixForServer(channel.GuildID),
@@ -1846,7 +1846,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 					}
 				}
 				if afterRole == nil || afterRole.ID == "" {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 					helpers.Relax(err)
 					return
 				}
@@ -1953,7 +1953,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			session.ChannelTyping(msg.ChannelID)
 
 			if len(msg.Attachments) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 
@@ -1962,7 +1962,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			image, err := png.Decode(bytes.NewReader(imageData))
 			if err != nil {
 				if strings.Contains(err.Error(), "not a PNG file") {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.set-bot-dp-error-not-png"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.set-bot-dp-error-not-png"))
 					return
 				}
 			}
@@ -1983,7 +1983,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			)
 			helpers.Relax(err)
 
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.set-bot-dp-success"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.set-bot-dp-success"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		})
@@ -2024,17 +2024,17 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			}
 
 			if targetMessageID == "" || targetChannelID == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 
 			targetChannel, err := helpers.GetChannelFromMention(msg, targetChannelID)
 			if err != nil || targetChannel.ID == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 			if sourceChannel.GuildID != targetChannel.GuildID {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.echo-error-wrong-server"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.echo-error-wrong-server"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			}
@@ -2042,7 +2042,7 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			if err != nil {
 				if errD, ok := err.(*discordgo.RESTError); ok {
 					if errD.Message.Code == discordgo.ErrCodeUnknownMessage || errD.Message.Code == discordgo.ErrCodeMissingAccess {
-						_, err = helpers.SendMessage(sourceChannel.ID, helpers.GetText("plugins.mod.edit-error-not-found"))
+						_, err = helpers.SendMessage(sourceChannel.ID, helpers.GetMessageText(msg, "plugins.mod.edit-error-not-found"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -2064,24 +2064,24 @@ func (m *Mod) Action(command string, content string, msg *discordgo.Message, ses
 			if !alreadyPinned {
 				err = session.ChannelMessagePin(targetMessage.ChannelID, targetMessage.ID)
 				if targetChannel.ID != sourceChannel.ID {
-					message = helpers.GetText("plugins.mod.pin-success")
+					message = helpers.GetMessageText(msg, "plugins.mod.pin-success")
 				}
 			} else {
 				err = session.ChannelMessageUnpin(targetMessage.ChannelID, targetMessage.ID)
-				message = helpers.GetText("plugins.mod.unpin-success")
+				message = helpers.GetMessageText(msg, "plugins.mod.unpin-success")
 			}
 			if err != nil {
 				if errD, ok := err.(*discordgo.RESTError); ok {
 					if errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.pin-error-permissions"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.pin-error-permissions"))
 						return
 					}
 					if errD.Message.Code == discordgo.ErrCodeMaximumPinsReached {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.pin-error-limit"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.pin-error-limit"))
 						return
 					}
 					if errD.Message.Code == discordgo.ErrCodeCannotExecuteActionOnSystemMessage {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.mod.pin-error-system-message"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.mod.pin-error-system-message"))
 						return
 					}
 				}
@@ -2327,12 +2327,12 @@ func (m *Mod) OnGuildMemberAdd(member *discordgo.Member, session *discordgo.Sess
 					}
 
 					resultEmbed := &discordgo.MessageEmbed{
-						Title: helpers.GetTextF("plugins.mod.inspect-embed-title", member.User.Username, member.User.Discriminator),
-						Description: helpers.GetTextF("plugins.mod.inspect-description-done", member.User.ID) +
+						Title: helpers.GetTextLF(helpers.GetLocale(member.GuildID, ""), "plugins.mod.inspect-embed-title", member.User.Username, member.User.Discriminator),
+						Description: helpers.GetTextLF(helpers.GetLocale(member.GuildID, ""), "plugins.mod.inspect-description-done", member.User.ID) +
 							"\n_inspected because User joined this Server._",
 						URL:       helpers.GetAvatarUrl(member.User),
 						Thumbnail: &discordgo.MessageEmbedThumbnail{URL: helpers.GetAvatarUrl(member.User)},
-						Footer:    &discordgo.MessageEmbedFooter{Text: helpers.GetTextF("plugins.mod.inspect-embed-footer", member.User.ID, len(session.State.Guilds))},
+						Footer:    &discordgo.MessageEmbedFooter{Text: helpers.GetTextLF(helpers.GetLocale(member.GuildID, ""), "plugins.mod.inspect-embed-footer", member.User.ID, len(session.State.Guilds))},
 						Color:     0x0FADED,
 					}
 
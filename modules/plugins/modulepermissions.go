@@ -49,7 +49,7 @@ func (mp *ModulePermissions) actionStart(args []string, in *discordgo.Message, o
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = mp.newMsg("bot.arguments.too-few")
+		*out = mp.newMsg(in, "bot.arguments.too-few")
 		return mp.actionFinish
 	}
 
@@ -62,13 +62,13 @@ func (mp *ModulePermissions) actionStart(args []string, in *discordgo.Message, o
 		return mp.actionDeny
 	}
 
-	*out = mp.newMsg("bot.arguments.invalid")
+	*out = mp.newMsg(in, "bot.arguments.invalid")
 	return mp.actionFinish
 }
 
 func (mp *ModulePermissions) actionStatus(args []string, in *discordgo.Message, out **discordgo.MessageSend) modulePermissionsAction {
 	if !helpers.IsMod(in) {
-		*out = mp.newMsg("mod.no_permission")
+		*out = mp.newMsg(in, "mod.no_permission")
 		return mp.actionFinish
 	}
 
@@ -187,18 +187,18 @@ func (mp *ModulePermissions) actionStatus(args []string, in *discordgo.Message,
 		"__**:arrow_down: Allowed Categories**__\n" + messageAllowCategory +
 		"__**:arrow_down: Denied Categories**__\n" + messageDenyCategory +
 		"__**Module List**__\n" + messageModuleList
-	*out = mp.newMsg(messageFinal)
+	*out = mp.newMsg(in, messageFinal)
 	return mp.actionFinish
 }
 
 func (mp *ModulePermissions) actionAllow(args []string, in *discordgo.Message, out **discordgo.MessageSend) modulePermissionsAction {
 	if !helpers.IsMod(in) {
-		*out = mp.newMsg("mod.no_permission")
+		*out = mp.newMsg(in, "mod.no_permission")
 		return mp.actionFinish
 	}
 
 	if len(args) < 3 {
-		*out = mp.newMsg("bot.arguments.too-few")
+		*out = mp.newMsg(in, "bot.arguments.too-few")
 		return mp.actionFinish
 	}
 
@@ -214,7 +214,7 @@ func (mp *ModulePermissions) actionAllow(args []string, in *discordgo.Message, o
 		}
 	}
 	if permToAdd == 0 {
-		*out = mp.newMsg("plugins.modulepermissions.module-not-found")
+		*out = mp.newMsg(in, "plugins.modulepermissions.module-not-found")
 		return mp.actionFinish
 	}
 
@@ -243,7 +243,7 @@ func (mp *ModulePermissions) actionAllow(args []string, in *discordgo.Message, o
 				}, false)
 			helpers.RelaxLog(err)
 
-			*out = mp.newMsg("plugins.modulepermissions.set-allow-removed")
+			*out = mp.newMsg(in, "plugins.modulepermissions.set-allow-removed")
 			return mp.actionFinish
 		}
 
@@ -263,7 +263,7 @@ func (mp *ModulePermissions) actionAllow(args []string, in *discordgo.Message, o
 			}, false)
 		helpers.RelaxLog(err)
 
-		*out = mp.newMsg("plugins.modulepermissions.set-allow-added")
+		*out = mp.newMsg(in, "plugins.modulepermissions.set-allow-added")
 		return mp.actionFinish
 	}
 
@@ -294,7 +294,7 @@ func (mp *ModulePermissions) actionAllow(args []string, in *discordgo.Message, o
 				}, false)
 			helpers.RelaxLog(err)
 
-			*out = mp.newMsg("plugins.modulepermissions.set-allow-removed")
+			*out = mp.newMsg(in, "plugins.modulepermissions.set-allow-removed")
 			return mp.actionFinish
 		}
 
@@ -314,22 +314,22 @@ func (mp *ModulePermissions) actionAllow(args []string, in *discordgo.Message, o
 			}, false)
 		helpers.RelaxLog(err)
 
-		*out = mp.newMsg("plugins.modulepermissions.set-allow-added")
+		*out = mp.newMsg(in, "plugins.modulepermissions.set-allow-added")
 		return mp.actionFinish
 	}
 
-	*out = mp.newMsg("bot.arguments.invalid")
+	*out = mp.newMsg(in, "bot.arguments.invalid")
 	return mp.actionFinish
 }
 
 func (mp *ModulePermissions) actionDeny(args []string, in *discordgo.Message, out **discordgo.MessageSend) modulePermissionsAction {
 	if !helpers.IsMod(in) {
-		*out = mp.newMsg("mod.no_permission")
+		*out = mp.newMsg(in, "mod.no_permission")
 		return mp.actionFinish
 	}
 
 	if len(args) < 3 {
-		*out = mp.newMsg("bot.arguments.too-few")
+		*out = mp.newMsg(in, "bot.arguments.too-few")
 		return mp.actionFinish
 	}
 
@@ -345,7 +345,7 @@ func (mp *ModulePermissions) actionDeny(args []string, in *discordgo.Message, ou
 		}
 	}
 	if permToAdd == 0 {
-		*out = mp.newMsg("plugins.modulepermissions.module-not-found")
+		*out = mp.newMsg(in, "plugins.modulepermissions.module-not-found")
 		return mp.actionFinish
 	}
 
@@ -374,7 +374,7 @@ func (mp *ModulePermissions) actionDeny(args []string, in *discordgo.Message, ou
 				}, false)
 			helpers.RelaxLog(err)
 
-			*out = mp.newMsg("plugins.modulepermissions.set-deny-removed")
+			*out = mp.newMsg(in, "plugins.modulepermissions.set-deny-removed")
 			return mp.actionFinish
 		}
 
@@ -394,7 +394,7 @@ func (mp *ModulePermissions) actionDeny(args []string, in *discordgo.Message, ou
 			}, false)
 		helpers.RelaxLog(err)
 
-		*out = mp.newMsg("plugins.modulepermissions.set-deny-added")
+		*out = mp.newMsg(in, "plugins.modulepermissions.set-deny-added")
 		return mp.actionFinish
 	}
 
@@ -425,7 +425,7 @@ func (mp *ModulePermissions) actionDeny(args []string, in *discordgo.Message, ou
 				}, false)
 			helpers.RelaxLog(err)
 
-			*out = mp.newMsg("plugins.modulepermissions.set-deny-removed")
+			*out = mp.newMsg(in, "plugins.modulepermissions.set-deny-removed")
 			return mp.actionFinish
 		}
 
@@ -445,11 +445,11 @@ func (mp *ModulePermissions) actionDeny(args []string, in *discordgo.Message, ou
 			}, false)
 		helpers.RelaxLog(err)
 
-		*out = mp.newMsg("plugins.modulepermissions.set-deny-added")
+		*out = mp.newMsg(in, "plugins.modulepermissions.set-deny-added")
 		return mp.actionFinish
 	}
 
-	*out = mp.newMsg("bot.arguments.invalid")
+	*out = mp.newMsg(in, "bot.arguments.invalid")
 	return mp.actionFinish
 }
 
@@ -460,8 +460,8 @@ func (mp *ModulePermissions) actionFinish(args []string, in *discordgo.Message,
 	return nil
 }
 
-func (mp *ModulePermissions) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (mp *ModulePermissions) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (mp *ModulePermissions) logger() *logrus.Entry {
@@ -54,12 +54,12 @@ func (m *Move) Action(command string, content string, msg *discordgo.Message, se
 // moves messages and deletes old messages after that
 func (m *Move) actionMove(args []string, in *discordgo.Message, out **discordgo.MessageSend) moveAction {
 	if !helpers.IsMod(in) {
-		*out = m.newMsg("mod.no_permission")
+		*out = m.newMsg(in, "mod.no_permission")
 		return m.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = m.newMsg("bot.arguments.too-few")
+		*out = m.newMsg(in, "bot.arguments.too-few")
 		return m.actionFinish
 	}
 
@@ -83,13 +83,13 @@ func (m *Move) actionMove(args []string, in *discordgo.Message, out **discordgo.
 		numOfMessagesToMove, err = strconv.Atoi(args[2])
 		if err != nil {
 			cache.GetSession().MessageReactionRemove(in.ChannelID, in.ID, "🔄", cache.GetSession().State.User.ID)
-			*out = m.newMsg("bot.arguments.invalid")
+			*out = m.newMsg(in, "bot.arguments.invalid")
 			return m.actionFinish
 		}
 	}
 	if numOfMessagesToMove < 1 || numOfMessagesToMove > 100 {
 		cache.GetSession().MessageReactionRemove(in.ChannelID, in.ID, "🔄", cache.GetSession().State.User.ID)
-		*out = m.newMsg("bot.arguments.invalid")
+		*out = m.newMsg(in, "bot.arguments.invalid")
 		return m.actionFinish
 	}
 
@@ -98,11 +98,11 @@ func (m *Move) actionMove(args []string, in *discordgo.Message, out **discordgo.
 		cache.GetSession().MessageReactionRemove(in.ChannelID, in.ID, "🔄", cache.GetSession().State.User.ID)
 		if err != nil {
 			if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeUnknownMessage {
-				*out = m.newMsg("bot.arguments.invalid")
+				*out = m.newMsg(in, "bot.arguments.invalid")
 				return m.actionFinish
 			}
 			if strings.Contains(err.Error(), "no permission to manage webhooks") {
-				*out = m.newMsg("plugins.move.no-webhook-permissions")
+				*out = m.newMsg(in, "plugins.move.no-webhook-permissions")
 				return m.actionFinish
 			}
 		}
@@ -124,12 +124,12 @@ func (m *Move) actionMove(args []string, in *discordgo.Message, out **discordgo.
 // moves messages and keeps the old messages
 func (m *Move) actionCopy(args []string, in *discordgo.Message, out **discordgo.MessageSend) moveAction {
 	if !helpers.IsMod(in) {
-		*out = m.newMsg("mod.no_permission")
+		*out = m.newMsg(in, "mod.no_permission")
 		return m.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = m.newMsg("bot.arguments.too-few")
+		*out = m.newMsg(in, "bot.arguments.too-few")
 		return m.actionFinish
 	}
 
@@ -153,13 +153,13 @@ func (m *Move) actionCopy(args []string, in *discordgo.Message, out **discordgo.
 		numOfMessagesToMove, err = strconv.Atoi(args[2])
 		if err != nil {
 			cache.GetSession().MessageReactionRemove(in.ChannelID, in.ID, "🔄", cache.GetSession().State.User.ID)
-			*out = m.newMsg("bot.arguments.invalid")
+			*out = m.newMsg(in, "bot.arguments.invalid")
 			return m.actionFinish
 		}
 	}
 	if numOfMessagesToMove < 1 || numOfMessagesToMove > 100 {
 		cache.GetSession().MessageReactionRemove(in.ChannelID, in.ID, "🔄", cache.GetSession().State.User.ID)
-		*out = m.newMsg("bot.arguments.invalid")
+		*out = m.newMsg(in, "bot.arguments.invalid")
 		return m.actionFinish
 	}
 
@@ -168,11 +168,11 @@ func (m *Move) actionCopy(args []string, in *discordgo.Message, out **discordgo.
 		cache.GetSession().MessageReactionRemove(in.ChannelID, in.ID, "🔄", cache.GetSession().State.User.ID)
 		if err != nil {
 			if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeUnknownMessage {
-				*out = m.newMsg("bot.arguments.invalid")
+				*out = m.newMsg(in, "bot.arguments.invalid")
 				return m.actionFinish
 			}
 			if strings.Contains(err.Error(), "no permission to manage webhooks") {
-				*out = m.newMsg("plugins.move.no-webhook-permissions")
+				*out = m.newMsg(in, "plugins.move.no-webhook-permissions")
 				return m.actionFinish
 			}
 		}
@@ -301,8 +301,8 @@ func (m *Move) actionFinish(args []string, in *discordgo.Message, out **discordg
 	return nil
 }
 
-func (m *Move) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (m *Move) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (m *Move) Relax(err error) {
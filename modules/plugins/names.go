@@ -43,9 +43,9 @@ func (n *Names) Init(session *discordgo.Session) {
 	previousUsernamesMutex.Lock()
 	previousUsernames = make(map[string]string, 0)
 	previousUsernamesMutex.Unlock()
-	session.AddHandler(n.OnGuildMemberListChunk)
-	session.AddHandler(n.OnPresenceUpdate)
-	session.AddHandler(n.OnGuildMemberUpdate)
+	cache.AddHandler(n.OnGuildMemberListChunk)
+	cache.AddHandler(n.OnPresenceUpdate)
+	cache.AddHandler(n.OnGuildMemberUpdate)
 }
 
 func (n *Names) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
@@ -76,7 +76,7 @@ func (n *Names) actionNames(args []string, in *discordgo.Message, out **discordg
 	if len(args) >= 1 {
 		user, err = helpers.GetUserFromMention(args[0])
 		if err != nil {
-			_, err := helpers.SendMessage(in.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			_, err := helpers.SendMessage(in.ChannelID, helpers.GetMessageText(in, "bot.arguments.invalid"))
 			helpers.RelaxMessage(err, in.ChannelID, in.ID)
 			return nil
 		}
@@ -140,7 +140,7 @@ func (n *Names) actionNames(args []string, in *discordgo.Message, out **discordg
 		pastNicknamesText = "None"
 	}
 
-	resultText := helpers.GetTextF("plugins.names.list-result",
+	resultText := helpers.GetMessageTextF(in, "plugins.names.list-result",
 		user.Username, user.Discriminator, user.ID, pastUsernamesText, pastNicknamesText)
 	for _, page := range helpers.Pagify(resultText, ",") {
 		_, err := helpers.SendMessage(in.ChannelID, page)
@@ -387,8 +387,8 @@ func (n *Names) actionFinish(args []string, in *discordgo.Message, out **discord
 	return nil
 }
 
-func (n *Names) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (n *Names) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (n *Names) Relax(err error) {
@@ -29,7 +29,7 @@ func (m *Handler) Commands() []string {
 }
 
 func (m *Handler) Init(session *discordgo.Session) {
-	session.AddHandler(m.OnMessage)
+	cache.AddHandler(m.OnMessage)
 	go func() {
 		defer helpers.Recover()
 
@@ -52,7 +52,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 		switch args[0] {
 		case "add": // [p]notifications add <keyword(s)>
 			if len(args) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 			channel, err := helpers.GetChannel(msg.ChannelID)
@@ -78,7 +78,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 			)
 
 			if err == nil {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.notifications.keyword-add-error-duplicate", msg.Author.ID))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.notifications.keyword-add-error-duplicate", msg.Author.ID))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				session.ChannelMessageDelete(msg.ChannelID, msg.ID)
 				return
@@ -98,7 +98,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 			)
 			helpers.Relax(err)
 
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.notifications.keyword-added-success", msg.Author.ID))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.notifications.keyword-added-success", msg.Author.ID))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			cache.GetLogger().WithField("module", "notifications").Info(fmt.Sprintf("Added Notification Keyword \"%s\" to Guild %s (#%s) for User %s (#%s)", keywords, guild.Name, guild.ID, msg.Author.Username, msg.Author.ID))
 			session.ChannelMessageDelete(msg.ChannelID, msg.ID) // Do not get error as it might fail because deletion permissions are not given to the user
@@ -114,7 +114,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 			guild, err := helpers.GetGuild(channel.GuildID)
 			helpers.Relax(err)
 			if len(args) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 				return
 			}
 			session.ChannelTyping(msg.ChannelID)
@@ -134,7 +134,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 				&entryBucket,
 			)
 			if helpers.IsMdbNotFound(err) {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.notifications.keyword-delete-not-found-error", msg.Author.ID))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.notifications.keyword-delete-not-found-error", msg.Author.ID))
 				return
 			}
 			helpers.Relax(err)
@@ -142,7 +142,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 			err = helpers.MDbDelete(models.NotificationsTable, entryBucket.ID)
 			helpers.Relax(err)
 
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.notifications.keyword-delete-success", msg.Author.ID))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.notifications.keyword-delete-success", msg.Author.ID))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			cache.GetLogger().WithField("module", "notifications").Info(fmt.Sprintf("Deleted Notification Keyword \"%s\" from Guild %s (#%s) for User %s (#%s)", entryBucket.Keyword, guild.Name, guild.ID, msg.Author.Username, msg.Author.ID))
 			session.ChannelMessageDelete(msg.ChannelID, msg.ID) // Do not get error as it might fail because deletion permissions are not given to the user
@@ -160,7 +160,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 			return
 		case "ignore-channel":
 			if len(args) < 2 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 				return
 			}
 			commandIssueChannel, err := helpers.GetChannel(msg.ChannelID)
@@ -172,7 +172,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 				helpers.Relax(err)
 
 				if entryBucket == nil || len(entryBucket) <= 0 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.notifications.ignoredchannels-list-no-keywords-error"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.notifications.ignoredchannels-list-no-keywords-error"))
 					return
 				}
 				helpers.Relax(err)
@@ -190,14 +190,14 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 					targetChannel, err := helpers.GetChannelOrCategoryFromMention(msg, args[1])
 					if err != nil {
 						if strings.Contains(err.Error(), "Channel not found.") {
-							helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 							return
 						}
 					}
 					helpers.Relax(err)
 
 					if targetChannel.GuildID != commandIssueChannel.GuildID {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.notifications.ignore-channel-addorremove-error-server"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.notifications.ignore-channel-addorremove-error-server"))
 						return
 					}
 
@@ -232,7 +232,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 							}, false)
 						helpers.RelaxLog(err)
 
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.notifications.ignore-channel-add-success", targetChannel.ID))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.notifications.ignore-channel-add-success", targetChannel.ID))
 
 						go func() {
 							defer helpers.Recover()
@@ -261,7 +261,7 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 						}, false)
 					helpers.RelaxLog(err)
 
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.notifications.ignore-channel-remove-success", targetChannel.ID))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.notifications.ignore-channel-remove-success", targetChannel.ID))
 
 					go func() {
 						defer helpers.Recover()
@@ -283,16 +283,16 @@ func (m *Handler) Action(command string, content string, msg *discordgo.Message,
 
 			switch newValue {
 			case 2:
-				message = helpers.GetTextF("plugins.notifications.mode-2")
+				message = helpers.GetMessageTextF(msg, "plugins.notifications.mode-2")
 				break
 			case 3:
-				message = helpers.GetTextF("plugins.notifications.mode-3")
+				message = helpers.GetMessageTextF(msg, "plugins.notifications.mode-3")
 				break
 			case 4:
-				message = helpers.GetTextF("plugins.notifications.mode-4")
+				message = helpers.GetMessageTextF(msg, "plugins.notifications.mode-4")
 				break
 			default:
-				message = helpers.GetTextF("plugins.notifications.mode-1")
+				message = helpers.GetMessageTextF(msg, "plugins.notifications.mode-1")
 				break
 			}
 
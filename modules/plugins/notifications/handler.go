@@ -24,7 +24,7 @@ func handleList(session *discordgo.Session, msg *discordgo.Message) {
 	helpers.Relax(err)
 
 	if entryBucket == nil || len(entryBucket) <= 0 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.notifications.keyword-list-no-keywords-error", msg.Author.ID))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.notifications.keyword-list-no-keywords-error", msg.Author.ID))
 		return
 	}
 
@@ -67,7 +67,7 @@ func handleList(session *discordgo.Session, msg *discordgo.Message) {
 	dmChannel, err := session.UserChannelCreate(msg.Author.ID)
 	helpers.Relax(err)
 
-	_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.check-your-dms", msg.Author.ID))
+	_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.check-your-dms", msg.Author.ID))
 	helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 
 	_, err = helpers.SendMessage(dmChannel.ID, resultMessage)
@@ -77,7 +77,7 @@ func handleList(session *discordgo.Session, msg *discordgo.Message) {
 // _noti ignore <keyword(s)> [<#channel or channel id>]
 func handleIgnore(session *discordgo.Session, content string, msg *discordgo.Message, args []string) {
 	if len(args) < 2 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 		return
 	}
 
@@ -103,7 +103,7 @@ func handleIgnore(session *discordgo.Session, content string, msg *discordgo.Mes
 	if err != nil {
 		switch err {
 		case KeywordsNotFoundError:
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.notifications.keyword-ignore-not-found-error"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.notifications.keyword-ignore-not-found-error"))
 			return
 		}
 		helpers.Relax(err)
@@ -112,15 +112,15 @@ func handleIgnore(session *discordgo.Session, content string, msg *discordgo.Mes
 	var message string
 	if added {
 		if targetChannelID == "" {
-			message = helpers.GetText("plugins.notifications.keyword-ignore-guild-added")
+			message = helpers.GetMessageText(msg, "plugins.notifications.keyword-ignore-guild-added")
 		} else {
-			message = helpers.GetTextF("plugins.notifications.keyword-ignore-channel-added", targetChannel.Mention())
+			message = helpers.GetMessageTextF(msg, "plugins.notifications.keyword-ignore-channel-added", targetChannel.Mention())
 		}
 	} else {
 		if targetChannelID == "" {
-			message = helpers.GetText("plugins.notifications.keyword-ignore-guild-removed")
+			message = helpers.GetMessageText(msg, "plugins.notifications.keyword-ignore-guild-removed")
 		} else {
-			message = helpers.GetTextF("plugins.notifications.keyword-ignore-channel-removed", targetChannel.Mention())
+			message = helpers.GetMessageTextF(msg, "plugins.notifications.keyword-ignore-channel-removed", targetChannel.Mention())
 		}
 	}
 
@@ -197,7 +197,7 @@ func countIdolsByDifficulty(msg *discordgo.Message, commandArgs []string) {
 func listIdolsByDifficulty(msg *discordgo.Message, commandArgs []string) {
 
 	if len(commandArgs) < 2 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
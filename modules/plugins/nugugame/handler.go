@@ -40,6 +40,9 @@ func (m *Module) Init(session *discordgo.Session) {
 
 		// load all images and information
 		loadMiscImages()
+
+		// resume games which were running when the bot shut down
+		resumeNugugames()
 	}()
 }
 
@@ -48,6 +51,7 @@ func (m *Module) Uninit(session *discordgo.Session) {
 
 	// save any currently running games
 	cacheNugugames()
+	saveResumableNugugames()
 }
 
 // Will validate if the passed command entered is used for this plugin
@@ -67,7 +71,7 @@ func (m *Module) Action(command string, content string, msg *discordgo.Message,
 	// process text after the initial command
 	commandArgs, err := helpers.ToArgv(content)
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		return
 	}
 
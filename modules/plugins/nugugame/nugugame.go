@@ -24,6 +24,7 @@ const (
 	CHECKMARK_EMOJI              = "✅"
 	SINGLE_NUGUGAME_CACHE_KEY    = "currentSingleNugugames"
 	MULTI_NUGUGAME_CACHE_KEY     = "currentMultiNugugames"
+	RESUME_NUGUGAME_CACHE_KEY    = "resumeNugugames"
 )
 
 var currentNuguGames map[string]*nuguGame
@@ -78,7 +79,7 @@ func startNuguGame(msg *discordgo.Message, commandArgs []string) {
 			}
 
 			// if a arg was passed that didn't match any check, send invalid args message
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
@@ -106,16 +107,21 @@ func startNuguGame(msg *discordgo.Message, commandArgs []string) {
 
 	game.ChannelID = msg.ChannelID
 	game.User = msg.Author
-	game.GuessChannel = make(chan *discordgo.Message)
-	game.GuessTimeoutTimer = time.NewTimer(NUGUGAME_DEFULT_ROUND_DELAY * time.Second)
+	game.start()
+}
+
+// start saves the game and sends the first round
+func (g *nuguGame) start() {
+	g.GuessChannel = make(chan *discordgo.Message)
+	g.GuessTimeoutTimer = time.NewTimer(NUGUGAME_DEFULT_ROUND_DELAY * time.Second)
 
-	if game.UsersCorrectGuesses == nil {
-		game.UsersCorrectGuesses = make(map[string][]bson.ObjectId)
+	if g.UsersCorrectGuesses == nil {
+		g.UsersCorrectGuesses = make(map[string][]bson.ObjectId)
 	}
 
-	game.saveGame()
-	game.sendRound()
-	game.watchForGuesses()
+	g.saveGame()
+	g.sendRound()
+	g.watchForGuesses()
 }
 
 // sendRound sends the next round in the game
@@ -179,7 +185,7 @@ func (g *nuguGame) sendRound() {
 	fileSendMessage, err := helpers.SendFile(g.ChannelID, "idol_image.png", helpers.ImageToReader(idolImage), roundMessage)
 	if err != nil {
 		if checkPermissionError(err, g.ChannelID) {
-			helpers.SendMessage(g.ChannelID, helpers.GetText("bot.errors.no-file"))
+			helpers.SendMessage(g.ChannelID, helpers.GetTextL(helpers.GetChannelLocale(g.ChannelID, g.User.ID), "bot.errors.no-file"))
 		}
 		return
 	}
@@ -283,6 +289,10 @@ func (g *nuguGame) watchForGuesses() {
 					g.finishGame()
 					return
 				}
+
+			case <-helpers.ShutdownContext().Done():
+				// stop without finishing the game, it is saved on shutdown and resumed after the restart
+				return
 			}
 		}
 	}()
@@ -633,3 +643,53 @@ func cacheNugugames() {
 	}
 	log().Infof("Cached %d nugugames to redis", len(cachedGames))
 }
+
+// saveResumableNugugames saves the running games to be resumed after a restart
+func saveResumableNugugames() {
+	var resumableGames []nuguGameForCache
+	for _, game := range convertNugugameToCached(getAllNuguGames()) {
+		resumableGames = append(resumableGames, game)
+	}
+
+	// only resume games after a quick restart
+	err := setModuleCache(RESUME_NUGUGAME_CACHE_KEY, resumableGames, time.Hour)
+	helpers.RelaxLog(err)
+	log().Infof("Saved %d nugugames to resume after the restart", len(resumableGames))
+}
+
+// resumeNugugames restarts the games which were running when the bot shut down
+func resumeNugugames() {
+	var resumableGames []nuguGameForCache
+	err := getModuleCache(RESUME_NUGUGAME_CACHE_KEY, &resumableGames)
+	if err != nil || len(resumableGames) <= 0 {
+		return
+	}
+	delModuleCache(RESUME_NUGUGAME_CACHE_KEY)
+
+	// wait until the idols have been loaded
+	for i := 0; len(idols.GetAllIdols()) <= 0; i++ {
+		if i >= 60 {
+			log().Warnf("Idols did not load, unable to resume %d nugugames", len(resumableGames))
+			return
+		}
+		time.Sleep(5 * time.Second)
+	}
+
+	resumed := 0
+	for _, cachedGame := range resumableGames {
+		game := convertCachedNugugame(cachedGame)
+		if game == nil || getNuguGamesByChannelID(game.ChannelID) != nil {
+			continue
+		}
+
+		game.User, err = helpers.GetUser(cachedGame.UserId)
+		if err != nil {
+			continue
+		}
+
+		helpers.SendMessage(game.ChannelID, "Resuming the nugu game after a restart...")
+		game.start()
+		resumed++
+	}
+	log().Infof("Resumed %d nugugames on launch", resumed)
+}
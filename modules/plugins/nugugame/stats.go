@@ -161,7 +161,7 @@ func displayNuguGameStats(msg *discordgo.Message, commandArgs []string) {
 			}
 
 			// if a arg was passed that didn't match any check, send invalid args message
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
@@ -475,7 +475,7 @@ func displayNugugameRanking(msg *discordgo.Message, commandArgs []string, byServ
 			}
 
 			// if a arg was passed that didn't match any check, send invalid args message
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
@@ -632,7 +632,7 @@ func displayMissedIdols(msg *discordgo.Message, commandArgs []string) {
 			}
 
 			// if a arg was passed that didn't match any check, send invalid args message
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
@@ -760,14 +760,14 @@ func displayIdolStats(msg *discordgo.Message, commandArgs []string, targetIdol *
 		commandArgs = commandArgs[1:]
 
 		if len(commandArgs) < 2 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			return
 		}
 
 		// attempt to get matching idol
 		_, _, targetIdol = idols.GetMatchingIdolAndGroup(commandArgs[0], commandArgs[1], true)
 		if targetIdol == nil {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-matching-idol"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-matching-idol"))
 			return
 		}
 	}
@@ -876,14 +876,14 @@ func displayGroupStats(msg *discordgo.Message, commandArgs []string, groupName s
 		commandArgs = commandArgs[1:]
 
 		if len(commandArgs) < 1 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			return
 		}
 
 		// get real group name from arg
 		var exists bool
 		if exists, targetGroupName = idols.GetMatchingGroup(commandArgs[0], true); !exists {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.biasgame.stats.no-matching-group"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.biasgame.stats.no-matching-group"))
 			return
 		}
 	}
@@ -47,7 +47,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 		switch args[0] {
 		case "user": // [p]nuke user <user id/mention> "<reason>"
 			if !helpers.IsNukeMod(msg.Author.ID) {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.nuke.no-nukemod-permissions"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.nuke.no-nukemod-permissions"))
 				helpers.Relax(err)
 				return
 			} else {
@@ -55,7 +55,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 
 				safeArgs := splitChooseRegex.FindAllString(content, -1)
 				if len(safeArgs) < 3 {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 					return
 				} else {
 					var err error
@@ -63,7 +63,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 					targetUser, err = helpers.GetUserFromMention(strings.Trim(safeArgs[1], "\""))
 					if err != nil {
 						if err, ok := err.(*discordgo.RESTError); ok && err.Message.Code == discordgo.ErrCodeUnknownUser {
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.nuke.user-not-found"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.nuke.user-not-found"))
 							helpers.Relax(err)
 							return
 						} else {
@@ -74,7 +74,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 
 					reason := strings.TrimSpace(strings.Replace(content, strings.Join(args[:2], " "), "", 1))
 
-					if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetTextF("plugins.nuke.nuke-confirm",
+					if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetMessageTextF(msg, "plugins.nuke.nuke-confirm",
 						targetUser.Username, targetUser.ID, targetUser.ID, reason), "✅", "🚫") == true {
 						_, err = helpers.MDbInsert(
 							models.NukelogTable,
@@ -87,7 +87,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 						)
 						helpers.Relax(err)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.nuke.nuke-saved-in-db"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.nuke.nuke-saved-in-db"))
 						helpers.Relax(err)
 
 						bannedOnN := 0
@@ -101,22 +101,22 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 								err = session.GuildBanCreateWithReason(targetGuild.ID, targetUser.ID, reasonText, 1)
 								if err != nil {
 									if err, ok := err.(*discordgo.RESTError); ok {
-										helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.nuke.ban-error",
+										helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.nuke.ban-error",
 											targetGuild.Name, targetGuild.ID, err.Message.Message))
 										if targetGuildSettings.NukeLogChannel != "" {
 											helpers.SendMessage(targetGuildSettings.NukeLogChannel,
-												helpers.GetTextF("plugins.nuke.onserver-banned-error",
+												helpers.GetMessageTextF(msg, "plugins.nuke.onserver-banned-error",
 													targetUser.Username, targetUser.ID,
 													err.Message.Message,
 													msg.Author.Username, msg.Author.ID,
 													reason))
 										}
 									} else {
-										helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.nuke.ban-error",
+										helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.nuke.ban-error",
 											targetGuild.Name, targetGuild.ID, err.Error()))
 										if targetGuildSettings.NukeLogChannel != "" {
 											helpers.SendMessage(targetGuildSettings.NukeLogChannel,
-												helpers.GetTextF("plugins.nuke.onserver-banned-error",
+												helpers.GetMessageTextF(msg, "plugins.nuke.onserver-banned-error",
 													targetUser.Username, targetUser.ID,
 													err.Error(),
 													msg.Author.Username, msg.Author.ID,
@@ -124,11 +124,11 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 										}
 									}
 								} else {
-									helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.nuke.banned-on-server",
+									helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.nuke.banned-on-server",
 										targetGuild.Name, targetGuild.ID))
 									if targetGuildSettings.NukeLogChannel != "" {
 										helpers.SendMessage(targetGuildSettings.NukeLogChannel,
-											helpers.GetTextF("plugins.nuke.onserver-banned-success",
+											helpers.GetMessageTextF(msg, "plugins.nuke.onserver-banned-success",
 												targetUser.Username, targetUser.ID,
 												msg.Author.Username, msg.Author.ID,
 												reason))
@@ -141,7 +141,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 						// add user to global blacklist
 						helpers.Blacklisted = append(helpers.Blacklisted, targetUser.ID)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.nuke.nuke-completed", bannedOnN))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.nuke.nuke-completed", bannedOnN))
 						helpers.Relax(err)
 					}
 				}
@@ -159,7 +159,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 				if len(args) >= 2 {
 					targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
 					if err != nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 
@@ -168,7 +168,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 						nukeModMentions = append(nukeModMentions, "<@"+nukeMod+">")
 					}
 
-					if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetTextF("plugins.nuke.participation-confirm", strings.Join(nukeModMentions, ", "), helpers.GetPrefixForServer(channel.GuildID)), "✅", "🚫") == true {
+					if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetMessageTextF(msg, "plugins.nuke.participation-confirm", strings.Join(nukeModMentions, ", "), helpers.GetPrefixForServer(channel.GuildID)), "✅", "🚫") == true {
 						settings.NukeIsParticipating = true
 						previousChannel := settings.NukeLogChannel
 						settings.NukeLogChannel = targetChannel.ID
@@ -206,7 +206,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 							}, false)
 						helpers.RelaxLog(err)
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.nuke.participation-enabled"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.nuke.participation-enabled"))
 						helpers.Relax(err)
 						// TODO: ask to ban people already nuked?
 					}
@@ -228,7 +228,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 						nil, false)
 					helpers.RelaxLog(err)
 
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.nuke.participation-disabled"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.nuke.participation-disabled"))
 					helpers.Relax(err)
 				}
 			})
@@ -261,7 +261,7 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 				botPermissions := helpers.GetMemberPermissions(channel.GuildID, session.State.User.ID)
 				if botPermissions&discordgo.PermissionBanMembers != discordgo.PermissionBanMembers &&
 					botPermissions&discordgo.PermissionAdministrator != discordgo.PermissionAdministrator {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.nuke.apply-bot-not-allowed"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.nuke.apply-bot-not-allowed"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
@@ -269,12 +269,12 @@ func (n *Nuke) Action(command string, content string, msg *discordgo.Message, se
 				userPermissions := helpers.GetMemberPermissions(channel.GuildID, msg.Author.ID)
 				if userPermissions&discordgo.PermissionBanMembers != discordgo.PermissionBanMembers &&
 					userPermissions&discordgo.PermissionAdministrator != discordgo.PermissionAdministrator {
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.nuke.apply-user-not-allowed"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.nuke.apply-user-not-allowed"))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				}
 
-				if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetTextF("plugins.nuke.apply-confirm",
+				if helpers.ConfirmEmbed(msg.ChannelID, msg.Author, helpers.GetMessageTextF(msg, "plugins.nuke.apply-confirm",
 					helpers.GetPrefixForServer(channel.GuildID)), "✅", "🚫") {
 					// gather bans
 					guildBans, err := cache.GetSession().GuildBans(channel.GuildID)
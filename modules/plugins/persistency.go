@@ -27,8 +27,8 @@ func (p *Persistency) Commands() []string {
 }
 
 func (p *Persistency) Init(session *discordgo.Session) {
-	session.AddHandler(p.OnGuildMemberListChunk)
-	session.AddHandler(p.OnGuildMemberUpdate)
+	cache.AddHandler(p.OnGuildMemberListChunk)
+	cache.AddHandler(p.OnGuildMemberUpdate)
 }
 
 func (p *Persistency) Uninit(session *discordgo.Session) {
@@ -57,7 +57,7 @@ func (p *Persistency) actionStart(args []string, in *discordgo.Message, out **di
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = p.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = p.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return p.actionFinish
 	}
 
@@ -70,13 +70,13 @@ func (p *Persistency) actionStart(args []string, in *discordgo.Message, out **di
 		return p.statusAction
 	}
 
-	*out = p.newMsg(helpers.GetText("bot.arguments.invalid"))
+	*out = p.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 	return p.actionFinish
 }
 
 func (p *Persistency) roleAction(args []string, in *discordgo.Message, out **discordgo.MessageSend) PersistencyAction {
 	if len(args) < 2 {
-		*out = p.newMsg("bot.arguments.too-few")
+		*out = p.newMsg(in, "bot.arguments.too-few")
 		return p.actionFinish
 	}
 
@@ -87,14 +87,14 @@ func (p *Persistency) roleAction(args []string, in *discordgo.Message, out **dis
 		return p.roleRemoveAction
 	}
 
-	*out = p.newMsg(helpers.GetText("bot.arguments.invalid"))
+	*out = p.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 	return p.actionFinish
 }
 
 // [p]persistency roles add <role name or id>
 func (p *Persistency) roleAddAction(args []string, in *discordgo.Message, out **discordgo.MessageSend) PersistencyAction {
 	if len(args) < 3 {
-		*out = p.newMsg("bot.arguments.too-few")
+		*out = p.newMsg(in, "bot.arguments.too-few")
 		return p.actionFinish
 	}
 
@@ -114,7 +114,7 @@ func (p *Persistency) roleAddAction(args []string, in *discordgo.Message, out **
 	}
 
 	if roleToAdd.ID == "" {
-		*out = p.newMsg(helpers.GetText("bot.arguments.invalid"))
+		*out = p.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 		return p.actionFinish
 	}
 
@@ -128,7 +128,7 @@ func (p *Persistency) roleAddAction(args []string, in *discordgo.Message, out **
 	}
 
 	if alreadyAdded {
-		*out = p.newMsg("plugins.persistency.role-add-error-duplicate")
+		*out = p.newMsg(in, "plugins.persistency.role-add-error-duplicate")
 		return p.actionFinish
 	}
 
@@ -159,14 +159,14 @@ func (p *Persistency) roleAddAction(args []string, in *discordgo.Message, out **
 		}, false)
 	helpers.RelaxLog(err)
 
-	*out = p.newMsg("plugins.persistency.role-add-success", roleToAdd.Name)
+	*out = p.newMsg(in, "plugins.persistency.role-add-success", roleToAdd.Name)
 	return p.actionFinish
 }
 
 // [p]persistency roles remove <role name or id>
 func (p *Persistency) roleRemoveAction(args []string, in *discordgo.Message, out **discordgo.MessageSend) PersistencyAction {
 	if len(args) < 3 {
-		*out = p.newMsg("bot.arguments.too-few")
+		*out = p.newMsg(in, "bot.arguments.too-few")
 		return p.actionFinish
 	}
 
@@ -186,7 +186,7 @@ func (p *Persistency) roleRemoveAction(args []string, in *discordgo.Message, out
 	}
 
 	if roleToRemove.ID == "" {
-		*out = p.newMsg(helpers.GetText("bot.arguments.invalid"))
+		*out = p.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 		return p.actionFinish
 	}
 
@@ -204,7 +204,7 @@ func (p *Persistency) roleRemoveAction(args []string, in *discordgo.Message, out
 	}
 
 	if !removed {
-		*out = p.newMsg("plugins.persistency.role-remove-error-not-found")
+		*out = p.newMsg(in, "plugins.persistency.role-remove-error-not-found")
 		return p.actionFinish
 	}
 
@@ -235,7 +235,7 @@ func (p *Persistency) roleRemoveAction(args []string, in *discordgo.Message, out
 	err = helpers.GuildSettingsSet(guild.ID, guildSettings)
 	helpers.Relax(err)
 
-	*out = p.newMsg("plugins.persistency.role-remove-success")
+	*out = p.newMsg(in, "plugins.persistency.role-remove-success")
 	return p.actionFinish
 }
 
@@ -249,7 +249,7 @@ func (p *Persistency) statusAction(args []string, in *discordgo.Message, out **d
 	message += "**Custom Roles:** "
 	customRoles := p.GetPersistentCustomRoles(channel.GuildID)
 	if len(customRoles) <= 0 {
-		message += helpers.GetText("plugins.persistency.status-roles-none")
+		message += helpers.GetMessageText(in, "plugins.persistency.status-roles-none")
 	} else {
 		for _, persistentRole := range customRoles {
 			message += "`" + persistentRole.Name + "`, "
@@ -261,7 +261,7 @@ func (p *Persistency) statusAction(args []string, in *discordgo.Message, out **d
 	message += "**Managed Roles:** "
 	managedRoles := p.GetPersistentManagedRoles(channel.GuildID)
 	if len(managedRoles) <= 0 {
-		message += helpers.GetText("plugins.persistency.status-roles-none")
+		message += helpers.GetMessageText(in, "plugins.persistency.status-roles-none")
 	} else {
 		for _, persistentRole := range managedRoles {
 			message += "`" + persistentRole.Name + "`, "
@@ -273,7 +273,7 @@ func (p *Persistency) statusAction(args []string, in *discordgo.Message, out **d
 	message += "**Bias Roles:** "
 	biasRoles := p.GetPersistentBiasRoles(channel.GuildID)
 	if len(biasRoles) <= 0 {
-		message += helpers.GetText("plugins.persistency.status-roles-none")
+		message += helpers.GetMessageText(in, "plugins.persistency.status-roles-none")
 	} else {
 		for _, persistentRole := range biasRoles {
 			message += "`" + persistentRole.Name + "`, "
@@ -294,7 +294,7 @@ func (p *Persistency) statusAction(args []string, in *discordgo.Message, out **d
 
 func (p *Persistency) toggleAction(args []string, in *discordgo.Message, out **discordgo.MessageSend) PersistencyAction {
 	if len(args) < 2 {
-		*out = p.newMsg("bot.arguments.too-few")
+		*out = p.newMsg(in, "bot.arguments.too-few")
 		return p.actionFinish
 	}
 
@@ -303,13 +303,13 @@ func (p *Persistency) toggleAction(args []string, in *discordgo.Message, out **d
 		return p.toggleBiasAction
 	}
 
-	*out = p.newMsg(helpers.GetText("bot.arguments.invalid"))
+	*out = p.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 	return p.actionFinish
 }
 
 func (p *Persistency) toggleBiasAction(args []string, in *discordgo.Message, out **discordgo.MessageSend) PersistencyAction {
 	if !helpers.IsMod(in) {
-		*out = p.newMsg("mod.no_permission")
+		*out = p.newMsg(in, "mod.no_permission")
 		return p.actionFinish
 	}
 
@@ -323,11 +323,11 @@ func (p *Persistency) toggleBiasAction(args []string, in *discordgo.Message, out
 	if config.PersistencyBiasEnabled {
 		config.PersistencyBiasEnabled = false
 
-		*out = p.newMsg(helpers.GetText("plugins.persistency.bias-persistency-disabled"))
+		*out = p.newMsg(in, helpers.GetMessageText(in, "plugins.persistency.bias-persistency-disabled"))
 	} else {
 		config.PersistencyBiasEnabled = true
 
-		*out = p.newMsg(helpers.GetText("plugins.persistency.bias-persistency-enabled"))
+		*out = p.newMsg(in, helpers.GetMessageText(in, "plugins.persistency.bias-persistency-enabled"))
 	}
 
 	_, err = helpers.EventlogLog(time.Now(), channel.GuildID, channel.GuildID,
@@ -356,11 +356,11 @@ func (p *Persistency) actionFinish(args []string, in *discordgo.Message, out **d
 	return nil
 }
 
-func (p *Persistency) newMsg(content string, replacements ...interface{}) *discordgo.MessageSend {
+func (p *Persistency) newMsg(in *discordgo.Message, content string, replacements ...interface{}) *discordgo.MessageSend {
 	if len(replacements) < 1 {
-		return &discordgo.MessageSend{Content: helpers.GetText(content)}
+		return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 	}
-	return &discordgo.MessageSend{Content: helpers.GetTextF(content, replacements...)}
+	return &discordgo.MessageSend{Content: helpers.GetMessageTextF(in, content, replacements...)}
 }
 
 func (p *Persistency) Relax(err error) {
@@ -88,7 +88,7 @@ func (m *Perspective) actionStart(args []string, in *discordgo.Message, out **di
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = m.newMsg("bot.arguments.too-few")
+		*out = m.newMsg(in, "bot.arguments.too-few")
 		return m.actionFinish
 	}
 
@@ -120,7 +120,7 @@ func (m *Perspective) actionTest(args []string, in *discordgo.Message, out **dis
 		obsceneWarning = " ⚠"
 	}
 
-	*out = m.newMsg(fmt.Sprintf(
+	*out = m.newMsg(in, fmt.Sprintf(
 		"Severe Toxicity: %.2f%s, Inflammatory: %.2f%s, Obscene: %.2f%s\nTook %s",
 		messageResults.SevereToxicity, severeToxicityWarning,
 		messageResults.Inflammatory, inflammatoryWarning,
@@ -134,11 +134,11 @@ func (m *Perspective) actionTest(args []string, in *discordgo.Message, out **dis
 func (m *Perspective) actionParticipate(args []string, in *discordgo.Message, out **discordgo.MessageSend) perspectiveAction {
 	// TODO: remove robyul mod check in the future
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = m.newMsg("robyulmod.no_permission")
+		*out = m.newMsg(in, "robyulmod.no_permission")
 		return m.actionFinish
 	}
 	if !helpers.IsAdmin(in) {
-		*out = m.newMsg("admin.no_permission")
+		*out = m.newMsg(in, "admin.no_permission")
 		return m.actionFinish
 	}
 
@@ -151,11 +151,11 @@ func (m *Perspective) actionParticipate(args []string, in *discordgo.Message, ou
 		// disable perspective checking
 		settings.PerspectiveIsParticipating = false
 		settings.PerspectiveChannelID = ""
-		*out = m.newMsg("plugins.perspective.participation-disabled")
+		*out = m.newMsg(in, "plugins.perspective.participation-disabled")
 	} else {
 		// enable perspective checking
 		if len(args) < 2 {
-			*out = m.newMsg("bot.arguments.too-few")
+			*out = m.newMsg(in, "bot.arguments.too-few")
 			return m.actionFinish
 		}
 
@@ -164,7 +164,7 @@ func (m *Perspective) actionParticipate(args []string, in *discordgo.Message, ou
 
 		settings.PerspectiveIsParticipating = true
 		settings.PerspectiveChannelID = targetChannel.ID
-		*out = m.newMsg("plugins.perspective.participation-enabled")
+		*out = m.newMsg(in, "plugins.perspective.participation-enabled")
 	}
 
 	err = helpers.GuildSettingsSet(channel.GuildID, settings)
@@ -435,8 +435,8 @@ func (m *Perspective) OnGuildBanRemove(user *discordgo.GuildBanRemove, session *
 
 }
 
-func (m *Perspective) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (m *Perspective) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (m *Perspective) Relax(err error) {
@@ -27,7 +27,7 @@ var (
 
 func (p *Ping) Init(session *discordgo.Session) {
 	pingMessage = helpers.GetText("plugins.ping.message")
-	session.AddHandler(p.OnMessage)
+	cache.AddHandler(p.OnMessage)
 }
 
 func (p *Ping) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
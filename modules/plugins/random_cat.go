@@ -33,10 +33,10 @@ func (rc RandomCat) Action(command string, content string, msg *discordgo.Messag
 
 	session.ChannelTyping(msg.ChannelID)
 
-	content = helpers.GetText("plugins.randomcat.error")
+	content = helpers.GetMessageText(msg, "plugins.randomcat.error")
 	link := rc.getRandomCatLink()
 	if link != "" {
-		content = helpers.GetTextF("plugins.randomcat.success", link)
+		content = helpers.GetMessageTextF(msg, "plugins.randomcat.success", link)
 	} else {
 		cache.GetLogger().Error("received a empty randon cat link")
 	}
@@ -66,7 +66,7 @@ func (rc RandomCat) Action(command string, content string, msg *discordgo.Messag
 						link = rc.getRandomCatLink()
 						if link != "" {
 							helpers.EditMessage(messages[0].ChannelID, messages[0].ID,
-								helpers.GetTextF("plugins.randomcat.success", link))
+								helpers.GetMessageTextF(msg, "plugins.randomcat.success", link))
 						}
 						session.MessageReactionRemove(reaction.ChannelID, reaction.MessageID, reaction.Emoji.Name, reaction.UserID)
 					}
@@ -15,6 +15,7 @@ import (
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/metrics"
 	"github.com/Seklfreak/Robyul2/models"
+	"github.com/Seklfreak/Robyul2/modules/plugins/randompictures"
 	"github.com/bradfitz/slice"
 	"github.com/bwmarrin/discordgo"
 	humanize "github.com/dustin/go-humanize"
@@ -22,22 +23,11 @@ import (
 	"github.com/globalsign/mgo/bson"
 	redisCache "github.com/go-redis/cache"
 	"github.com/vmihailenco/msgpack"
-	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/googleapi"
 )
 
 type RandomPictures struct{}
 
-var (
-	driveService *drive.Service
-)
-
-const (
-	driveSearchText       = "\"%s\" in parents and (mimeType = \"image/gif\" or mimeType = \"image/jpeg\" or mimeType = \"image/png\" or mimeType = \"application/vnd.google-apps.folder\")"
-	driveFieldsText       = "nextPageToken, files(id, size, mimeType)"
-	driveFieldsSingleText = "id, name, size, modifiedTime, imageMediaMetadata, webContentLink"
-)
-
 func (rp *RandomPictures) Commands() []string {
 	return []string{
 		"randompictures",
@@ -49,8 +39,13 @@ func (rp *RandomPictures) Commands() []string {
 
 func (rp *RandomPictures) Init(session *discordgo.Session) {
 
-	// Get drive service
-	driveService = cache.GetGoogleDriveService()
+	// register providers, Google Drive is optional
+	if cache.HasGoogleDrive() {
+		randompictures.Register(&randompictures.DriveProvider{Service: cache.GetGoogleDriveService()})
+	}
+	randompictures.Register(&randompictures.StorageProvider{})
+	randompictures.Register(&randompictures.ChannelProvider{})
+	randompictures.Register(randompictures.NewImgurProvider())
 
 	// initial random generator
 	rand.Seed(time.Now().Unix())
@@ -72,15 +67,15 @@ func (rp *RandomPictures) Init(session *discordgo.Session) {
 			}
 			helpers.Relax(err)
 
-			log.WithField("module", "randompictures").Info("gathering google drive picture cache")
+			log.WithField("module", "randompictures").Info("gathering picture cache")
 			for _, sourceEntry := range rpSources {
 				var key1 string
 				var key2 string
 				var fileHash string
 				var i int
-				var entry *drive.File
-				for i, entry = range rp.getFileCache(sourceEntry) {
-					fileHash = rp.GetFileHash(sourceEntry.ID, sourceEntry.PreviousID, entry.Id)
+				var entry randompictures.Picture
+				for i, entry = range rp.getPictures(sourceEntry) {
+					fileHash = rp.GetFileHash(sourceEntry.ID, sourceEntry.PreviousID, entry.ID)
 					key1 = fmt.Sprintf("robyul2-discord:randompictures:filescache:by-n:%s:entry:%d", helpers.MdbIdToHuman(sourceEntry.ID), i+1)
 					key2 = fmt.Sprintf("robyul2-discord:randompictures:filescache:by-hash:%s", fileHash)
 					marshalled, err = msgpack.Marshal(entry)
@@ -149,10 +144,10 @@ func (rp *RandomPictures) Init(session *discordgo.Session) {
 							key = fmt.Sprintf("robyul2-discord:randompictures:filescache:by-hash:%s", fileHash)
 							resultBytes, err := redisClient.Get(key).Bytes()
 							if err == nil {
-								var gPicture *drive.File
-								msgpack.Unmarshal(resultBytes, &gPicture)
+								var picture randompictures.Picture
+								msgpack.Unmarshal(resultBytes, &picture)
 
-								err = rp.postItem(sourceEntry.GuildID, postToChannelID, "", gPicture, sourceEntry.ID, sourceEntry.PreviousID, strconv.Itoa(chosenPicN))
+								err = rp.postItem(sourceEntry.GuildID, postToChannelID, "", picture, sourceEntry.ID, sourceEntry.PreviousID, strconv.Itoa(chosenPicN))
 								if err != nil {
 									if errG, ok := err.(*googleapi.Error); ok {
 										if strings.Contains("The download quota for this file has been exceeded", errG.Error()) {
@@ -247,7 +242,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 			session.ChannelMessageDelete(msg.ChannelID, msg.ID)
 			dmChannel, err := session.UserChannelCreate(msg.Author.ID)
 			helpers.Relax(err)
-			_, err = helpers.SendMessage(dmChannel.ID, helpers.GetText("plugins.randompictures.pic-delay-dm"))
+			_, err = helpers.SendMessage(dmChannel.ID, helpers.GetMessageText(msg, "plugins.randompictures.pic-delay-dm"))
 			helpers.RelaxMessage(err, "", "")
 			return
 		}
@@ -259,23 +254,23 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 		err = helpers.MDbIter(helpers.MdbCollection(models.RandompictureSourcesTable).Find(bson.M{"guildid": channel.GuildID})).All(&rpSources)
 		helpers.Relax(err)
 		if len(rpSources) <= 0 {
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.randompictures.pic-no-picture"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.randompictures.pic-no-picture"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
 		helpers.Relax(err)
 
-		initialMessages, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.randompictures.waiting-for-picture"))
+		initialMessages, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.randompictures.waiting-for-picture"))
 		helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		if len(initialMessages) <= 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 			return
 		}
 		initialMessage := initialMessages[0]
 
 		postedPic, err = rp.postRandomItemFromContent(channel, msg, content, initialMessage, rpSources)
 		if err != nil || postedPic == false {
-			helpers.EditMessage(msg.ChannelID, initialMessage.ID, helpers.GetText("plugins.randompictures.pic-no-picture"))
+			helpers.EditMessage(msg.ChannelID, initialMessage.ID, helpers.GetMessageText(msg, "plugins.randompictures.pic-no-picture"))
 		} else {
 			isPostingNewPic := false
 			err = session.MessageReactionAdd(msg.ChannelID, initialMessage.ID, "🎲")
@@ -291,7 +286,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 						if reaction.UserID == msg.Author.ID && reaction.Emoji.Name == "🎲" && isPostingNewPic == false {
 							postedPic, err = rp.postRandomItemFromContent(channel, msg, content, initialMessage, rpSources)
 							if err != nil || postedPic == false {
-								helpers.EditMessage(msg.ChannelID, initialMessage.ID, helpers.GetText("plugins.randompictures.pic-no-picture"))
+								helpers.EditMessage(msg.ChannelID, initialMessage.ID, helpers.GetMessageText(msg, "plugins.randompictures.pic-no-picture"))
 							}
 							session.MessageReactionRemove(reaction.ChannelID, reaction.MessageID, reaction.Emoji.Name, reaction.UserID)
 						}
@@ -307,12 +302,12 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 		args := strings.Fields(content)
 		if len(args) > 0 {
 			switch args[0] {
-			case "new-config": // [p]randompictures new-config
+			case "new-config": // [p]randompictures new-config alias=<names> [provider=<drive|storage|channel|imgur>] target=<ids> [channel=<channels>] [skiproles=<roles>]
 				helpers.RequireRobyulMod(msg, func() {
 					session.ChannelTyping(msg.ChannelID)
 
 					if len(args) <= 1 {
-						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 						helpers.Relax(err)
 						return
 					}
@@ -325,6 +320,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 
 					postToChannelIDs := make([]string, 0)
 					driveFolderIDs := make([]string, 0)
+					targets := make([]string, 0)
 					aliases := make([]string, 0)
 					blacklistedRoleIDs := make([]string, 0)
 					data := helpers.ParseKeyValueString(
@@ -335,25 +331,55 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 						for _, parsedID := range postToChannelIDsParsed {
 							channelParsed, err := helpers.GetChannelFromMention(msg, parsedID)
 							if err != nil || channelParsed == nil || channelParsed.ID == "" {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 								helpers.Relax(err)
 								return
 							}
 							postToChannelIDs = append(postToChannelIDs, channelParsed.ID)
 						}
 					}
-					if folderIDsText, ok := data["folder"]; ok {
-						folderIDsParsed := strings.Split(folderIDsText, ",")
-						for _, parsedID := range folderIDsParsed {
-							result, err := driveService.Files.List().Q(fmt.Sprintf(driveSearchText, parsedID)).Fields(googleapi.Field(driveFieldsText)).PageSize(1).Do()
-							if err != nil || len(result.Files) <= 0 {
-								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					providerName := randompictures.DefaultProvider
+					if providerText, ok := data["provider"]; ok {
+						providerName = strings.ToLower(strings.TrimSpace(providerText))
+					}
+					provider, err := randompictures.GetProvider(providerName)
+					if err != nil {
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.randompictures.new-config-provider-invalid",
+							strings.Join(randompictures.ProviderNames(), ", ")))
+						helpers.Relax(err)
+						return
+					}
+					targetsText, ok := data["target"]
+					if !ok && providerName == randompictures.DefaultProvider {
+						targetsText, ok = data["folder"]
+					}
+					if ok {
+						targetsParsed := strings.Split(targetsText, ",")
+						for _, parsedTarget := range targetsParsed {
+							parsedTarget = strings.TrimSpace(parsedTarget)
+							if providerName == "channel" {
+								channelParsed, err := helpers.GetChannelFromMention(msg, parsedTarget)
+								if err != nil || channelParsed == nil || channelParsed.GuildID != channel.GuildID {
+									_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+									helpers.Relax(err)
+									return
+								}
+								parsedTarget = channelParsed.ID
+							}
+							err = provider.Validate(parsedTarget)
+							if err != nil {
+								_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 								helpers.Relax(err)
 								return
 							}
-							driveFolderIDs = append(driveFolderIDs, parsedID)
+							targets = append(targets, parsedTarget)
 						}
 					}
+					// Google Drive sources keep their folders in DriveFolderIDs, like sources created before there were other providers
+					if providerName == randompictures.DefaultProvider {
+						driveFolderIDs = targets
+						targets = make([]string, 0)
+					}
 					if aliasesText, ok := data["alias"]; ok {
 						aliasesParsed := strings.Split(aliasesText, ",")
 						for _, parsedAlias := range aliasesParsed {
@@ -374,8 +400,8 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 						}
 					}
 
-					if len(aliases) <= 0 || len(driveFolderIDs) <= 0 {
-						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+					if len(aliases) <= 0 || len(driveFolderIDs)+len(targets) <= 0 {
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 						helpers.Relax(err)
 						return
 					}
@@ -384,7 +410,9 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 						models.RandompictureSourcesTable,
 						models.RandompictureSourceEntry{
 							PostToChannelIDs:   postToChannelIDs,
+							Provider:           providerName,
 							DriveFolderIDs:     driveFolderIDs,
+							Targets:            targets,
 							Aliases:            aliases,
 							GuildID:            channel.GuildID,
 							BlacklistedRoleIDs: blacklistedRoleIDs,
@@ -406,6 +434,14 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 								Key:   "randompicture_source_drivefolderids",
 								Value: strings.Join(driveFolderIDs, ";"),
 							},
+							{
+								Key:   "randompicture_source_provider",
+								Value: providerName,
+							},
+							{
+								Key:   "randompicture_source_targets",
+								Value: strings.Join(targets, ";"),
+							},
 							{
 								Key:   "randompicture_source_aliases",
 								Value: strings.Join(aliases, ";"),
@@ -434,7 +470,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 					helpers.Relax(err)
 
 					if len(rpSources) <= 0 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.randompictures.list-no-entries-error"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.randompictures.list-no-entries-error"))
 						return
 					} else if err != nil {
 						helpers.Relax(err)
@@ -454,17 +490,22 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 							totalCachedImages += pictureCount
 						}
 
-						listText += fmt.Sprintf(":arrow_forward: `%s`: on %s (`#%s`), %d Aliases (`%s`), %d Folders, %d Channels, %d Skipped Roles, %s\n",
+						providerName := rpSource.Provider
+						if providerName == "" {
+							providerName = randompictures.DefaultProvider
+						}
+
+						listText += fmt.Sprintf(":arrow_forward: `%s`: on %s (`#%s`), %d Aliases (`%s`), %d Targets on `%s`, %d Channels, %d Skipped Roles, %s\n",
 							helpers.MdbIdToHuman(rpSource.ID), rpSourceGuild.Name, rpSourceGuild.ID,
 							len(rpSource.Aliases), strings.Join(rpSource.Aliases, ","),
-							len(rpSource.DriveFolderIDs),
+							len(rp.getTargets(rpSource)), providerName,
 							len(rpSource.PostToChannelIDs), len(rpSource.BlacklistedRoleIDs),
 							cacheText)
 						totalSources += 1
 					}
 
 					if totalSources <= 0 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.randompictures.list-no-entries-error"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.randompictures.list-no-entries-error"))
 						return
 					}
 
@@ -479,7 +520,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 			case "delete-config", "remove-config": // [p]randompictures delete-config <source id>
 				helpers.RequireMod(msg, func() {
 					if len(args) < 2 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 						return
 					}
 					session.ChannelTyping(msg.ChannelID)
@@ -493,7 +534,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 						&entryBucket,
 					)
 					if helpers.IsMdbNotFound(err) {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 						return
 					}
 					helpers.Relax(err)
@@ -515,6 +556,14 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 								Key:   "randompicture_source_drivefolderids",
 								Value: strings.Join(entryBucket.DriveFolderIDs, ";"),
 							},
+							{
+								Key:   "randompicture_source_provider",
+								Value: entryBucket.Provider,
+							},
+							{
+								Key:   "randompicture_source_targets",
+								Value: strings.Join(entryBucket.Targets, ";"),
+							},
 							{
 								Key:   "randompicture_source_aliases",
 								Value: strings.Join(entryBucket.Aliases, ";"),
@@ -527,7 +576,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 						}, false)
 					helpers.RelaxLog(err)
 
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.randompictures.remove-success"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.randompictures.remove-success"))
 					return
 				})
 				return
@@ -535,7 +584,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 				helpers.RequireRobyulMod(msg, func() {
 					session.ChannelTyping(msg.ChannelID)
 					if len(args) < 2 {
-						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+						_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 						helpers.Relax(err)
 						return
 					}
@@ -548,7 +597,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 					helpers.Relax(err)
 
 					if len(rpSources) <= 0 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.randompictures.list-no-entries-error"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.randompictures.list-no-entries-error"))
 						return
 					} else if err != nil {
 						helpers.Relax(err)
@@ -559,13 +608,13 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 							var fileHash string
 							var key string
 							var i int
-							var entry *drive.File
+							var entry randompictures.Picture
 							var marshalled []byte
 							redisClient := cache.GetRedisClient()
-							helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.randompictures.refresh-started"))
-							for i, entry = range rp.getFileCache(rpSource) {
+							helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.randompictures.refresh-started"))
+							for i, entry = range rp.getPictures(rpSource) {
 								key = fmt.Sprintf("robyul2-discord:randompictures:filescache:by-n:%s:entry:%d", helpers.MdbIdToHuman(rpSource.ID), i+1)
-								fileHash = rp.GetFileHash(rpSource.ID, rpSource.PreviousID, entry.Id)
+								fileHash = rp.GetFileHash(rpSource.ID, rpSource.PreviousID, entry.ID)
 								if fileHash == "" {
 									continue
 								}
@@ -593,13 +642,13 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 								continue
 							}
 							rp.updateImagesCachedMetric()
-							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.randompictures.refresh-success"))
+							_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.randompictures.refresh-success"))
 							helpers.Relax(err)
 							return
 						}
 					}
 
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.randompictures.refresh-not-found-error"))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.randompictures.refresh-not-found-error"))
 					helpers.Relax(err)
 					return
 				})
@@ -607,7 +656,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 				// [p]rapi pic-delay <n in minutes>
 				helpers.RequireMod(msg, func() {
 					if len(args) <= 1 {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 
@@ -616,7 +665,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 
 					n, err := strconv.Atoi(args[1])
 					if err != nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 
@@ -644,7 +693,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 						}, false)
 					helpers.RelaxLog(err)
 
-					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.randompictures.pic-delay-set-success", n))
+					_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.randompictures.pic-delay-set-success", n))
 					helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 					return
 				})
@@ -664,13 +713,13 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 							channelText = "`no channels selected`"
 						}
 
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.randompictures.pic-delay-ignore-channels-status", channelText))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.randompictures.pic-delay-ignore-channels-status", channelText))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
 					targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
 					if err != nil || targetChannel == nil || targetChannel.ID == "" {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 
@@ -696,7 +745,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 					options := make([]models.ElasticEventlogOption, 0)
 					message := ""
 					if removed {
-						message = helpers.GetText("plugins.randompictures.pic-delay-ignore-channels-removed")
+						message = helpers.GetMessageText(msg, "plugins.randompictures.pic-delay-ignore-channels-removed")
 						options = []models.ElasticEventlogOption{
 							{
 								Key:   "randompictures_config_ignoredchannelids_removed",
@@ -705,7 +754,7 @@ func (rp *RandomPictures) Action(command string, content string, msg *discordgo.
 							},
 						}
 					} else {
-						message = helpers.GetText("plugins.randompictures.pic-delay-ignore-channels-added")
+						message = helpers.GetMessageText(msg, "plugins.randompictures.pic-delay-ignore-channels-added")
 						options = []models.ElasticEventlogOption{
 							{
 								Key:   "randompictures_config_ignoredchannelids_added",
@@ -823,9 +872,9 @@ func (rp *RandomPictures) postRandomItemFromContent(channel *discordgo.Channel,
 			if err != nil {
 				return false, errors.New("invalid picture data cached")
 			}
-			var gPicture *drive.File
-			msgpack.Unmarshal(resultBytes, &gPicture)
-			err = rp.postItem(channel.GuildID, msg.ChannelID, initialMessage.ID, gPicture, matchEntry.ID, matchEntry.PreviousID, strconv.Itoa(chosenPicN))
+			var picture randompictures.Picture
+			msgpack.Unmarshal(resultBytes, &picture)
+			err = rp.postItem(channel.GuildID, msg.ChannelID, initialMessage.ID, picture, matchEntry.ID, matchEntry.PreviousID, strconv.Itoa(chosenPicN))
 			if err == nil {
 				return true, nil
 			} else {
@@ -836,83 +885,32 @@ func (rp *RandomPictures) postRandomItemFromContent(channel *discordgo.Channel,
 	return false, errors.New("unable to match to source")
 }
 
-func (rp *RandomPictures) getFileCache(sourceEntry models.RandompictureSourceEntry) []*drive.File {
-	var allFiles []*drive.File
-
-Loop:
-	for {
-		foldersChecked := make([]string, 0)
-		foldersToCheck := sourceEntry.DriveFolderIDs
-		for {
-		CheckFoldersLoop:
-			for _, driveFolderID := range foldersToCheck {
-				for _, checkedFolder := range foldersChecked {
-					if checkedFolder == driveFolderID {
-						continue CheckFoldersLoop
-					}
-				}
-				//cache.GetLogger().WithField("module", "randompictures").Debug(fmt.Sprintf("getting google drive picture cache Folder #%s for Entry #%s", driveFolderID, sourceEntry.ID))
-				result, err := driveService.Files.List().Q(fmt.Sprintf(driveSearchText, driveFolderID)).Fields(googleapi.Field(driveFieldsText)).PageSize(1000).Do()
-				if err != nil {
-					cache.GetLogger().WithField("module", "randompictures").Error(fmt.Sprintf("google drive error: %s, retrying in 10 seconds", err.Error()))
-					time.Sleep(10 * time.Second)
-					continue Loop
-				}
-				helpers.Relax(err)
-				for _, file := range result.Files {
-					if rp.isValidDriveFile(file) {
-						allFiles = append(allFiles, file)
-					}
-					if file.MimeType == "application/vnd.google-apps.folder" {
-						foldersToCheck = append(foldersToCheck, file.Id)
-					}
-				}
+// getPictures returns the pictures of all targets of the source, targets which fail are skipped
+func (rp *RandomPictures) getPictures(sourceEntry models.RandompictureSourceEntry) (pictures []randompictures.Picture) {
+	provider, err := randompictures.GetProvider(sourceEntry.Provider)
+	if err != nil {
+		cache.GetLogger().WithField("module", "randompictures").Warnf(
+			"unable to get pictures for source #%s: %s", helpers.MdbIdToHuman(sourceEntry.ID), err.Error())
+		return nil
+	}
 
-				for {
-					if result.NextPageToken == "" {
-						break
-					}
-				RetryRequest:
-					result, err = driveService.Files.List().Q(fmt.Sprintf(driveSearchText, driveFolderID)).Fields(googleapi.Field(driveFieldsText)).PageSize(1000).PageToken(result.NextPageToken).Do()
-					if err != nil {
-						if strings.Contains(err.Error(), "Error 500: Internal Error") {
-							cache.GetLogger().WithField("module", "randompictures").Warnf(
-								"internal error requesting files for %s, retrying in 10 seconds",
-								driveFolderID,
-							)
-							time.Sleep(time.Second * 10)
-							goto RetryRequest
-						}
-						helpers.Relax(err)
-					}
-					for _, file := range result.Files {
-						if rp.isValidDriveFile(file) {
-							allFiles = append(allFiles, file)
-						}
-						if file.MimeType == "application/vnd.google-apps.folder" {
-							foldersToCheck = append(foldersToCheck, file.Id)
-						}
-					}
-				}
-				foldersChecked = append(foldersChecked, driveFolderID)
-			}
-			if len(foldersChecked) == len(foldersToCheck) {
-				break
-			}
+	for _, target := range rp.getTargets(sourceEntry) {
+		targetPictures, err := provider.List(target)
+		if err != nil {
+			cache.GetLogger().WithField("module", "randompictures").Errorf(
+				"%s error for source #%s target %s: %s", provider.Name(), helpers.MdbIdToHuman(sourceEntry.ID), target, err.Error())
+			continue
 		}
-		break
+		pictures = append(pictures, targetPictures...)
 	}
-	return allFiles
+	return pictures
 }
 
-func (rp *RandomPictures) isValidDriveFile(file *drive.File) bool {
-	if file.MimeType == "application/vnd.google-apps.folder" {
-		return false
-	}
-	if file.Size > 8000000 { // bigger than 8 MB? (discords file size limit)
-		return false
+func (rp *RandomPictures) getTargets(sourceEntry models.RandompictureSourceEntry) []string {
+	if sourceEntry.Provider == "" || sourceEntry.Provider == randompictures.DefaultProvider {
+		return sourceEntry.DriveFolderIDs
 	}
-	return true
+	return sourceEntry.Targets
 }
 
 func (rp *RandomPictures) updateImagesCachedMetric() {
@@ -942,51 +940,34 @@ func (rp *RandomPictures) updateImagesCachedMetric() {
 	metrics.RandomPictureSourcesImagesCachedCount.Set(totalImages)
 }
 
-func (rp *RandomPictures) postItem(guildID string, channelID string, messageID string, file *drive.File, sourceID bson.ObjectId, previousSourceID, pictureID string) error {
-	// fmt.Println("channelID:", channelID, "messageID:", messageID, "file:", file.Name, "sourceID:", sourceID, "pictureID:", pictureID)
-	file, err := driveService.Files.Get(file.Id).Fields(googleapi.Field(driveFieldsSingleText)).Do()
+func (rp *RandomPictures) postItem(guildID string, channelID string, messageID string, picture randompictures.Picture, sourceID bson.ObjectId, previousSourceID, pictureID string) error {
+	provider, err := randompictures.GetProvider(picture.Provider)
+	if err != nil {
+		return err
+	}
+	picture, err = provider.Resolve(picture)
 	if err != nil {
 		return err
 	}
 
 	camerModelText := ""
-	if file.ImageMediaMetadata.CameraModel != "" {
-		camerModelText = fmt.Sprintf(" 📷 `%s`", file.ImageMediaMetadata.CameraModel)
+	if picture.CameraModel != "" {
+		camerModelText = fmt.Sprintf(" 📷 `%s`", picture.CameraModel)
 	}
 
-	linkToPost := helpers.GetConfig().Path("imageproxy.base_url").Data().(string)
+	// pictures without a direct link are served by the image proxy
+	linkToPost := picture.URL
+	if linkToPost == "" {
+		linkToPost = helpers.GetConfig().Path("imageproxy.base_url").Data().(string)
 
-	splitFilename := strings.Split(file.Name, ".")
-
-	linkToPost = fmt.Sprintf(linkToPost, rp.GetFileHash(sourceID, previousSourceID, file.Id), url.QueryEscape(strings.Join(splitFilename[0:len(splitFilename)-1], "-")+"."+strings.ToLower(splitFilename[len(splitFilename)-1])))
-	linkToHistory := helpers.GetConfig().Path("website.randompictures_base_url").Data().(string) + guildID
+		splitFilename := strings.Split(picture.Name, ".")
 
-	// open link to prepare cache
-	client := &http.Client{
-		Timeout: 3 * time.Second,
-	}
-	request, err := http.NewRequest("GET", linkToPost, nil)
-	if err == nil {
-		request.Header.Set("User-Agent", helpers.DEFAULT_UA)
-		resp, err := client.Do(request)
-		if err != nil {
-			if errU, ok := err.(*url.Error); ok {
-				if !strings.Contains(errU.Err.Error(), "Client.Timeout exceeded while awaiting headers") {
-					raven.CaptureError(fmt.Errorf("%#v", errU.Err), map[string]string{})
-				} else {
-					cache.GetLogger().WithField("module", "randompictures").Warn(fmt.Sprintf("warming up cache for %s failed: time out", linkToPost))
-				}
-			} else {
-				raven.CaptureError(fmt.Errorf("%#v", err), map[string]string{})
-			}
-		}
-		if resp != nil && resp.Body != nil {
-			defer resp.Body.Close()
-		}
+		linkToPost = fmt.Sprintf(linkToPost, rp.GetFileHash(sourceID, previousSourceID, picture.ID), url.QueryEscape(strings.Join(splitFilename[0:len(splitFilename)-1], "-")+"."+strings.ToLower(splitFilename[len(splitFilename)-1])))
+		rp.warmUpImageProxy(linkToPost)
 	}
-	helpers.RelaxLog(err)
+	linkToHistory := helpers.GetConfig().Path("website.randompictures_base_url").Data().(string) + guildID
 
-	err = rp.appendLinkToServerHistory(linkToPost, sourceID, pictureID, file.Name, guildID)
+	err = rp.appendLinkToServerHistory(linkToPost, sourceID, pictureID, picture.Name, guildID)
 	helpers.RelaxLog(err)
 
 	var shortUrl string
@@ -1001,7 +982,7 @@ func (rp *RandomPictures) postItem(guildID string, channelID string, messageID s
 
 	embed := &discordgo.MessageEmbed{
 		URL:   shortUrl,
-		Title: "🏷 " + file.Name + camerModelText,
+		Title: "🏷 " + picture.Name + camerModelText,
 		Author: &discordgo.MessageEmbedAuthor{
 			URL:  linkToHistory,
 			Name: "🖼  Gallery",
@@ -1034,6 +1015,33 @@ func (rp *RandomPictures) postItem(guildID string, channelID string, messageID s
 	return nil
 }
 
+// warmUpImageProxy opens the link to prepare the cache of the image proxy
+func (rp *RandomPictures) warmUpImageProxy(linkToPost string) {
+	client := &http.Client{
+		Timeout: 3 * time.Second,
+	}
+	request, err := http.NewRequest("GET", linkToPost, nil)
+	if err == nil {
+		request.Header.Set("User-Agent", helpers.DEFAULT_UA)
+		resp, err := client.Do(request)
+		if err != nil {
+			if errU, ok := err.(*url.Error); ok {
+				if !strings.Contains(errU.Err.Error(), "Client.Timeout exceeded while awaiting headers") {
+					raven.CaptureError(fmt.Errorf("%#v", errU.Err), map[string]string{})
+				} else {
+					cache.GetLogger().WithField("module", "randompictures").Warn(fmt.Sprintf("warming up cache for %s failed: time out", linkToPost))
+				}
+			} else {
+				raven.CaptureError(fmt.Errorf("%#v", err), map[string]string{})
+			}
+		}
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+	}
+	helpers.RelaxLog(err)
+}
+
 func (rp *RandomPictures) GetFileHash(sourceID bson.ObjectId, previousID string, fileID string) string {
 	if previousID != "" {
 		return helpers.GetMD5Hash(previousID + "-" + fileID)
@@ -0,0 +1,58 @@
+package randompictures
+
+import (
+	"github.com/Seklfreak/Robyul2/cache"
+)
+
+const (
+	// only the latest messages of a channel are checked for attachments
+	channelMaxMessages = 5000
+)
+
+// ChannelProvider lists the picture attachments of a Discord channel, the targets are channel IDs
+type ChannelProvider struct{}
+
+func (p *ChannelProvider) Name() string {
+	return "channel"
+}
+
+func (p *ChannelProvider) Validate(target string) error {
+	_, err := cache.GetSession().ChannelMessages(target, 1, "", "", "")
+	return err
+}
+
+func (p *ChannelProvider) List(target string) (pictures []Picture, err error) {
+	var beforeID string
+	for checked := 0; checked < channelMaxMessages; {
+		messages, err := cache.GetSession().ChannelMessages(target, 100, beforeID, "", "")
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) <= 0 {
+			break
+		}
+
+		for _, message := range messages {
+			for _, attachment := range message.Attachments {
+				if !isPictureFile(attachment.Filename) || attachment.Size > maxPictureSize {
+					continue
+				}
+				pictures = append(pictures, Picture{
+					ID:       attachment.ID,
+					Name:     attachment.Filename,
+					Size:     int64(attachment.Size),
+					URL:      attachment.URL,
+					Provider: p.Name(),
+				})
+			}
+		}
+
+		checked += len(messages)
+		beforeID = messages[len(messages)-1].ID
+	}
+	return pictures, nil
+}
+
+func (p *ChannelProvider) Resolve(picture Picture) (Picture, error) {
+	return picture, nil
+}
@@ -0,0 +1,110 @@
+package randompictures
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	driveSearchText       = "\"%s\" in parents and (mimeType = \"image/gif\" or mimeType = \"image/jpeg\" or mimeType = \"image/png\" or mimeType = \"application/vnd.google-apps.folder\")"
+	driveFieldsText       = "nextPageToken, files(id, size, mimeType)"
+	driveFieldsSingleText = "id, name, size, modifiedTime, imageMediaMetadata, webContentLink"
+	driveFolderMimeType   = "application/vnd.google-apps.folder"
+)
+
+// DriveProvider lists the pictures of Google Drive folders and their subfolders, the targets are folder IDs
+// the pictures are served by the image proxy
+type DriveProvider struct {
+	Service *drive.Service
+}
+
+func (p *DriveProvider) Name() string {
+	return "drive"
+}
+
+func (p *DriveProvider) Validate(target string) error {
+	result, err := p.Service.Files.List().Q(fmt.Sprintf(driveSearchText, target)).Fields(googleapi.Field(driveFieldsText)).PageSize(1).Do()
+	if err != nil {
+		return err
+	}
+	if len(result.Files) <= 0 {
+		return ErrNoPictures
+	}
+	return nil
+}
+
+func (p *DriveProvider) List(target string) (pictures []Picture, err error) {
+	foldersChecked := make(map[string]bool, 0)
+	foldersToCheck := []string{target}
+
+	for len(foldersToCheck) > 0 {
+		driveFolderID := foldersToCheck[0]
+		foldersToCheck = foldersToCheck[1:]
+		if foldersChecked[driveFolderID] {
+			continue
+		}
+
+		var pageToken string
+		for {
+			request := p.Service.Files.List().Q(fmt.Sprintf(driveSearchText, driveFolderID)).Fields(googleapi.Field(driveFieldsText)).PageSize(1000)
+			if pageToken != "" {
+				request = request.PageToken(pageToken)
+			}
+			result, err := request.Do()
+			if err != nil {
+				if strings.Contains(err.Error(), "Error 500: Internal Error") {
+					cache.GetLogger().WithField("module", "randompictures").Warnf(
+						"internal error requesting files for %s, retrying in 10 seconds",
+						driveFolderID,
+					)
+					time.Sleep(time.Second * 10)
+					continue
+				}
+				return nil, err
+			}
+
+			for _, file := range result.Files {
+				if file.MimeType == driveFolderMimeType {
+					foldersToCheck = append(foldersToCheck, file.Id)
+					continue
+				}
+				if file.Size > maxPictureSize {
+					continue
+				}
+				pictures = append(pictures, Picture{
+					ID:       file.Id,
+					Size:     file.Size,
+					MimeType: file.MimeType,
+					Provider: p.Name(),
+				})
+			}
+
+			pageToken = result.NextPageToken
+			if pageToken == "" {
+				break
+			}
+		}
+		foldersChecked[driveFolderID] = true
+	}
+
+	return pictures, nil
+}
+
+func (p *DriveProvider) Resolve(picture Picture) (Picture, error) {
+	file, err := p.Service.Files.Get(picture.ID).Fields(googleapi.Field(driveFieldsSingleText)).Do()
+	if err != nil {
+		return picture, err
+	}
+
+	picture.Name = file.Name
+	picture.Size = file.Size
+	if file.ImageMediaMetadata != nil {
+		picture.CameraModel = file.ImageMediaMetadata.CameraModel
+	}
+	return picture, nil
+}
@@ -0,0 +1,119 @@
+package randompictures
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+)
+
+const (
+	imgurAlbumImagesEndpoint = "https://api.imgur.com/3/album/%s/images"
+)
+
+// ImgurProvider lists the pictures of imgur albums, the targets are album IDs or links
+type ImgurProvider struct {
+	ClientID string
+	// Endpoint is the album images endpoint with a placeholder for the album ID
+	Endpoint string
+	Client   *http.Client
+}
+
+type imgurAlbumImagesResponse struct {
+	Data []struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+		Type  string `json:"type"`
+		Size  int64  `json:"size"`
+		Link  string `json:"link"`
+	} `json:"data"`
+	Success bool `json:"success"`
+	Status  int  `json:"status"`
+}
+
+// NewImgurProvider returns an imgur provider using the client ID configured as imgur.client_id
+func NewImgurProvider() *ImgurProvider {
+	clientID, _ := helpers.GetConfig().Path("imgur.client_id").Data().(string)
+
+	return &ImgurProvider{
+		ClientID: clientID,
+		Endpoint: imgurAlbumImagesEndpoint,
+		Client:   &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+func (p *ImgurProvider) Name() string {
+	return "imgur"
+}
+
+func (p *ImgurProvider) Validate(target string) error {
+	pictures, err := p.List(target)
+	if err != nil {
+		return err
+	}
+	if len(pictures) <= 0 {
+		return ErrNoPictures
+	}
+	return nil
+}
+
+func (p *ImgurProvider) List(target string) (pictures []Picture, err error) {
+	request, err := http.NewRequest("GET", fmt.Sprintf(p.Endpoint, imgurAlbumID(target)), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "Client-ID "+p.ClientID)
+	request.Header.Set("User-Agent", helpers.DEFAULT_UA)
+
+	response, err := p.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var albumImages imgurAlbumImagesResponse
+	err = json.NewDecoder(response.Body).Decode(&albumImages)
+	if err != nil {
+		return nil, err
+	}
+	if !albumImages.Success {
+		return nil, fmt.Errorf("imgur api error: status %d", albumImages.Status)
+	}
+
+	for _, image := range albumImages.Data {
+		if !strings.HasPrefix(image.Type, "image/") || image.Size > maxPictureSize {
+			continue
+		}
+
+		name := image.Title
+		if name == "" {
+			name = path.Base(image.Link)
+		}
+		pictures = append(pictures, Picture{
+			ID:       image.ID,
+			Name:     name,
+			Size:     image.Size,
+			MimeType: image.Type,
+			URL:      image.Link,
+			Provider: p.Name(),
+		})
+	}
+	return pictures, nil
+}
+
+func (p *ImgurProvider) Resolve(picture Picture) (Picture, error) {
+	return picture, nil
+}
+
+// imgurAlbumID returns the album ID of a link like https://imgur.com/a/<id>, other targets are returned unchanged
+func imgurAlbumID(target string) string {
+	target = strings.TrimSuffix(strings.TrimSpace(target), "/")
+	if index := strings.Index(target, "/a/"); index >= 0 {
+		return target[index+len("/a/"):]
+	}
+	return target
+}
@@ -0,0 +1,93 @@
+package randompictures
+
+import (
+	"errors"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultProvider is used by sources created before there were other providers
+	DefaultProvider = "drive"
+	// pictures bigger than the file size limit of Discord are skipped
+	maxPictureSize = 8000000
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown random pictures provider")
+	ErrNoPictures      = errors.New("no pictures found")
+
+	providers     = make(map[string]Provider, 0)
+	providersLock sync.RWMutex
+)
+
+// Picture is a picture of a source, it is cached in redis between refreshes
+// the msgpack names of the drive fields match drive.File, the image proxy reads Google Drive pictures from the cache
+type Picture struct {
+	ID          string `msgpack:"Id"` // unique within the provider
+	Name        string `msgpack:"Name"`
+	Size        int64  `msgpack:"Size"`
+	MimeType    string `msgpack:"MimeType"`
+	URL         string // direct link, empty for pictures served by the image proxy
+	Provider    string // empty for pictures cached before there were other providers
+	CameraModel string
+}
+
+// Provider lists the pictures of a target, for example a Google Drive folder or an imgur album
+type Provider interface {
+	// Name is the unique lowercase name of the provider, used in the source configuration
+	Name() string
+	// Validate checks a target before it is added to a source
+	Validate(target string) error
+	// List returns all pictures of a target
+	List(target string) ([]Picture, error)
+	// Resolve returns the picture with the details needed to post it
+	Resolve(picture Picture) (Picture, error)
+}
+
+// Register makes a provider available for sources, providers with the same name are replaced
+func Register(provider Provider) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+
+	providers[provider.Name()] = provider
+}
+
+// GetProvider returns the provider of the name, an empty name returns the DefaultProvider
+func GetProvider(name string) (Provider, error) {
+	if name == "" {
+		name = DefaultProvider
+	}
+
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+
+	provider, ok := providers[strings.ToLower(name)]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return provider, nil
+}
+
+// ProviderNames returns the names of all registered providers
+func ProviderNames() (names []string) {
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isPictureFile returns true for file names of pictures Discord can embed
+func isPictureFile(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".jpg", ".jpeg", ".png", ".gif", ".webp":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package randompictures
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testProvider struct{}
+
+func (p *testProvider) Name() string                             { return "test" }
+func (p *testProvider) Validate(target string) error             { return nil }
+func (p *testProvider) List(target string) ([]Picture, error)    { return nil, nil }
+func (p *testProvider) Resolve(picture Picture) (Picture, error) { return picture, nil }
+
+func TestGetProvider(t *testing.T) {
+	Register(&testProvider{})
+
+	provider, err := GetProvider("Test")
+	if err != nil || provider.Name() != "test" {
+		t.Errorf("expected the test provider, got %v, %v", provider, err)
+	}
+	if _, err = GetProvider("unknown"); err != ErrUnknownProvider {
+		t.Errorf("expected ErrUnknownProvider, got %v", err)
+	}
+}
+
+func TestImgurProviderList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/album/abc123/images" || r.Header.Get("Authorization") != "Client-ID client" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"data":[
+			{"id":"a","title":"","type":"image/png","size":100,"link":"https://i.imgur.com/a.png"},
+			{"id":"b","title":"Video","type":"video/mp4","size":100,"link":"https://i.imgur.com/b.mp4"},
+			{"id":"c","title":"Huge","type":"image/jpeg","size":9000000,"link":"https://i.imgur.com/c.jpg"}
+		],"success":true,"status":200}`)
+	}))
+	defer server.Close()
+
+	provider := &ImgurProvider{ClientID: "client", Endpoint: server.URL + "/album/%s/images", Client: server.Client()}
+	pictures, err := provider.List("https://imgur.com/a/abc123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pictures) != 1 {
+		t.Fatalf("expected 1 picture, got %d", len(pictures))
+	}
+	if pictures[0].ID != "a" || pictures[0].Name != "a.png" || pictures[0].URL != "https://i.imgur.com/a.png" {
+		t.Errorf("unexpected picture %#v", pictures[0])
+	}
+}
+
+func TestIsPictureFile(t *testing.T) {
+	for name, want := range map[string]bool{"a.JPG": true, "b.gif": true, "c.mp4": false, "d": false} {
+		if got := isPictureFile(name); got != want {
+			t.Errorf("%s: expected %v, got %v", name, want, got)
+		}
+	}
+}
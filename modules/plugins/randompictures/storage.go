@@ -0,0 +1,56 @@
+package randompictures
+
+import (
+	"strings"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+)
+
+// StorageProvider lists the public pictures in the object storage, the targets are object name prefixes
+type StorageProvider struct{}
+
+func (p *StorageProvider) Name() string {
+	return "storage"
+}
+
+func (p *StorageProvider) Validate(target string) error {
+	pictures, err := p.List(target)
+	if err != nil {
+		return err
+	}
+	if len(pictures) <= 0 {
+		return ErrNoPictures
+	}
+	return nil
+}
+
+func (p *StorageProvider) List(target string) (pictures []Picture, err error) {
+	entries, err := helpers.RetrieveFilesByPrefix(target)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.Public || !strings.HasPrefix(entry.MimeType, "image/") || entry.Filesize > maxPictureSize {
+			continue
+		}
+
+		filename := entry.Filename
+		if filename == "" {
+			filename = entry.ObjectName
+		}
+		pictures = append(pictures, Picture{
+			ID:       entry.ObjectName,
+			Name:     filename,
+			Size:     int64(entry.Filesize),
+			MimeType: entry.MimeType,
+			URL:      helpers.GeneratePublicFileLink(filename, entry.ObjectNameHash),
+			Provider: p.Name(),
+		})
+	}
+	return pictures, nil
+}
+
+func (p *StorageProvider) Resolve(picture Picture) (Picture, error) {
+	return picture, nil
+}
@@ -21,8 +21,11 @@ func (r *Ratelimit) Init(session *discordgo.Session) {
 }
 
 func (r *Ratelimit) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
+	left, err := ratelimits.CommandKeysLeft(msg.Author.ID)
+	helpers.Relax(err)
+
 	helpers.SendMessage(
 		msg.ChannelID,
-		"You've still got "+strconv.Itoa(int(ratelimits.Container.Get(msg.Author.ID)))+" commands left",
+		"You've still got "+strconv.Itoa(left)+" commands left",
 	)
 }
@@ -58,19 +58,19 @@ func (rp *ReactionPolls) Action(command string, content string, msg *discordgo.M
 	case "create": // [p]reactionpolls create "<poll text>" <max number of votes> <allowed emotes>
 		session.ChannelTyping(msg.ChannelID)
 		if len(args) < 4 {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
 		pollText := strings.TrimSuffix(strings.TrimPrefix(args[1], "\""), "\"")
 		if pollText == "" {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
 		pollMaxVotes, err := strconv.Atoi(args[2])
 		if err != nil {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
@@ -85,7 +85,7 @@ func (rp *ReactionPolls) Action(command string, content string, msg *discordgo.M
 			)
 		}
 		if len(allowedEmotes) > 20 {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.reactionpolls.create-too-many-reactions"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.reactionpolls.create-too-many-reactions"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
@@ -95,7 +95,7 @@ func (rp *ReactionPolls) Action(command string, content string, msg *discordgo.M
 				if len(emoteParts) >= 2 {
 					_, err = session.State.Emoji(guild.ID, emoteParts[1])
 					if err != nil {
-						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.reactionpolls.create-external-emote"))
+						_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.reactionpolls.create-external-emote"))
 						helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 						return
 					}
@@ -111,7 +111,7 @@ func (rp *ReactionPolls) Action(command string, content string, msg *discordgo.M
 		helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 
 		if len(pollPostedMessages) <= 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 			return
 		}
 		pollPostedMessage := pollPostedMessages[0]
@@ -154,7 +154,7 @@ func (rp *ReactionPolls) Action(command string, content string, msg *discordgo.M
 			var err error
 			reactionPollIDsCache, err = rp.getAllActiveReactionPollIDs()
 			helpers.Relax(err)
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.reactionpolls.refreshed-polls"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.reactionpolls.refreshed-polls"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		})
 		return
@@ -1,6 +1,7 @@
 package plugins
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -13,12 +14,12 @@ import (
 	"html"
 
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/feeds"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/Seklfreak/Robyul2/version"
 	"github.com/bwmarrin/discordgo"
 	humanize "github.com/dustin/go-humanize"
-	"github.com/globalsign/mgo/bson"
 	"github.com/jzelinskie/geddit"
 	"github.com/sirupsen/logrus"
 )
@@ -39,6 +40,10 @@ const (
 	RedditColor   = "ff4500"
 )
 
+var (
+	ErrRedditSubredditNotFound = errors.New("subreddit not found")
+)
+
 func (r *Reddit) Commands() []string {
 	return []string{
 		"reddit",
@@ -58,134 +63,123 @@ func (r *Reddit) Init(session *discordgo.Session) {
 		r.logger().WithError(err).Error("failed to create reddit OAuth Session")
 		return
 	}
-	err = redditSession.LoginAuth(
-		helpers.GetConfig().Path("reddit.username").Data().(string),
-		helpers.GetConfig().Path("reddit.password").Data().(string),
-	)
+	err = r.login()
 	if err != nil {
 		r.logger().WithError(err).Error("failed to login to reddit")
 		return
 	}
 	r.redditLoggedIn = true
-	go r.checkSubredditLoop()
-	r.logger().Info("Started checkSubredditLoop loop (0s)")
-}
-
-func (r *Reddit) checkSubredditLoop() {
-	defer helpers.Recover()
-	defer func() {
-		go func() {
-			r.logger().Error("The checkSubredditLoop died. Please investigate! Will be restarted in 60 seconds")
-			time.Sleep(60 * time.Second)
-			r.checkSubredditLoop()
-		}()
-	}()
-
-	var entries []models.RedditSubredditEntry
-	var bundledEntries map[string][]models.RedditSubredditEntry
-	var newPost bool
-
-	for {
-		err := helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.RedditSubredditsTable).Find(nil)).All(&entries)
-		helpers.Relax(err)
-
-		bundledEntries = make(map[string][]models.RedditSubredditEntry, 0)
-
-		for _, entry := range entries {
-			channel, err := helpers.GetChannelWithoutApi(entry.ChannelID)
-			if err != nil || channel == nil || channel.ID == "" {
-				//r.logger().Warn(fmt.Sprintf("skipped subreddit r/%s for Channel #%s on Guild #%s: channel not found!",
-				//	entry.SubredditName, entry.ChannelID, entry.GuildID))
-				continue
-			}
-
-			if _, ok := bundledEntries[entry.SubredditName]; ok {
-				bundledEntries[entry.SubredditName] = append(bundledEntries[entry.SubredditName], entry)
-			} else {
-				bundledEntries[entry.SubredditName] = []models.RedditSubredditEntry{entry}
-			}
-		}
 
-		r.logger().Infof("checking %d subreddits for %d feeds", len(bundledEntries), len(entries))
-
-		for subredditName, entries := range bundledEntries {
-		BundleStart:
-			// r.logger().Info(fmt.Sprintf("checking subreddit r/%s for %d channels", subredditName, len(entries)))
-			newSubmissions, err := redditSession.SubredditSubmissions(subredditName, geddit.NewSubmissions, geddit.ListingOptions{
-				Limit: 30,
-			})
-			if err != nil {
-				if strings.Contains(err.Error(), "oauth2: token expired and refresh token is not set") {
-					// login when token expired
-					err = redditSession.LoginAuth(
-						helpers.GetConfig().Path("reddit.username").Data().(string),
-						helpers.GetConfig().Path("reddit.password").Data().(string),
-					)
-					helpers.Relax(err)
-					r.logger().Warn("logged in again after token expired")
-					goto BundleStart
-				}
-				r.logger().Warnf("updating subreddit r/%s failed: %s", subredditName, err.Error())
-				time.Sleep(2 * time.Second)
-				continue
-			}
-			for _, entry := range entries {
-				newPost = false
-				hasToBeBefore := time.Now().Add(-(time.Duration(entry.PostDelay) * time.Minute))
-				hasToBeAfter := entry.LastChecked
-
-				for _, submission := range newSubmissions {
-					submissionTime := time.Unix(int64(submission.DateCreated), 0)
-					if !submissionTime.Before(hasToBeBefore) || !submissionTime.After(hasToBeAfter) {
-						continue
-					}
-					newPost = true
-
-					postSubmission := submission
-					postChannelID := entry.ChannelID
-					go func() {
-						defer helpers.Recover()
-
-						r.logger().Info(fmt.Sprintf("posting submission: #%s (%s) on r/%s (%s) to #%s",
-							postSubmission.ID, submissionTime.Format(time.ANSIC), subredditName,
-							RedditBaseUrl+"/r/"+subredditName+"/comments/"+postSubmission.ID+"/", entry.ChannelID))
-
-						err = r.postSubmission(postChannelID, postSubmission, entry.PostDirectLinks)
-						if err != nil {
-							if errD, ok := err.(*discordgo.RESTError); ok && errD.Message != nil {
-								if errD.Message.Code != discordgo.ErrCodeMissingPermissions &&
-									errD.Message.Code != discordgo.ErrCodeUnknownChannel &&
-									errD.Message.Code != discordgo.ErrCodeMissingAccess {
-									helpers.Relax(err)
-								}
-							} else {
-								helpers.Relax(err)
-							}
-						}
-					}()
-				}
-				if newPost {
-					entry.LastChecked = hasToBeBefore
-					err = helpers.MDbUpdateWithoutLogging(models.RedditSubredditsTable, entry.ID, entry)
-					helpers.Relax(err)
-				}
-			}
-			time.Sleep(2 * time.Second)
-		}
+	err = feeds.Register(&redditSource{r: r})
+	if err != nil {
+		r.logger().WithError(err).Error("failed to register the reddit feed source")
+	}
+}
 
-		if len(entries) <= 10 {
-			time.Sleep(time.Second * 60)
+func (r *Reddit) login() error {
+	return redditSession.LoginAuth(
+		helpers.GetConfig().Path("reddit.username").Data().(string),
+		helpers.GetConfig().Path("reddit.password").Data().(string),
+	)
+}
+
+// redditSource is the feeds.Source for the new submissions of subreddits
+type redditSource struct {
+	r *Reddit
+}
+
+func (s *redditSource) Name() string {
+	return "reddit"
+}
+
+func (s *redditSource) Interval(target string) time.Duration {
+	return time.Minute
+}
+
+func (s *redditSource) FilterOptions() feeds.FilterOptions {
+	return feeds.FilterOptions{Score: true, Flair: true}
+}
+
+// Resolve looks the subreddit up and returns its name as normalised target
+func (s *redditSource) Resolve(target string) (normalised, name string, err error) {
+	subredditName := strings.TrimLeft(target, "/")
+	subredditName = strings.Replace(subredditName, "r/", "", -1)
+
+	subredditData, err := redditSession.AboutSubreddit(subredditName)
+	if err != nil {
+		return "", "", err
+	}
+	if subredditData.ID == "" {
+		return "", "", ErrRedditSubredditNotFound
+	}
+	return subredditData.Name, "r/" + subredditData.Name, nil
+}
+
+func (s *redditSource) Fetch(target string, state map[string]string) (posts []feeds.Post, newState map[string]string, err error) {
+	// the maximum, so submissions waiting for their minimum score stay in the listing for longer
+	newSubmissions, err := redditSession.SubredditSubmissions(target, geddit.NewSubmissions, geddit.ListingOptions{
+		Limit: 100,
+	})
+	if err != nil && strings.Contains(err.Error(), "oauth2: token expired and refresh token is not set") {
+		// login when token expired
+		err = s.r.login()
+		if err != nil {
+			return nil, state, err
 		}
+		s.r.logger().Warn("logged in again after token expired")
+
+		newSubmissions, err = redditSession.SubredditSubmissions(target, geddit.NewSubmissions, geddit.ListingOptions{
+			Limit: 100,
+		})
 	}
+	if err != nil {
+		return nil, state, err
+	}
+
+	for _, submission := range newSubmissions {
+		posts = append(posts, s.r.submissionToPost(submission))
+	}
+	return posts, state, nil
 }
 
-func (r *Reddit) postSubmission(channelID string, submission *geddit.Submission, postDirectLinks bool) (err error) {
-	data := &discordgo.MessageSend{}
+func (s *redditSource) Render(entry models.FeedEntry, post feeds.Post) *discordgo.MessageEmbed {
+	submission, ok := post.Raw.(*geddit.Submission)
+	if !ok {
+		return feeds.Embed(post)
+	}
+	return s.r.submissionEmbed(submission)
+}
 
-	data.Content = "<" + RedditBaseUrl + submission.Permalink + ">"
+// submissionToPost converts a submission for the feeds framework
+func (r *Reddit) submissionToPost(submission *geddit.Submission) (post feeds.Post) {
+	post = feeds.Post{
+		ID:        submission.ID,
+		URL:       RedditBaseUrl + submission.Permalink,
+		Title:     html.UnescapeString(submission.Title),
+		Text:      html.UnescapeString(submission.Selftext),
+		Author:    submission.Author,
+		AuthorURL: RedditBaseUrl + "/u/" + submission.Author,
+		Score:     submission.Score,
+		Flair:     submission.LinkFlairText,
+		Type:      "link",
+		Time:      time.Unix(int64(submission.DateCreated), 0),
+		Raw:       submission,
+	}
+	if submission.IsSelf {
+		post.Type = "self"
+	}
+	// link posts only count as media if they link to a picture or a video host
+	lowerURL := strings.ToLower(submission.URL)
+	if strings.HasSuffix(lowerURL, ".jpg") || strings.HasSuffix(lowerURL, ".jpeg") ||
+		strings.HasSuffix(lowerURL, ".gif") || strings.HasSuffix(lowerURL, ".png") ||
+		helpers.StringSliceContains(submission.Domain, []string{"i.redd.it", "v.redd.it", "i.imgur.com", "imgur.com", "gfycat.com", "streamable.com", "youtube.com", "youtu.be"}) {
+		post.ImageURLs = []string{submission.URL}
+	}
+	return post
+}
 
-	var content string
-	data.Embed = &discordgo.MessageEmbed{
+func (r *Reddit) submissionEmbed(submission *geddit.Submission) (embed *discordgo.MessageEmbed) {
+	embed = &discordgo.MessageEmbed{
 		Footer: &discordgo.MessageEmbedFooter{
 			Text:    helpers.GetText("plugins.reddit.embed-footer") + " | /r/" + submission.Subreddit + " | reddit #" + submission.ID,
 			IconURL: helpers.GetText("plugins.reddit.embed-footer-imageurl"),
@@ -195,55 +189,30 @@ func (r *Reddit) postSubmission(channelID string, submission *geddit.Submission,
 		Color:  helpers.GetDiscordColorFromHex(RedditColor),
 	}
 
-	var textModeTitle, textModeSelftext string
-
-	data.Embed.Title = submission.Title
-	textModeTitle = "**" + submission.Title
+	embed.Title = submission.Title
 	if submission.LinkFlairText != "" {
-		textModeTitle = "`[" + submission.LinkFlairText + "]` **" + data.Embed.Title
-		data.Embed.Title = "`" + submission.LinkFlairText + "` " + data.Embed.Title
-
-	}
-	data.Embed.Title = html.UnescapeString(data.Embed.Title)
-	if len(data.Embed.Title) > 128 {
-		data.Embed.Title = data.Embed.Title[0:127] + "…"
+		embed.Title = "`" + submission.LinkFlairText + "` " + embed.Title
 	}
-	textModeTitle = html.UnescapeString(textModeTitle)
-	if len(textModeTitle) > 128 {
-		textModeTitle = textModeTitle[0:127] + "…"
+	embed.Title = html.UnescapeString(embed.Title)
+	if len(embed.Title) > 128 {
+		embed.Title = embed.Title[0:127] + "…"
 	}
-	textModeTitle += "**"
 	if submission.Selftext != "" {
-		data.Embed.Description = html.UnescapeString(submission.Selftext)
-		if len(data.Embed.Description) > 500 {
-			data.Embed.Description = data.Embed.Description[0:499] + "…"
+		embed.Description = html.UnescapeString(submission.Selftext)
+		if len(embed.Description) > 500 {
+			embed.Description = embed.Description[0:499] + "…"
 		}
-		textModeSelftext = data.Embed.Description
 	}
 	if strings.HasSuffix(strings.ToLower(submission.URL), ".jpg") ||
 		strings.HasSuffix(strings.ToLower(submission.URL), ".jpeg") ||
 		strings.HasSuffix(strings.ToLower(submission.URL), ".gif") ||
 		strings.HasSuffix(strings.ToLower(submission.URL), ".png") {
-		data.Embed.Image = &discordgo.MessageEmbedImage{URL: submission.URL}
+		embed.Image = &discordgo.MessageEmbedImage{URL: submission.URL}
 	} else if submission.ThumbnailURL != "" && strings.HasPrefix(submission.ThumbnailURL, "http") {
-		data.Embed.Image = &discordgo.MessageEmbedImage{URL: submission.ThumbnailURL}
-	}
-
-	if postDirectLinks {
-		content += textModeTitle + " _" + helpers.GetText("plugins.reddit.embed-footer") + "_\n"
-		content += "<" + RedditBaseUrl + submission.Permalink + "> by `/u/" + submission.Author + "`\n"
-		if textModeSelftext != "" {
-			content += textModeSelftext + "\n"
-		}
-		if submission.URL != RedditBaseUrl+submission.Permalink {
-			content += submission.URL
-		}
-		data.Content = content
-		data.Embed = nil
+		embed.Image = &discordgo.MessageEmbedImage{URL: submission.ThumbnailURL}
 	}
 
-	_, err = helpers.SendComplex(channelID, data)
-	return err
+	return embed
 }
 
 func (r *Reddit) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
@@ -264,12 +233,12 @@ func (r *Reddit) Action(command string, content string, msg *discordgo.Message,
 
 func (r *Reddit) actionStart(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
 	if len(args) < 1 {
-		*out = r.newMsg("bot.arguments.too-few")
+		*out = r.newMsg(in, "bot.arguments.too-few")
 		return r.actionFinish
 	}
 
 	if redditSession == nil || !r.redditLoggedIn {
-		*out = r.newMsg("plugins.reddit.inactive")
+		*out = r.newMsg(in, "plugins.reddit.inactive")
 		return r.actionFinish
 	}
 
@@ -282,6 +251,12 @@ func (r *Reddit) actionStart(args []string, in *discordgo.Message, out **discord
 		return r.actionList
 	case "toggle-direct-link", "toggle-direct-links":
 		return r.actionToggleDirectLinks
+	case "filter":
+		return r.actionFilter
+	case "template":
+		return r.actionTemplate
+	case "mention":
+		return r.actionMention
 	default:
 		return r.actionInfo
 	}
@@ -290,12 +265,12 @@ func (r *Reddit) actionStart(args []string, in *discordgo.Message, out **discord
 func (r *Reddit) actionAdd(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
 	var err error
 	if !helpers.IsMod(in) {
-		*out = r.newMsg(helpers.GetText("mod.no_permission"))
+		*out = r.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
 		return r.actionFinish
 	}
 
 	if len(args) < 3 {
-		*out = r.newMsg("bot.arguments.too-few")
+		*out = r.newMsg(in, "bot.arguments.too-few")
 		return r.actionFinish
 	}
 
@@ -309,140 +284,234 @@ func (r *Reddit) actionAdd(args []string, in *discordgo.Message, out **discordgo
 
 	targetChannel, err := helpers.GetChannelFromMention(in, args[2])
 	if err != nil {
-		*out = r.newMsg("bot.arguments.invalid")
+		*out = r.newMsg(in, "bot.arguments.invalid")
 		return r.actionFinish
 	}
 
-	subredditName := strings.TrimLeft(args[1], "/")
-	subredditName = strings.Replace(subredditName, "r/", "", -1)
-
 	var specialText string
 	if postDelay > 0 {
 		specialText += fmt.Sprintf(" with a %d minutes delay", postDelay)
 	}
 
-	var linkMode bool
+	postMode := models.FeedPostModeEmbed
 	if strings.HasSuffix(in.Content, " direct link mode") ||
 		strings.HasSuffix(in.Content, " link mode") ||
 		strings.HasSuffix(in.Content, " links") {
-		linkMode = true
+		postMode = models.FeedPostModeText
 		specialText += " using direct links"
 	}
 
-	subredditData, err := redditSession.AboutSubreddit(subredditName)
+	entry, err := RedditAddFeed(models.FeedEntry{
+		GuildID:       targetChannel.GuildID,
+		ChannelID:     targetChannel.ID,
+		AddedByUserID: in.Author.ID,
+		PostMode:      postMode,
+		PostDelay:     postDelay,
+	}, args[1])
+	if err == ErrRedditSubredditNotFound {
+		*out = r.newMsg(in, "plugins.reddit.subreddit-not-found")
+		return r.actionFinish
+	}
+	if err == feeds.ErrAlreadyAdded {
+		*out = r.newMsg(in, "plugins.feeds.add-already-added", targetChannel.ID)
+		return r.actionFinish
+	}
 	helpers.Relax(err)
 
-	if subredditData.ID == "" {
-		*out = r.newMsg("plugins.reddit.subreddit-not-found")
+	// TODO: Post preview post
+
+	*out = r.newMsg(in, "plugins.reddit.add-subreddit-success", entry.Target, targetChannel.ID, specialText)
+	return r.actionFinish
+}
+
+func (r *Reddit) actionList(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
+	channel, err := helpers.GetChannel(in.ChannelID)
+	helpers.Relax(err)
+
+	subredditEntries, err := feeds.GetAll(channel.GuildID, "reddit")
+	helpers.Relax(err)
+
+	if subredditEntries == nil || len(subredditEntries) <= 0 {
+		*out = r.newMsg(in, "plugins.reddit.list-none")
 		return r.actionFinish
 	}
 
-	newID, err := helpers.MDbInsert(models.RedditSubredditsTable,
-		models.RedditSubredditEntry{
-			SubredditName:   subredditData.Name,
-			LastChecked:     time.Now().Add(-(time.Duration(postDelay) * time.Minute)),
-			GuildID:         targetChannel.GuildID,
-			ChannelID:       targetChannel.ID,
-			AddedByUserID:   in.Author.ID,
-			AddedAt:         time.Now(),
-			PostDelay:       postDelay,
-			PostDirectLinks: linkMode,
-		})
-	helpers.Relax(err)
+	subredditListText := ""
+	for _, subredditEntry := range subredditEntries {
+		var specialText string
+		if subredditEntry.PostMode == models.FeedPostModeText {
+			specialText += ", direct link mode"
+		}
+		if subredditEntry.MentionRoleID != "" {
+			specialText += fmt.Sprintf(", mentioning <@&%s>", subredditEntry.MentionRoleID)
+		}
+		if subredditEntry.Template != "" {
+			specialText += ", using a template"
+		}
 
-	_, err = helpers.EventlogLog(time.Now(), targetChannel.GuildID, helpers.MdbIdToHuman(newID),
-		models.EventlogTargetTypeRobyulRedditFeed, in.Author.ID,
+		subredditListText += fmt.Sprintf("`%s`: Subreddit `r/%s` posting to <#%s> (Delay: %d minutes%s)\n",
+			helpers.MdbIdToHuman(subredditEntry.ID), subredditEntry.Target, subredditEntry.ChannelID,
+			subredditEntry.PostDelay, specialText)
+	}
+	subredditListText += fmt.Sprintf("Found **%d** Subreddits in total.", len(subredditEntries))
+
+	*out = r.newMsg(in, subredditListText)
+	return r.actionFinish
+}
+
+// RedditAddFeed adds a subreddit feed, used by the reddit command and the REST API
+func RedditAddFeed(entry models.FeedEntry, subreddit string) (models.FeedEntry, error) {
+	entry, err := feeds.AddEntry("reddit", subreddit, entry)
+	if err != nil {
+		return entry, err
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulRedditFeed, entry.AddedByUserID,
 		models.EventlogTypeRobyulRedditFeedAdd, "",
 		nil,
 		[]models.ElasticEventlogOption{
 			{
 				Key:   "reddit_channelid",
-				Value: targetChannel.ID,
+				Value: entry.ChannelID,
 				Type:  models.EventlogTargetTypeChannel,
 			},
 			{
 				Key:   "reddit_postdirectlinks",
-				Value: helpers.StoreBoolAsString(linkMode),
+				Value: helpers.StoreBoolAsString(entry.PostMode == models.FeedPostModeText),
 			},
 			{
 				Key:   "reddit_postdelay",
-				Value: strconv.Itoa(postDelay),
+				Value: strconv.Itoa(entry.PostDelay),
 			},
 			{
 				Key:   "reddit_subredditname",
-				Value: subredditData.Name,
+				Value: entry.Target,
 			},
 		}, false)
 	helpers.RelaxLog(err)
 
-	// TODO: Post preview post
+	return entry, nil
+}
 
-	*out = r.newMsg("plugins.reddit.add-subreddit-success", subredditData.Name, targetChannel.ID, specialText)
-	return r.actionFinish
+// RedditRemoveFeed removes a subreddit feed, used by the reddit command and the REST API
+func RedditRemoveFeed(entry models.FeedEntry, userID string) (err error) {
+	err = feeds.Remove(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulRedditFeed, userID,
+		models.EventlogTypeRobyulRedditFeedRemove, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "reddit_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "reddit_postdirectlinks",
+				Value: helpers.StoreBoolAsString(entry.PostMode == models.FeedPostModeText),
+			},
+			{
+				Key:   "reddit_postdelay",
+				Value: strconv.Itoa(entry.PostDelay),
+			},
+			{
+				Key:   "reddit_subredditname",
+				Value: entry.Target,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return nil
 }
 
-func (r *Reddit) actionList(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
-	channel, err := helpers.GetChannel(in.ChannelID)
-	helpers.Relax(err)
+func (r *Reddit) actionRemove(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
+	if !helpers.IsMod(in) {
+		*out = r.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
+		return r.actionFinish
+	}
 
-	var subredditEntries []models.RedditSubredditEntry
-	err = helpers.MDbIter(helpers.MdbCollection(models.RedditSubredditsTable).Find(bson.M{"guildid": channel.GuildID})).All(&subredditEntries)
+	if len(args) < 2 {
+		*out = r.newMsg(in, "bot.arguments.too-few")
+		return r.actionFinish
+	}
+
+	channel, err := helpers.GetChannel(in.ChannelID)
 	helpers.Relax(err)
 
-	if subredditEntries == nil || len(subredditEntries) <= 0 {
-		*out = r.newMsg("plugins.reddit.list-none")
+	subredditEntry, found := r.findEntry(channel.GuildID, args[1])
+	if !found {
+		*out = r.newMsg(in, "plugins.reddit.remove-subreddit-error-not-found")
 		return r.actionFinish
 	}
 
-	subredditListText := ""
-	for _, subredditEntry := range subredditEntries {
-		var directLinkModeText string
-		if subredditEntry.PostDirectLinks {
-			directLinkModeText = ", direct link mode"
-		}
+	err = RedditRemoveFeed(subredditEntry, in.Author.ID)
+	helpers.Relax(err)
 
-		subredditListText += fmt.Sprintf("`%s`: Subreddit `r/%s` posting to <#%s> (Delay: %d minutes%s)\n",
-			helpers.MdbIdToHuman(subredditEntry.ID), subredditEntry.SubredditName, subredditEntry.ChannelID,
-			subredditEntry.PostDelay, directLinkModeText)
+	*out = r.newMsg(in, "plugins.reddit.remove-subreddit-success", subredditEntry.Target)
+	return r.actionFinish
+}
+
+func (r *Reddit) actionInfo(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
+	searchName := strings.TrimLeft(args[0], "/")
+
+	if strings.HasPrefix(searchName, "u/") {
+		searchName = strings.Replace(searchName, "u/", "", -1)
+
+		*out = r.getRedditorInfo(in, searchName)
+		return r.actionFinish
 	}
-	subredditListText += fmt.Sprintf("Found **%d** Subreddits in total.", len(subredditEntries))
 
-	*out = r.newMsg(subredditListText)
+	searchName = strings.Replace(searchName, "r/", "", -1)
+
+	*out = r.getSubredditInfo(in, searchName)
 	return r.actionFinish
 }
 
-func (r *Reddit) actionRemove(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
+func (r *Reddit) actionToggleDirectLinks(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
 	if !helpers.IsMod(in) {
-		*out = r.newMsg(helpers.GetText("mod.no_permission"))
+		*out = r.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
 		return r.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = r.newMsg("bot.arguments.too-few")
+		*out = r.newMsg(in, "bot.arguments.too-few")
 		return r.actionFinish
 	}
 
 	channel, err := helpers.GetChannel(in.ChannelID)
 	helpers.Relax(err)
 
-	var subredditEntry models.RedditSubredditEntry
-	err = helpers.MdbOne(
-		helpers.MdbCollection(models.RedditSubredditsTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(args[1])}),
-		&subredditEntry,
-	)
-	if helpers.IsMdbNotFound(err) {
-		*out = r.newMsg("plugins.reddit.remove-subreddit-error-not-found")
+	subredditEntry, found := r.findEntry(channel.GuildID, args[1])
+	if !found {
+		*out = r.newMsg(in, "plugins.reddit.toggledirectlinks-error-subreddit-not-found")
 		return r.actionFinish
 	}
-	helpers.Relax(err)
 
-	err = helpers.MDbDelete(models.RedditSubredditsTable, subredditEntry.ID)
-	helpers.Relax(err)
+	beforeValue := subredditEntry.PostMode == models.FeedPostModeText
+
+	if beforeValue {
+		subredditEntry.PostMode = models.FeedPostModeEmbed
+		*out = r.newMsg(in, "plugins.reddit.toggledirectlinks-disabled", subredditEntry.Target)
+	} else {
+		subredditEntry.PostMode = models.FeedPostModeText
+		*out = r.newMsg(in, "plugins.reddit.toggledirectlinks-enabled", subredditEntry.Target)
+	}
 
 	_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(subredditEntry.ID),
 		models.EventlogTargetTypeRobyulRedditFeed, in.Author.ID,
-		models.EventlogTypeRobyulRedditFeedRemove, "",
-		nil,
+		models.EventlogTypeRobyulRedditFeedUpdate, "",
+		[]models.ElasticEventlogChange{
+			{
+				Key:      "reddit_postdirectlinks",
+				OldValue: helpers.StoreBoolAsString(beforeValue),
+				NewValue: helpers.StoreBoolAsString(!beforeValue),
+			},
+		},
 		[]models.ElasticEventlogOption{
 			{
 				Key:   "reddit_channelid",
@@ -451,7 +520,7 @@ func (r *Reddit) actionRemove(args []string, in *discordgo.Message, out **discor
 			},
 			{
 				Key:   "reddit_postdirectlinks",
-				Value: helpers.StoreBoolAsString(subredditEntry.PostDirectLinks),
+				Value: helpers.StoreBoolAsString(!beforeValue),
 			},
 			{
 				Key:   "reddit_postdelay",
@@ -459,74 +528,137 @@ func (r *Reddit) actionRemove(args []string, in *discordgo.Message, out **discor
 			},
 			{
 				Key:   "reddit_subredditname",
-				Value: subredditEntry.SubredditName,
+				Value: subredditEntry.Target,
 			},
 		}, false)
 	helpers.RelaxLog(err)
 
-	*out = r.newMsg("plugins.reddit.remove-subreddit-success", subredditEntry.SubredditName)
+	err = feeds.Update(subredditEntry)
+	helpers.Relax(err)
+
 	return r.actionFinish
 }
 
-func (r *Reddit) actionInfo(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
-	searchName := strings.TrimLeft(args[0], "/")
+// [p]reddit filter <id> [<setting> [<value>]]
+func (r *Reddit) actionFilter(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
+	if !helpers.IsMod(in) {
+		*out = r.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
+		return r.actionFinish
+	}
 
-	if strings.HasPrefix(searchName, "u/") {
-		searchName = strings.Replace(searchName, "u/", "", -1)
+	if len(args) < 2 {
+		*out = r.newMsg(in, "bot.arguments.too-few")
+		return r.actionFinish
+	}
 
-		*out = r.getRedditorInfo(searchName)
+	channel, err := helpers.GetChannel(in.ChannelID)
+	helpers.Relax(err)
+
+	subredditEntry, found := r.findEntry(channel.GuildID, args[1])
+	if !found {
+		*out = r.newMsg(in, "plugins.reddit.remove-subreddit-error-not-found")
 		return r.actionFinish
 	}
 
-	searchName = strings.Replace(searchName, "r/", "", -1)
+	beforeValue := feeds.DescribeFilter(subredditEntry.Filter)
+	reply, changed := feeds.FilterCommand(helpers.GetMessageLocale(in), &subredditEntry.Filter,
+		feeds.SourceFilterOptions("reddit"), subredditEntry.TargetName, args[2:])
+	*out = &discordgo.MessageSend{Content: reply}
+	if !changed {
+		return r.actionFinish
+	}
 
-	*out = r.getSubredditInfo(searchName)
+	r.updateEntry(in, subredditEntry, "reddit_filter", beforeValue, feeds.DescribeFilter(subredditEntry.Filter))
 	return r.actionFinish
 }
 
-func (r *Reddit) actionToggleDirectLinks(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
+func (r *Reddit) actionTemplate(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
 	if !helpers.IsMod(in) {
-		*out = r.newMsg(helpers.GetText("mod.no_permission"))
+		*out = r.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
 		return r.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = r.newMsg("bot.arguments.too-few")
+		*out = r.newMsg(in, "bot.arguments.too-few")
 		return r.actionFinish
 	}
 
 	channel, err := helpers.GetChannel(in.ChannelID)
 	helpers.Relax(err)
 
-	var subredditEntry models.RedditSubredditEntry
-	err = helpers.MdbOne(
-		helpers.MdbCollection(models.RedditSubredditsTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(args[1])}),
-		&subredditEntry,
-	)
-	if helpers.IsMdbNotFound(err) {
-		*out = r.newMsg("plugins.reddit.toggledirectlinks-error-subreddit-not-found")
+	subredditEntry, found := r.findEntry(channel.GuildID, args[1])
+	if !found {
+		*out = r.newMsg(in, "plugins.reddit.remove-subreddit-error-not-found")
+		return r.actionFinish
+	}
+
+	beforeValue := subredditEntry.Template
+	reply, changed := feeds.TemplateCommand(helpers.GetMessageLocale(in), &subredditEntry.Template,
+		subredditEntry.TargetName, strings.Join(args[2:], " "))
+	*out = &discordgo.MessageSend{Content: reply}
+	if !changed {
 		return r.actionFinish
 	}
+
+	r.updateEntry(in, subredditEntry, "reddit_template", beforeValue, subredditEntry.Template)
+	return r.actionFinish
+}
+
+func (r *Reddit) actionMention(args []string, in *discordgo.Message, out **discordgo.MessageSend) redditAction {
+	if !helpers.IsMod(in) {
+		*out = r.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
+		return r.actionFinish
+	}
+
+	if len(args) < 2 {
+		*out = r.newMsg(in, "bot.arguments.too-few")
+		return r.actionFinish
+	}
+
+	channel, err := helpers.GetChannel(in.ChannelID)
 	helpers.Relax(err)
 
-	beforeValue := subredditEntry.PostDirectLinks
+	subredditEntry, found := r.findEntry(channel.GuildID, args[1])
+	if !found {
+		*out = r.newMsg(in, "plugins.reddit.remove-subreddit-error-not-found")
+		return r.actionFinish
+	}
 
-	if subredditEntry.PostDirectLinks {
-		subredditEntry.PostDirectLinks = false
-		*out = r.newMsg("plugins.reddit.toggledirectlinks-disabled", subredditEntry.SubredditName)
-	} else {
-		subredditEntry.PostDirectLinks = true
-		*out = r.newMsg("plugins.reddit.toggledirectlinks-enabled", subredditEntry.SubredditName)
+	beforeValue := subredditEntry.MentionRoleID
+	reply, changed := feeds.MentionCommand(helpers.GetMessageLocale(in), channel.GuildID, &subredditEntry.MentionRoleID,
+		subredditEntry.TargetName, strings.Join(args[2:], " "))
+	*out = &discordgo.MessageSend{Content: reply}
+	if !changed {
+		return r.actionFinish
 	}
 
-	_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(subredditEntry.ID),
+	r.updateEntry(in, subredditEntry, "reddit_mentionroleid", beforeValue, subredditEntry.MentionRoleID)
+	return r.actionFinish
+}
+
+// findEntry returns the subreddit feed with the ID on the guild
+func (r *Reddit) findEntry(guildID, id string) (subredditEntry models.FeedEntry, found bool) {
+	subredditEntry, err := feeds.Get(guildID, id)
+	if helpers.IsMdbNotFound(err) || (err == nil && subredditEntry.Source != "reddit") {
+		return subredditEntry, false
+	}
+	helpers.Relax(err)
+	return subredditEntry, true
+}
+
+// updateEntry saves a changed subreddit feed and logs the change to the eventlog
+func (r *Reddit) updateEntry(in *discordgo.Message, subredditEntry models.FeedEntry, key, oldValue, newValue string) {
+	err := feeds.Update(subredditEntry)
+	helpers.Relax(err)
+
+	_, err = helpers.EventlogLog(time.Now(), subredditEntry.GuildID, helpers.MdbIdToHuman(subredditEntry.ID),
 		models.EventlogTargetTypeRobyulRedditFeed, in.Author.ID,
 		models.EventlogTypeRobyulRedditFeedUpdate, "",
 		[]models.ElasticEventlogChange{
 			{
-				Key:      "reddit_postdirectlinks",
-				OldValue: helpers.StoreBoolAsString(beforeValue),
-				NewValue: helpers.StoreBoolAsString(subredditEntry.PostDirectLinks),
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
 			},
 		},
 		[]models.ElasticEventlogOption{
@@ -535,35 +667,22 @@ func (r *Reddit) actionToggleDirectLinks(args []string, in *discordgo.Message, o
 				Value: subredditEntry.ChannelID,
 				Type:  models.EventlogTargetTypeChannel,
 			},
-			{
-				Key:   "reddit_postdirectlinks",
-				Value: helpers.StoreBoolAsString(subredditEntry.PostDirectLinks),
-			},
-			{
-				Key:   "reddit_postdelay",
-				Value: strconv.Itoa(subredditEntry.PostDelay),
-			},
 			{
 				Key:   "reddit_subredditname",
-				Value: subredditEntry.SubredditName,
+				Value: subredditEntry.Target,
 			},
 		}, false)
 	helpers.RelaxLog(err)
-
-	err = helpers.MDbUpdate(models.RedditSubredditsTable, subredditEntry.ID, subredditEntry)
-	helpers.Relax(err)
-
-	return r.actionFinish
 }
 
-func (r *Reddit) getSubredditInfo(subreddit string) (data *discordgo.MessageSend) {
+func (r *Reddit) getSubredditInfo(in *discordgo.Message, subreddit string) (data *discordgo.MessageSend) {
 	subredditData, err := redditSession.AboutSubreddit(subreddit)
 	if err != nil {
-		return r.newMsg(err.Error())
+		return r.newMsg(in, err.Error())
 	}
 
 	if subredditData.ID == "" {
-		return r.newMsg("plugins.reddit.subreddit-not-found")
+		return r.newMsg(in, "plugins.reddit.subreddit-not-found")
 	}
 
 	data = &discordgo.MessageSend{}
@@ -581,8 +700,8 @@ func (r *Reddit) getSubredditInfo(subreddit string) (data *discordgo.MessageSend
 
 	data.Embed = &discordgo.MessageEmbed{
 		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.reddit.embed-footer") + " | reddit #" + subredditData.ID,
-			IconURL: helpers.GetText("plugins.reddit.embed-footer-imageurl"),
+			Text:    helpers.GetMessageText(in, "plugins.reddit.embed-footer") + " | reddit #" + subredditData.ID,
+			IconURL: helpers.GetMessageText(in, "plugins.reddit.embed-footer-imageurl"),
 		},
 		Title:       html.UnescapeString(titleText),
 		Description: html.UnescapeString(subredditData.PublicDesc),
@@ -603,14 +722,14 @@ func (r *Reddit) getSubredditInfo(subreddit string) (data *discordgo.MessageSend
 	return
 }
 
-func (r *Reddit) getRedditorInfo(username string) (data *discordgo.MessageSend) {
+func (r *Reddit) getRedditorInfo(in *discordgo.Message, username string) (data *discordgo.MessageSend) {
 	redditorData, err := redditSession.AboutRedditor(username)
 	if err != nil {
-		return r.newMsg(err.Error())
+		return r.newMsg(in, err.Error())
 	}
 
 	if redditorData.ID == "" {
-		return r.newMsg("plugins.reddit.redditor-not-found")
+		return r.newMsg(in, "plugins.reddit.redditor-not-found")
 	}
 
 	data = &discordgo.MessageSend{}
@@ -626,8 +745,8 @@ func (r *Reddit) getRedditorInfo(username string) (data *discordgo.MessageSend)
 
 	data.Embed = &discordgo.MessageEmbed{
 		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.reddit.embed-footer") + " | reddit #" + redditorData.ID,
-			IconURL: helpers.GetText("plugins.reddit.embed-footer-imageurl"),
+			Text:    helpers.GetMessageText(in, "plugins.reddit.embed-footer") + " | reddit #" + redditorData.ID,
+			IconURL: helpers.GetMessageText(in, "plugins.reddit.embed-footer-imageurl"),
 		},
 		Title: html.UnescapeString(titleText),
 		URL:   RedditBaseUrl + "/u/" + redditorData.Name,
@@ -652,11 +771,11 @@ func (r *Reddit) actionFinish(args []string, in *discordgo.Message, out **discor
 	return nil
 }
 
-func (r *Reddit) newMsg(content string, replacements ...interface{}) *discordgo.MessageSend {
+func (r *Reddit) newMsg(in *discordgo.Message, content string, replacements ...interface{}) *discordgo.MessageSend {
 	if len(replacements) < 1 {
-		return &discordgo.MessageSend{Content: helpers.GetText(content)}
+		return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 	}
-	return &discordgo.MessageSend{Content: helpers.GetTextF(content, replacements...)}
+	return &discordgo.MessageSend{Content: helpers.GetMessageTextF(in, content, replacements...)}
 }
 
 func (r *Reddit) Relax(err error) {
@@ -1,6 +1,7 @@
 package plugins
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -18,6 +19,8 @@ import (
 
 type Reminders struct {
 	parser *when.Parser
+	// closed when the reminder loop stopped after the bot started to shut down
+	loopStopped chan bool
 }
 
 // maps guildid => custom message
@@ -37,16 +40,18 @@ func (r *Reminders) Init(session *discordgo.Session) {
 	r.parser = when.New(nil)
 	r.parser.Add(en.All...)
 	r.parser.Add(common.All...)
+	r.loopStopped = make(chan bool)
 
 	go func() {
 		defer helpers.Recover()
+		defer close(r.loopStopped)
 
-		for {
+		for !helpers.IsShuttingDown() {
 			reminderBucket := make([]models.RemindersEntry, 0)
 			err := helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.RemindersTable).Find(nil)).All(&reminderBucket)
 			if err != nil {
 				helpers.RelaxLog(err)
-				time.Sleep(10 * time.Second)
+				helpers.SleepUnlessShuttingDown(10 * time.Second)
 				continue
 			}
 
@@ -86,7 +91,7 @@ func (r *Reminders) Init(session *discordgo.Session) {
 				}
 			}
 
-			time.Sleep(5 * time.Second)
+			helpers.SleepUnlessShuttingDown(5 * time.Second)
 		}
 	}()
 
@@ -101,6 +106,14 @@ func (r *Reminders) Init(session *discordgo.Session) {
 	cache.GetLogger().WithField("module", "reminders").Info("Started reminder loop (10s)")
 }
 
+// Shutdown waits until the reminder loop saved the reminders it sent
+func (r *Reminders) Shutdown(ctx context.Context) {
+	select {
+	case <-r.loopStopped:
+	case <-ctx.Done():
+	}
+}
+
 func (r *Reminders) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
 	if !helpers.ModuleIsAllowed(msg.ChannelID, msg.ID, msg.Author.ID, helpers.ModulePermReminders) {
 		return
@@ -185,7 +198,7 @@ func (r *Reminders) Action(command string, content string, msg *discordgo.Messag
 		}
 
 		if len(embedFields) == 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.reminders.empty"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.reminders.empty"))
 			return
 		}
 
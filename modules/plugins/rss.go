@@ -0,0 +1,169 @@
+package plugins
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/commands"
+	"github.com/Seklfreak/Robyul2/feeds"
+	"github.com/Seklfreak/Robyul2/feeds/rss"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RSS posts new items of RSS, Atom and JSON feeds, the feeds are checked by the feeds framework
+type RSS struct {
+	feeds Feeds
+}
+
+func (m *RSS) Commands() []*commands.Command {
+	return []*commands.Command{
+		{
+			Name:        "rss",
+			Description: "Posts new items of RSS, Atom and JSON feeds, for example of fan sites, news or blogs.",
+			Permission:  commands.PermissionMod,
+			Module:      helpers.ModulePermRSS,
+			Subcommands: []*commands.Command{
+				{
+					Name:        "add",
+					Description: "Adds a feed, optionally mentioning a role in every post.",
+					Arguments: []commands.Argument{
+						{Name: "url", Type: commands.ArgumentString},
+						{Name: "channel", Type: commands.ArgumentChannel},
+						{Name: "role", Type: commands.ArgumentRole, Optional: true},
+					},
+					Handler: m.actionAdd,
+				},
+				{
+					Name:        "list",
+					Description: "Lists all feeds on this server.",
+					Handler:     m.actionList,
+				},
+				{
+					Name:        "template",
+					Description: "Shows or sets the text or embed code to post items with, reset removes it. Placeholders: " + feeds.TemplatePlaceholders + ".",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+						{Name: "template", Type: commands.ArgumentText, Optional: true},
+					},
+					Handler: m.feeds.actionTemplate,
+				},
+				{
+					Name:        "mention",
+					Description: "Sets the role to mention in every post, removes it if no role is given.",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+						{Name: "role", Type: commands.ArgumentRole, Optional: true},
+					},
+					Handler: m.feeds.actionMention,
+				},
+				{
+					Name:        "filter",
+					Description: "Shows or changes which items of a feed are posted.",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+						{Name: "setting", Type: commands.ArgumentText, Optional: true},
+					},
+					Handler: m.feeds.actionFilter,
+				},
+				{
+					Name:        "remove",
+					Aliases:     []string{"delete"},
+					Description: "Removes a feed.",
+					Arguments: []commands.Argument{
+						{Name: "feed id", Type: commands.ArgumentString},
+					},
+					Handler: m.feeds.actionRemove,
+				},
+			},
+		},
+	}
+}
+
+func (m *RSS) Init(session *discordgo.Session) {
+	err := feeds.Register(rss.New())
+	if err != nil {
+		cache.GetLogger().WithField("module", "rss").WithError(err).Error("failed to register the rss feed source")
+	}
+}
+
+// [p]rss add <url> <#channel> [<role>]
+func (m *RSS) actionAdd(ctx *commands.Context) {
+	channel := ctx.ChannelArg("channel")
+	if channel.GuildID != ctx.GuildID {
+		ctx.SendText("bot.arguments.invalid")
+		return
+	}
+
+	var mentionRoleID string
+	if ctx.Has("role") {
+		mentionRoleID = ctx.Role("role").ID
+	}
+
+	cache.GetSession().ChannelTyping(ctx.Msg.ChannelID)
+
+	entry, err := feeds.Add("rss", ctx.String("url"), channel, ctx.Msg.Author.ID, mentionRoleID)
+	if err == feeds.ErrAlreadyAdded {
+		ctx.SendText("plugins.rss.add-already-added", channel.ID)
+		return
+	}
+	if err != nil {
+		ctx.SendText("plugins.rss.add-feed-invalid")
+		return
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), ctx.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulFeed, ctx.Msg.Author.ID,
+		models.EventlogTypeRobyulFeedAdd, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "feed_source",
+				Value: entry.Source,
+			},
+			{
+				Key:   "feed_target",
+				Value: entry.Target,
+			},
+			{
+				Key:   "feed_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "feed_mentionroleid",
+				Value: entry.MentionRoleID,
+				Type:  models.EventlogTargetTypeRole,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	ctx.SendText("plugins.rss.add-success", entry.TargetName, entry.ChannelID)
+}
+
+// [p]rss list
+func (m *RSS) actionList(ctx *commands.Context) {
+	items, err := feeds.List(ctx.GuildID)
+	helpers.Relax(err)
+
+	var listText string
+	var count int
+	for _, item := range items {
+		if item.Source != "rss" {
+			continue
+		}
+		count++
+
+		listText += fmt.Sprintf("`%s`: `%s` posting to <#%s> (%s)\n", item.ID, item.Name, item.ChannelID, item.Details)
+	}
+
+	if count <= 0 {
+		ctx.SendText("plugins.rss.list-none")
+		return
+	}
+
+	listText += ctx.Text("plugins.rss.list-total", count)
+	ctx.Send(listText)
+}
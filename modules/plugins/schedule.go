@@ -0,0 +1,536 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/en"
+)
+
+type Schedule struct{}
+
+const (
+	scheduleMaxPerGuild   = 25
+	schedulePreviewLength = 50
+	scheduleTimeFormat    = "Mon, 02 Jan 2006 15:04 MST"
+	scheduleMinimumRunGap = time.Minute
+	scheduleRunClaimTTL   = time.Hour * 24 * 7
+	scheduleSweepInterval = time.Minute * 10
+	scheduleOverdueAfter  = time.Minute * 5
+)
+
+var (
+	scheduleWhenParser *when.Parser
+
+	scheduleTimeLayouts = []string{
+		"2006-01-02 15:04",
+		"2006-01-02T15:04",
+		"2006-01-02",
+	}
+)
+
+func (s *Schedule) Commands() []string {
+	return []string{
+		"schedule",
+	}
+}
+
+func (s *Schedule) Init(session *discordgo.Session) {
+	scheduleWhenParser = when.New(nil)
+	scheduleWhenParser.Add(en.All...)
+	scheduleWhenParser.Add(common.All...)
+
+	go s.sweepLoop()
+}
+
+func (s *Schedule) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
+	if !helpers.ModuleIsAllowed(msg.ChannelID, msg.ID, msg.Author.ID, helpers.ModulePermSchedule) {
+		return
+	}
+
+	args := strings.Fields(content)
+	if len(args) < 1 {
+		args = []string{"list"}
+	}
+
+	switch args[0] {
+	case "add": // [p]schedule add <#channel> <"when" or "cron"> <text or embed code>
+		helpers.RequireMod(msg, func() {
+			session.ChannelTyping(msg.ChannelID)
+
+			if len(args) < 4 {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+				return
+			}
+
+			channel, err := helpers.GetChannel(msg.ChannelID)
+			helpers.Relax(err)
+
+			targetChannel, err := helpers.GetChannelFromMention(msg, args[1])
+			if err != nil || targetChannel == nil || targetChannel.GuildID != channel.GuildID {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+				return
+			}
+
+			spec, text := splitScheduleSpec(strings.TrimSpace(strings.Replace(
+				strings.Replace(content, args[0], "", 1), args[1], "", 1)))
+			if spec == "" || text == "" {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+				return
+			}
+
+			if helpers.IsEmbedCode(text) {
+				_, _, err = helpers.ParseEmbedCode(text)
+				if err != nil {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+					return
+				}
+			}
+
+			location := helpers.GetLocationForServer(channel.GuildID)
+			cron, runAt, err := parseScheduleSpec(spec, time.Now().In(location))
+			if err != nil {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.schedule.when-invalid"))
+				return
+			}
+
+			count, err := helpers.MdbCount(models.ScheduledMessagesTable, bson.M{"guildid": channel.GuildID})
+			helpers.Relax(err)
+			if count >= scheduleMaxPerGuild {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.schedule.add-too-many", scheduleMaxPerGuild))
+				return
+			}
+
+			entry := models.ScheduledMessageEntry{
+				GuildID:   channel.GuildID,
+				ChannelID: targetChannel.ID,
+				AuthorID:  msg.Author.ID,
+				Content:   text,
+				Cron:      cron,
+				NextRunAt: runAt,
+				CreatedAt: time.Now(),
+			}
+			newID, err := helpers.MDbInsert(models.ScheduledMessagesTable, entry)
+			helpers.Relax(err)
+			entry.ID = newID
+
+			err = sendScheduledMessageTask(entry)
+			if err != nil {
+				helpers.RelaxLog(helpers.MDbDelete(models.ScheduledMessagesTable, newID))
+				helpers.Relax(err)
+			}
+
+			_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(newID),
+				models.EventlogTargetTypeRobyulScheduledMessage, msg.Author.ID,
+				models.EventlogTypeRobyulScheduledMessageAdd, "",
+				nil,
+				[]models.ElasticEventlogOption{
+					{
+						Key:   "scheduledmessage_channelid",
+						Value: targetChannel.ID,
+						Type:  models.EventlogTargetTypeChannel,
+					},
+					{
+						Key:   "scheduledmessage_cron",
+						Value: cron,
+					},
+					{
+						Key:   "scheduledmessage_nextrunat",
+						Value: runAt.Format(time.RFC3339),
+					},
+					{
+						Key:   "scheduledmessage_content",
+						Value: text,
+					},
+				}, false)
+			helpers.RelaxLog(err)
+
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.schedule.add-success",
+				helpers.MdbIdToHuman(newID), targetChannel.ID, runAt.In(location).Format(scheduleTimeFormat)))
+			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+		})
+		return
+	case "list": // [p]schedule list
+		helpers.RequireMod(msg, func() {
+			session.ChannelTyping(msg.ChannelID)
+
+			channel, err := helpers.GetChannel(msg.ChannelID)
+			helpers.Relax(err)
+
+			var entries []models.ScheduledMessageEntry
+			err = helpers.MDbIter(helpers.MdbCollection(models.ScheduledMessagesTable).Find(
+				bson.M{"guildid": channel.GuildID},
+			).Sort("nextrunat")).All(&entries)
+			helpers.Relax(err)
+
+			if len(entries) <= 0 {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.schedule.list-empty"))
+				return
+			}
+
+			location := helpers.GetLocationForServer(channel.GuildID)
+			locale := helpers.GetMessageLocale(msg)
+			listText := helpers.GetTextLF(locale, "plugins.schedule.list-title", location.String()) + "\n"
+			for _, entry := range entries {
+				repeatText := helpers.GetTextL(locale, "plugins.schedule.list-once")
+				if entry.Cron != "" {
+					repeatText = helpers.GetTextLF(locale, "plugins.schedule.list-every", entry.Cron)
+				}
+				statusText := helpers.GetTextLF(locale, "plugins.schedule.list-next-at",
+					entry.NextRunAt.In(location).Format(scheduleTimeFormat))
+				if entry.Paused {
+					statusText = helpers.GetTextL(locale, "plugins.schedule.list-paused")
+				}
+
+				listText += helpers.GetTextLF(locale, "plugins.schedule.list-entry",
+					helpers.MdbIdToHuman(entry.ID), entry.ChannelID, repeatText, statusText,
+					schedulePreview(entry.Content)) + "\n"
+			}
+			listText += helpers.GetTextLF(locale, "plugins.schedule.list-total", len(entries))
+
+			for _, page := range helpers.Pagify(listText, "\n") {
+				_, err = helpers.SendMessage(msg.ChannelID, page)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			}
+		})
+		return
+	case "pause", "resume": // [p]schedule pause|resume <id>
+		helpers.RequireMod(msg, func() {
+			session.ChannelTyping(msg.ChannelID)
+
+			entry, ok := s.findEntry(msg, args)
+			if !ok {
+				return
+			}
+
+			pause := args[0] == "pause"
+			if entry.Paused == pause {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.schedule.pause-unchanged"))
+				return
+			}
+			entry.Paused = pause
+
+			// the pending task posts the message if it is resumed in time, otherwise a new task is needed
+			resumedLate := !pause && !entry.NextRunAt.After(time.Now())
+			if resumedLate && entry.Cron != "" {
+				cronSchedule, err := helpers.ParseCron(entry.Cron)
+				helpers.Relax(err)
+				entry.NextRunAt = cronSchedule.Next(time.Now().In(helpers.GetLocationForServer(entry.GuildID)))
+			}
+
+			err := helpers.MDbUpdate(models.ScheduledMessagesTable, entry.ID, entry)
+			helpers.Relax(err)
+
+			if resumedLate {
+				err = sendScheduledMessageTask(entry)
+				helpers.Relax(err)
+			}
+
+			_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+				models.EventlogTargetTypeRobyulScheduledMessage, msg.Author.ID,
+				models.EventlogTypeRobyulScheduledMessageUpdate, "",
+				[]models.ElasticEventlogChange{
+					{
+						Key:      "scheduledmessage_paused",
+						OldValue: helpers.StoreBoolAsString(!entry.Paused),
+						NewValue: helpers.StoreBoolAsString(entry.Paused),
+					},
+				},
+				nil, false)
+			helpers.RelaxLog(err)
+
+			if pause {
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.schedule.pause-success"))
+				return
+			}
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.schedule.resume-success",
+				entry.NextRunAt.In(helpers.GetLocationForServer(entry.GuildID)).Format(scheduleTimeFormat)))
+		})
+		return
+	case "delete", "remove": // [p]schedule delete <id>
+		helpers.RequireMod(msg, func() {
+			session.ChannelTyping(msg.ChannelID)
+
+			entry, ok := s.findEntry(msg, args)
+			if !ok {
+				return
+			}
+
+			// pending tasks of deleted messages do nothing
+			err := helpers.MDbDelete(models.ScheduledMessagesTable, entry.ID)
+			helpers.Relax(err)
+
+			_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+				models.EventlogTargetTypeRobyulScheduledMessage, msg.Author.ID,
+				models.EventlogTypeRobyulScheduledMessageRemove, "",
+				nil,
+				[]models.ElasticEventlogOption{
+					{
+						Key:   "scheduledmessage_channelid",
+						Value: entry.ChannelID,
+						Type:  models.EventlogTargetTypeChannel,
+					},
+					{
+						Key:   "scheduledmessage_cron",
+						Value: entry.Cron,
+					},
+					{
+						Key:   "scheduledmessage_content",
+						Value: entry.Content,
+					},
+				}, false)
+			helpers.RelaxLog(err)
+
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.schedule.delete-success"))
+		})
+		return
+	}
+
+	helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+}
+
+// findEntry returns the scheduled message of the guild with the ID in args[1], sends an error message if there is none
+func (s *Schedule) findEntry(msg *discordgo.Message, args []string) (entry models.ScheduledMessageEntry, ok bool) {
+	if len(args) < 2 {
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+		return entry, false
+	}
+
+	channel, err := helpers.GetChannel(msg.ChannelID)
+	helpers.Relax(err)
+
+	err = helpers.MdbOne(
+		helpers.MdbCollection(models.ScheduledMessagesTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(args[1])}),
+		&entry,
+	)
+	if helpers.IsMdbNotFound(err) {
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.schedule.not-found"))
+		return entry, false
+	}
+	helpers.Relax(err)
+
+	return entry, true
+}
+
+// ScheduledMessagePost posts a scheduled message and schedules the next run of recurring messages
+// tasks of deleted, paused or rescheduled messages do nothing
+func ScheduledMessagePost(scheduledMessageID string, runAt int64) (err error) {
+	var entry models.ScheduledMessageEntry
+	err = helpers.MdbOneWithoutLogging(
+		helpers.MdbCollection(models.ScheduledMessagesTable).Find(bson.M{"_id": helpers.HumanToMdbId(scheduledMessageID)}),
+		&entry,
+	)
+	if helpers.IsMdbNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if entry.Paused || entry.NextRunAt.Unix() != runAt {
+		return nil
+	}
+
+	// every run is only posted once, even if a task for it has been sent again, for example by a late resume
+	claimKey := fmt.Sprintf("robyul2-discord:schedule:run:%s:%d", scheduledMessageID, runAt)
+	claimed, err := cache.GetRedisClient().SetNX(claimKey, true, scheduleRunClaimTTL).Result()
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	err = postScheduledMessage(entry)
+	if err != nil {
+		if errD, ok := err.(*discordgo.RESTError); !ok || errD.Message == nil ||
+			(errD.Message.Code != discordgo.ErrCodeMissingPermissions &&
+				errD.Message.Code != discordgo.ErrCodeMissingAccess &&
+				errD.Message.Code != discordgo.ErrCodeUnknownChannel) {
+			// the message has not been posted, the task will be retried
+			helpers.RelaxLog(cache.GetRedisClient().Del(claimKey).Err())
+			return err
+		}
+		cache.GetLogger().WithField("module", "schedule").Warnf("posting scheduled message #%s to #%s failed: %s",
+			scheduledMessageID, entry.ChannelID, err.Error())
+	}
+
+	if entry.Cron == "" {
+		return helpers.MDbDeleteWithoutLogging(models.ScheduledMessagesTable, entry.ID)
+	}
+
+	cronSchedule, err := helpers.ParseCron(entry.Cron)
+	if err != nil {
+		return err
+	}
+	entry.LastRunAt = time.Now()
+	entry.NextRunAt = cronSchedule.Next(time.Now().Add(scheduleMinimumRunGap).In(helpers.GetLocationForServer(entry.GuildID)))
+	if entry.NextRunAt.IsZero() {
+		return helpers.MDbDeleteWithoutLogging(models.ScheduledMessagesTable, entry.ID)
+	}
+
+	err = helpers.MDbUpdateWithoutLogging(models.ScheduledMessagesTable, entry.ID, entry)
+	if err != nil {
+		return err
+	}
+
+	return sendScheduledMessageTask(entry)
+}
+
+// sweepLoop sends new tasks for overdue scheduled messages, for example if their tasks got lost while the bot was offline
+func (s *Schedule) sweepLoop() {
+	defer helpers.Recover()
+	defer func() {
+		if helpers.IsShuttingDown() {
+			return
+		}
+		go func() {
+			cache.GetLogger().WithField("module", "schedule").Error("The sweep loop died. Please investigate! Will be restarted in 60 seconds")
+			if helpers.SleepUnlessShuttingDown(60 * time.Second) {
+				s.sweepLoop()
+			}
+		}()
+	}()
+
+	for {
+		helpers.RelaxLog(sendOverdueScheduledMessageTasks())
+
+		if !helpers.SleepUnlessShuttingDown(scheduleSweepInterval) {
+			return
+		}
+	}
+}
+
+// sendOverdueScheduledMessageTasks sends new tasks for all scheduled messages that should have been posted a while ago,
+// runs which have been posted in the meantime are skipped because of their claim
+func sendOverdueScheduledMessageTasks() (err error) {
+	var entries []models.ScheduledMessageEntry
+	err = helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.ScheduledMessagesTable).Find(
+		bson.M{"paused": false, "nextrunat": bson.M{"$lt": time.Now().Add(-scheduleOverdueAfter)}},
+	)).All(&entries)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		err = sendScheduledMessageTask(entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(entries) > 0 {
+		cache.GetLogger().WithField("module", "schedule").Infof("sent tasks for %d overdue scheduled messages", len(entries))
+	}
+	return nil
+}
+
+func ScheduledMessagePostSignature(scheduledMessageID string, runAt time.Time) (signature *tasks.Signature) {
+	return helpers.NewMachinerySignature(
+		"post_scheduled_message",
+		tasks.Arg{
+			Type:  "string",
+			Value: scheduledMessageID,
+		},
+		tasks.Arg{
+			Type:  "int64",
+			Value: runAt.Unix(),
+		},
+	)
+}
+
+func sendScheduledMessageTask(entry models.ScheduledMessageEntry) (err error) {
+	signature := ScheduledMessagePostSignature(helpers.MdbIdToHuman(entry.ID), entry.NextRunAt)
+	if entry.NextRunAt.After(time.Now()) {
+		runAt := entry.NextRunAt
+		signature.ETA = &runAt
+	}
+
+	_, err = cache.GetMachineryServer().SendTask(signature)
+	return err
+}
+
+func postScheduledMessage(entry models.ScheduledMessageEntry) (err error) {
+	send := &discordgo.MessageSend{
+		Content: entry.Content,
+	}
+	if helpers.IsEmbedCode(entry.Content) {
+		send.Content, send.Embed, err = helpers.ParseEmbedCode(entry.Content)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = helpers.SendComplex(entry.ChannelID, send)
+	return err
+}
+
+// splitScheduleSpec splits the time or cron expression from the text, expressions with spaces have to be in quotes
+func splitScheduleSpec(content string) (spec, text string) {
+	if strings.HasPrefix(content, "\"") {
+		end := strings.Index(content[1:], "\"")
+		if end < 0 {
+			return "", ""
+		}
+		return strings.TrimSpace(content[1 : end+1]), strings.TrimSpace(content[end+2:])
+	}
+
+	parts := strings.SplitN(content, " ", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], strings.TrimSpace(parts[1])
+}
+
+// parseScheduleSpec returns the cron expression of recurring messages and the time of the first run
+// spec is a cron expression, a date, a duration or a natural language time like "tomorrow 9am"
+func parseScheduleSpec(spec string, now time.Time) (cron string, runAt time.Time, err error) {
+	if cronSchedule, err := helpers.ParseCron(spec); err == nil {
+		runAt = cronSchedule.Next(now)
+		if runAt.IsZero() {
+			return "", runAt, helpers.ErrInvalidCron
+		}
+		return strings.ToLower(strings.Join(strings.Fields(spec), " ")), runAt, nil
+	}
+
+	for _, layout := range scheduleTimeLayouts {
+		if runAt, err = time.ParseInLocation(layout, spec, now.Location()); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		var duration time.Duration
+		if duration, err = time.ParseDuration(spec); err == nil {
+			runAt = now.Add(duration)
+		}
+	}
+	if err != nil && scheduleWhenParser != nil {
+		result, err := scheduleWhenParser.Parse(spec, now)
+		if err != nil || result == nil {
+			return "", runAt, errors.New("unable to parse time")
+		}
+		runAt = result.Time
+	}
+	if runAt.IsZero() || !runAt.After(now) {
+		return "", runAt, errors.New("time is not in the future")
+	}
+
+	return "", runAt, nil
+}
+
+func schedulePreview(content string) string {
+	content = strings.Replace(strings.Replace(content, "\n", " ", -1), "`", "", -1)
+	if len([]rune(content)) > schedulePreviewLength {
+		return string([]rune(content)[:schedulePreviewLength]) + "…"
+	}
+	return content
+}
@@ -75,7 +75,7 @@ func (s *Spoiler) Action(command string, content string, msg *discordgo.Message,
 	content = strings.TrimSpace(content)
 
 	if len(content) <= 0 {
-		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		helpers.Relax(err)
 		return
 	}
@@ -119,7 +119,7 @@ func (s *Spoiler) Action(command string, content string, msg *discordgo.Message,
 	status := surface.WriteToPNG(contentFrameFilename)
 	if status != cairo.STATUS_SUCCESS {
 		helpers.RelaxLog(errors.New("failed to write surface"))
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.spoiler.error-generic"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.spoiler.error-generic"))
 		return
 	}
 
@@ -135,7 +135,7 @@ func (s *Spoiler) Action(command string, content string, msg *discordgo.Message,
 	status = surface.WriteToPNG(blankFrameFilename)
 	if status != cairo.STATUS_SUCCESS {
 		helpers.RelaxLog(errors.New("failed to write surface"))
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.spoiler.error-generic"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.spoiler.error-generic"))
 		return
 	}
 
@@ -163,7 +163,7 @@ func (s *Spoiler) Action(command string, content string, msg *discordgo.Message,
 	err := imgCmd.Run()
 	if err != nil {
 		helpers.RelaxLog(errors.New(fmt.Sprint(err) + ": " + stderr.String()))
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.spoiler.error-generic"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.spoiler.error-generic"))
 		return
 	}
 
@@ -68,7 +68,7 @@ func (s *Starboard) actionStart(args []string, in *discordgo.Message, out **disc
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = s.newMsg("bot.arguments.too-few")
+		*out = s.newMsg(in, "bot.arguments.too-few")
 		return s.actionFinish
 	}
 
@@ -87,7 +87,7 @@ func (s *Starboard) actionStart(args []string, in *discordgo.Message, out **disc
 		return s.actionEmoji
 	}
 
-	*out = s.newMsg("bot.arguments.invalid")
+	*out = s.newMsg(in, "bot.arguments.invalid")
 	return s.actionFinish
 }
 
@@ -98,7 +98,7 @@ func (s *Starboard) actionTop(args []string, in *discordgo.Message, out **discor
 	topEntries, err := s.getTopStarboardEntries(channel.GuildID, 100)
 	if err != nil {
 		if strings.Contains(err.Error(), "no starboard entries") {
-			*out = s.newMsg(helpers.GetText("plugins.starboard.top-no-entries"))
+			*out = s.newMsg(in, helpers.GetMessageText(in, "plugins.starboard.top-no-entries"))
 			return s.actionFinish
 		} else {
 			helpers.Relax(err)
@@ -108,7 +108,7 @@ func (s *Starboard) actionTop(args []string, in *discordgo.Message, out **discor
 	pages, err := s.getTopMessagesEmbeds(topEntries, 5, 400)
 	if err != nil {
 		if strings.Contains(err.Error(), "no star entries passed") {
-			*out = s.newMsg(helpers.GetText("plugins.starboard.top-no-entries"))
+			*out = s.newMsg(in, helpers.GetMessageText(in, "plugins.starboard.top-no-entries"))
 			return s.actionFinish
 		}
 	}
@@ -123,7 +123,7 @@ func (s *Starboard) actionTop(args []string, in *discordgo.Message, out **discor
 
 func (s *Starboard) actionStarrers(args []string, in *discordgo.Message, out **discordgo.MessageSend) starboardAction {
 	if len(args) < 2 {
-		*out = s.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return s.actionFinish
 	}
 
@@ -133,7 +133,7 @@ func (s *Starboard) actionStarrers(args []string, in *discordgo.Message, out **d
 	starboardEntry, err := s.getStarboardEntry(channel.GuildID, args[1])
 	if err != nil {
 		if strings.Contains(err.Error(), "no starboard entry") {
-			*out = s.newMsg(helpers.GetText("bot.arguments.invalid"))
+			*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 			return s.actionFinish
 		}
 		helpers.Relax(err)
@@ -167,17 +167,17 @@ func (s *Starboard) actionStatus(args []string, in *discordgo.Message, out **dis
 	emojiText = strings.TrimRight(emojiText, ", ")
 
 	if guildSettings.StarboardChannelID != "" {
-		*out = s.newMsg(helpers.GetTextF("plugins.starboard.status-set",
+		*out = s.newMsg(in, helpers.GetMessageTextF(in, "plugins.starboard.status-set",
 			guildSettings.StarboardChannelID, s.getMinimum(channel.GuildID), emojiText))
 	} else {
-		*out = s.newMsg(helpers.GetText("plugins.starboard.status-none"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "plugins.starboard.status-none"))
 	}
 	return s.actionFinish
 }
 
 func (s *Starboard) actionSet(args []string, in *discordgo.Message, out **discordgo.MessageSend) starboardAction {
 	if !helpers.IsMod(in) {
-		*out = s.newMsg(helpers.GetText("mod.no_permission"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
 		return s.actionFinish
 	}
 
@@ -210,10 +210,10 @@ func (s *Starboard) actionSet(args []string, in *discordgo.Message, out **discor
 				}, false)
 			helpers.RelaxLog(err)
 
-			*out = s.newMsg(helpers.GetText("plugins.starboard.reset-success"))
+			*out = s.newMsg(in, helpers.GetMessageText(in, "plugins.starboard.reset-success"))
 			return s.actionFinish
 		} else {
-			*out = s.newMsg(helpers.GetText("plugins.starboard.status-none"))
+			*out = s.newMsg(in, helpers.GetMessageText(in, "plugins.starboard.status-none"))
 		}
 		return s.actionFinish
 	}
@@ -221,7 +221,7 @@ func (s *Starboard) actionSet(args []string, in *discordgo.Message, out **discor
 	targetChannel, err := helpers.GetChannelFromMention(in, args[1])
 	if err != nil {
 		if strings.Contains(err.Error(), "Channel not found") {
-			*out = s.newMsg(helpers.GetText("bot.arguments.invalid"))
+			*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 			return s.actionFinish
 		}
 		helpers.Relax(err)
@@ -261,30 +261,30 @@ func (s *Starboard) actionSet(args []string, in *discordgo.Message, out **discor
 		}, false)
 	helpers.RelaxLog(err)
 
-	*out = s.newMsg(helpers.GetTextF("plugins.starboard.set-success", guildSettings.StarboardChannelID))
+	*out = s.newMsg(in, helpers.GetMessageTextF(in, "plugins.starboard.set-success", guildSettings.StarboardChannelID))
 	return s.actionFinish
 }
 
 func (s *Starboard) actionMinimum(args []string, in *discordgo.Message, out **discordgo.MessageSend) starboardAction {
 	if !helpers.IsMod(in) {
-		*out = s.newMsg(helpers.GetText("mod.no_permission"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
 		return s.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = s.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return s.actionFinish
 	}
 
 	var err error
 	var newMinimum int
 	if newMinimum, err = strconv.Atoi(args[1]); err != nil {
-		*out = s.newMsg(helpers.GetText("bot.arguments.invalid"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 		return s.actionFinish
 	}
 
 	if newMinimum < 1 {
-		*out = s.newMsg(helpers.GetText("bot.arguments.invalid"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 		return s.actionFinish
 	}
 
@@ -310,25 +310,25 @@ func (s *Starboard) actionMinimum(args []string, in *discordgo.Message, out **di
 		nil, false)
 	helpers.RelaxLog(err)
 
-	*out = s.newMsg(helpers.GetTextF("plugins.starboard.minimum-success", guildSettings.StarboardMinimum))
+	*out = s.newMsg(in, helpers.GetMessageTextF(in, "plugins.starboard.minimum-success", guildSettings.StarboardMinimum))
 	return s.actionFinish
 }
 
 func (s *Starboard) actionEmoji(args []string, in *discordgo.Message, out **discordgo.MessageSend) starboardAction {
 	if !helpers.IsMod(in) {
-		*out = s.newMsg(helpers.GetText("mod.no_permission"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "mod.no_permission"))
 		return s.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = s.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return s.actionFinish
 	}
 
 	newEmoji := args[1]
 
 	if !helpers.IsEmoji(newEmoji) {
-		*out = s.newMsg(helpers.GetText("bot.arguments.invalid"))
+		*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 		return s.actionFinish
 	}
 
@@ -338,7 +338,7 @@ func (s *Starboard) actionEmoji(args []string, in *discordgo.Message, out **disc
 	if helpers.IsDiscordEmoji(newEmoji) {
 		discordEmoji, err := helpers.GetDiscordEmojiFromText(channel.GuildID, newEmoji)
 		if err != nil || discordEmoji == nil || discordEmoji.Name == "" {
-			*out = s.newMsg(helpers.GetText("bot.arguments.invalid"))
+			*out = s.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 			return s.actionFinish
 		}
 		newEmoji = discordEmoji.Name
@@ -397,9 +397,9 @@ func (s *Starboard) actionEmoji(args []string, in *discordgo.Message, out **disc
 	helpers.RelaxLog(err)
 
 	if !removed {
-		*out = s.newMsg(helpers.GetTextF("plugins.starboard.emoji-add-success", newEmoji))
+		*out = s.newMsg(in, helpers.GetMessageTextF(in, "plugins.starboard.emoji-add-success", newEmoji))
 	} else {
-		*out = s.newMsg(helpers.GetTextF("plugins.starboard.emoji-remove-success", newEmoji))
+		*out = s.newMsg(in, helpers.GetMessageTextF(in, "plugins.starboard.emoji-remove-success", newEmoji))
 	}
 	return s.actionFinish
 }
@@ -412,8 +412,8 @@ func (s *Starboard) actionFinish(args []string, in *discordgo.Message, out **dis
 	return nil
 }
 
-func (s *Starboard) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (s *Starboard) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (s *Starboard) Relax(err error) {
@@ -1051,6 +1051,16 @@ func (s *Starboard) getEmoji(guildID string) (emojis []string) {
 	}
 }
 
+// StarboardGetMinimum returns the stars required for the starboard of the guild, used by the REST API
+func StarboardGetMinimum(guildID string) int {
+	return new(Starboard).getMinimum(guildID)
+}
+
+// StarboardGetEmoji returns the emoji counted as stars on the guild, used by the REST API
+func StarboardGetEmoji(guildID string) (emojis []string) {
+	return new(Starboard).getEmoji(guildID)
+}
+
 func (s *Starboard) lockGuild(guildID string) {
 	if _, ok := starboardStarLocks[guildID]; ok {
 		starboardStarLocks[guildID].Lock()
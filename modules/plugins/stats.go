@@ -60,6 +60,7 @@ var (
 
 const (
 	VOICE_SESSION_SAVE_DURATION_MIN_SECONDS = 60
+	statsMaxListedShards                    = 5
 )
 
 type VoiceSessionStart struct {
@@ -163,7 +164,7 @@ func (s *Stats) handleVoiceStateUpdate(session *discordgo.Session, update *disco
 
 func (s *Stats) Init(session *discordgo.Session) {
 	VoiceSessionStarts = make([]VoiceSessionStart, 0)
-	session.AddHandler(s.handleVoiceStateUpdate)
+	cache.AddHandler(s.handleVoiceStateUpdate)
 }
 
 func (s *Stats) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
@@ -290,14 +291,38 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 			)
 		}
 
-		pendingTasks, err := cache.GetMachineryServer().GetBroker().GetPendingTasks("robyul_tasks")
-		helpers.Relax(err)
+		var pendingTasks int
+		for _, queue := range helpers.MachineryQueues {
+			queuePendingTasks, err := cache.GetMachineryServer().GetBroker().GetPendingTasks(queue)
+			helpers.Relax(err)
+			pendingTasks += len(queuePendingTasks)
+		}
 
 		machineryText := fmt.Sprintf("Workers %d\nPending/Delay %d/%d",
 			len(cache.GetMachineryActiveWorkers()),
-			len(pendingTasks), int(metrics.MachineryDelayedTasksCount.Value()),
+			pendingTasks, int(metrics.MachineryDelayedTasksCount.Value()),
 		)
 
+		shardStatuses := cache.GetShardStatuses()
+		var shardsReady int
+		var shardsText string
+		for _, shard := range shardStatuses {
+			if shard.Ready {
+				shardsReady++
+			}
+			// list all shards of small bots, only the disconnected shards of big bots
+			if len(shardStatuses) > statsMaxListedShards && shard.Ready {
+				continue
+			}
+			shardStatusText := "ready"
+			if !shard.Ready {
+				shardStatusText = "**disconnected**"
+			}
+			shardsText += fmt.Sprintf("\n#%d %s, %s servers, %s",
+				shard.ID, shardStatusText, humanize.Comma(int64(shard.Guilds)), shard.Latency.Truncate(time.Millisecond))
+		}
+		shardsText = fmt.Sprintf("%d/%d ready", shardsReady, len(shardStatuses)) + shardsText
+
 		statsEmbed := &discordgo.MessageEmbed{
 			Color: 0x0FADED,
 			Fields: []*discordgo.MessageEmbedField{
@@ -324,6 +349,8 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 				{Name: "Watching channels", Value: strconv.Itoa(channels), Inline: true},
 				{Name: "Users", Value: strconv.Itoa(len(users)), Inline: true},
 
+				{Name: "Shards", Value: shardsText, Inline: true},
+
 				// Machinery
 				{Name: "Machinery", Value: machineryText, Inline: true},
 
@@ -508,7 +535,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 			targetUser, err = helpers.GetUserFromMention(args[0])
 			if err != nil {
 				if errD, ok := err.(*discordgo.RESTError); (ok && errD.Message.Code == 10013) || strings.Contains(err.Error(), "User not found.") {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.user-not-found"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.user-not-found"))
 					helpers.Relax(err)
 					return
 				}
@@ -523,7 +550,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		targetMember, err := helpers.GetGuildMember(currentGuild.ID, targetUser.ID)
 		if err != nil {
 			if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == 10007 {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.user-not-found"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.user-not-found"))
 				helpers.Relax(err)
 				return
 			} else {
@@ -856,7 +883,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 			channel, err = helpers.GetChannel(channel.ID)
 			helpers.Relax(err)
 			if channel.GuildID != sourceChannel.GuildID && !helpers.IsRobyulMod(msg.Author.ID) {
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				return
 			}
@@ -1015,7 +1042,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 				}
 
 				if len(channelUsersDurations) <= 0 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.voicestats-toplist-no-entries"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.voicestats-toplist-no-entries"))
 					helpers.Relax(err)
 					return
 				}
@@ -1028,17 +1055,17 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 				}
 
 				if totalDurationSeconds <= 0 {
-					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.voicestats-toplist-no-entries"))
+					_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.voicestats-toplist-no-entries"))
 					helpers.Relax(err)
 					return
 				}
 
 				totalVoiceStatsEmbed := &discordgo.MessageEmbed{
 					Color: 0x0FADED,
-					Title: helpers.GetText("plugins.stats.voicestats-toplist-embed-title"),
+					Title: helpers.GetMessageText(msg, "plugins.stats.voicestats-toplist-embed-title"),
 					Description: fmt.Sprintf("Total time connected by all users: **%s**",
 						helpers.HumanizedTimesSinceText(time.Now().UTC().Add(-1*(time.Second*time.Duration(totalDurationSeconds))))),
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.stats.voicestats-embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.stats.voicestats-embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{},
 				}
 
@@ -1085,7 +1112,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 			}
 			targetUser, err = helpers.GetUserFromMention(args[0])
 			if err != nil || targetUser.ID == "" {
-				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				helpers.Relax(err)
 				return
 			}
@@ -1147,7 +1174,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 			Title:       title,
 			Description: currentConnectionText,
 			Footer: &discordgo.MessageEmbedFooter{
-				Text: voiceStatSummaryText + helpers.GetText("plugins.stats.voicestats-embed-footer"),
+				Text: voiceStatSummaryText + helpers.GetMessageText(msg, "plugins.stats.voicestats-embed-footer"),
 			},
 			Fields: []*discordgo.MessageEmbedField{},
 		}
@@ -1196,7 +1223,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		}
 
 		if len(guild.Emojis) <= 0 {
-			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.no-emotes"))
+			_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.no-emotes"))
 			helpers.Relax(err)
 			return
 		}
@@ -1217,7 +1244,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		}
 
 		reactionEmbed := &discordgo.MessageEmbed{
-			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetTextF("plugins.stats.reaction-embed-footer", len(guild.Emojis)) + footerAdditionalText},
+			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageTextF(msg, "plugins.stats.reaction-embed-footer", len(guild.Emojis)) + footerAdditionalText},
 		}
 
 		s.setEmbedEmojiPage(reactionEmbed, msg.Author, guild, 1, numberOfPages)
@@ -1225,7 +1252,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 
 		if len(reactionEmbedMessages) <= 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 			return
 		}
 		reactionEmbedMessage := reactionEmbedMessages[0]
@@ -1370,7 +1397,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		}
 
 		memberlistEmbed := &discordgo.MessageEmbed{
-			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetTextF("plugins.stats.memberlist-embed-footer", humanize.Comma(int64(len(allMembers)))) + footerAdditionalText},
+			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageTextF(msg, "plugins.stats.memberlist-embed-footer", humanize.Comma(int64(len(allMembers)))) + footerAdditionalText},
 		}
 
 		s.setEmbedMemberlistPage(memberlistEmbed, msg.Author, guild, allMembers, currentPage, numberOfPages, kind, kindTitle)
@@ -1378,7 +1405,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 
 		if len(memberlistEmbedMessages) <= 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 			return
 		}
 		memberlistEmbedMessage := memberlistEmbedMessages[0]
@@ -1439,7 +1466,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		args := strings.Fields(content)
 
 		if len(args) < 1 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 
@@ -1452,7 +1479,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		invite, err := cache.GetSession().InviteWithCounts(inviteCodes[0])
 		if err != nil {
 			if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeUnknownInvite {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.unknown-invite"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.unknown-invite"))
 				return
 			}
 		}
@@ -1613,7 +1640,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		allRoles := guild.Roles
 
 		if len(allRoles) <= 0 {
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.rolelist-none"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.rolelist-none"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
@@ -1640,7 +1667,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		}
 
 		rolelistEmbed := &discordgo.MessageEmbed{
-			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetTextF("plugins.stats.rolelist-embed-footer", humanize.Comma(int64(len(allRoles)))) + footerAdditionalText},
+			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageTextF(msg, "plugins.stats.rolelist-embed-footer", humanize.Comma(int64(len(allRoles)))) + footerAdditionalText},
 		}
 
 		s.setEmbedRolelistPage(rolelistEmbed, msg.Author, guild, allRoles, currentPage, numberOfPages)
@@ -1648,7 +1675,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 
 		if len(rolelistEmbedMessages) <= 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 			return
 		}
 		rolelistEmbedMessage := rolelistEmbedMessages[0]
@@ -1726,7 +1753,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		allChannels := guild.Channels
 
 		if len(allChannels) <= 0 {
-			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.channellist-none"))
+			_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.channellist-none"))
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			return
 		}
@@ -1803,7 +1830,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		}
 
 		channellistEmbed := &discordgo.MessageEmbed{
-			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetTextF("plugins.stats.channellist-embed-footer", humanize.Comma(int64(len(allChannels)))) + footerAdditionalText},
+			Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageTextF(msg, "plugins.stats.channellist-embed-footer", humanize.Comma(int64(len(allChannels)))) + footerAdditionalText},
 		}
 
 		s.setEmbedChannellistPage(channellistEmbed, msg.Author, guild, allChannels, currentPage, numberOfPages)
@@ -1811,7 +1838,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 		helpers.RelaxEmbed(err, msg.ChannelID, msg.ID)
 
 		if len(channellistEmbedMessages) <= 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.errors.generic-nomessage"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.errors.generic-nomessage"))
 			return
 		}
 		channellistEmbedMessage := channellistEmbedMessages[0]
@@ -1879,7 +1906,7 @@ func (s *Stats) Action(command string, content string, msg *discordgo.Message, s
 			targetUser, err = helpers.GetUserFromMention(args[0])
 			if err != nil {
 				if errD, ok := err.(*discordgo.RESTError); (ok && errD.Message.Code == discordgo.ErrCodeUnknownUser) || strings.Contains(err.Error(), "User not found.") {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.stats.user-not-found"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.stats.user-not-found"))
 					return
 				}
 			}
@@ -1898,7 +1925,7 @@ func (r *Stats) setEmbedEmojiPage(reactionEmbed *discordgo.MessageEmbed, author
 	if maxPagesN > 1 {
 		pageText = fmt.Sprintf(" | Page %d of %d", pageN, maxPagesN)
 	}
-	reactionEmbed.Title = helpers.GetTextF("plugins.stats.reaction-embed-title", author.Username, guild.Name) + pageText
+	reactionEmbed.Title = helpers.GetTextLF(helpers.GetLocale(guild.ID, author.ID), "plugins.stats.reaction-embed-title", author.Username, guild.Name) + pageText
 	startEmoteN := (pageN - 1) * 12
 	i := startEmoteN
 	var value string
@@ -1931,10 +1958,10 @@ func (r *Stats) setEmbedMemberlistPage(memberlistEmbed *discordgo.MessageEmbed,
 	}
 	switch kind {
 	case "role":
-		memberlistEmbed.Title = helpers.GetTextF("plugins.stats.role-memberlist-embed-title", author.Username, guild.Name, kindTitle) + pageText
+		memberlistEmbed.Title = helpers.GetTextLF(helpers.GetLocale(guild.ID, author.ID), "plugins.stats.role-memberlist-embed-title", author.Username, guild.Name, kindTitle) + pageText
 		break
 	default:
-		memberlistEmbed.Title = helpers.GetTextF("plugins.stats.memberlist-embed-title", author.Username, guild.Name) + pageText
+		memberlistEmbed.Title = helpers.GetTextLF(helpers.GetLocale(guild.ID, author.ID), "plugins.stats.memberlist-embed-title", author.Username, guild.Name) + pageText
 		break
 	}
 	memberlistEmbed.Description = ""
@@ -1964,7 +1991,7 @@ func (r *Stats) setEmbedRolelistPage(memberlistEmbed *discordgo.MessageEmbed, au
 	if maxPagesN > 1 {
 		pageText = fmt.Sprintf(" | Page %s of %s", humanize.Comma(int64(pageN)), humanize.Comma(int64(maxPagesN)))
 	}
-	memberlistEmbed.Title = helpers.GetTextF("plugins.stats.rolelist-embed-title", author.Username, guild.Name) + pageText
+	memberlistEmbed.Title = helpers.GetTextLF(helpers.GetLocale(guild.ID, author.ID), "plugins.stats.rolelist-embed-title", author.Username, guild.Name) + pageText
 	memberlistEmbed.Description = ""
 	startMemberN := (pageN - 1) * 10
 	i := startMemberN
@@ -2005,7 +2032,7 @@ func (r *Stats) setEmbedChannellistPage(memberlistEmbed *discordgo.MessageEmbed,
 	if maxPagesN > 1 {
 		pageText = fmt.Sprintf(" | Page %s of %s", humanize.Comma(int64(pageN)), humanize.Comma(int64(maxPagesN)))
 	}
-	memberlistEmbed.Title = helpers.GetTextF("plugins.stats.channellist-embed-title", author.Username, guild.Name) + pageText
+	memberlistEmbed.Title = helpers.GetTextLF(helpers.GetLocale(guild.ID, author.ID), "plugins.stats.channellist-embed-title", author.Username, guild.Name) + pageText
 	memberlistEmbed.Description = ""
 	startMemberN := (pageN - 1) * 10
 	i := startMemberN
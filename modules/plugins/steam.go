@@ -61,7 +61,7 @@ func (m *Steam) actionStart(args []string, in *discordgo.Message, out **discordg
 // [p]steam <user id or username>
 func (m *Steam) actionInfo(args []string, in *discordgo.Message, out **discordgo.MessageSend) steamAction {
 	if len(args) < 1 {
-		*out = m.newMsg("bot.arguments.too-few")
+		*out = m.newMsg(in, "bot.arguments.too-few")
 		return m.actionFinish
 	}
 
@@ -71,7 +71,7 @@ func (m *Steam) actionInfo(args []string, in *discordgo.Message, out **discordgo
 	steamUser, err := m.getSteamProfileSummary(steamID)
 	if err != nil {
 		if strings.Contains(err.Error(), "steam profile not found") {
-			*out = m.newMsg("plugins.steam.user-not-found")
+			*out = m.newMsg(in, "plugins.steam.user-not-found")
 			return m.actionFinish
 		}
 	}
@@ -90,8 +90,8 @@ func (m *Steam) actionInfo(args []string, in *discordgo.Message, out **discordgo
 		Title: steamUser.Personaname + " on Steam",
 		Color: helpers.GetDiscordColorFromHex(steamColour),
 		Footer: &discordgo.MessageEmbedFooter{
-			Text:    "Steam #" + steamUser.Steamid + " | " + helpers.GetText("plugins.steam.embed-footer"),
-			IconURL: helpers.GetText("plugins.steam.embed-footer-imageurl"),
+			Text:    "Steam #" + steamUser.Steamid + " | " + helpers.GetMessageText(in, "plugins.steam.embed-footer"),
+			IconURL: helpers.GetMessageText(in, "plugins.steam.embed-footer-imageurl"),
 		},
 		Thumbnail: &discordgo.MessageEmbedThumbnail{
 			URL: steamUser.Avatarfull,
@@ -232,8 +232,8 @@ func (m *Steam) actionFinish(args []string, in *discordgo.Message, out **discord
 	return nil
 }
 
-func (m *Steam) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (m *Steam) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (m *Steam) Relax(err error) {
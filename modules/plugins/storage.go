@@ -5,7 +5,12 @@ import (
 
 	"fmt"
 
+	"strconv"
+
+	"time"
+
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/commands"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
@@ -14,53 +19,190 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-type storageAction func(args []string, in *discordgo.Message, out **discordgo.MessageSend) (next storageAction)
-
 type Storage struct{}
 
-func (m *Storage) Commands() []string {
-	return []string{
-		"storage",
+func (m *Storage) Commands() []*commands.Command {
+	return []*commands.Command{
+		{
+			Name:        "storage",
+			Description: "Shows how many files you uploaded to Robyul.",
+			Arguments: []commands.Argument{
+				// a word instead of a user, so the argument is ignored instead of rejected for everyone but Robyul Moderators
+				{Name: "user", Type: commands.ArgumentString, Optional: true},
+			},
+			Module:  helpers.ModulePermStats,
+			Handler: m.actionStatus,
+			Subcommands: []*commands.Command{
+				{
+					Name:        "server",
+					Aliases:     []string{"guild"},
+					Description: "Shows the storage usage and quota of this server.",
+					Permission:  commands.PermissionAdmin,
+					Handler:     m.actionGuild,
+				},
+				{
+					Name:        "quota",
+					Description: "Sets the storage quota of a server, in bytes or default.",
+					Arguments: []commands.Argument{
+						{Name: "guild id", Type: commands.ArgumentString},
+						{Name: "bytes", Type: commands.ArgumentString},
+					},
+					Permission: commands.PermissionBotAdmin,
+					Handler:    m.actionQuota,
+				},
+				{
+					Name:        "gc",
+					Description: "Deletes expired and orphaned files, only a dry run without run.",
+					Arguments: []commands.Argument{
+						{Name: "run", Type: commands.ArgumentString, Optional: true},
+					},
+					Permission: commands.PermissionBotAdmin,
+					Handler:    m.actionGarbageCollect,
+				},
+			},
+		},
 	}
 }
 
 func (m *Storage) Init(session *discordgo.Session) {
+	go func() {
+		defer helpers.Recover()
+		m.garbageCollectionLoop()
+	}()
 }
 
-func (m *Storage) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
-	if !helpers.ModuleIsAllowed(msg.ChannelID, msg.ID, msg.Author.ID, helpers.ModulePermStats) {
-		return
+func (m *Storage) garbageCollectionLoop() {
+	defer helpers.Recover()
+	defer func() {
+		go func() {
+			defer helpers.Recover()
+			m.logger().Error("The garbageCollectionLoop died. Please investigate! Will be restarted in 60 seconds")
+			time.Sleep(60 * time.Second)
+			m.garbageCollectionLoop()
+		}()
+	}()
+
+	for {
+		time.Sleep(24 * time.Hour)
+
+		_, err := helpers.StorageGarbageCollect(false)
+		helpers.RelaxLog(err)
 	}
+}
+
+// [p]storage server
+func (m *Storage) actionGuild(ctx *commands.Context) {
+	cache.GetSession().ChannelTyping(ctx.Msg.ChannelID)
+
+	guild, err := helpers.GetGuild(ctx.GuildID)
+	helpers.Relax(err)
+
+	usage, err := helpers.GetGuildStorageUsage(guild.ID)
+	helpers.Relax(err)
 
-	var result *discordgo.MessageSend
-	args := strings.Fields(content)
+	quota := helpers.GetGuildStorageQuota(guild.ID)
 
-	action := m.actionStart
-	for action != nil {
-		action = action(args, msg, &result)
+	// create by source text
+	var bySourceText string
+	for sourceName, sourceStorage := range usage.BySource {
+		retentionText := ""
+		if retention := helpers.GetStorageRetention(sourceName); retention > 0 {
+			retentionText = fmt.Sprintf(", kept for %s", helpers.HumanizeDuration(retention))
+		}
+		bySourceText += fmt.Sprintf("**%s:** %s%s\n", strings.Title(sourceName), humanize.Bytes(sourceStorage), retentionText)
+	}
+	if bySourceText == "" {
+		bySourceText = "None"
 	}
+
+	percentage := float64(usage.Storage) / (float64(quota) / float64(100))
+
+	embed := &discordgo.MessageEmbed{
+		Color: 0xFADED,
+		Author: &discordgo.MessageEmbedAuthor{
+			Name:    fmt.Sprintf("Storage Usage for %s", guild.Name),
+			IconURL: discordgo.EndpointGuildIcon(guild.ID, guild.Icon),
+		},
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name: "Usage",
+				Value: "**Storage:** " + humanize.Bytes(usage.Storage) +
+					fmt.Sprintf(" of %s (%.1f %%)", humanize.Bytes(quota), percentage) +
+					fmt.Sprintf("\n**Files:** %d", usage.Files) +
+					"\n**Traffic:** " + humanize.Bytes(usage.Traffic),
+			},
+			{
+				Name:  "By Source",
+				Value: bySourceText,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text:    "Robyul-generated files do not count towards the quota",
+			IconURL: cache.GetSession().State.User.AvatarURL("64"),
+		},
+	}
+
+	ctx.SendComplex(&discordgo.MessageSend{Embed: embed})
 }
 
-func (m *Storage) actionStart(args []string, in *discordgo.Message, out **discordgo.MessageSend) storageAction {
-	cache.GetSession().ChannelTyping(in.ChannelID)
+// [p]storage quota <guild id> <bytes|default>
+func (m *Storage) actionQuota(ctx *commands.Context) {
+	guild, err := helpers.GetGuild(ctx.String("guild id"))
+	if err != nil {
+		ctx.SendText("bot.arguments.invalid")
+		return
+	}
+
+	var newQuota int64
+	if ctx.String("bytes") != "default" {
+		newQuota, err = strconv.ParseInt(ctx.String("bytes"), 10, 64)
+		if err != nil || newQuota <= 0 {
+			ctx.SendText("bot.arguments.invalid")
+			return
+		}
+	}
 
-	return m.actionStatus
+	settings := helpers.GuildSettingsGetCached(guild.ID)
+	settings.StorageQuota = newQuota
+	err = helpers.GuildSettingsSet(guild.ID, settings)
+	helpers.Relax(err)
+
+	ctx.SendText("plugins.storage.quota-set-success", guild.Name, humanize.Bytes(helpers.GetGuildStorageQuota(guild.ID)))
 }
 
-// [p]storage
-func (m *Storage) actionStatus(args []string, in *discordgo.Message, out **discordgo.MessageSend) storageAction {
-	channel, err := helpers.GetChannel(in.ChannelID)
+// [p]storage gc [run]
+func (m *Storage) actionGarbageCollect(ctx *commands.Context) {
+	dryRun := ctx.String("run") != "run"
+
+	result, err := helpers.StorageGarbageCollect(dryRun)
 	helpers.Relax(err)
 
-	guild, err := helpers.GetGuild(channel.GuildID)
+	if dryRun {
+		ctx.SendText("plugins.storage.gc-result-dry-run",
+			result.Checked, result.DeletedOrphans, result.DeletedExpired, humanize.Bytes(result.FreedStorage), ctx.Prefix)
+		return
+	}
+
+	ctx.SendText("plugins.storage.gc-result",
+		result.Checked, result.DeletedOrphans, result.DeletedExpired, humanize.Bytes(result.FreedStorage))
+}
+
+// [p]storage [user]
+func (m *Storage) actionStatus(ctx *commands.Context) {
+	cache.GetSession().ChannelTyping(ctx.Msg.ChannelID)
+
+	guild, err := helpers.GetGuild(ctx.GuildID)
 	helpers.Relax(err)
 
-	targetUser, err := helpers.GetUser(in.Author.ID)
+	targetUser, err := helpers.GetUser(ctx.Msg.Author.ID)
 	helpers.Relax(err)
 
-	if len(args) >= 1 && helpers.IsRobyulMod(in.Author.ID) {
-		targetUser, err = helpers.GetUserFromMention(args[0])
-		helpers.Relax(err)
+	if ctx.Has("user") && helpers.IsRobyulMod(ctx.Msg.Author.ID) {
+		targetUser, err = helpers.GetUserFromMention(ctx.String("user"))
+		if err != nil || targetUser == nil || targetUser.ID == "" {
+			ctx.SendText("bot.arguments.invalid")
+			return
+		}
 	}
 
 	// request all user files
@@ -75,8 +217,8 @@ func (m *Storage) actionStatus(args []string, in *discordgo.Message, out **disco
 
 	// don't send stats if no files found
 	if entryBucket == nil || len(entryBucket) <= 0 {
-		*out = m.newMsg("plugins.storage.no-stats-for-user")
-		return m.actionFinish
+		ctx.SendText("plugins.storage.no-stats-for-user")
+		return
 	}
 
 	// calculate stats
@@ -161,19 +303,7 @@ func (m *Storage) actionStatus(args []string, in *discordgo.Message, out **disco
 		},
 	}
 
-	*out = &discordgo.MessageSend{Embed: embed}
-	return m.actionFinish
-}
-
-func (m *Storage) actionFinish(args []string, in *discordgo.Message, out **discordgo.MessageSend) storageAction {
-	_, err := helpers.SendComplex(in.ChannelID, *out)
-	helpers.Relax(err)
-
-	return nil
-}
-
-func (m *Storage) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+	ctx.SendComplex(&discordgo.MessageSend{Embed: embed})
 }
 
 func (m *Storage) Relax(err error) {
@@ -53,7 +53,7 @@ func (s *Streamable) Action(command string, content string, msg *discordgo.Messa
 	session.ChannelTyping(msg.ChannelID)
 
 	if len(content) <= 0 && len(msg.Attachments) <= 0 {
-		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+		_, err := helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 		helpers.Relax(err)
 		return
 	}
@@ -58,19 +58,19 @@ func (t *Translator) Action(command string, content string, msg *discordgo.Messa
 	parts := strings.Fields(content)
 
 	if len(parts) < 3 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.translator.check_format"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.translator.check_format"))
 		return
 	}
 
 	source, err := language.Parse(parts[0])
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.translator.unknown_lang_specific", parts[0]))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.translator.unknown_lang_specific", parts[0]))
 		return
 	}
 
 	target, err := language.Parse(parts[1])
 	if err != nil {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.translator.unknown_lang_specific", parts[1]))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.translator.unknown_lang_specific", parts[1]))
 		return
 	}
 
@@ -161,17 +161,17 @@ func (t *Translator) Action(command string, content string, msg *discordgo.Messa
 	}
 
 	translateEmbed := &discordgo.MessageEmbed{
-		Title:       helpers.GetTextF("plugins.translator.translation-embed-title", strings.ToUpper(source.String()), strings.ToUpper(target.String())),
-		Footer:      &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.translator.embed-footer")},
+		Title:       helpers.GetMessageTextF(msg, "plugins.translator.translation-embed-title", strings.ToUpper(source.String()), strings.ToUpper(target.String())),
+		Footer:      &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.translator.embed-footer")},
 		Description: m,
 		Color:       helpers.GetDiscordColorFromHex(googleTranslateHexColor),
 		Fields:      []*discordgo.MessageEmbedField{},
 	}
 	if translatedNaverResult != "" {
 		translateEmbed.Fields = append(translateEmbed.Fields, &discordgo.MessageEmbedField{
-			Name:  helpers.GetText("plugins.translator.embed-title-alternative-naver"),
+			Name:  helpers.GetMessageText(msg, "plugins.translator.embed-title-alternative-naver"),
 			Value: translatedNaverResult})
-		translateEmbed.Footer = &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.translator.embed-footer-plus-naver")}
+		translateEmbed.Footer = &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.translator.embed-footer-plus-naver")}
 	}
 
 	_, err = helpers.SendEmbed(msg.ChannelID, translateEmbed)
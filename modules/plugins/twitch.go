@@ -3,6 +3,7 @@ package plugins
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,22 +14,41 @@ import (
 	"net/url"
 
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/feeds"
 	"github.com/Seklfreak/Robyul2/helpers"
-	"github.com/Seklfreak/Robyul2/metrics"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
 	humanize "github.com/dustin/go-humanize"
-	raven "github.com/getsentry/raven-go"
-	"github.com/globalsign/mgo/bson"
 )
 
 type Twitch struct{}
 
 const (
-	twitchStatsEndpoint = "https://api.twitch.tv/kraken/streams/%s"
-	twitchHexColor      = "#6441a5"
+	twitchStatsEndpoint  = "https://api.twitch.tv/kraken/streams/%s"
+	twitchVideosEndpoint = "https://api.twitch.tv/kraken/channels/%s/videos?broadcasts=true&limit=10"
+	twitchChannelURL     = "https://www.twitch.tv/%s"
+	twitchHexColor       = "#6441a5"
+	// live messages are edited at least this often for the viewer count, title and game changes are edited right away
+	twitchLiveUpdateInterval = 5 * time.Minute
+
+	// feed options, the summary is edited into the live message unless the summary option is new
+	twitchOptionSummary    = "summary"
+	twitchOptionSummaryNew = "new"
+	twitchOptionVOD        = "vod"
 )
 
+var (
+	ErrTwitchChannelNotFound = errors.New("twitch channel not found")
+)
+
+type TwitchVideos struct {
+	Videos []struct {
+		ID          string `json:"_id"`
+		BroadcastID int64  `json:"broadcast_id"`
+		URL         string `json:"url"`
+	} `json:"videos"`
+}
+
 type TwitchStatus struct {
 	Stream struct {
 		ID          int64     `json:"_id"`
@@ -97,84 +117,82 @@ func (m *Twitch) Commands() []string {
 }
 
 func (m *Twitch) Init(session *discordgo.Session) {
-	go m.checkTwitchFeedsLoop()
-	cache.GetLogger().WithField("module", "twitch").Info("Started twitch loop (60s)")
+	err := feeds.Register(&twitchSource{m: m})
+	if err != nil {
+		cache.GetLogger().WithField("module", "twitch").WithError(err).Error("failed to register the twitch feed source")
+	}
 }
-func (m *Twitch) checkTwitchFeedsLoop() {
-	defer helpers.Recover()
-	defer func() {
-		go func() {
-			cache.GetLogger().WithField("module", "twitch").Info("The checkTwitchFeedsLoop died. Please investigate! Will be restarted in 60 seconds")
-			time.Sleep(60 * time.Second)
-			m.checkTwitchFeedsLoop()
-		}()
-	}()
-
-	var entries []models.TwitchEntry
-	var bundledEntries map[string][]models.TwitchEntry
-
-	for {
-		err := helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.TwitchTable).Find(nil)).All(&entries)
-		helpers.Relax(err)
-
-		bundledEntries = make(map[string][]models.TwitchEntry, 0)
-
-		for _, entry := range entries {
-			channel, err := helpers.GetChannelWithoutApi(entry.ChannelID)
-			if err != nil || channel == nil || channel.ID == "" {
-				//cache.GetLogger().WithField("module", "twitch").Warn(fmt.Sprintf("skipped twitch @%s for Channel #%s on Guild #%s: channel not found!",
-				//	entry.TwitchChannelName, entry.ChannelID, entry.ServerID))
-				continue
-			}
 
-			if _, ok := bundledEntries[entry.TwitchChannelName]; ok {
-				bundledEntries[entry.TwitchChannelName] = append(bundledEntries[entry.TwitchChannelName], entry)
-			} else {
-				bundledEntries[entry.TwitchChannelName] = []models.TwitchEntry{entry}
-			}
-		}
+// twitchSource is the feeds.Source for Twitch streams, the live message is posted when a stream starts,
+// edited while it runs and turned into a summary when it ends
+type twitchSource struct {
+	m *Twitch
+}
 
-		cache.GetLogger().WithField("module", "twitch").Infof("checking %d channels for %d feeds", len(bundledEntries), len(entries))
-		start := time.Now()
-
-		// TODO: Check multiple entries at once
-		for twitchChannelName, entries := range bundledEntries {
-			//cache.GetLogger().WithField("module", "twitch").Info(fmt.Sprintf("checking Twitch Channel %s", twitchChannelName))
-			twitchStatus := m.getTwitchStatus(twitchChannelName)
-
-			for _, entry := range entries {
-				changes := false
-				if twitchStatus.Links.Channel != "" {
-					if entry.IsLive == false {
-						if twitchStatus.Stream.ID != 0 {
-							go func(gEntry models.TwitchEntry, gTwitchStatus TwitchStatus) {
-								defer helpers.Recover()
-								m.postTwitchLiveToChannel(gEntry, gTwitchStatus)
-							}(entry, twitchStatus)
-							entry.IsLive = true
-							changes = true
-						}
-					} else {
-						if twitchStatus.Stream.ID == 0 {
-							entry.IsLive = false
-							changes = true
-						}
-					}
-				}
+func (s *twitchSource) Name() string {
+	return "twitch"
+}
 
-				if changes == true {
-					err = helpers.MDbUpdateWithoutLogging(models.TwitchTable, entry.ID, entry)
-					helpers.Relax(err)
-				}
-			}
-		}
+func (s *twitchSource) Interval(target string) time.Duration {
+	return time.Minute
+}
+
+func (s *twitchSource) FilterOptions() feeds.FilterOptions {
+	return feeds.FilterOptions{Category: true}
+}
+
+// Resolve checks that the Twitch channel exists
+func (s *twitchSource) Resolve(target string) (normalised, name string, err error) {
+	normalised = strings.ToLower(strings.TrimSpace(target))
+	if normalised == "" {
+		return "", "", ErrTwitchChannelNotFound
+	}
+
+	twitchStatus, err := s.m.getTwitchStatus(normalised)
+	if err != nil {
+		return "", "", err
+	}
+	if twitchStatus.Links.Channel == "" {
+		return "", "", ErrTwitchChannelNotFound
+	}
+	return normalised, target, nil
+}
 
-		elapsed := time.Since(start)
-		cache.GetLogger().WithField("module", "twitch").Infof("checked %d channels for %d feeds, took %s", len(bundledEntries), len(entries), elapsed)
-		metrics.TwitchRefreshTime.Set(elapsed.Seconds())
+// Fetch returns the running stream as a live post
+func (s *twitchSource) Fetch(target string, state map[string]string) (posts []feeds.Post, newState map[string]string, err error) {
+	twitchStatus, err := s.m.getTwitchStatus(target)
+	if err != nil {
+		return nil, state, err
+	}
+	if twitchStatus.Stream.ID != 0 {
+		posts = append(posts, s.m.twitchPost(twitchStatus))
+	}
+	return posts, state, nil
+}
+
+func (s *twitchSource) Render(entry models.FeedEntry, post feeds.Post) *discordgo.MessageEmbed {
+	twitchStatus, ok := post.Raw.(TwitchStatus)
+	if !ok {
+		return feeds.Embed(post)
+	}
+	return s.m.twitchLiveEmbed(helpers.GetLocale(entry.GuildID, ""), twitchStatus)
+}
+
+// CheckTarget posts, edits and finishes the live messages of all feeds of the channel
+func (s *twitchSource) CheckTarget(target string, entries []models.FeedEntry) (updated []models.FeedEntry, err error) {
+	twitchStatus, err := s.m.getTwitchStatus(target)
+	if err != nil {
+		return nil, err
+	}
+	if twitchStatus.Links.Channel == "" {
+		return nil, errors.New("twitch status request failed")
+	}
 
-		time.Sleep(30 * time.Second)
+	for _, entry := range entries {
+		s.m.updateTwitchEntry(&entry, twitchStatus)
+		updated = append(updated, entry)
 	}
+	return updated, nil
 }
 
 func (m *Twitch) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
@@ -196,12 +214,12 @@ func (m *Twitch) Action(command string, content string, msg *discordgo.Message,
 				if len(args) >= 3 {
 					targetChannel, err = helpers.GetChannelFromMention(msg, args[2])
 					if err != nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 					targetTwitchChannelName = args[1]
 				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 					return
 				}
 				targetGuild, err = helpers.GetGuild(targetChannel.GuildID)
@@ -229,27 +247,31 @@ func (m *Twitch) Action(command string, content string, msg *discordgo.Message,
 						}
 					}
 				}
-				// create new entry in db
-				newID, err := helpers.MDbInsert(
-					models.TwitchTable,
-					models.TwitchEntry{
-						GuildID:           targetChannel.GuildID,
-						ChannelID:         targetChannel.ID,
-						TwitchChannelName: targetTwitchChannelName,
-						IsLive:            false,
-						MentionRoleID:     mentionRole.ID,
-					},
-				)
+				entry, err := feeds.AddEntry("twitch", targetTwitchChannelName, models.FeedEntry{
+					GuildID:       targetChannel.GuildID,
+					ChannelID:     targetChannel.ID,
+					AddedByUserID: msg.Author.ID,
+					MentionRoleID: mentionRole.ID,
+					PostMode:      models.FeedPostModeEmbed,
+				})
+				if err == ErrTwitchChannelNotFound {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.twitch.no-channel-information"))
+					return
+				}
+				if err == feeds.ErrAlreadyAdded {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.feeds.add-already-added", targetChannel.ID))
+					return
+				}
 				helpers.Relax(err)
 
-				_, err = helpers.EventlogLog(time.Now(), targetChannel.GuildID, helpers.MdbIdToHuman(newID),
+				_, err = helpers.EventlogLog(time.Now(), targetChannel.GuildID, helpers.MdbIdToHuman(entry.ID),
 					models.EventlogTargetTypeRobyulTwitchFeed, msg.Author.ID,
 					models.EventlogTypeRobyulTwitchFeedAdd, "",
 					nil,
 					[]models.ElasticEventlogOption{
 						{
 							Key:   "twitch_feed_channelname",
-							Value: targetTwitchChannelName,
+							Value: entry.Target,
 						},
 						{
 							Key:   "twitch_feed_mentionroleid",
@@ -259,7 +281,7 @@ func (m *Twitch) Action(command string, content string, msg *discordgo.Message,
 					}, false)
 				helpers.RelaxLog(err)
 
-				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.twitch.channel-added-success", targetTwitchChannelName, targetChannel.ID))
+				_, err = helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.twitch.channel-added-success", targetTwitchChannelName, targetChannel.ID))
 				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 				cache.GetLogger().WithField("module", "twitch").Info(fmt.Sprintf("Added Twitch Channel %s to Channel %s (#%s) on Guild %s (#%s)", targetTwitchChannelName, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID))
 			})
@@ -268,23 +290,12 @@ func (m *Twitch) Action(command string, content string, msg *discordgo.Message,
 				if len(args) >= 2 {
 					session.ChannelTyping(msg.ChannelID)
 
-					channel, err := helpers.GetChannel(msg.ChannelID)
-					helpers.Relax(err)
-
-					entryId := args[1]
-
-					var entryBucket models.TwitchEntry
-					err = helpers.MdbOne(
-						helpers.MdbCollection(models.TwitchTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(entryId)}),
-						&entryBucket,
-					)
-					if helpers.IsMdbNotFound(err) {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.twitch.channel-delete-not-found-error"))
+					entryBucket, ok := m.findTwitchEntry(msg, args)
+					if !ok {
 						return
 					}
-					helpers.Relax(err)
 
-					err = helpers.MDbDelete(models.TwitchTable, entryBucket.ID)
+					err := feeds.Remove(entryBucket)
 					helpers.Relax(err)
 
 					_, err = helpers.EventlogLog(time.Now(), entryBucket.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
@@ -294,7 +305,7 @@ func (m *Twitch) Action(command string, content string, msg *discordgo.Message,
 						[]models.ElasticEventlogOption{
 							{
 								Key:   "twitch_feed_channelname",
-								Value: entryBucket.TwitchChannelName,
+								Value: entryBucket.Target,
 							},
 							{
 								Key:   "twitch_feed_mentionroleid",
@@ -304,23 +315,138 @@ func (m *Twitch) Action(command string, content string, msg *discordgo.Message,
 						}, false)
 					helpers.RelaxLog(err)
 
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.twitch.channel-delete-success", entryBucket.TwitchChannelName))
-					cache.GetLogger().WithField("module", "twitch").Info(fmt.Sprintf("Deleted Twitch Channel %s", entryBucket.TwitchChannelName))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.twitch.channel-delete-success", entryBucket.Target))
+					cache.GetLogger().WithField("module", "twitch").Info(fmt.Sprintf("Deleted Twitch Channel %s", entryBucket.Target))
 
 				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
 					return
 				}
 			})
+		case "template": // [p]twitch template <id> [<template>|reset]
+			helpers.RequireMod(msg, func() {
+				entryBucket, ok := m.findTwitchEntry(msg, args)
+				if !ok {
+					return
+				}
+
+				oldTemplate := entryBucket.Template
+				reply, changed := feeds.TemplateCommand(helpers.GetMessageLocale(msg), &entryBucket.Template,
+					entryBucket.TargetName, strings.Join(args[2:], " "))
+				if changed {
+					err := feeds.Update(entryBucket)
+					helpers.Relax(err)
+
+					_, err = helpers.EventlogLog(time.Now(), entryBucket.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
+						models.EventlogTargetTypeRobyulTwitchFeed, msg.Author.ID,
+						models.EventlogTypeRobyulTwitchFeedUpdate, "",
+						[]models.ElasticEventlogChange{
+							{
+								Key:      "twitch_feed_template",
+								OldValue: oldTemplate,
+								NewValue: entryBucket.Template,
+							},
+						},
+						[]models.ElasticEventlogOption{
+							{
+								Key:   "twitch_feed_channelname",
+								Value: entryBucket.Target,
+							},
+						}, false)
+					helpers.RelaxLog(err)
+				}
+
+				helpers.SendMessage(msg.ChannelID, reply)
+			})
+		case "filter": // [p]twitch filter <id> [<setting> <value>]
+			helpers.RequireMod(msg, func() {
+				entryBucket, ok := m.findTwitchEntry(msg, args)
+				if !ok {
+					return
+				}
+
+				beforeValue := feeds.DescribeFilter(entryBucket.Filter)
+				reply, changed := feeds.FilterCommand(helpers.GetMessageLocale(msg), &entryBucket.Filter,
+					feeds.SourceFilterOptions("twitch"), entryBucket.TargetName, args[2:])
+				if changed {
+					m.updateTwitchEntryBySettings(msg, entryBucket, "twitch_feed_filter", beforeValue, feeds.DescribeFilter(entryBucket.Filter))
+				}
+
+				_, err := helpers.SendMessage(msg.ChannelID, reply)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			})
+		case "mode": // [p]twitch mode <id> [edit|new]
+			helpers.RequireMod(msg, func() {
+				entryBucket, ok := m.findTwitchEntry(msg, args)
+				if !ok {
+					return
+				}
+
+				oldMode := entryBucket.Options[twitchOptionSummary]
+				if len(args) >= 3 {
+					switch strings.ToLower(args[2]) {
+					case "edit":
+						delete(entryBucket.Options, twitchOptionSummary)
+					case "new":
+						m.setTwitchOption(&entryBucket, twitchOptionSummary, twitchOptionSummaryNew)
+					default:
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+						return
+					}
+				}
+				if entryBucket.Options[twitchOptionSummary] != oldMode {
+					m.updateTwitchEntryBySettings(msg, entryBucket, "twitch_feed_postmode",
+						oldMode, entryBucket.Options[twitchOptionSummary])
+				}
+
+				reply := helpers.GetMessageTextF(msg, "plugins.twitch.mode-edit", entryBucket.TargetName)
+				if entryBucket.Options[twitchOptionSummary] == twitchOptionSummaryNew {
+					reply = helpers.GetMessageTextF(msg, "plugins.twitch.mode-new", entryBucket.TargetName)
+				}
+				_, err := helpers.SendMessage(msg.ChannelID, reply)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			})
+		case "vod": // [p]twitch vod <id> [on|off]
+			helpers.RequireMod(msg, func() {
+				entryBucket, ok := m.findTwitchEntry(msg, args)
+				if !ok {
+					return
+				}
+
+				oldPostVOD := entryBucket.Options[twitchOptionVOD] == helpers.StoreBoolAsString(true)
+				postVOD := oldPostVOD
+				if len(args) >= 3 {
+					switch strings.ToLower(args[2]) {
+					case "on", "enable", "yes":
+						postVOD = true
+					case "off", "disable", "no":
+						postVOD = false
+					default:
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
+						return
+					}
+				}
+				if postVOD != oldPostVOD {
+					m.setTwitchOption(&entryBucket, twitchOptionVOD, helpers.StoreBoolAsString(postVOD))
+					m.updateTwitchEntryBySettings(msg, entryBucket, "twitch_feed_postvod",
+						helpers.StoreBoolAsString(oldPostVOD), helpers.StoreBoolAsString(postVOD))
+				}
+
+				reply := helpers.GetMessageTextF(msg, "plugins.twitch.vod-disabled", entryBucket.TargetName)
+				if postVOD {
+					reply = helpers.GetMessageTextF(msg, "plugins.twitch.vod-enabled", entryBucket.TargetName)
+				}
+				_, err := helpers.SendMessage(msg.ChannelID, reply)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			})
 		case "list": // [p]twitch list
 			currentChannel, err := helpers.GetChannel(msg.ChannelID)
 			helpers.Relax(err)
-			var entryBucket []models.TwitchEntry
-			err = helpers.MDbIter(helpers.MdbCollection(models.TwitchTable).Find(bson.M{"guildid": currentChannel.GuildID})).All(&entryBucket)
+			entryBucket, err := feeds.GetAll(currentChannel.GuildID, "twitch")
 			helpers.Relax(err)
 
 			if entryBucket == nil || len(entryBucket) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.twitch.channel-list-no-channels-error"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.twitch.channel-list-no-channels-error"))
 				return
 			} else if err != nil {
 				helpers.Relax(err)
@@ -334,26 +460,39 @@ func (m *Twitch) Action(command string, content string, msg *discordgo.Message,
 					helpers.Relax(err)
 					mentionText += fmt.Sprintf(" mentioning `@%s`", role.Name)
 				}
-				resultMessage += fmt.Sprintf("`%s`: Twitch Channel `%s` posting to <#%s>%s\n", helpers.MdbIdToHuman(entry.ID), entry.TwitchChannelName, entry.ChannelID, mentionText)
+				if entry.Template != "" {
+					mentionText += " using a template"
+				}
+				if entry.Options[twitchOptionSummary] == twitchOptionSummaryNew {
+					mentionText += " posting summaries as new messages"
+				}
+				if entry.Options[twitchOptionVOD] == helpers.StoreBoolAsString(true) {
+					mentionText += " linking VODs"
+				}
+				if feeds.DescribeFilter(entry.Filter) != "" {
+					mentionText += " filtered"
+				}
+				resultMessage += fmt.Sprintf("`%s`: Twitch Channel `%s` posting to <#%s>%s\n", helpers.MdbIdToHuman(entry.ID), entry.TargetName, entry.ChannelID, mentionText)
 			}
 			resultMessage += fmt.Sprintf("Found **%d** Twitch Channels in total.", len(entryBucket))
 			_, err = helpers.SendMessage(msg.ChannelID, resultMessage)
 			helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 		default:
 			if args[0] == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 				return
 			}
 			session.ChannelTyping(msg.ChannelID)
-			twitchStatus := m.getTwitchStatus(args[0])
+			twitchStatus, err := m.getTwitchStatus(args[0])
+			helpers.Relax(err)
 			if twitchStatus.Stream.ID == 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.twitch.no-channel-information"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.twitch.no-channel-information"))
 				return
 			} else {
 				twitchChannelEmbed := &discordgo.MessageEmbed{
-					Title:  helpers.GetTextF("plugins.twitch.channel-embed-title", twitchStatus.Stream.Channel.DisplayName, twitchStatus.Stream.Channel.Name),
+					Title:  helpers.GetMessageTextF(msg, "plugins.twitch.channel-embed-title", twitchStatus.Stream.Channel.DisplayName, twitchStatus.Stream.Channel.Name),
 					URL:    twitchStatus.Stream.Channel.URL,
-					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.twitch.embed-footer")},
+					Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetMessageText(msg, "plugins.twitch.embed-footer")},
 					Fields: []*discordgo.MessageEmbedField{
 						{Name: "Viewers", Value: humanize.Comma(int64(twitchStatus.Stream.Viewers)), Inline: true},
 						{Name: "Followers", Value: humanize.Comma(int64(twitchStatus.Stream.Channel.Followers)), Inline: true},
@@ -386,16 +525,66 @@ func (m *Twitch) Action(command string, content string, msg *discordgo.Message,
 	}
 }
 
-func (m *Twitch) getTwitchStatus(name string) TwitchStatus {
-	var twitchStatus TwitchStatus
+// findTwitchEntry returns the feed of the ID in args[1] on the current server, replies with an error if it is not found
+func (m *Twitch) findTwitchEntry(msg *discordgo.Message, args []string) (entryBucket models.FeedEntry, ok bool) {
+	if len(args) < 2 {
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+		return entryBucket, false
+	}
+
+	channel, err := helpers.GetChannel(msg.ChannelID)
+	helpers.Relax(err)
+
+	entryBucket, err = feeds.Get(channel.GuildID, args[1])
+	if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "twitch") {
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.twitch.channel-delete-not-found-error"))
+		return entryBucket, false
+	}
+	helpers.Relax(err)
+
+	return entryBucket, true
+}
+
+// setTwitchOption sets an option of a feed
+func (m *Twitch) setTwitchOption(entry *models.FeedEntry, key, value string) {
+	if entry.Options == nil {
+		entry.Options = make(map[string]string, 0)
+	}
+	entry.Options[key] = value
+}
+
+// updateTwitchEntryBySettings saves a changed setting of a feed and logs it to the eventlog
+func (m *Twitch) updateTwitchEntryBySettings(msg *discordgo.Message, entryBucket models.FeedEntry, key, oldValue, newValue string) {
+	err := feeds.Update(entryBucket)
+	helpers.Relax(err)
+
+	_, err = helpers.EventlogLog(time.Now(), entryBucket.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
+		models.EventlogTargetTypeRobyulTwitchFeed, msg.Author.ID,
+		models.EventlogTypeRobyulTwitchFeedUpdate, "",
+		[]models.ElasticEventlogChange{
+			{
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
+			},
+		},
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "twitch_feed_channelname",
+				Value: entryBucket.Target,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+}
 
+func (m *Twitch) getTwitchStatus(name string) (twitchStatus TwitchStatus, err error) {
 	client := &http.Client{
 		Timeout: time.Duration(10 * time.Second),
 	}
 
-	request, err := http.NewRequest("GET", fmt.Sprintf(twitchStatsEndpoint, name), nil)
+	request, err := http.NewRequest("GET", fmt.Sprintf(twitchStatsEndpoint, url.PathEscape(name)), nil)
 	if err != nil {
-		panic(err)
+		return twitchStatus, err
 	}
 
 	request.Header.Set("User-Agent", helpers.DEFAULT_UA)
@@ -403,44 +592,223 @@ func (m *Twitch) getTwitchStatus(name string) TwitchStatus {
 
 	response, err := client.Do(request)
 	if err != nil {
-		if errU, ok := err.(*url.Error); ok {
-			cache.GetLogger().WithField("module", "twitch").Warnf("twitch status request failed: %#v", errU.Err)
-			return twitchStatus
-		} else {
-			raven.CaptureError(fmt.Errorf("%#v", err), map[string]string{})
-		}
-		panic(err)
-	}
-
-	if response != nil && response.Body != nil {
-		defer response.Body.Close()
+		return twitchStatus, err
 	}
+	defer response.Body.Close()
 
 	buf := bytes.NewBuffer(nil)
 	_, err = io.Copy(buf, response.Body)
 	if err != nil {
-		panic(err)
+		return twitchStatus, err
 	}
 
 	json.Unmarshal(buf.Bytes(), &twitchStatus)
-	return twitchStatus
+	return twitchStatus, nil
+}
+
+// updateTwitchEntry posts, edits and finishes the live message of a feed
+func (m *Twitch) updateTwitchEntry(entry *models.FeedEntry, twitchStatus TwitchStatus) {
+	streamID := strconv.FormatInt(twitchStatus.Stream.ID, 10)
+
+	if entry.Live.PostID != "" && (twitchStatus.Stream.ID == 0 || (entry.Live.PostID != "0" && entry.Live.PostID != streamID)) {
+		m.finishTwitchStream(entry)
+	}
+	if twitchStatus.Stream.ID == 0 {
+		return
+	}
+
+	if entry.Live.PostID == "" {
+		entry.Live.PostID = streamID
+		entry.Live.StartedAt = twitchStatus.Stream.CreatedAt
+		entry.Live.Filtered = true
+	} else if entry.Live.PostID == "0" {
+		// went live before streams were tracked, the live message has already been posted
+		entry.Live.PostID = streamID
+		entry.Live.StartedAt = twitchStatus.Stream.CreatedAt
+	}
+
+	if twitchStatus.Stream.Viewers > entry.Live.PeakScore {
+		entry.Live.PeakScore = twitchStatus.Stream.Viewers
+	}
+
+	if entry.Live.Filtered {
+		// the game or title can change while the stream runs
+		if !feeds.Matches(entry.Filter, m.twitchPost(twitchStatus)) {
+			return
+		}
+
+		messages, err := helpers.SendComplex(entry.ChannelID, m.twitchLiveMessage(*entry, twitchStatus))
+		if err != nil || len(messages) <= 0 {
+			helpers.RelaxLog(err)
+			return
+		}
+		entry.Live.Filtered = false
+		entry.Live.MessageID = messages[len(messages)-1].ID
+		entry.Live.Title = twitchStatus.Stream.Channel.Status
+		entry.Live.Category = twitchStatus.Stream.Game
+		entry.Live.UpdatedAt = time.Now()
+		return
+	}
+
+	if entry.Live.MessageID == "" {
+		return
+	}
+
+	if entry.Live.Title != twitchStatus.Stream.Channel.Status || entry.Live.Category != twitchStatus.Stream.Game ||
+		time.Since(entry.Live.UpdatedAt) >= twitchLiveUpdateInterval {
+		data := m.twitchLiveMessage(*entry, twitchStatus)
+		edit := discordgo.NewMessageEdit(entry.ChannelID, entry.Live.MessageID).SetContent(data.Content)
+		if data.Embed != nil {
+			edit.SetEmbed(data.Embed)
+		}
+		_, err := helpers.EditComplex(edit)
+		if err != nil {
+			cache.GetLogger().WithField("module", "twitch").Warnf("editing live message of %s failed: %s",
+				entry.Target, err.Error())
+		}
+		entry.Live.Title = twitchStatus.Stream.Channel.Status
+		entry.Live.Category = twitchStatus.Stream.Game
+		entry.Live.UpdatedAt = time.Now()
+	}
 }
 
-func (m *Twitch) postTwitchLiveToChannel(entry models.TwitchEntry, twitchStatus TwitchStatus) {
+// finishTwitchStream posts the offline summary of the stream and resets the stream of the feed
+func (m *Twitch) finishTwitchStream(entry *models.FeedEntry) {
+	if entry.Live.MessageID != "" {
+		data := m.twitchOfflineMessage(*entry)
+
+		var err error
+		switch entry.Options[twitchOptionSummary] {
+		case twitchOptionSummaryNew:
+			_, err = helpers.SendComplex(entry.ChannelID, data)
+		default:
+			edit := discordgo.NewMessageEdit(entry.ChannelID, entry.Live.MessageID).
+				SetContent(data.Content).
+				SetEmbed(data.Embed)
+			_, err = helpers.EditComplex(edit)
+		}
+		if err != nil {
+			cache.GetLogger().WithField("module", "twitch").Warnf("posting offline summary of %s failed: %s",
+				entry.Target, err.Error())
+		}
+	}
+
+	entry.Live = models.FeedLive{}
+}
+
+func (m *Twitch) twitchOfflineMessage(entry models.FeedEntry) *discordgo.MessageSend {
+	channelURL := fmt.Sprintf(twitchChannelURL, entry.Target)
+
+	twitchChannelEmbed := &discordgo.MessageEmbed{
+		Title:  helpers.GetTextLF(helpers.GetLocale(entry.GuildID, ""), "plugins.twitch.offline-embed-title", entry.TargetName),
+		URL:    channelURL,
+		Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetTextL(helpers.GetLocale(entry.GuildID, ""), "plugins.twitch.embed-footer")},
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Peak Viewers", Value: humanize.Comma(int64(entry.Live.PeakScore)), Inline: true}},
+		Color: helpers.GetDiscordColorFromHex(twitchHexColor),
+	}
+	if !entry.Live.StartedAt.IsZero() {
+		duration := helpers.HumanizeDuration(time.Since(entry.Live.StartedAt).Truncate(time.Minute))
+		if duration != "" {
+			twitchChannelEmbed.Fields = append([]*discordgo.MessageEmbedField{
+				{Name: "Duration", Value: duration, Inline: true},
+			}, twitchChannelEmbed.Fields...)
+		}
+	}
+	if entry.Live.Title != "" {
+		twitchChannelEmbed.Description += fmt.Sprintf("**%s**\n", entry.Live.Title)
+	}
+	if entry.Live.Category != "" {
+		twitchChannelEmbed.Description += fmt.Sprintf("played **%s**\n", entry.Live.Category)
+	}
+	twitchChannelEmbed.Description = strings.Trim(twitchChannelEmbed.Description, "\n")
+
+	content := fmt.Sprintf("<%s>", channelURL)
+	if entry.Options[twitchOptionVOD] == helpers.StoreBoolAsString(true) {
+		streamID, _ := strconv.ParseInt(entry.Live.PostID, 10, 64)
+		vodURL := m.getTwitchVOD(entry.Target, streamID)
+		if vodURL != "" {
+			twitchChannelEmbed.Fields = append(twitchChannelEmbed.Fields,
+				&discordgo.MessageEmbedField{Name: "VOD", Value: vodURL, Inline: false})
+			content = fmt.Sprintf("<%s>", vodURL)
+		}
+	}
+
+	return &discordgo.MessageSend{
+		Content: content,
+		Embed:   twitchChannelEmbed,
+	}
+}
+
+// getTwitchVOD returns the URL of the past broadcast of a stream, or an empty string if it has none
+func (m *Twitch) getTwitchVOD(name string, streamID int64) (vodURL string) {
+	if streamID == 0 {
+		return ""
+	}
+
+	request, err := http.NewRequest("GET", fmt.Sprintf(twitchVideosEndpoint, name), nil)
+	helpers.Relax(err)
+	request.Header.Set("User-Agent", helpers.DEFAULT_UA)
+	request.Header.Set("Client-ID", helpers.GetConfig().Path("twitch.token").Data().(string))
+
+	client := &http.Client{
+		Timeout: time.Duration(10 * time.Second),
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		cache.GetLogger().WithField("module", "twitch").Warnf("twitch videos request failed: %s", err.Error())
+		return ""
+	}
+	defer response.Body.Close()
+
+	var twitchVideos TwitchVideos
+	err = json.NewDecoder(response.Body).Decode(&twitchVideos)
+	if err != nil {
+		return ""
+	}
+
+	for _, video := range twitchVideos.Videos {
+		if video.BroadcastID == streamID {
+			return video.URL
+		}
+	}
+	return ""
+}
+
+func (m *Twitch) twitchPost(twitchStatus TwitchStatus) feeds.Post {
+	post := feeds.Post{
+		ID:       strconv.FormatInt(twitchStatus.Stream.ID, 10),
+		URL:      twitchStatus.Stream.Channel.URL,
+		Title:    twitchStatus.Stream.Channel.Status,
+		Text:     twitchStatus.Stream.Game,
+		Author:   m.twitchStreamName(twitchStatus),
+		Type:     "live",
+		Category: twitchStatus.Stream.Game,
+		Time:     twitchStatus.Stream.CreatedAt,
+		Raw:      twitchStatus,
+	}
+	if twitchStatus.Stream.Preview.Medium != "" {
+		post.ImageURLs = []string{twitchStatus.Stream.Preview.Medium}
+	}
+	return post
+}
+
+func (m *Twitch) twitchStreamName(twitchStatus TwitchStatus) string {
 	twitchStreamName := twitchStatus.Stream.Channel.DisplayName
 	if strings.ToLower(twitchStatus.Stream.Channel.Name) != strings.ToLower(twitchStatus.Stream.Channel.DisplayName) {
 		twitchStreamName += fmt.Sprintf(" (%s)", twitchStatus.Stream.Channel.Name)
 	}
-	var mentionText string
-	if entry.MentionRoleID != "" {
-		mentionText = fmt.Sprintf("<@&%s>\n", entry.MentionRoleID)
-	}
+	return twitchStreamName
+}
 
+// twitchLiveEmbed returns the embed of a running stream
+func (m *Twitch) twitchLiveEmbed(locale string, twitchStatus TwitchStatus) *discordgo.MessageEmbed {
 	twitchChannelEmbed := &discordgo.MessageEmbed{
-		Title:  helpers.GetTextF("plugins.twitch.wentlive-embed-title", twitchStreamName),
+		Title:  helpers.GetTextLF(locale, "plugins.twitch.wentlive-embed-title", m.twitchStreamName(twitchStatus)),
 		URL:    twitchStatus.Stream.Channel.URL,
-		Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetText("plugins.twitch.embed-footer")},
+		Footer: &discordgo.MessageEmbedFooter{Text: helpers.GetTextL(locale, "plugins.twitch.embed-footer")},
 		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Viewers", Value: humanize.Comma(int64(twitchStatus.Stream.Viewers)), Inline: true},
 			{Name: "Followers", Value: humanize.Comma(int64(twitchStatus.Stream.Channel.Followers)), Inline: true},
 			{Name: "Total Views", Value: humanize.Comma(int64(twitchStatus.Stream.Channel.Views)), Inline: true}},
 		Color: helpers.GetDiscordColorFromHex(twitchHexColor),
@@ -460,9 +828,17 @@ func (m *Twitch) postTwitchLiveToChannel(entry models.TwitchEntry, twitchStatus
 	if twitchChannelEmbed.Description != "" {
 		twitchChannelEmbed.Description = strings.Trim(twitchChannelEmbed.Description, "\n")
 	}
-	_, err := helpers.SendComplex(entry.ChannelID, &discordgo.MessageSend{
-		Content: mentionText + fmt.Sprintf("<%s>", twitchStatus.Stream.Channel.URL),
-		Embed:   twitchChannelEmbed,
-	})
-	helpers.Relax(err)
+	return twitchChannelEmbed
+}
+
+// twitchLiveMessage returns the live message of a stream, used for the first post and the edits while the stream runs
+func (m *Twitch) twitchLiveMessage(entry models.FeedEntry, twitchStatus TwitchStatus) *discordgo.MessageSend {
+	data := &discordgo.MessageSend{
+		Content: fmt.Sprintf("<%s>", twitchStatus.Stream.Channel.URL),
+		Embed:   m.twitchLiveEmbed(helpers.GetLocale(entry.GuildID, ""), twitchStatus),
+	}
+
+	data = feeds.ApplyTemplate(data, entry.Template, entry.TargetName, m.twitchPost(twitchStatus))
+	feeds.ApplyMentionRole(data, entry.MentionRoleID)
+	return data
 }
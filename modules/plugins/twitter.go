@@ -15,8 +15,8 @@ import (
 	"github.com/ChimeraCoder/anaconda"
 	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/Seklfreak/Robyul2/emojis"
+	"github.com/Seklfreak/Robyul2/feeds"
 	"github.com/Seklfreak/Robyul2/helpers"
-	"github.com/Seklfreak/Robyul2/metrics"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
 	"github.com/dghubble/go-twitter/twitter"
@@ -33,10 +33,13 @@ var (
 	twitterClient            *twitter.Client
 	twitterStream            *anaconda.Stream
 	twitterStreamNeedsUpdate bool
-	twitterEntriesCache      []models.TwitterEntry
 	twitterStreamIsStarting  sync.Mutex
-	twitterEntryLocks        = make(map[string]*sync.Mutex)
-	twitterEntryLock         sync.Mutex
+	// receives the tweets of the stream
+	activeTwitterSource *twitterSource
+)
+
+var (
+	ErrTwitterAccountNotFound = errors.New("twitter account not found")
 )
 
 const (
@@ -68,74 +71,29 @@ func (t *Twitter) Init(session *discordgo.Session) {
 		helpers.GetConfig().Path("twitter.access_token").Data().(string),
 		helpers.GetConfig().Path("twitter.access_secret").Data().(string),
 	)
+
+	activeTwitterSource = &twitterSource{t: t}
+	err := feeds.Register(activeTwitterSource)
+	if err != nil {
+		cache.GetLogger().WithField("module", "twitter").WithError(err).Error("failed to register the twitter feed source")
+	}
+
 	go func() {
 		defer helpers.Recover()
 
-		for {
+		for !helpers.IsShuttingDown() {
 			if twitterStream == nil {
-				time.Sleep(1 * time.Second)
+				helpers.SleepUnlessShuttingDown(1 * time.Second)
 				continue
 			}
 			for event := range twitterStream.C {
 				switch item := event.(type) {
 				case anaconda.Tweet:
-					for _, entry := range twitterEntriesCache {
-						if entry.AccountID != item.User.IdStr {
-							continue
-						}
-
-						// exclude RTs?
-						if entry.ExcludeRTs && item.RetweetedStatus != nil {
-							continue
-						}
-
-						// exclude Mentions?
-						if entry.ExcludeMentions && strings.HasPrefix(item.Text, "@") {
-							continue
-						}
-
-						entryID := entry.ID
-						t.lockEntry(entryID)
-
-						err := helpers.MdbOneWithoutLogging(
-							helpers.MdbCollection(models.TwitterTable).Find(bson.M{"_id": entry.ID}),
-							&entry,
-						)
-						if err != nil {
-							t.unlockEntry(entryID)
-							helpers.RelaxLog(err)
-							continue
-						}
-
-						changes := false
-						tweetAlreadyPosted := false
-
-						for _, postedTweet := range entry.PostedTweets {
-							if postedTweet.ID == item.IdStr {
-								tweetAlreadyPosted = true
-							}
-						}
-						if tweetAlreadyPosted == false {
-							// cache.GetLogger().WithField("module", "twitter").Info(fmt.Sprintf("posting tweet (via streaming): #%s to: #%s", item.IdStr, entry.ChannelID))
-							entry.PostedTweets = append(entry.PostedTweets, models.TwitterTweetEntry{ID: item.IdStr, CreatedAt: item.CreatedAt})
-							changes = true
-							go t.postAnacondaTweetToChannel(entry.ChannelID, &item, &item.User, entry)
-						}
-
-						if changes == true {
-							err = helpers.MDbUpsertIDWithoutLogging(
-								models.TwitterTable,
-								entry.ID,
-								entry,
-							)
-							if err != nil {
-								t.unlockEntry(entryID)
-								helpers.RelaxLog(err)
-								continue
-							}
-						}
-
-						t.unlockEntry(entryID)
+					tweet := item
+					// the tweets are only posted to feeds which were checked once, the first check remembers the tweets
+					err := feeds.Push(activeTwitterSource, tweet.User.IdStr, []feeds.Post{t.anacondaTweetToPost(&tweet)})
+					if err != nil {
+						cache.GetLogger().WithField("module", "twitter").WithError(err).Warn("failed to post tweet of the stream")
 					}
 				case anaconda.StallWarning:
 					cache.GetLogger().WithField("module", "twitter").Warn("received stall warning from twitter stream:", item.Message)
@@ -146,99 +104,86 @@ func (t *Twitter) Init(session *discordgo.Session) {
 
 	go t.startTwitterStream()
 	go t.updateTwitterStreamLoop()
-
-	go func() {
-		// wait for twitterEntriesCache to initialize
-		time.Sleep(30 * time.Second)
-		// TODO: only to REST API check on start or after stream restarts
-		go t.checkTwitterFeedsLoop()
-		cache.GetLogger().WithField("module", "twitter").Info("started twitter loop (10m)")
-	}()
 }
 
 func (t *Twitter) Uninit(session *discordgo.Session) {
 	t.stopTwitterStream()
 }
 
-func (t *Twitter) startTwitterStream() {
-	defer helpers.Recover()
+// twitterSource is the feeds.Source for the tweets of Twitter accounts, the stream pushes new tweets
+// and the accounts are polled as a fallback
+type twitterSource struct {
+	t *Twitter
+}
 
-	twitterStreamIsStarting.Lock()
-	defer twitterStreamIsStarting.Unlock()
+func (s *twitterSource) Name() string {
+	return "twitter"
+}
 
-	var err error
-	var accountIDs []string
+func (s *twitterSource) Interval(target string) time.Duration {
+	return 10 * time.Minute
+}
 
-	err = helpers.MDbIterWithoutLogging(
-		helpers.MdbCollection(models.TwitterTable).Find(nil).Sort("_id"),
-	).All(&twitterEntriesCache)
-	helpers.Relax(err)
+func (s *twitterSource) FilterOptions() feeds.FilterOptions {
+	return feeds.FilterOptions{Types: []string{"tweet", "retweet", "mention"}}
+}
 
-	for _, entry := range twitterEntriesCache {
-		// check if channel exists
-		channel, err := helpers.GetChannelWithoutApi(entry.ChannelID)
-		if err != nil || channel == nil || channel.ID == "" {
-			continue
-		}
+// Resolve looks the account up and returns its ID as normalised target, the screen name can change
+func (s *twitterSource) Resolve(target string) (normalised, name string, err error) {
+	twitterUsername := strings.TrimSpace(strings.Replace(target, "@", "", 1))
+	twitterUser, _, err := twitterClient.Users.Show(&twitter.UserShowParams{
+		ScreenName: twitterUsername,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if twitterUser.IDStr == "" || twitterUser.IDStr == "0" {
+		return "", "", ErrTwitterAccountNotFound
+	}
+	return twitterUser.IDStr, "@" + twitterUser.ScreenName, nil
+}
 
-		// check if we can send messages
-		channelPermission, err := cache.GetSession().State.UserChannelPermissions(cache.GetSession().State.User.ID, channel.ID)
-		if err != nil {
-			continue
-		}
+func (s *twitterSource) Fetch(target string, state map[string]string) (posts []feeds.Post, newState map[string]string, err error) {
+	userID, err := strconv.ParseInt(target, 10, 64)
+	if err != nil {
+		return nil, state, err
+	}
 
-		if channelPermission&discordgo.PermissionSendMessages != discordgo.PermissionSendMessages {
-			continue
-		}
+	twitterUserTweets, _, err := twitterClient.Timelines.UserTimeline(&twitter.UserTimelineParams{
+		UserID:          userID,
+		Count:           10,
+		ExcludeReplies:  twitter.Bool(true),
+		IncludeRetweets: twitter.Bool(true),
+	})
+	if err != nil {
+		return nil, state, err
+	}
 
-		if entry.PostMode == models.TwitterPostModeRobyulEmbed {
-			if channelPermission&discordgo.PermissionEmbedLinks != discordgo.PermissionEmbedLinks {
-				continue
-			}
-		}
+	for i := range twitterUserTweets {
+		posts = append(posts, s.t.tweetToPost(&twitterUserTweets[i]))
+	}
+	return posts, state, nil
+}
 
-		idToAdd := entry.AccountID
+func (s *twitterSource) Render(entry models.FeedEntry, post feeds.Post) *discordgo.MessageEmbed {
+	switch tweet := post.Raw.(type) {
+	case *twitter.Tweet:
+		return s.t.tweetEmbed(tweet)
+	case *anaconda.Tweet:
+		return s.t.anacondaTweetEmbed(tweet)
+	}
+	return feeds.Embed(post)
+}
 
-		if idToAdd == "" && entry.AccountScreenName != "" {
-			user, _, err := twitterClient.Users.Show(&twitter.UserShowParams{
-				ScreenName: entry.AccountScreenName,
-			})
-			if err != nil {
-				if strings.Contains(err.Error(), "User not found.") {
-					continue
-				}
-			}
-			helpers.RelaxLog(err)
-			if err == nil {
-				idToAdd = user.IDStr
-				if idToAdd != "" && idToAdd != "0" {
-					entry.AccountID = idToAdd
-					err = helpers.MDbUpsertID(
-						models.TwitterTable,
-						entry.ID,
-						entry,
-					)
-					if err != nil {
-						helpers.RelaxLog(err)
-						continue
-					}
-				}
-			}
-			cache.GetLogger().WithField("module", "twitter").Infof("saved User ID %s for Twitter Account @%s", idToAdd, entry.AccountScreenName)
-		}
+func (t *Twitter) startTwitterStream() {
+	defer helpers.Recover()
 
-		if idToAdd != "" {
-			idInSlice := false
-			for _, accountID := range accountIDs {
-				if idToAdd == accountID {
-					idInSlice = true
-				}
-			}
-			if idInSlice == false {
-				accountIDs = append(accountIDs, entry.AccountID)
-			}
-		}
-	}
+	twitterStreamIsStarting.Lock()
+	defer twitterStreamIsStarting.Unlock()
+
+	var accountIDs []string
+	err := helpers.MdbCollection(models.FeedsTable).Find(bson.M{"source": "twitter"}).Distinct("target", &accountIDs)
+	helpers.Relax(err)
 
 	if len(accountIDs) > twitterStreamLimit {
 		accountIDs = accountIDs[0:twitterStreamLimit]
@@ -248,7 +193,6 @@ func (t *Twitter) startTwitterStream() {
 		"follow":         accountIDs,
 		"stall_warnings": []string{"true"},
 	})
-	helpers.Relax(err)
 	cache.GetLogger().WithField("module", "twitter").Infof("started Twitter stream for %d accounts", len(accountIDs))
 }
 
@@ -262,190 +206,24 @@ func (t *Twitter) stopTwitterStream() {
 func (t *Twitter) updateTwitterStreamLoop() {
 	defer helpers.Recover()
 	defer func() {
+		if helpers.IsShuttingDown() {
+			return
+		}
 		go func() {
 			cache.GetLogger().WithField("module", "twitter").Error("the updateTwitterStreamLoop died. Please investigate! Will be restarted in 60 seconds")
-			time.Sleep(60 * time.Second)
-			t.updateTwitterStreamLoop()
+			if helpers.SleepUnlessShuttingDown(60 * time.Second) {
+				t.updateTwitterStreamLoop()
+			}
 		}()
 	}()
 
-	for {
+	for helpers.SleepUnlessShuttingDown(30 * time.Second) {
 		if twitterStreamNeedsUpdate {
 			cache.GetLogger().WithField("module", "twitter").Info("restarting stream since update is required")
 			t.stopTwitterStream()
 			t.startTwitterStream()
 			twitterStreamNeedsUpdate = false
 		}
-
-		time.Sleep(30 * time.Second)
-	}
-}
-
-func (m *Twitter) checkTwitterFeedsLoop() {
-	defer helpers.Recover()
-	defer func() {
-		go func() {
-			cache.GetLogger().WithField("module", "twitter").Error("The checkTwitterFeedsLoop died. Please investigate! Will be restarted in 60 seconds")
-			time.Sleep(60 * time.Second)
-			m.checkTwitterFeedsLoop()
-		}()
-	}()
-
-	var bundledEntries map[string][]models.TwitterEntry
-
-	for {
-		bundledEntries = make(map[string][]models.TwitterEntry, 0)
-
-		for _, entry := range twitterEntriesCache {
-			// check if channel exists
-			channel, err := helpers.GetChannelWithoutApi(entry.ChannelID)
-			if err != nil || channel == nil || channel.ID == "" {
-				continue
-			}
-
-			// check if we can send messages
-			channelPermission, err := cache.GetSession().State.UserChannelPermissions(cache.GetSession().State.User.ID, channel.ID)
-			if err != nil {
-				continue
-			}
-
-			if channelPermission&discordgo.PermissionSendMessages != discordgo.PermissionSendMessages {
-				continue
-			}
-
-			if entry.PostMode == models.TwitterPostModeRobyulEmbed {
-				if channelPermission&discordgo.PermissionEmbedLinks != discordgo.PermissionEmbedLinks {
-					continue
-				}
-			}
-
-			if _, ok := bundledEntries[entry.AccountScreenName]; ok {
-				bundledEntries[entry.AccountScreenName] = append(bundledEntries[entry.AccountScreenName], entry)
-			} else {
-				bundledEntries[entry.AccountScreenName] = []models.TwitterEntry{entry}
-			}
-		}
-
-		cache.GetLogger().WithField("module", "twitter").Infof("checking %d accounts for %d feeds", len(bundledEntries), len(twitterEntriesCache))
-		start := time.Now()
-
-		for twitterAccoutnScreenName, entries := range bundledEntries {
-			// cache.GetLogger().WithField("module", "twitter").Info(fmt.Sprintf("checking Twitter Account @%s", twitterAccoutnScreenName))
-
-			twitterUser, _, err := twitterClient.Users.Show(&twitter.UserShowParams{
-				ScreenName: twitterAccoutnScreenName,
-			})
-			if err != nil {
-				if strings.Contains(err.Error(), "50 User not found") ||
-					strings.Contains(err.Error(), "63 User has been suspended") {
-					for _, entry := range entries {
-						err = helpers.MDbDelete(models.TwitterTable, entry.ID)
-						if err != nil {
-							helpers.RelaxLog(err)
-							continue
-						}
-						cache.GetLogger().WithField("module", "twitter").Infof(
-							"removed entry %s (@%s) because user suspended or deleted",
-							helpers.MdbIdToHuman(entry.ID), entry.AccountScreenName,
-						)
-					}
-					continue
-				}
-				cache.GetLogger().WithField("module", "twitter").Warnf("updating twitter account @%s failed: %s", twitterAccoutnScreenName, err.Error())
-				continue
-			}
-
-			twitterUserTweets, _, err := twitterClient.Timelines.UserTimeline(&twitter.UserTimelineParams{
-				ScreenName:      twitterAccoutnScreenName,
-				Count:           10,
-				ExcludeReplies:  twitter.Bool(true),
-				IncludeRetweets: twitter.Bool(true),
-			})
-			if err != nil {
-				cache.GetLogger().WithField("module", "twitter").Warnf("getting tweets of @%s failed: %s", twitterAccoutnScreenName, err.Error())
-				continue
-			}
-
-			// https://github.com/golang/go/wiki/SliceTricks#reversing
-			for i := len(twitterUserTweets)/2 - 1; i >= 0; i-- {
-				opp := len(twitterUserTweets) - 1 - i
-				twitterUserTweets[i], twitterUserTweets[opp] = twitterUserTweets[opp], twitterUserTweets[i]
-			}
-
-			for _, entry := range entries {
-				entryID := entry.ID
-				m.lockEntry(entryID)
-
-				err = helpers.MdbOneWithoutLogging(
-					helpers.MdbCollection(models.TwitterTable).Find(bson.M{"_id": entry.ID}),
-					&entry,
-				)
-				if err != nil {
-					m.unlockEntry(entryID)
-					if !helpers.IsMdbNotFound(err) {
-						helpers.RelaxLog(err)
-					}
-					continue
-				}
-
-				changes := false
-
-				for _, tweet := range twitterUserTweets {
-					tweetCreatedAt, err := tweet.CreatedAtTime()
-					if err != nil || time.Now().Sub(tweetCreatedAt) > time.Hour {
-						continue
-					}
-
-					// exclude RTs?
-					if entry.ExcludeRTs && tweet.RetweetedStatus != nil {
-						continue
-					}
-
-					// exclude Mentions?
-					if entry.ExcludeMentions && strings.HasPrefix(tweet.Text, "@") {
-						continue
-					}
-
-					tweetAlreadyPosted := false
-					for _, postedTweet := range entry.PostedTweets {
-						if postedTweet.ID == tweet.IDStr {
-							tweetAlreadyPosted = true
-						}
-					}
-					if tweetAlreadyPosted == false {
-						// cache.GetLogger().WithField("module", "twitter").Info(fmt.Sprintf("posting tweet (via REST): #%s to: #%s", tweet.IDStr, entry.ChannelID))
-						entry.PostedTweets = append(entry.PostedTweets, models.TwitterTweetEntry{ID: tweet.IDStr, CreatedAt: tweet.CreatedAt})
-						changes = true
-						tweetToPost := tweet
-						go m.postTweetToChannel(entry.ChannelID, &tweetToPost, twitterUser, entry)
-					}
-
-				}
-				if changes == true {
-					err = helpers.MDbUpsertIDWithoutLogging(
-						models.TwitterTable,
-						entry.ID,
-						entry,
-					)
-					if err != nil {
-						m.unlockEntry(entryID)
-						helpers.RelaxLog(err)
-						continue
-					}
-				}
-
-				m.unlockEntry(entryID)
-			}
-			time.Sleep(5 * time.Second)
-		}
-
-		elapsed := time.Since(start)
-		cache.GetLogger().WithField("module", "twitter").Infof("checked %d accounts for %d feeds, took %s", len(bundledEntries), len(twitterEntriesCache), elapsed)
-		metrics.TwitterRefreshTime.Set(elapsed.Seconds())
-
-		if len(bundledEntries) <= 10 {
-			time.Sleep(10 * time.Minute)
-		}
 	}
 }
 
@@ -467,40 +245,16 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 				if len(args) >= 3 {
 					targetChannel, err = helpers.GetChannelFromMention(msg, args[2])
 					if err != nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 					return
 				}
 				targetGuild, err = helpers.GetGuild(targetChannel.GuildID)
 				helpers.Relax(err)
-				// get twitter account and tweets
-				twitterUsername := strings.TrimSpace(strings.Replace(args[1], "@", "", 1))
-				twitterUser, _, err := twitterClient.Users.Show(&twitter.UserShowParams{
-					ScreenName: twitterUsername,
-				})
-				if err != nil {
-					errText := m.handleError(err)
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF(errText))
-					return
-				}
-
-				twitterUserTweets, _, err := twitterClient.Timelines.UserTimeline(&twitter.UserTimelineParams{
-					ScreenName:      twitterUser.ScreenName,
-					Count:           10,
-					ExcludeReplies:  twitter.Bool(true),
-					IncludeRetweets: twitter.Bool(true),
-				})
-				if err != nil {
-					if strings.Contains(err.Error(), "invalid character 'x' looking for beginning of value") {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.twitter.rate-limit-exceed"))
-						return
-					}
-				}
-				helpers.Relax(err)
-
+				// get twitter account
 				mentionRole := new(discordgo.Role)
 				if len(args) >= 4 && (args[3] != "discord-embed" && args[3] != "text") {
 					mentionRoleName := args[3]
@@ -525,19 +279,12 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 						}
 					}
 				}
-				postMode := models.TwitterPostModeRobyulEmbed
+				postMode := models.FeedPostModeEmbed
 				if strings.Contains(strings.ToLower(content), " discord-embed") {
-					postMode = models.TwitterPostModeDiscordEmbed
+					postMode = models.FeedPostModeLink
 				}
 				if strings.Contains(strings.ToLower(content), " text") {
-					postMode = models.TwitterPostModeText
-				}
-				// Create DB Entries
-				var dbTweets []models.TwitterTweetEntry
-				for _, tweet := range twitterUserTweets {
-					tweetEntry := models.TwitterTweetEntry{ID: tweet.IDStr, CreatedAt: tweet.CreatedAt}
-					dbTweets = append(dbTweets, tweetEntry)
-
+					postMode = models.FeedPostModeText
 				}
 				// exclude RTs or Mentions?
 				var excludeRTs, excludeMentions bool
@@ -548,72 +295,29 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 					excludeMentions = true
 				}
 				// create new entry in db
-				newID, err := helpers.MDbInsert(
-					models.TwitterTable,
-					models.TwitterEntry{
-						GuildID:           targetChannel.GuildID,
-						ChannelID:         targetChannel.ID,
-						AccountScreenName: twitterUser.ScreenName,
-						AccountID:         twitterUser.IDStr,
-						PostedTweets:      dbTweets,
-						MentionRoleID:     mentionRole.ID,
-						PostMode:          postMode,
-						ExcludeRTs:        excludeRTs,
-						ExcludeMentions:   excludeMentions,
-					},
-				)
-				helpers.Relax(err)
-
-				twitterStreamNeedsUpdate = true
-
-				postModeText := "robyul embed"
-				switch postMode {
-				case models.TwitterPostModeDiscordEmbed:
-					postModeText = "discord embed"
-				case models.TwitterPostModeText:
-					postModeText = "text"
+				entry, err := TwitterAddFeed(models.FeedEntry{
+					GuildID:       targetChannel.GuildID,
+					ChannelID:     targetChannel.ID,
+					AddedByUserID: msg.Author.ID,
+					MentionRoleID: mentionRole.ID,
+					PostMode:      postMode,
+				}, args[1], excludeRTs, excludeMentions)
+				if err == feeds.ErrAlreadyAdded {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.feeds.add-already-added", targetChannel.ID))
+					return
+				}
+				if err == ErrTwitterAccountNotFound {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.twitter.account-not-found"))
+					return
+				}
+				if err != nil {
+					errText := m.handleError(msg, err)
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, errText))
+					return
 				}
 
-				_, err = helpers.EventlogLog(time.Now(), targetChannel.GuildID, helpers.MdbIdToHuman(newID),
-					models.EventlogTargetTypeRobyulTwitterFeed, msg.Author.ID,
-					models.EventlogTypeRobyulTwitterFeedAdd, "",
-					nil,
-					[]models.ElasticEventlogOption{
-						{
-							Key:   "twitter_channelid",
-							Value: targetChannel.ID,
-							Type:  models.EventlogTargetTypeChannel,
-						},
-						{
-							Key:   "twitter_accountscreename",
-							Value: twitterUser.ScreenName,
-						},
-						{
-							Key:   "twitter_accountid",
-							Value: twitterUser.IDStr,
-						},
-						{
-							Key:   "twitter_mentionroleid",
-							Value: mentionRole.ID,
-							Type:  models.EventlogTargetTypeRole,
-						},
-						{
-							Key:   "twitter_postmode",
-							Value: postModeText,
-						},
-						{
-							Key:   "twitter_exclude_rts",
-							Value: helpers.StoreBoolAsString(excludeRTs),
-						},
-						{
-							Key:   "twitter_exclude_mentions",
-							Value: helpers.StoreBoolAsString(excludeMentions),
-						},
-					}, false)
-				helpers.RelaxLog(err)
-
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.twitter.account-added-success", twitterUser.ScreenName, targetChannel.ID))
-				cache.GetLogger().WithField("module", "twitter").Info(fmt.Sprintf("Added Twitter Account @%s to Channel %s (#%s) on Guild %s (#%s)", twitterUser.ScreenName, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.twitter.account-added-success", twitterScreenName(entry), targetChannel.ID))
+				cache.GetLogger().WithField("module", "twitter").Info(fmt.Sprintf("Added Twitter Account %s to Channel %s (#%s) on Guild %s (#%s)", entry.TargetName, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID))
 			})
 		case "delete", "del", "remove": // [p]twitter delete <id>
 			helpers.RequireMod(msg, func() {
@@ -624,34 +328,58 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 					helpers.Relax(err)
 
 					entryId := args[1]
-					var entryBucket models.TwitterEntry
-					err = helpers.MdbOne(
-						helpers.MdbCollection(models.TwitterTable).Find(bson.M{"_id": helpers.HumanToMdbId(entryId), "guildid": channel.GuildID}),
-						&entryBucket,
-					)
-					if helpers.IsMdbNotFound(err) || entryBucket.ID == "" {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.twitter.account-delete-not-found-error"))
+					entryBucket, err := feeds.Get(channel.GuildID, entryId)
+					if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "twitter") {
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.twitter.account-delete-not-found-error"))
 						return
 					}
 					helpers.Relax(err)
 
-					err = helpers.MDbDelete(models.TwitterTable, entryBucket.ID)
+					err = TwitterRemoveFeed(entryBucket, msg.Author.ID)
 					helpers.Relax(err)
 
-					twitterStreamNeedsUpdate = true
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.twitter.account-delete-success", twitterScreenName(entryBucket)))
+					cache.GetLogger().WithField("module", "twitter").Info(fmt.Sprintf("Deleted Twitter Account %s", entryBucket.TargetName))
 
-					postModeText := "robyul embed"
-					switch entryBucket.PostMode {
-					case models.TwitterPostModeDiscordEmbed:
-						postModeText = "discord embed"
-					case models.TwitterPostModeText:
-						postModeText = "text"
-					}
+				} else {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+			})
+		case "template": // [p]twitter template <id> [<template>|reset]
+			helpers.RequireMod(msg, func() {
+				if len(args) < 2 {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+
+				channel, err := helpers.GetChannel(msg.ChannelID)
+				helpers.Relax(err)
+
+				entryBucket, err := feeds.Get(channel.GuildID, args[1])
+				if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "twitter") {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.twitter.account-delete-not-found-error"))
+					return
+				}
+				helpers.Relax(err)
+
+				oldTemplate := entryBucket.Template
+				reply, changed := feeds.TemplateCommand(helpers.GetMessageLocale(msg), &entryBucket.Template,
+					entryBucket.TargetName, strings.Join(args[2:], " "))
+				if changed {
+					err = feeds.Update(entryBucket)
+					helpers.Relax(err)
 
 					_, err = helpers.EventlogLog(time.Now(), entryBucket.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
 						models.EventlogTargetTypeRobyulTwitterFeed, msg.Author.ID,
-						models.EventlogTypeRobyulTwitterFeedRemove, "",
-						nil,
+						models.EventlogTypeRobyulTwitterFeedUpdate, "",
+						[]models.ElasticEventlogChange{
+							{
+								Key:      "twitter_template",
+								OldValue: oldTemplate,
+								NewValue: entryBucket.Template,
+							},
+						},
 						[]models.ElasticEventlogOption{
 							{
 								Key:   "twitter_channelid",
@@ -660,51 +388,23 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 							},
 							{
 								Key:   "twitter_accountscreename",
-								Value: entryBucket.AccountScreenName,
-							},
-							{
-								Key:   "twitter_accountid",
-								Value: entryBucket.AccountID,
-							},
-							{
-								Key:   "twitter_mentionroleid",
-								Value: entryBucket.MentionRoleID,
-								Type:  models.EventlogTargetTypeRole,
-							},
-							{
-								Key:   "twitter_postmode",
-								Value: postModeText,
-							},
-							{
-								Key:   "twitter_exclude_rts",
-								Value: helpers.StoreBoolAsString(entryBucket.ExcludeRTs),
-							},
-							{
-								Key:   "twitter_exclude_mentions",
-								Value: helpers.StoreBoolAsString(entryBucket.ExcludeMentions),
+								Value: twitterScreenName(entryBucket),
 							},
 						}, false)
 					helpers.RelaxLog(err)
-
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.twitter.account-delete-success", entryBucket.AccountScreenName))
-					cache.GetLogger().WithField("module", "twitter").Info(fmt.Sprintf("Deleted Twitter Account @%s", entryBucket.AccountScreenName))
-
-				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
-					return
 				}
+
+				helpers.SendMessage(msg.ChannelID, reply)
 			})
 		case "list": // [p]twitter list
 			currentChannel, err := helpers.GetChannel(msg.ChannelID)
 			helpers.Relax(err)
 
-			var entryBucket []models.TwitterEntry
-			err = helpers.MDbIter(helpers.MdbCollection(models.TwitterTable).Find(bson.M{"guildid": currentChannel.GuildID})).All(&entryBucket)
+			entryBucket, err := feeds.GetAll(currentChannel.GuildID, "twitter")
 			helpers.Relax(err)
 
-			helpers.Relax(err)
 			if entryBucket == nil || len(entryBucket) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.twitter.account-list-no-accounts-error"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.twitter.account-list-no-accounts-error"))
 				return
 			}
 
@@ -712,9 +412,9 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 			for _, entry := range entryBucket {
 				var specialText string
 				switch entry.PostMode {
-				case models.TwitterPostModeDiscordEmbed:
+				case models.FeedPostModeLink:
 					specialText += " as discord embed"
-				case models.TwitterPostModeText:
+				case models.FeedPostModeText:
 					specialText += " as text"
 				}
 				if entry.MentionRoleID != "" {
@@ -725,14 +425,18 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 						specialText += " mentioning N/A"
 					}
 				}
-				if entry.ExcludeRTs {
+				excludeRTs, excludeMentions := twitterExcludes(entry)
+				if excludeRTs {
 					specialText += " ignoring RTs"
 				}
-				if entry.ExcludeMentions {
+				if excludeMentions {
 					specialText += " ignoring Mentions"
 				}
-				resultMessage += fmt.Sprintf("`%s`: Twitter Account `@%s` posting to <#%s>%s\n",
-					helpers.MdbIdToHuman(entry.ID), entry.AccountScreenName, entry.ChannelID, specialText)
+				if entry.Template != "" {
+					specialText += " using a template"
+				}
+				resultMessage += fmt.Sprintf("`%s`: Twitter Account `%s` posting to <#%s>%s\n",
+					helpers.MdbIdToHuman(entry.ID), entry.TargetName, entry.ChannelID, specialText)
 			}
 			resultMessage += fmt.Sprintf("Found **%d** Twitter Accounts in total.", len(entryBucket))
 			for _, resultPage := range helpers.Pagify(resultMessage, "\n") {
@@ -746,8 +450,8 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 				ScreenName: twitterUsername,
 			})
 			if err != nil {
-				errText := m.handleError(err)
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF(errText))
+				errText := m.handleError(msg, err)
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, errText))
 				return
 			}
 
@@ -773,12 +477,12 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 				twitterNameModifier += " :lock:"
 			}
 			accountEmbed := &discordgo.MessageEmbed{
-				Title:     helpers.GetTextF("plugins.twitter.account-embed-title", twitterUser.Name, twitterUser.ScreenName, twitterNameModifier),
+				Title:     helpers.GetMessageTextF(msg, "plugins.twitter.account-embed-title", twitterUser.Name, twitterUser.ScreenName, twitterNameModifier),
 				URL:       fmt.Sprintf(TwitterFriendlyUser, twitterUser.ScreenName),
 				Thumbnail: &discordgo.MessageEmbedThumbnail{URL: twitterUser.ProfileImageURLHttps},
 				Footer: &discordgo.MessageEmbedFooter{
-					Text:    helpers.GetText("plugins.twitter.embed-footer"),
-					IconURL: helpers.GetText("plugins.twitter.embed-footer-imageurl"),
+					Text:    helpers.GetMessageText(msg, "plugins.twitter.embed-footer"),
+					IconURL: helpers.GetMessageText(msg, "plugins.twitter.embed-footer-imageurl"),
 				},
 				Description: twitterUserDescription,
 				Fields: []*discordgo.MessageEmbedField{
@@ -804,46 +508,202 @@ func (m *Twitter) Action(command string, content string, msg *discordgo.Message,
 			return
 		}
 	} else {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 	}
 }
 
-func (m *Twitter) postTweetToChannel(channelID string, tweet *twitter.Tweet, twitterUser *twitter.User, entry models.TwitterEntry) {
-	if entry.PostMode == models.TwitterPostModeDiscordEmbed || entry.PostMode == models.TwitterPostModeText {
-		content := fmt.Sprintf("%s", fmt.Sprintf(TwitterFriendlyStatus, twitterUser.ScreenName, tweet.IDStr))
-		if entry.PostMode == models.TwitterPostModeText {
-			content = "<" + content + ">"
-		}
-		if entry.MentionRoleID != "" {
-			content = fmt.Sprintf("<@&%s>\n%s", entry.MentionRoleID, content)
-		}
-		if entry.PostMode == models.TwitterPostModeText {
-			// hide URL previews
-			content += "\n" + helpers.URLRegex.ReplaceAllStringFunc(tweet.Text, func(link string) string {
-				return "<" + link + ">"
-			})
-			if tweet.ExtendedEntities != nil && len(tweet.ExtendedEntities.Media) > 0 {
-				content += "\n"
-				for _, mediaUrl := range tweet.ExtendedEntities.Media {
-					switch mediaUrl.Type {
-					case "video", "animated_gif":
-						if len(mediaUrl.VideoInfo.Variants) > 0 && m.bestVideoVariant(mediaUrl.VideoInfo.Variants).URL != "" {
-							content += m.bestVideoVariant(mediaUrl.VideoInfo.Variants).URL + "\n"
-						} else {
-							content += m.maxQualityMediaUrl(mediaUrl.DisplayURL) + "\n"
-						}
-					default:
-						content += m.maxQualityMediaUrl(mediaUrl.MediaURLHttps) + "\n"
-					}
+// TwitterAddFeed adds a Twitter feed, used by the twitter command and the REST API
+// retweets and mentions are excluded with the post types of the filter
+func TwitterAddFeed(entry models.FeedEntry, account string, excludeRTs, excludeMentions bool) (models.FeedEntry, error) {
+	entry.Filter.Types = twitterTypes(excludeRTs, excludeMentions)
+	entry, err := feeds.AddEntry("twitter", account, entry)
+	if err != nil {
+		return entry, err
+	}
+
+	twitterStreamNeedsUpdate = true
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulTwitterFeed, entry.AddedByUserID,
+		models.EventlogTypeRobyulTwitterFeedAdd, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "twitter_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "twitter_accountscreename",
+				Value: twitterScreenName(entry),
+			},
+			{
+				Key:   "twitter_accountid",
+				Value: entry.Target,
+			},
+			{
+				Key:   "twitter_mentionroleid",
+				Value: entry.MentionRoleID,
+				Type:  models.EventlogTargetTypeRole,
+			},
+			{
+				Key:   "twitter_postmode",
+				Value: twitterPostModeText(entry.PostMode),
+			},
+			{
+				Key:   "twitter_exclude_rts",
+				Value: helpers.StoreBoolAsString(excludeRTs),
+			},
+			{
+				Key:   "twitter_exclude_mentions",
+				Value: helpers.StoreBoolAsString(excludeMentions),
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return entry, nil
+}
+
+// TwitterRemoveFeed removes a Twitter feed, used by the twitter command and the REST API
+func TwitterRemoveFeed(entry models.FeedEntry, userID string) (err error) {
+	err = feeds.Remove(entry)
+	if err != nil {
+		return err
+	}
+
+	twitterStreamNeedsUpdate = true
+
+	excludeRTs, excludeMentions := twitterExcludes(entry)
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulTwitterFeed, userID,
+		models.EventlogTypeRobyulTwitterFeedRemove, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "twitter_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "twitter_accountscreename",
+				Value: twitterScreenName(entry),
+			},
+			{
+				Key:   "twitter_accountid",
+				Value: entry.Target,
+			},
+			{
+				Key:   "twitter_mentionroleid",
+				Value: entry.MentionRoleID,
+				Type:  models.EventlogTargetTypeRole,
+			},
+			{
+				Key:   "twitter_postmode",
+				Value: twitterPostModeText(entry.PostMode),
+			},
+			{
+				Key:   "twitter_exclude_rts",
+				Value: helpers.StoreBoolAsString(excludeRTs),
+			},
+			{
+				Key:   "twitter_exclude_mentions",
+				Value: helpers.StoreBoolAsString(excludeMentions),
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return nil
+}
+
+// twitterScreenName returns the screen name of the account of a feed without the @
+func twitterScreenName(entry models.FeedEntry) string {
+	return strings.TrimPrefix(entry.TargetName, "@")
+}
+
+// twitterPostModeText returns the name of the post mode as used by the twitter command
+func twitterPostModeText(postMode models.FeedPostMode) string {
+	switch postMode {
+	case models.FeedPostModeLink:
+		return "discord embed"
+	case models.FeedPostModeText:
+		return "text"
+	}
+	return "robyul embed"
+}
+
+// twitterTypes returns the post types of a feed excluding retweets or mentions, nil if nothing is excluded
+func twitterTypes(excludeRTs, excludeMentions bool) (types []string) {
+	if !excludeRTs && !excludeMentions {
+		return nil
+	}
+	types = []string{"tweet"}
+	if !excludeRTs {
+		types = append(types, "retweet")
+	}
+	if !excludeMentions {
+		types = append(types, "mention")
+	}
+	return types
+}
+
+// twitterExcludes returns whether a feed excludes retweets and mentions
+func twitterExcludes(entry models.FeedEntry) (excludeRTs, excludeMentions bool) {
+	if len(entry.Filter.Types) <= 0 {
+		return false, false
+	}
+	return !helpers.StringSliceContains("retweet", entry.Filter.Types),
+		!helpers.StringSliceContains("mention", entry.Filter.Types)
+}
+
+// tweetType returns the post type of a tweet for the filters, tweet, retweet or mention
+func tweetType(text string, isRetweet bool) string {
+	if isRetweet {
+		return "retweet"
+	}
+	if strings.HasPrefix(text, "@") {
+		return "mention"
+	}
+	return "tweet"
+}
+
+// tweetToPost converts a tweet of the REST API for the feeds framework
+func (m *Twitter) tweetToPost(tweet *twitter.Tweet) (post feeds.Post) {
+	post = feeds.Post{
+		ID:   tweet.IDStr,
+		Text: html.UnescapeString(tweet.Text),
+		Type: tweetType(tweet.Text, tweet.RetweetedStatus != nil),
+		Raw:  tweet,
+	}
+	post.Time, _ = tweet.CreatedAtTime()
+	if tweet.User != nil {
+		post.URL = fmt.Sprintf(TwitterFriendlyStatus, tweet.User.ScreenName, tweet.IDStr)
+		post.Author = fmt.Sprintf("%s (@%s)", tweet.User.Name, tweet.User.ScreenName)
+		post.AuthorURL = fmt.Sprintf(TwitterFriendlyUser, tweet.User.ScreenName)
+		post.AuthorIconURL = tweet.User.ProfileImageURLHttps
+	}
+	if tweet.ExtendedEntities != nil {
+		for _, mediaUrl := range tweet.ExtendedEntities.Media {
+			switch mediaUrl.Type {
+			case "video", "animated_gif":
+				if post.VideoURL == "" && len(mediaUrl.VideoInfo.Variants) > 0 && m.bestVideoVariant(mediaUrl.VideoInfo.Variants).URL != "" {
+					post.VideoURL = m.bestVideoVariant(mediaUrl.VideoInfo.Variants).URL
 				}
+			default:
+				post.ImageURLs = append(post.ImageURLs, m.maxQualityMediaUrl(mediaUrl.MediaURLHttps))
 			}
 		}
+	} else if tweet.Entities != nil && len(tweet.Entities.Media) > 0 {
+		post.ImageURLs = []string{tweet.Entities.Media[0].MediaURLHttps}
+	}
+	return post
+}
 
-		helpers.SendComplex(
-			channelID, &discordgo.MessageSend{
-				Content: content,
-			})
-		return
+// tweetEmbed returns the embed of a tweet of the REST API
+func (m *Twitter) tweetEmbed(tweet *twitter.Tweet) *discordgo.MessageEmbed {
+	twitterUser := tweet.User
+	if twitterUser == nil {
+		twitterUser = new(twitter.User)
 	}
 
 	twitterNameModifier := ""
@@ -913,56 +773,41 @@ func (m *Twitter) postTweetToChannel(channelID string, tweet *twitter.Tweet, twi
 		}
 	}
 
-	content := fmt.Sprintf("<%s>", fmt.Sprintf(TwitterFriendlyStatus, twitterUser.ScreenName, tweet.IDStr))
-	if entry.MentionRoleID != "" {
-		content = fmt.Sprintf("<@&%s>\n%s", entry.MentionRoleID, content)
-	}
-
-	helpers.SendComplex(
-		channelID, &discordgo.MessageSend{
-			Content: content,
-			Embed:   channelEmbed,
-		})
+	return channelEmbed
 }
 
-func (m *Twitter) postAnacondaTweetToChannel(channelID string, tweet *anaconda.Tweet, twitterUser *anaconda.User, entry models.TwitterEntry) {
-	if entry.PostMode == models.TwitterPostModeDiscordEmbed || entry.PostMode == models.TwitterPostModeText {
-		content := fmt.Sprintf("%s", fmt.Sprintf(TwitterFriendlyStatus, twitterUser.ScreenName, tweet.IdStr))
-		if entry.PostMode == models.TwitterPostModeText {
-			content = "<" + content + ">"
-		}
-		if entry.MentionRoleID != "" {
-			content = fmt.Sprintf("<@&%s>\n%s", entry.MentionRoleID, content)
-		}
-		if entry.PostMode == models.TwitterPostModeText {
-			// hide URL previews
-			content += "\n" + helpers.URLRegex.ReplaceAllStringFunc(tweet.Text, func(link string) string {
-				return "<" + link + ">"
-			})
-			// link medias
-			if len(tweet.ExtendedEntities.Media) > 0 {
-				content += "\n"
-				for _, mediaUrl := range tweet.ExtendedEntities.Media {
-					switch mediaUrl.Type {
-					case "video", "animated_gif":
-						if len(mediaUrl.VideoInfo.Variants) > 0 && m.bestAnacondaVideoVariant(mediaUrl.VideoInfo.Variants).Url != "" {
-							content += m.bestAnacondaVideoVariant(mediaUrl.VideoInfo.Variants).Url + "\n"
-						} else {
-							content += m.maxQualityMediaUrl(mediaUrl.Display_url) + "\n"
-						}
-					default:
-						content += m.maxQualityMediaUrl(mediaUrl.Media_url_https) + "\n"
-					}
-				}
+// anacondaTweetToPost converts a tweet of the stream for the feeds framework
+func (m *Twitter) anacondaTweetToPost(tweet *anaconda.Tweet) (post feeds.Post) {
+	post = feeds.Post{
+		ID:            tweet.IdStr,
+		URL:           fmt.Sprintf(TwitterFriendlyStatus, tweet.User.ScreenName, tweet.IdStr),
+		Text:          html.UnescapeString(tweet.Text),
+		Author:        fmt.Sprintf("%s (@%s)", tweet.User.Name, tweet.User.ScreenName),
+		AuthorURL:     fmt.Sprintf(TwitterFriendlyUser, tweet.User.ScreenName),
+		AuthorIconURL: tweet.User.ProfileImageUrlHttps,
+		Type:          tweetType(tweet.Text, tweet.RetweetedStatus != nil),
+		Raw:           tweet,
+	}
+	post.Time, _ = tweet.CreatedAtTime()
+	for _, mediaUrl := range tweet.ExtendedEntities.Media {
+		switch mediaUrl.Type {
+		case "video", "animated_gif":
+			if post.VideoURL == "" && len(mediaUrl.VideoInfo.Variants) > 0 && m.bestAnacondaVideoVariant(mediaUrl.VideoInfo.Variants).Url != "" {
+				post.VideoURL = m.bestAnacondaVideoVariant(mediaUrl.VideoInfo.Variants).Url
 			}
+		default:
+			post.ImageURLs = append(post.ImageURLs, m.maxQualityMediaUrl(mediaUrl.Media_url_https))
 		}
-
-		helpers.SendComplex(
-			channelID, &discordgo.MessageSend{
-				Content: content,
-			})
-		return
 	}
+	if len(post.ImageURLs) <= 0 && post.VideoURL == "" && len(tweet.Entities.Media) > 0 {
+		post.ImageURLs = []string{tweet.Entities.Media[0].Media_url_https}
+	}
+	return post
+}
+
+// anacondaTweetEmbed returns the embed of a tweet of the stream
+func (m *Twitter) anacondaTweetEmbed(tweet *anaconda.Tweet) *discordgo.MessageEmbed {
+	twitterUser := tweet.User
 
 	twitterNameModifier := ""
 	if twitterUser.Verified {
@@ -1027,16 +872,7 @@ func (m *Twitter) postAnacondaTweetToChannel(channelID string, tweet *anaconda.T
 		}
 	}
 
-	content := fmt.Sprintf("<%s>", fmt.Sprintf(TwitterFriendlyStatus, twitterUser.ScreenName, tweet.IdStr))
-	if entry.MentionRoleID != "" {
-		content = fmt.Sprintf("<@&%s>\n%s", entry.MentionRoleID, content)
-	}
-
-	helpers.SendComplex(
-		channelID, &discordgo.MessageSend{
-			Content: content,
-			Embed:   channelEmbed,
-		})
+	return channelEmbed
 }
 
 func (m *Twitter) bestVideoVariant(videoVariants []twitter.VideoVariant) (bestVariant twitter.VideoVariant) {
@@ -1077,28 +913,28 @@ func (t *Twitter) maxQualityMediaUrl(input string) (result string) {
 	return input
 }
 
-func (m *Twitter) handleError(err error) string {
+func (m *Twitter) handleError(msg *discordgo.Message, err error) string {
 	// Extract 'error code' from dghubble/go-twitter's err message.
 	// Has a dependency with dghubble/go-twitter package.
 	var errCode int
 	var errMsg string
 	_, scanErr := fmt.Sscanf(err.Error(), "twitter: %d %s", &errCode, &errMsg)
 	if scanErr != nil {
-		return helpers.GetTextF("plugins.twitter.rate-limit-exceed")
+		return helpers.GetMessageTextF(msg, "plugins.twitter.rate-limit-exceed")
 	}
 
 	// Handle twitter API error by code
 	switch errCode {
 	case 50:
-		return helpers.GetTextF("plugins.twitter.account-not-found")
+		return helpers.GetMessageTextF(msg, "plugins.twitter.account-not-found")
 	case 63:
-		return helpers.GetTextF("plugins.twitter.account-has-been-suspended")
+		return helpers.GetMessageTextF(msg, "plugins.twitter.account-has-been-suspended")
 	case 88:
-		return helpers.GetTextF("plugins.twitter.rate-limit-exceed")
+		return helpers.GetMessageTextF(msg, "plugins.twitter.rate-limit-exceed")
 	case 130:
-		return helpers.GetTextF("plugins.twitter.over-capacity")
+		return helpers.GetMessageTextF(msg, "plugins.twitter.over-capacity")
 	case 131:
-		return helpers.GetTextF("plugins.twitter.internal-error")
+		return helpers.GetMessageTextF(msg, "plugins.twitter.internal-error")
 	default:
 		helpers.Relax(err)
 	}
@@ -1108,29 +944,6 @@ func (m *Twitter) handleError(err error) string {
 	panic(err)
 }
 
-func (m *Twitter) lockEntry(entryID bson.ObjectId) {
-	twitterEntryLock.Lock()
-	defer twitterEntryLock.Unlock()
-
-	key := string(entryID)
-	if _, ok := twitterEntryLocks[key]; ok && twitterEntryLocks[key] != nil {
-		twitterEntryLocks[key].Lock()
-		return
-	}
-	twitterEntryLocks[key] = new(sync.Mutex)
-	twitterEntryLocks[key].Lock()
-}
-
-func (m *Twitter) unlockEntry(entryID bson.ObjectId) {
-	twitterEntryLock.Lock()
-	defer twitterEntryLock.Unlock()
-
-	key := string(entryID)
-	if _, ok := twitterEntryLocks[key]; ok && twitterEntryLocks[key] != nil {
-		twitterEntryLocks[key].Unlock()
-	}
-}
-
 func (t *Twitter) OnMessage(content string, msg *discordgo.Message, session *discordgo.Session) {
 
 }
@@ -38,7 +38,7 @@ func (m *Useruploads) actionStart(args []string, in *discordgo.Message, out **di
 	cache.GetSession().ChannelTyping(in.ChannelID)
 
 	if len(args) < 1 {
-		*out = m.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = m.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return m.actionFinish
 	}
 
@@ -47,18 +47,18 @@ func (m *Useruploads) actionStart(args []string, in *discordgo.Message, out **di
 		return m.actionDisable
 	}
 
-	*out = m.newMsg(helpers.GetText("bot.arguments.invalid"))
+	*out = m.newMsg(in, helpers.GetMessageText(in, "bot.arguments.invalid"))
 	return m.actionFinish
 }
 
 func (m *Useruploads) actionDisable(args []string, in *discordgo.Message, out **discordgo.MessageSend) useruploadsAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = m.newMsg(helpers.GetText("robyulmod.no_permission"))
+		*out = m.newMsg(in, helpers.GetMessageText(in, "robyulmod.no_permission"))
 		return m.actionFinish
 	}
 
 	if len(args) < 2 {
-		*out = m.newMsg(helpers.GetText("bot.arguments.too-few"))
+		*out = m.newMsg(in, helpers.GetMessageText(in, "bot.arguments.too-few"))
 		return m.actionFinish
 	}
 
@@ -68,7 +68,7 @@ func (m *Useruploads) actionDisable(args []string, in *discordgo.Message, out **
 	err = helpers.UseruploadsDisableUser(targetUser.ID, in.Author.ID)
 	helpers.Relax(err)
 
-	*out = m.newMsg(helpers.GetTextF("plugins.useruploads.disable-success"))
+	*out = m.newMsg(in, helpers.GetMessageTextF(in, "plugins.useruploads.disable-success"))
 	return m.actionFinish
 }
 
@@ -79,8 +79,8 @@ func (m *Useruploads) actionFinish(args []string, in *discordgo.Message, out **d
 	return nil
 }
 
-func (m *Useruploads) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (m *Useruploads) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (m *Useruploads) Relax(err error) {
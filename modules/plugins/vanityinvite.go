@@ -65,25 +65,25 @@ func (vi VanityInvite) actionStart(args []string, in *discordgo.Message, out **d
 // [p]custom-invite set <vanity name> <#channel or channel id>
 func (vi VanityInvite) actionSet(args []string, in *discordgo.Message, out **discordgo.MessageSend) vanityInviteAction {
 	if !helpers.IsAdmin(in) {
-		*out = vi.newMsg("admin.no_permission")
+		*out = vi.newMsg(in, "admin.no_permission")
 		return vi.actionFinish
 	}
 
 	if len(args) < 3 {
-		*out = vi.newMsg("bot.arguments.too-few")
+		*out = vi.newMsg(in, "bot.arguments.too-few")
 		return vi.actionFinish
 	}
 
 	targetChannel, err := helpers.GetChannelFromMention(in, args[2])
 	if err != nil || targetChannel.ID == "" {
-		*out = vi.newMsg("bot.arguments.invalid")
+		*out = vi.newMsg(in, "bot.arguments.invalid")
 		return vi.actionFinish
 	}
 
 	vanityEntryByGuildID, _ := helpers.GetVanityUrlByGuildID(targetChannel.GuildID)
 	if vanityEntryByGuildID.VanityName != "" && vanityEntryByGuildID.VanityName != strings.ToLower(args[1]) {
 		if !helpers.ConfirmEmbed(in.ChannelID, in.Author,
-			helpers.GetTextF("plugins.vanityinvite.set-change-confirm", vanityEntryByGuildID.VanityNamePretty),
+			helpers.GetMessageTextF(in, "plugins.vanityinvite.set-change-confirm", vanityEntryByGuildID.VanityNamePretty),
 			"✅", "🚫") {
 			return nil
 		}
@@ -94,7 +94,7 @@ func (vi VanityInvite) actionSet(args []string, in *discordgo.Message, out **dis
 	})
 	if err != nil {
 		if errD, ok := err.(*discordgo.RESTError); ok && errD.Message.Code == discordgo.ErrCodeMissingPermissions {
-			*out = vi.newMsg("plugins.vanityinvite.set-error-noinviteperm")
+			*out = vi.newMsg(in, "plugins.vanityinvite.set-error-noinviteperm")
 			return vi.actionFinish
 		}
 	}
@@ -103,17 +103,17 @@ func (vi VanityInvite) actionSet(args []string, in *discordgo.Message, out **dis
 	err = helpers.UpdateOrInsertVanityUrl(args[1], targetChannel.GuildID, targetChannel.ID, in.Author.ID)
 	if err != nil {
 		if strings.Contains(err.Error(), "invalid vanity name") {
-			*out = vi.newMsg("plugins.vanityinvite.set-error-invalidname")
+			*out = vi.newMsg(in, "plugins.vanityinvite.set-error-invalidname")
 			return vi.actionFinish
 		}
 		if strings.Contains(err.Error(), "vanity name already in use") {
-			*out = vi.newMsg("plugins.vanityinvite.set-error-duplicate")
+			*out = vi.newMsg(in, "plugins.vanityinvite.set-error-duplicate")
 			return vi.actionFinish
 		}
 	}
 	helpers.Relax(err)
 
-	*out = vi.newMsg(helpers.GetTextF("plugins.vanityinvite.set-success",
+	*out = vi.newMsg(in, helpers.GetMessageTextF(in, "plugins.vanityinvite.set-success",
 		args[1], targetChannel.ID,
 		helpers.GetConfig().Path("website.vanityurl_domain").Data().(string),
 		args[1],
@@ -125,7 +125,7 @@ func (vi VanityInvite) actionSet(args []string, in *discordgo.Message, out **dis
 // [p]custom-invite
 func (vi VanityInvite) actionStatus(args []string, in *discordgo.Message, out **discordgo.MessageSend) vanityInviteAction {
 	if !helpers.IsMod(in) {
-		*out = vi.newMsg("mod.no_permission")
+		*out = vi.newMsg(in, "mod.no_permission")
 		return vi.actionFinish
 	}
 
@@ -134,11 +134,11 @@ func (vi VanityInvite) actionStatus(args []string, in *discordgo.Message, out **
 
 	vanityInvite, _ := helpers.GetVanityUrlByGuildID(channel.GuildID)
 	if vanityInvite.VanityName == "" {
-		*out = vi.newMsg(helpers.GetTextF("plugins.vanityinvite.status-none"))
+		*out = vi.newMsg(in, helpers.GetMessageTextF(in, "plugins.vanityinvite.status-none"))
 		return vi.actionFinish
 	}
 
-	*out = vi.newMsg(helpers.GetTextF("plugins.vanityinvite.status",
+	*out = vi.newMsg(in, helpers.GetMessageTextF(in, "plugins.vanityinvite.status",
 		vanityInvite.VanityNamePretty, vanityInvite.ChannelID,
 		helpers.GetConfig().Path("website.vanityurl_domain").Data().(string),
 		vanityInvite.VanityNamePretty,
@@ -150,7 +150,7 @@ func (vi VanityInvite) actionStatus(args []string, in *discordgo.Message, out **
 // [p]custom-invite remove
 func (vi VanityInvite) actionRemove(args []string, in *discordgo.Message, out **discordgo.MessageSend) vanityInviteAction {
 	if !helpers.IsAdmin(in) {
-		*out = vi.newMsg("admin.no_permission")
+		*out = vi.newMsg(in, "admin.no_permission")
 		return vi.actionFinish
 	}
 
@@ -159,12 +159,12 @@ func (vi VanityInvite) actionRemove(args []string, in *discordgo.Message, out **
 
 	vanityInvite, _ := helpers.GetVanityUrlByGuildID(channel.GuildID)
 	if vanityInvite.VanityName == "" {
-		*out = vi.newMsg(helpers.GetTextF("plugins.vanityinvite.remove-none"))
+		*out = vi.newMsg(in, helpers.GetMessageTextF(in, "plugins.vanityinvite.remove-none"))
 		return vi.actionFinish
 	}
 
 	if !helpers.ConfirmEmbed(in.ChannelID, in.Author,
-		helpers.GetTextF("plugins.vanityinvite.remove-confirm", vanityInvite.VanityNamePretty),
+		helpers.GetMessageTextF(in, "plugins.vanityinvite.remove-confirm", vanityInvite.VanityNamePretty),
 		"✅", "🚫") {
 		return nil
 	}
@@ -189,14 +189,14 @@ func (vi VanityInvite) actionRemove(args []string, in *discordgo.Message, out **
 		}, false)
 	helpers.RelaxLog(err)
 
-	*out = vi.newMsg(helpers.GetTextF("plugins.vanityinvite.remove-success"))
+	*out = vi.newMsg(in, helpers.GetMessageTextF(in, "plugins.vanityinvite.remove-success"))
 	return vi.actionFinish
 }
 
 // [p]custom-invite set-log <#channel or channel id>
 func (vi VanityInvite) actionSetLog(args []string, in *discordgo.Message, out **discordgo.MessageSend) vanityInviteAction {
 	if !helpers.IsRobyulMod(in.Author.ID) {
-		*out = vi.newMsg("robyulmod.no_permission")
+		*out = vi.newMsg(in, "robyulmod.no_permission")
 		return vi.actionFinish
 	}
 
@@ -213,7 +213,7 @@ func (vi VanityInvite) actionSetLog(args []string, in *discordgo.Message, out **
 		err = helpers.SetBotConfigString(models.VanityInviteLogChannelKey, "")
 	}
 
-	*out = vi.newMsg("plugins.vanityinvite.setlog-success")
+	*out = vi.newMsg(in, "plugins.vanityinvite.setlog-success")
 	return vi.actionFinish
 }
 
@@ -224,8 +224,8 @@ func (vi VanityInvite) actionFinish(args []string, in *discordgo.Message, out **
 	return nil
 }
 
-func (vi VanityInvite) newMsg(content string) *discordgo.MessageSend {
-	return &discordgo.MessageSend{Content: helpers.GetText(content)}
+func (vi VanityInvite) newMsg(in *discordgo.Message, content string) *discordgo.MessageSend {
+	return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 }
 
 func (vi VanityInvite) logger() *logrus.Entry {
@@ -5,17 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/feeds"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/metrics"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
 	humanize "github.com/dustin/go-humanize"
-	"github.com/globalsign/mgo/bson"
 	redisCache "github.com/go-redis/cache"
 )
 
@@ -39,6 +40,10 @@ const (
 	VLiveWorkers                   = 15
 )
 
+var (
+	ErrVliveChannelNotFound = errors.New("vlive channel not found")
+)
+
 type VLive struct{}
 
 func (r *VLive) Commands() []string {
@@ -48,189 +53,158 @@ func (r *VLive) Commands() []string {
 }
 
 func (r *VLive) Init(session *discordgo.Session) {
-	go r.checkVliveFeedsLoop()
-	cache.GetLogger().WithField("module", "vlive").Info("Started vlive loop (0s)")
+	err := feeds.Register(&vliveSource{r: r})
+	if err != nil {
+		cache.GetLogger().WithField("module", "vlive").WithError(err).Error("failed to register the vlive feed source")
+	}
 }
-func (r *VLive) checkVliveFeedsLoop() {
-	var entries []models.VliveEntry
-	var bundledEntries map[string][]models.VliveEntry
 
-	defer helpers.Recover()
-	defer func() {
-		go func() {
-			cache.GetLogger().WithField("module", "vlive").Error("The checkVliveFeedsLoop died. Please investigate! Will be restarted in 60 seconds")
-			time.Sleep(60 * time.Second)
-			r.checkVliveFeedsLoop()
-		}()
-	}()
-
-	for {
-		bundledEntries = make(map[string][]models.VliveEntry, 0)
-
-		err := helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.VliveTable).Find(nil)).All(&entries)
-		helpers.Relax(err)
-
-		for _, entry := range entries {
-			// check if channel exists
-			channel, err := helpers.GetChannelWithoutApi(entry.ChannelID)
-			if err != nil || channel == nil || channel.ID == "" {
-				continue
-			}
+// vliveSource is the feeds.Source for the videos, upcoming videos, lives, notices and celeb posts of V Live channels
+type vliveSource struct {
+	r *VLive
+}
 
-			// check if we can send messages
-			channelPermission, err := cache.GetSession().State.UserChannelPermissions(cache.GetSession().State.User.ID, channel.ID)
-			if err != nil {
-				continue
-			}
+// vliveRawPost is the raw post of a feeds.Post, the channel is needed to render it
+type vliveRawPost struct {
+	channel models.VliveChannelInfo
+	video   models.VliveVideoInfo
+	notice  models.VliveNoticeInfo
+	celeb   models.VliveCelebInfo
+}
 
-			if channelPermission&discordgo.PermissionSendMessages != discordgo.PermissionSendMessages ||
-				channelPermission&discordgo.PermissionEmbedLinks != discordgo.PermissionEmbedLinks {
-				continue
-			}
+func (s *vliveSource) Name() string {
+	return "vlive"
+}
 
-			if _, ok := bundledEntries[entry.VLiveChannel.Code]; ok {
-				bundledEntries[entry.VLiveChannel.Code] = append(bundledEntries[entry.VLiveChannel.Code], entry)
-			} else {
-				bundledEntries[entry.VLiveChannel.Code] = []models.VliveEntry{entry}
-			}
-		}
+func (s *vliveSource) Interval(target string) time.Duration {
+	return time.Minute
+}
 
-		cache.GetLogger().WithField("module", "vlive").Info(fmt.Sprintf("checking %d channels for %d feeds with %d workers", len(bundledEntries), len(entries), VLiveWorkers))
-		start := time.Now()
+func (s *vliveSource) Workers() int {
+	return VLiveWorkers
+}
 
-		jobs := make(chan map[string][]models.VliveEntry, 0)
-		results := make(chan int, 0)
+func (s *vliveSource) FilterOptions() feeds.FilterOptions {
+	return feeds.FilterOptions{Types: []string{"vod", "live", "upcoming", "notice", "celeb"}}
+}
 
-		workerEntries := make(map[int]map[string][]models.VliveEntry, 0)
-		for w := 1; w <= VLiveWorkers; w++ {
-			go r.feedWorker(w, jobs, results)
-			workerEntries[w] = make(map[string][]models.VliveEntry)
-		}
+// Resolve searches the channel by its name, or uses the target as channel code, and returns the channel code
+func (s *vliveSource) Resolve(target string) (normalised, name string, err error) {
+	// try to find channel by search
+	vliveChannelId := ""
+	if len(target) >= 2 {
+		vliveChannelId, err = s.r.getVliveChannelIdFromChannelName(target)
+	}
+	if err != nil || vliveChannelId == "" {
+		vliveChannelId = target
+	}
+	// use input as id instead or use the id from above (if channel found)
+	vliveChannel, err := s.r.getVLiveChannelByVliveChannelId(vliveChannelId)
+	if err != nil || vliveChannel.Code == "" {
+		return "", "", ErrVliveChannelNotFound
+	}
+	return vliveChannel.Code, vliveChannel.Name, nil
+}
 
-		lastWorker := 1
-		for code, codeEntries := range bundledEntries {
-			workerEntries[lastWorker][code] = codeEntries
-			lastWorker++
-			if lastWorker > VLiveWorkers {
-				lastWorker = 1
-			}
-		}
+func (s *vliveSource) Fetch(target string, state map[string]string) (posts []feeds.Post, newState map[string]string, err error) {
+	vliveChannel, err := s.r.getVLiveChannelByVliveChannelId(target)
+	if err != nil {
+		return nil, state, err
+	}
 
-		for _, workerEntry := range workerEntries {
-			jobs <- workerEntry
+	for _, vod := range vliveChannel.VOD {
+		// don't post playlists
+		if vod.Type == "PLAYLIST" {
+			continue
 		}
-		close(jobs)
+		posts = append(posts, s.r.videoToPost(vliveChannel, vod, "vod"))
+	}
+	for _, upcoming := range vliveChannel.Upcoming {
+		posts = append(posts, s.r.videoToPost(vliveChannel, upcoming, "upcoming"))
+	}
+	for _, live := range vliveChannel.Live {
+		posts = append(posts, s.r.videoToPost(vliveChannel, live, "live"))
+	}
+	for _, notice := range vliveChannel.Notices {
+		posts = append(posts, s.r.noticeToPost(vliveChannel, notice))
+	}
+	for _, celeb := range vliveChannel.Celebs {
+		posts = append(posts, s.r.celebToPost(vliveChannel, celeb))
+	}
+	return posts, state, nil
+}
 
-		for a := 1; a <= VLiveWorkers; a++ {
-			<-results
-		}
-		elapsed := time.Since(start)
-		cache.GetLogger().WithField("module", "vlive").Info(fmt.Sprintf("checked %d channels for %d feeds with %d workers, took %s", len(bundledEntries), len(entries), VLiveWorkers, elapsed))
-		metrics.VliveRefreshTime.Set(elapsed.Seconds())
+func (s *vliveSource) Render(entry models.FeedEntry, post feeds.Post) *discordgo.MessageEmbed {
+	raw, ok := post.Raw.(vliveRawPost)
+	if !ok {
+		return feeds.Embed(post)
+	}
 
-		if len(entries) <= 10 {
-			time.Sleep(60 * time.Second)
-		}
+	locale := helpers.GetLocale(entry.GuildID, "")
+	switch post.Type {
+	case "upcoming":
+		return s.r.postEmbed(raw.channel, helpers.GetTextLF(locale, "plugins.vlive.channel-embed-title-upcoming", raw.channel.Name, raw.video.Date),
+			raw.channel.Url, fmt.Sprintf("**%s**", raw.video.Title), raw.video.Thumbnail)
+	case "live":
+		return s.r.postEmbed(raw.channel, helpers.GetTextLF(locale, "plugins.vlive.channel-embed-title-live", raw.channel.Name),
+			raw.video.Url, fmt.Sprintf("**%s**", raw.video.Title), raw.video.Thumbnail)
+	case "notice":
+		return s.r.postEmbed(raw.channel, helpers.GetTextLF(locale, "plugins.vlive.channel-embed-title-notice", raw.channel.Name),
+			raw.notice.Url, fmt.Sprintf("**%s**", raw.notice.Title), raw.notice.ImageUrl)
+	case "celeb":
+		return s.r.postEmbed(raw.channel, helpers.GetTextLF(locale, "plugins.vlive.channel-embed-title-celeb", raw.channel.Name),
+			raw.celeb.Url, fmt.Sprintf("%s ...", raw.celeb.Summary), "")
 	}
+	return s.r.postEmbed(raw.channel, helpers.GetTextLF(locale, "plugins.vlive.channel-embed-title-vod", raw.channel.Name),
+		raw.video.Url, fmt.Sprintf("**%s**", raw.video.Title), raw.video.Thumbnail)
 }
 
-func (r *VLive) feedWorker(id int, jobs <-chan map[string][]models.VliveEntry, results chan<- int) {
-	defer helpers.Recover()
+// videoToPost converts a video for the feeds framework, postType is vod, upcoming or live
+// the IDs are prefixed with the type, a video is posted again when it goes from upcoming to live
+func (r *VLive) videoToPost(vliveChannel models.VliveChannelInfo, video models.VliveVideoInfo, postType string) feeds.Post {
+	post := feeds.Post{
+		ID:       postType + "-" + strconv.FormatInt(video.Seq, 10),
+		URL:      video.Url,
+		Title:    video.Title,
+		Author:   vliveChannel.Name,
+		VideoURL: video.Url,
+		Type:     postType,
+		Raw:      vliveRawPost{channel: vliveChannel, video: video},
+	}
+	// upcoming videos have no page yet
+	if post.URL == "" {
+		post.URL = vliveChannel.Url
+	}
+	return post
+}
 
-	for job := range jobs {
-		//cache.GetLogger().WithField("module", "vlive").WithField("worker", id).Infof("worker %d started for %d channels", id, len(job))
-		for channelCode, entries := range job {
-			//cache.GetLogger().WithField("module", "vlive").WithField("worker", id).Info(fmt.Sprintf("checking V Live Channel %s for %d channels", entries[0].VLiveChannel.Name, len(entries)))
-			updatedVliveChannel, err := r.getVLiveChannelByVliveChannelId(channelCode)
-			if err != nil {
-				cache.GetLogger().WithField("module", "vlive").WithField("worker", id).Warnf("updating vlive channel %s failed: %s", channelCode, err.Error())
-				continue
-			}
-			for _, entry := range entries {
-				changes := false
+// noticeToPost converts a notice for the feeds framework
+func (r *VLive) noticeToPost(vliveChannel models.VliveChannelInfo, notice models.VliveNoticeInfo) (post feeds.Post) {
+	post = feeds.Post{
+		ID:     "notice-" + strconv.FormatInt(notice.Number, 10),
+		URL:    notice.Url,
+		Title:  notice.Title,
+		Text:   notice.Summary,
+		Author: vliveChannel.Name,
+		Type:   "notice",
+		Raw:    vliveRawPost{channel: vliveChannel, notice: notice},
+	}
+	if notice.ImageUrl != "" {
+		post.ImageURLs = []string{notice.ImageUrl}
+	}
+	return post
+}
 
-				for _, vod := range updatedVliveChannel.VOD {
-					// don't post playlists
-					if vod.Type == "PLAYLIST" {
-						continue
-					}
-					videoAlreadyPosted := false
-					for _, postedVod := range entry.PostedVOD {
-						if postedVod.Seq == vod.Seq {
-							videoAlreadyPosted = true
-						}
-					}
-					if videoAlreadyPosted == false {
-						// cache.GetLogger().WithField("module", "vlive").WithField("worker", id).Info(fmt.Sprintf("Posting VOD: #%d", vod.Seq))
-						entry.PostedVOD = append(entry.PostedVOD, vod)
-						changes = true
-						go r.postVodToChannel(entry, vod, updatedVliveChannel)
-					}
-				}
-				for _, upcoming := range updatedVliveChannel.Upcoming {
-					videoAlreadyPosted := false
-					for _, postedUpcoming := range entry.PostedUpcoming {
-						if postedUpcoming.Seq == upcoming.Seq {
-							videoAlreadyPosted = true
-						}
-					}
-					if videoAlreadyPosted == false {
-						// cache.GetLogger().WithField("module", "vlive").WithField("worker", id).Info(fmt.Sprintf("Posting Upcoming: #%d", upcoming.Seq))
-						entry.PostedUpcoming = append(entry.PostedUpcoming, upcoming)
-						changes = true
-						go r.postUpcomingToChannel(entry, upcoming, updatedVliveChannel)
-					}
-				}
-				for _, live := range updatedVliveChannel.Live {
-					videoAlreadyPosted := false
-					for _, postedLive := range entry.PostedLive {
-						if postedLive.Seq == live.Seq {
-							videoAlreadyPosted = true
-						}
-					}
-					if videoAlreadyPosted == false {
-						// cache.GetLogger().WithField("module", "vlive").WithField("worker", id).Info(fmt.Sprintf("Posting Live: #%d", live.Seq))
-						entry.PostedLive = append(entry.PostedLive, live)
-						changes = true
-						go r.postLiveToChannel(entry, live, updatedVliveChannel)
-					}
-				}
-				for _, notice := range updatedVliveChannel.Notices {
-					noticeAlreadyPosted := false
-					for _, postedNotice := range entry.PostedNotices {
-						if postedNotice.Number == notice.Number {
-							noticeAlreadyPosted = true
-						}
-					}
-					if noticeAlreadyPosted == false {
-						// cache.GetLogger().WithField("module", "vlive").WithField("worker", id).Info(fmt.Sprintf("Posting Notice: #%d", notice.Number))
-						entry.PostedNotices = append(entry.PostedNotices, notice)
-						changes = true
-						go r.postNoticeToChannel(entry, notice, updatedVliveChannel)
-					}
-				}
-				for _, celeb := range updatedVliveChannel.Celebs {
-					celebAlreadyPosted := false
-					for _, postedCeleb := range entry.PostedCelebs {
-						if postedCeleb.ID == celeb.ID {
-							celebAlreadyPosted = true
-						}
-					}
-					if celebAlreadyPosted == false {
-						// cache.GetLogger().WithField("module", "vlive").WithField("worker", id).Info(fmt.Sprintf("Posting Celeb: #%s", celeb.ID))
-						entry.PostedCelebs = append(entry.PostedCelebs, celeb)
-						changes = true
-						go r.postCelebToChannel(entry, celeb, updatedVliveChannel)
-					}
-				}
-				if changes == true {
-					err = helpers.MDbUpdateWithoutLogging(models.VliveTable, entry.ID, entry)
-					helpers.Relax(err)
-				}
-			}
-		}
+// celebToPost converts a celeb post for the feeds framework
+func (r *VLive) celebToPost(vliveChannel models.VliveChannelInfo, celeb models.VliveCelebInfo) feeds.Post {
+	return feeds.Post{
+		ID:     "celeb-" + celeb.ID,
+		URL:    celeb.Url,
+		Text:   celeb.Summary,
+		Author: vliveChannel.Name,
+		Type:   "celeb",
+		Raw:    vliveRawPost{channel: vliveChannel, celeb: celeb},
 	}
-	results <- len(jobs)
 }
 
 func (r *VLive) Action(command string, content string, msg *discordgo.Message, session *discordgo.Session) {
@@ -251,11 +225,11 @@ func (r *VLive) Action(command string, content string, msg *discordgo.Message, s
 				if len(args) >= 3 {
 					targetChannel, err = helpers.GetChannelFromMention(msg, args[2])
 					if err != nil {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 					return
 				}
 				targetGuild, err = helpers.GetGuild(targetChannel.GuildID)
@@ -284,71 +258,34 @@ func (r *VLive) Action(command string, content string, msg *discordgo.Message, s
 						}
 					}
 					if mentionRole.ID == "" {
-						helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 						return
 					}
 				}
-				// try to find channel by search
-				vliveChannelId := ""
-				if len(args[1]) >= 2 {
-					vliveChannelId, err = r.getVliveChannelIdFromChannelName(args[1])
-				}
-				if err != nil || vliveChannelId == "" {
-					vliveChannelId = args[1]
+				entry, err := VliveAddFeed(models.FeedEntry{
+					GuildID:       targetChannel.GuildID,
+					ChannelID:     targetChannel.ID,
+					AddedByUserID: msg.Author.ID,
+					MentionRoleID: mentionRole.ID,
+					PostMode:      models.FeedPostModeEmbed,
+				}, args[1])
+				if err == ErrVliveChannelNotFound {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.vlive.channel-not-found"))
+					return
 				}
-				// use input as id instead or use the id from above (if channel found)
-				vliveChannel, err := r.getVLiveChannelByVliveChannelId(vliveChannelId)
-				if err != nil {
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.vlive.channel-not-found"))
+				if err == feeds.ErrAlreadyAdded {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.feeds.add-already-added", targetChannel.ID))
 					return
 				}
-				// create new entry in db
-				newID, err := helpers.MDbInsert(models.VliveTable, models.VliveEntry{
-					GuildID:        targetChannel.GuildID,
-					ChannelID:      targetChannel.ID,
-					VLiveChannel:   vliveChannel,
-					PostedVOD:      vliveChannel.VOD,
-					PostedUpcoming: vliveChannel.Upcoming,
-					PostedLive:     vliveChannel.Live,
-					PostedNotices:  vliveChannel.Notices,
-					PostedCelebs:   vliveChannel.Celebs,
-					MentionRoleID:  mentionRole.ID,
-				})
 				helpers.Relax(err)
 
-				_, err = helpers.EventlogLog(time.Now(), targetChannel.GuildID, helpers.MdbIdToHuman(newID),
-					models.EventlogTargetTypeRobyulVliveFeed, msg.Author.ID,
-					models.EventlogTypeRobyulVliveFeedAdd, "",
-					nil,
-					[]models.ElasticEventlogOption{
-						{
-							Key:   "vlive_feed_channelid",
-							Value: targetChannel.ID,
-							Type:  models.EventlogTargetTypeChannel,
-						},
-						{
-							Key:   "vlive_feed_vlivechannel_name",
-							Value: vliveChannel.Name,
-						},
-						{
-							Key:   "vlive_feed_vlivechannel_code",
-							Value: vliveChannel.Code,
-						},
-						{
-							Key:   "vlive_feed_mentionroleid",
-							Value: mentionRole.ID,
-							Type:  models.EventlogTargetTypeRole,
-						},
-					}, false)
-				helpers.RelaxLog(err)
-
-				successMessage := helpers.GetTextF("plugins.vlive.channel-added-success", vliveChannel.Name, targetChannel.ID)
+				successMessage := helpers.GetMessageTextF(msg, "plugins.vlive.channel-added-success", entry.TargetName, targetChannel.ID)
 				if mentionRole.ID != "" {
-					successMessage += helpers.GetTextF("plugins.vlive.channel-added-success-additional-role", mentionRole.Name)
+					successMessage += helpers.GetMessageTextF(msg, "plugins.vlive.channel-added-success-additional-role", mentionRole.Name)
 				}
 				helpers.SendMessage(msg.ChannelID, successMessage)
 				cache.GetLogger().WithField("module", "vlive").Info(fmt.Sprintf("Added V Live Channel %s (%s) to Channel %s (#%s) on Guild %s (#%s) Mention @%s (#%s)",
-					vliveChannel.Name, vliveChannel.Code, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID,
+					entry.TargetName, entry.Target, targetChannel.Name, targetChannel.ID, targetGuild.Name, targetGuild.ID,
 					mentionRole.Name, mentionRole.ID))
 			})
 		case "delete", "del", "remove": // [p]vlive delete <id>
@@ -361,62 +298,141 @@ func (r *VLive) Action(command string, content string, msg *discordgo.Message, s
 
 					entryId := args[1]
 
-					var entryBucket models.VliveEntry
-					err = helpers.MdbOne(
-						helpers.MdbCollection(models.VliveTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(entryId)}),
-						&entryBucket,
-					)
-					if helpers.IsMdbNotFound(err) {
-						helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.vlive.channel-delete-not-found-error"))
+					entryBucket, err := feeds.Get(channel.GuildID, entryId)
+					if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "vlive") {
+						helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.vlive.channel-delete-not-found-error"))
 						return
 					}
 					helpers.Relax(err)
 
-					err = helpers.MDbDelete(models.VliveTable, entryBucket.ID)
+					err = VliveRemoveFeed(entryBucket, msg.Author.ID)
 					helpers.Relax(err)
 
-					_, err = helpers.EventlogLog(time.Now(), channel.GuildID, entryId,
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.vlive.channel-delete-success", entryBucket.TargetName))
+					cache.GetLogger().WithField("module", "vlive").Info(fmt.Sprintf("Deleted V Live Channel %s (%s)", entryBucket.TargetName, entryBucket.Target))
+				} else {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+			})
+		case "filter": // [p]vlive filter <id> [<setting> [<value>]]
+			helpers.RequireMod(msg, func() {
+				if len(args) < 2 {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+
+				channel, err := helpers.GetChannel(msg.ChannelID)
+				helpers.Relax(err)
+
+				entryBucket, err := feeds.Get(channel.GuildID, args[1])
+				if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "vlive") {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.vlive.channel-delete-not-found-error"))
+					return
+				}
+				helpers.Relax(err)
+
+				beforeValue := feeds.DescribeFilter(entryBucket.Filter)
+				reply, changed := feeds.FilterCommand(helpers.GetMessageLocale(msg), &entryBucket.Filter,
+					feeds.SourceFilterOptions("vlive"), entryBucket.TargetName, args[2:])
+				if changed {
+					err = feeds.Update(entryBucket)
+					helpers.Relax(err)
+
+					_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
 						models.EventlogTargetTypeRobyulVliveFeed, msg.Author.ID,
-						models.EventlogTypeRobyulVliveFeedRemove, "",
-						nil,
+						models.EventlogTypeRobyulVliveFeedUpdate, "",
+						[]models.ElasticEventlogChange{
+							{
+								Key:      "vlive_feed_filter",
+								OldValue: beforeValue,
+								NewValue: feeds.DescribeFilter(entryBucket.Filter),
+							},
+						},
 						[]models.ElasticEventlogOption{
 							{
 								Key:   "vlive_feed_channelid",
-								Value: helpers.MdbIdToHuman(entryBucket.ID),
+								Value: entryBucket.ChannelID,
 								Type:  models.EventlogTargetTypeChannel,
 							},
 							{
 								Key:   "vlive_feed_vlivechannel_name",
-								Value: entryBucket.VLiveChannel.Name,
+								Value: entryBucket.TargetName,
 							},
 							{
 								Key:   "vlive_feed_vlivechannel_code",
-								Value: entryBucket.VLiveChannel.Code,
-							},
-							{
-								Key:   "vlive_feed_mentionroleid",
-								Value: entryBucket.MentionRoleID,
-								Type:  models.EventlogTargetTypeRole,
+								Value: entryBucket.Target,
 							},
 						}, false)
 					helpers.RelaxLog(err)
+				}
 
-					helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.vlive.channel-delete-success", entryBucket.VLiveChannel.Name))
-					cache.GetLogger().WithField("module", "vlive").Info(fmt.Sprintf("Deleted V Live Channel %s (%s)", entryBucket.VLiveChannel.Name, entryBucket.VLiveChannel.Code))
-				} else {
-					helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.too-few"))
+				_, err = helpers.SendMessage(msg.ChannelID, reply)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
+			})
+		case "template": // [p]vlive template <id> [<template>|reset]
+			helpers.RequireMod(msg, func() {
+				if len(args) < 2 {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.too-few"))
+					return
+				}
+
+				channel, err := helpers.GetChannel(msg.ChannelID)
+				helpers.Relax(err)
+
+				entryBucket, err := feeds.Get(channel.GuildID, args[1])
+				if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "vlive") {
+					helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.vlive.channel-delete-not-found-error"))
 					return
 				}
+				helpers.Relax(err)
+
+				beforeValue := entryBucket.Template
+				reply, changed := feeds.TemplateCommand(helpers.GetMessageLocale(msg), &entryBucket.Template,
+					entryBucket.TargetName, strings.Join(args[2:], " "))
+				if changed {
+					err = feeds.Update(entryBucket)
+					helpers.Relax(err)
+
+					_, err = helpers.EventlogLog(time.Now(), channel.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
+						models.EventlogTargetTypeRobyulVliveFeed, msg.Author.ID,
+						models.EventlogTypeRobyulVliveFeedUpdate, "",
+						[]models.ElasticEventlogChange{
+							{
+								Key:      "vlive_feed_template",
+								OldValue: beforeValue,
+								NewValue: entryBucket.Template,
+							},
+						},
+						[]models.ElasticEventlogOption{
+							{
+								Key:   "vlive_feed_channelid",
+								Value: entryBucket.ChannelID,
+								Type:  models.EventlogTargetTypeChannel,
+							},
+							{
+								Key:   "vlive_feed_vlivechannel_name",
+								Value: entryBucket.TargetName,
+							},
+							{
+								Key:   "vlive_feed_vlivechannel_code",
+								Value: entryBucket.Target,
+							},
+						}, false)
+					helpers.RelaxLog(err)
+				}
+
+				_, err = helpers.SendMessage(msg.ChannelID, reply)
+				helpers.RelaxMessage(err, msg.ChannelID, msg.ID)
 			})
 		case "list": // [p]vlive list
 			currentChannel, err := helpers.GetChannel(msg.ChannelID)
 			helpers.Relax(err)
-			var entryBucket []models.VliveEntry
-			err = helpers.MDbIter(helpers.MdbCollection(models.VliveTable).Find(bson.M{"guildid": currentChannel.GuildID})).All(&entryBucket)
+			entryBucket, err := feeds.GetAll(currentChannel.GuildID, "vlive")
 			helpers.Relax(err)
 
 			if entryBucket == nil || len(entryBucket) <= 0 {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.vlive.channel-list-no-channels-error"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.vlive.channel-list-no-channels-error"))
 				return
 			}
 
@@ -428,7 +444,10 @@ func (r *VLive) Action(command string, content string, msg *discordgo.Message, s
 					helpers.Relax(err)
 					mentionText += fmt.Sprintf(" mentioning `@%s`", role.Name)
 				}
-				resultMessage += fmt.Sprintf("`%s`: V Live Channel `%s` posting to <#%s>%s\n", helpers.MdbIdToHuman(entry.ID), entry.VLiveChannel.Name, entry.ChannelID, mentionText)
+				if entry.Template != "" {
+					mentionText += " using a template"
+				}
+				resultMessage += fmt.Sprintf("`%s`: V Live Channel `%s` posting to <#%s>%s\n", helpers.MdbIdToHuman(entry.ID), entry.TargetName, entry.ChannelID, mentionText)
 			}
 			resultMessage += fmt.Sprintf("Found **%d** V Live Channels in total.", len(entryBucket))
 			for _, resultPage := range helpers.Pagify(resultMessage, "\n") {
@@ -449,16 +468,16 @@ func (r *VLive) Action(command string, content string, msg *discordgo.Message, s
 			// use input as id instead or use the id from above (if channel found)
 			vliveChannel, err := r.getVLiveChannelByVliveChannelId(vliveChannelId)
 			if err != nil || vliveChannel.Name == "" {
-				helpers.SendMessage(msg.ChannelID, helpers.GetTextF("plugins.vlive.channel-not-found"))
+				helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "plugins.vlive.channel-not-found"))
 				return
 			}
 			channelEmbed := &discordgo.MessageEmbed{
-				Title:     helpers.GetTextF("plugins.vlive.channel-embed-title", vliveChannel.Name),
+				Title:     helpers.GetMessageTextF(msg, "plugins.vlive.channel-embed-title", vliveChannel.Name),
 				URL:       vliveChannel.Url,
 				Thumbnail: &discordgo.MessageEmbedThumbnail{URL: vliveChannel.ProfileImgUrl},
 				Footer: &discordgo.MessageEmbedFooter{
-					Text:    helpers.GetText("plugins.vlive.embed-footer"),
-					IconURL: helpers.GetText("plugins.vlive.embed-footer-imageurl"),
+					Text:    helpers.GetMessageText(msg, "plugins.vlive.embed-footer"),
+					IconURL: helpers.GetMessageText(msg, "plugins.vlive.embed-footer-imageurl"),
 				},
 				Fields: []*discordgo.MessageEmbedField{
 					{Name: "Followers", Value: humanize.Comma(vliveChannel.Followers), Inline: true},
@@ -467,14 +486,14 @@ func (r *VLive) Action(command string, content string, msg *discordgo.Message, s
 			}
 			if len(vliveChannel.Live) > 0 {
 				channelEmbed.Fields = append(channelEmbed.Fields, &discordgo.MessageEmbedField{
-					Name:   helpers.GetTextF("plugins.vlive.channel-embed-name-live", vliveChannel.Live[0].Date),
+					Name:   helpers.GetMessageTextF(msg, "plugins.vlive.channel-embed-name-live", vliveChannel.Live[0].Date),
 					Value:  fmt.Sprintf("**%s**\n%s", vliveChannel.Live[0].Title, vliveChannel.Live[0].Url),
 					Inline: false,
 				})
 				channelEmbed.Image = &discordgo.MessageEmbedImage{URL: vliveChannel.Live[0].Thumbnail}
 			} else if len(vliveChannel.VOD) > 0 {
 				channelEmbed.Fields = append(channelEmbed.Fields, &discordgo.MessageEmbedField{
-					Name:   helpers.GetTextF("plugins.vlive.channel-embed-name-vod", vliveChannel.VOD[0].Date),
+					Name:   helpers.GetMessageTextF(msg, "plugins.vlive.channel-embed-name-vod", vliveChannel.VOD[0].Date),
 					Value:  fmt.Sprintf("**%s**\n**Plays:** %s\n**Likes:** %s\n%s", vliveChannel.VOD[0].Title, humanize.Comma(vliveChannel.VOD[0].Plays), humanize.Comma(vliveChannel.VOD[0].Likes), vliveChannel.VOD[0].Url),
 					Inline: false,
 				})
@@ -482,7 +501,7 @@ func (r *VLive) Action(command string, content string, msg *discordgo.Message, s
 			}
 			if len(vliveChannel.Upcoming) > 0 {
 				channelEmbed.Fields = append(channelEmbed.Fields, &discordgo.MessageEmbedField{
-					Name:   helpers.GetTextF("plugins.vlive.channel-embed-name-upcoming", vliveChannel.Upcoming[0].Date),
+					Name:   helpers.GetMessageTextF(msg, "plugins.vlive.channel-embed-name-upcoming", vliveChannel.Upcoming[0].Date),
 					Value:  fmt.Sprintf("**%s**", vliveChannel.Upcoming[0].Title),
 					Inline: false,
 				})
@@ -496,10 +515,82 @@ func (r *VLive) Action(command string, content string, msg *discordgo.Message, s
 			return
 		}
 	} else {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 	}
 }
 
+// VliveAddFeed adds a V Live channel feed, used by the vlive command and the REST API
+func VliveAddFeed(entry models.FeedEntry, vliveChannel string) (models.FeedEntry, error) {
+	entry, err := feeds.AddEntry("vlive", vliveChannel, entry)
+	if err != nil {
+		return entry, err
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulVliveFeed, entry.AddedByUserID,
+		models.EventlogTypeRobyulVliveFeedAdd, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "vlive_feed_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "vlive_feed_vlivechannel_name",
+				Value: entry.TargetName,
+			},
+			{
+				Key:   "vlive_feed_vlivechannel_code",
+				Value: entry.Target,
+			},
+			{
+				Key:   "vlive_feed_mentionroleid",
+				Value: entry.MentionRoleID,
+				Type:  models.EventlogTargetTypeRole,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return entry, nil
+}
+
+// VliveRemoveFeed removes a V Live feed, used by the vlive command and the REST API
+func VliveRemoveFeed(entry models.FeedEntry, userID string) (err error) {
+	err = feeds.Remove(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulVliveFeed, userID,
+		models.EventlogTypeRobyulVliveFeedRemove, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "vlive_feed_channelid",
+				Value: helpers.MdbIdToHuman(entry.ID),
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "vlive_feed_vlivechannel_name",
+				Value: entry.TargetName,
+			},
+			{
+				Key:   "vlive_feed_vlivechannel_code",
+				Value: entry.Target,
+			},
+			{
+				Key:   "vlive_feed_mentionroleid",
+				Value: entry.MentionRoleID,
+				Type:  models.EventlogTargetTypeRole,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return nil
+}
+
 func (r *VLive) getVliveChannelIdFromChannelName(channelSearchName string) (string, error) {
 	friendlySearch := fmt.Sprintf(VliveFriendlySearch, channelSearchName)
 	doc, err := goquery.NewDocument(friendlySearch)
@@ -690,132 +781,21 @@ func (r *VLive) getVLiveChannelByVliveChannelId(channelId string) (models.VliveC
 	return vliveChannel, nil
 }
 
-func (r *VLive) postVodToChannel(entry models.VliveEntry, vod models.VliveVideoInfo, vliveChannel models.VliveChannelInfo) {
-	channelEmbed := &discordgo.MessageEmbed{
-		Title:     helpers.GetTextF("plugins.vlive.channel-embed-title-vod", vliveChannel.Name),
-		URL:       vod.Url,
-		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: vliveChannel.ProfileImgUrl},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.vlive.embed-footer"),
-			IconURL: helpers.GetText("plugins.vlive.embed-footer-imageurl"),
-		},
-		Description: fmt.Sprintf("**%s**", vod.Title),
-		Image:       &discordgo.MessageEmbedImage{URL: vod.Thumbnail},
-		Color:       helpers.GetDiscordColorFromHex(vliveChannel.Color),
-	}
-	mentionText := ""
-	if entry.MentionRoleID != "" {
-		mentionText = fmt.Sprintf("<@&%s>\n", entry.MentionRoleID)
-	}
-	helpers.SendComplex(entry.ChannelID, &discordgo.MessageSend{
-		Content: mentionText + fmt.Sprintf("<%s>", vod.Url),
-		Embed:   channelEmbed,
-	})
-	// if err != nil {
-	//	 cache.GetLogger().WithField("module", "vlive").Warnf("posting vod: #%d to channel: #%s failed: %s", vod.Seq, entry.ChannelID, err)
-	// }
-}
-
-func (r *VLive) postUpcomingToChannel(entry models.VliveEntry, vod models.VliveVideoInfo, vliveChannel models.VliveChannelInfo) {
-	channelEmbed := &discordgo.MessageEmbed{
-		Title:     helpers.GetTextF("plugins.vlive.channel-embed-title-upcoming", vliveChannel.Name, vod.Date),
-		URL:       vliveChannel.Url,
-		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: vliveChannel.ProfileImgUrl},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.vlive.embed-footer"),
-			IconURL: helpers.GetText("plugins.vlive.embed-footer-imageurl"),
-		},
-		Description: fmt.Sprintf("**%s**", vod.Title),
-		Image:       &discordgo.MessageEmbedImage{URL: vod.Thumbnail},
-		Color:       helpers.GetDiscordColorFromHex(vliveChannel.Color),
-	}
-	mentionText := ""
-	if entry.MentionRoleID != "" {
-		mentionText = fmt.Sprintf("<@&%s>\n", entry.MentionRoleID)
-	}
-	postText := fmt.Sprintf("<%s>", vliveChannel.Url)
-	helpers.SendComplex(entry.ChannelID, &discordgo.MessageSend{
-		Content: mentionText + postText,
-		Embed:   channelEmbed,
-	})
-	// if err != nil {
-	// 	cache.GetLogger().WithField("module", "vlive").Warnf("posting upcoming: #%d to channel: #%s failed: %s", vod.Seq, entry.ChannelID, err)
-	// }
-}
-
-func (r *VLive) postLiveToChannel(entry models.VliveEntry, vod models.VliveVideoInfo, vliveChannel models.VliveChannelInfo) {
+// postEmbed returns the embed of a post of the channel
+func (r *VLive) postEmbed(vliveChannel models.VliveChannelInfo, title, url, description, imageURL string) *discordgo.MessageEmbed {
 	channelEmbed := &discordgo.MessageEmbed{
-		Title:     helpers.GetTextF("plugins.vlive.channel-embed-title-live", vliveChannel.Name),
-		URL:       vod.Url,
+		Title:     title,
+		URL:       url,
 		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: vliveChannel.ProfileImgUrl},
 		Footer: &discordgo.MessageEmbedFooter{
 			Text:    helpers.GetText("plugins.vlive.embed-footer"),
 			IconURL: helpers.GetText("plugins.vlive.embed-footer-imageurl"),
 		},
-		Description: fmt.Sprintf("**%s**", vod.Title),
-		Image:       &discordgo.MessageEmbedImage{URL: vod.Thumbnail},
+		Description: description,
 		Color:       helpers.GetDiscordColorFromHex(vliveChannel.Color),
 	}
-	mentionText := ""
-	if entry.MentionRoleID != "" {
-		mentionText = fmt.Sprintf("<@&%s>\n", entry.MentionRoleID)
+	if imageURL != "" {
+		channelEmbed.Image = &discordgo.MessageEmbedImage{URL: imageURL}
 	}
-	helpers.SendComplex(entry.ChannelID, &discordgo.MessageSend{
-		Content: mentionText + fmt.Sprintf("<%s>", vod.Url),
-		Embed:   channelEmbed,
-	})
-	// if err != nil {
-	// 	cache.GetLogger().WithField("module", "vlive").Warnf("posting live: #%d to channel: #%s failed: %s", vod.Seq, entry.ChannelID, err)
-	// }
-}
-
-func (r *VLive) postNoticeToChannel(entry models.VliveEntry, notice models.VliveNoticeInfo, vliveChannel models.VliveChannelInfo) {
-	channelEmbed := &discordgo.MessageEmbed{
-		Title:     helpers.GetTextF("plugins.vlive.channel-embed-title-notice", vliveChannel.Name),
-		URL:       notice.Url,
-		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: vliveChannel.ProfileImgUrl},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.vlive.embed-footer"),
-			IconURL: helpers.GetText("plugins.vlive.embed-footer-imageurl"),
-		},
-		Description: fmt.Sprintf("**%s**", notice.Title),
-		Image:       &discordgo.MessageEmbedImage{URL: notice.ImageUrl},
-		Color:       helpers.GetDiscordColorFromHex(vliveChannel.Color),
-	}
-	mentionText := ""
-	if entry.MentionRoleID != "" {
-		mentionText = fmt.Sprintf("<@&%s>\n", entry.MentionRoleID)
-	}
-	helpers.SendComplex(entry.ChannelID, &discordgo.MessageSend{
-		Content: mentionText + fmt.Sprintf("<%s>", notice.Url),
-		Embed:   channelEmbed,
-	})
-	// if err != nil {
-	// 	cache.GetLogger().WithField("module", "vlive").Warnf("posting notice: #%d to channel: #%s failed: %s", notice.Number, entry.ChannelID, err)
-	// }
-}
-
-func (r *VLive) postCelebToChannel(entry models.VliveEntry, celeb models.VliveCelebInfo, vliveChannel models.VliveChannelInfo) {
-	channelEmbed := &discordgo.MessageEmbed{
-		Title:     helpers.GetTextF("plugins.vlive.channel-embed-title-celeb", vliveChannel.Name),
-		URL:       celeb.Url,
-		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: vliveChannel.ProfileImgUrl},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.vlive.embed-footer"),
-			IconURL: helpers.GetText("plugins.vlive.embed-footer-imageurl"),
-		},
-		Description: fmt.Sprintf("%s ...", celeb.Summary),
-		Color:       helpers.GetDiscordColorFromHex(vliveChannel.Color),
-	}
-	mentionText := ""
-	if entry.MentionRoleID != "" {
-		mentionText = fmt.Sprintf("<@&%s>\n", entry.MentionRoleID)
-	}
-	helpers.SendComplex(entry.ChannelID, &discordgo.MessageSend{
-		Content: mentionText + fmt.Sprintf("<%s>", celeb.Url),
-		Embed:   channelEmbed,
-	})
-	// if err != nil {
-	// 	cache.GetLogger().WithField("module", "vlive").Warnf("posting celeb: #%s to channel: #%s failed: %s", celeb.ID, entry.ChannelID, err)
-	// }
+	return channelEmbed
 }
@@ -60,7 +60,7 @@ func (w *Weather) Action(command string, content string, msg *discordgo.Message,
 			addressResult = entryBucket.Text
 		}
 		if latResult == 0 && lngResult == 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
@@ -74,7 +74,7 @@ func (w *Weather) Action(command string, content string, msg *discordgo.Message,
 		locationChildren, err := geocodingResult.Path("results").Children()
 		helpers.Relax(err)
 		if geocodingResult.Path("status").Data().(string) != "OK" || len(locationChildren) <= 0 {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.weather.address-not-found"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.weather.address-not-found"))
 			return
 		}
 
@@ -85,7 +85,7 @@ func (w *Weather) Action(command string, content string, msg *discordgo.Message,
 		}
 
 		if addressResult == "" {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.weather.address-not-found"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.weather.address-not-found"))
 			return
 		}
 	}
@@ -104,7 +104,7 @@ func (w *Weather) Action(command string, content string, msg *discordgo.Message,
 	helpers.Relax(err)
 
 	if darkSkyForecast.Currently.Summary == "" {
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.weather.no-weather"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.weather.no-weather"))
 		return
 	}
 
@@ -122,19 +122,19 @@ func (w *Weather) Action(command string, content string, msg *discordgo.Message,
 	}()
 
 	weatherEmbed := &discordgo.MessageEmbed{
-		Title: helpers.GetTextF("plugins.weather.weather-embed-title", addressResult),
+		Title: helpers.GetMessageTextF(msg, "plugins.weather.weather-embed-title", addressResult),
 		URL: fmt.Sprintf(darkSkyFriendlyForecast,
 			strconv.FormatFloat(latResult, 'f', -1, 64),
 			strconv.FormatFloat(lngResult, 'f', -1, 64)),
 		//Thumbnail: &discordgo.MessageEmbedThumbnail{URL: fmt.Sprintf(weatherIconsBaseUrl, darkSkyForecast.Currently.Icon)},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text:    helpers.GetText("plugins.weather.embed-footer"),
-			IconURL: helpers.GetText("plugins.weather.embed-footer-imageurl"),
+			Text:    helpers.GetMessageText(msg, "plugins.weather.embed-footer"),
+			IconURL: helpers.GetMessageText(msg, "plugins.weather.embed-footer-imageurl"),
 		},
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name: "Currently",
-				Value: helpers.GetTextF("plugins.weather.current-weather-description",
+				Value: helpers.GetMessageTextF(msg, "plugins.weather.current-weather-description",
 					w.getWeatherEmoji(darkSkyForecast.Currently.Icon),
 					darkSkyForecast.Currently.Summary,
 					strconv.FormatFloat(float64(darkSkyForecast.Currently.Temperature), 'f', 1, 64),
@@ -148,7 +148,7 @@ func (w *Weather) Action(command string, content string, msg *discordgo.Message,
 				Inline: false,
 			},
 			{
-				Name:   helpers.GetText("plugins.weather.week-title"),
+				Name:   helpers.GetMessageText(msg, "plugins.weather.week-title"),
 				Value:  w.getWeatherEmoji(darkSkyForecast.Daily.Icon) + " " + darkSkyForecast.Daily.Summary,
 				Inline: false,
 			},
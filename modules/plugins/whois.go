@@ -29,14 +29,14 @@ func (w *Whois) Action(command string, content string, msg *discordgo.Message, s
 	args := strings.Fields(content)
 
 	if len(args) < 1 {
-		helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.too-few"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.too-few"))
 		return
 	}
 
 	request, err := whois.NewRequest(args[0])
 	if err != nil {
 		if strings.Contains(err.Error(), "no public zone found for") {
-			helpers.SendMessage(msg.ChannelID, helpers.GetTextF("bot.arguments.invalid"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageTextF(msg, "bot.arguments.invalid"))
 			return
 		}
 	}
@@ -76,7 +76,7 @@ func (m *WolframAlpha) Action(command string, content string, msg *discordgo.Mes
 		buf := new(bytes.Buffer)
 		buf.ReadFrom(image)
 		if strings.Contains(buf.String(), "Wolfram|Alpha did not understand your input") {
-			helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.wolframalpha.error"))
+			helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.wolframalpha.error"))
 			return
 		}
 
@@ -96,7 +96,7 @@ func (m *WolframAlpha) Action(command string, content string, msg *discordgo.Mes
 
 	if res == "" || res == "Wolfram|Alpha did not understand your input" {
 		quitChannel <- 0
-		helpers.SendMessage(msg.ChannelID, helpers.GetText("plugins.wolframalpha.error"))
+		helpers.SendMessage(msg.ChannelID, helpers.GetMessageText(msg, "plugins.wolframalpha.error"))
 		return
 	}
 
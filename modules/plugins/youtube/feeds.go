@@ -1,24 +1,35 @@
 package youtube
 
 import (
+	"errors"
 	"fmt"
-	"sync/atomic"
+	"strconv"
+	"strings"
 	"time"
 
-	"gopkg.in/mgo.v2/bson"
-
 	youtubeService "github.com/Seklfreak/Robyul2/services/youtube"
 
-	"github.com/Seklfreak/Robyul2/cache"
+	feedsFramework "github.com/Seklfreak/Robyul2/feeds"
+
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/bwmarrin/discordgo"
-	"github.com/sirupsen/logrus"
+)
+
+const (
+	// the time of the last successful check, the activities published since then are fetched
+	youtubeStateLastCheck = "lastcheck"
+	// the interval until the quota interval was calculated
+	youtubeDefaultInterval = time.Minute
+)
+
+var (
+	ErrYoutubeChannelNotFound = errors.New("youtube channel not found")
 )
 
 type feeds struct {
 	service *youtubeService.Service
-	running uint32
+	webSub  *youtubeService.WebSub
 }
 
 func (f *feeds) Init(e *youtubeService.Service) {
@@ -26,162 +37,154 @@ func (f *feeds) Init(e *youtubeService.Service) {
 		helpers.Relax(fmt.Errorf("feeds loop initialize failed"))
 	}
 	f.service = e
+	f.webSub = youtubeService.NewWebSub()
+	activeFeeds = f
 
-	f.start()
-}
-
-func (f *feeds) start() {
-	// Checks if feeds loop is already running
-	if atomic.SwapUint32(&f.running, uint32(1)) == 1 {
-		logger().Error("feeds loop already running")
-		return
+	go f.quotaLoop()
+	err := feedsFramework.Register(f)
+	if err != nil {
+		logger().WithError(err).Error("failed to register the youtube feed source")
+	}
+	if f.webSub.Enabled() {
+		go f.webSubLoop()
+	} else if f.webSub.CallbackURL != "" {
+		logger().Warn("youtube.websub_secret is not configured, websub is disabled and channels are polled")
 	}
-
-	go f.run()
 }
 
-func (f *feeds) run() {
+// quotaLoop recalculates the checking interval from the quota left
+func (f *feeds) quotaLoop() {
 	defer helpers.Recover()
 	defer func() {
-		// Atomically set 'running' variable to 0(false)
-		atomic.StoreUint32(&f.running, uint32(0))
-
-		logger().Error("The feeds loop died. Please investigate! Will be restarted in 60 seconds")
-		time.Sleep(60 * time.Second)
-
-		f.start()
+		if helpers.IsShuttingDown() {
+			return
+		}
+		go func() {
+			logger().Error("The quota loop died. Please investigate! Will be restarted in 60 seconds")
+			if helpers.SleepUnlessShuttingDown(60 * time.Second) {
+				f.quotaLoop()
+			}
+		}()
 	}()
 
-	for ; ; time.Sleep(10 * time.Second) {
+	for {
 		err := f.service.UpdateCheckingInterval()
 		helpers.Relax(err)
 
-		f.check()
+		if !helpers.SleepUnlessShuttingDown(10 * time.Second) {
+			return
+		}
 	}
 }
 
-func (f *feeds) check() {
-	t := time.Now().Unix()
-	var entries []models.YoutubeChannelEntry
-	err := helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.YoutubeChannelTable).Find(
-		bson.M{"nextchecktime": bson.M{"$lte": t}},
-	)).All(&entries)
-	helpers.Relax(err)
-	// TODO: test
-
-	for _, e := range entries {
-		e = f.checkChannelFeeds(e)
-
-		// update next check time
-		e = f.setNextCheckTime(e)
-		err = helpers.MDbUpdateWithoutLogging(models.YoutubeChannelTable, e.ID, e)
-		helpers.Relax(err)
+func (f *feeds) Name() string {
+	return "youtube"
+}
+
+// Interval is calculated from the quota left, channels with a websub subscription are only polled as a fallback
+func (f *feeds) Interval(target string) time.Duration {
+	interval := time.Duration(f.service.GetCheckingInterval()) * time.Second
+	if interval <= 0 {
+		interval = youtubeDefaultInterval
+	}
+	if f.isSubscribed(target) && interval < webSubFallbackInterval {
+		interval = webSubFallbackInterval
 	}
+	return interval
 }
 
-func (f *feeds) checkChannelFeeds(e models.YoutubeChannelEntry) models.YoutubeChannelEntry {
-	// check if we have access to channel
-	channel, err := helpers.GetChannelWithoutApi(e.ChannelID)
-	if err != nil || channel == nil || channel.ID == "" {
-		return e
+// Resolve looks the channel up by its ID or searches it, and returns the channel ID as normalised target
+func (f *feeds) Resolve(target string) (normalised, name string, err error) {
+	// try ID
+	directItem, err := f.service.GetChannelSingle(target)
+	if err == nil && directItem != nil && directItem.Snippet != nil {
+		return directItem.Id, directItem.Snippet.Title, nil
 	}
 
-	// check if we can send messages and embed links in channel
-	channelPermission, err := cache.GetSession().State.UserChannelPermissions(cache.GetSession().State.User.ID, channel.ID)
+	// try searching for text
+	yc, err := f.service.SearchQuerySingle(strings.Fields(target), "channel")
 	if err != nil {
-		return e
+		return "", "", err
 	}
-
-	if channelPermission&discordgo.PermissionSendMessages != discordgo.PermissionSendMessages ||
-		channelPermission&discordgo.PermissionEmbedLinks != discordgo.PermissionEmbedLinks {
-		return e
+	if yc == nil || yc.Snippet == nil {
+		return "", "", ErrYoutubeChannelNotFound
+	}
+	// Very few channels only have snippet.ChannelID
+	// Maybe it's youtube API bug.
+	channelId := yc.Id.ChannelId
+	if channelId == "" {
+		channelId = yc.Snippet.ChannelId
 	}
+	if channelId == "" || yc.Snippet.ChannelTitle == "" {
+		return "", "", ErrYoutubeChannelNotFound
+	}
+	return channelId, yc.Snippet.ChannelTitle, nil
+}
+
+func (f *feeds) Fetch(target string, state map[string]string) (posts []feedsFramework.Post, newState map[string]string, err error) {
+	checkTime := time.Now()
 
+	lastCheckTime := checkTime
+	if state != nil {
+		lastCheck, err := strconv.ParseInt(state[youtubeStateLastCheck], 10, 64)
+		if err == nil {
+			lastCheckTime = time.Unix(lastCheck, 0)
+		}
+	}
 	// set iso8601 time which will be used search query filter "published after"
-	lastSuccessfulCheckTime := time.Unix(e.LastSuccessfulCheckTime, 0)
-	publishedAfter := lastSuccessfulCheckTime.
+	publishedAfter := lastCheckTime.
 		Add(time.Duration(-1) * time.Hour).
 		Format("2006-01-02T15:04:05-0700")
 
 	// get updated feeds
-	feeds, err := f.service.GetChannelFeeds(e.YoutubeChannelID, publishedAfter)
+	activities, err := f.service.GetChannelFeeds(target, publishedAfter)
 	if err != nil {
-		logger().Warn("check channel feeds error: " + err.Error() + " channel name: " + e.YoutubeChannelName + "id: " + e.YoutubeChannelID)
-		return e
+		return nil, state, err
 	}
 
-	newPostedVideos := make([]string, 0)
-	alreadyPostedVideos := make([]string, 0)
-
-	// check if posted videos and post new videos
-	for i := len(feeds) - 1; i >= 0; i-- {
-		feed := feeds[i]
-
+	for _, activity := range activities {
 		// only 'upload' type video can be posted
-		if feed.Snippet.Type != "upload" {
-			continue
-		}
-		videoId := feed.ContentDetails.Upload.VideoId
-
-		// check if the video is already posted
-		if f.isPosted(videoId, e.YoutubePostedVideos) {
-			alreadyPostedVideos = append(alreadyPostedVideos, videoId)
+		if activity.Snippet == nil || activity.Snippet.Type != "upload" ||
+			activity.ContentDetails == nil || activity.ContentDetails.Upload == nil {
 			continue
 		}
-
-		// make a message and send to discord channel
-		msg := &discordgo.MessageSend{
-			Content: fmt.Sprintf(youtubeVideoBaseUrl, videoId),
-			Embed: &discordgo.MessageEmbed{
-				Author: &discordgo.MessageEmbedAuthor{
-					Name: feed.Snippet.ChannelTitle,
-					URL:  fmt.Sprintf(youtubeChannelBaseUrl, feed.Snippet.ChannelId),
-				},
-				Title:       helpers.GetTextF("plugins.youtube.channel-embed-title-vod", feed.Snippet.ChannelTitle),
-				URL:         fmt.Sprintf(youtubeVideoBaseUrl, videoId),
-				Description: fmt.Sprintf("**%s**", feed.Snippet.Title),
-				Image:       &discordgo.MessageEmbedImage{URL: feed.Snippet.Thumbnails.High.Url},
-				Footer:      &discordgo.MessageEmbedFooter{Text: "YouTube"},
-				Color:       helpers.GetDiscordColorFromHex(youtubeColor),
-			},
+		videoId := activity.ContentDetails.Upload.VideoId
+
+		post := feedsFramework.Post{
+			ID:       videoId,
+			URL:      fmt.Sprintf(youtubeVideoBaseUrl, videoId),
+			Title:    activity.Snippet.Title,
+			Text:     activity.Snippet.Description,
+			Author:   activity.Snippet.ChannelTitle,
+			VideoURL: fmt.Sprintf(youtubeVideoBaseUrl, videoId),
+			Type:     "video",
 		}
-
-		_, err = helpers.SendComplex(e.ChannelID, msg)
-		if err != nil {
-			logger().Warn(err)
-			break
+		post.Time, _ = time.Parse(time.RFC3339, activity.Snippet.PublishedAt)
+		if activity.Snippet.Thumbnails != nil && activity.Snippet.Thumbnails.High != nil {
+			post.ImageURLs = []string{activity.Snippet.Thumbnails.High.Url}
 		}
-
-		newPostedVideos = append(newPostedVideos, videoId)
-
-		logger().WithFields(logrus.Fields{
-			"title":   feed.Snippet.Title,
-			"channel": e.ChannelID,
-		}).Info("posting video")
-	}
-
-	if err == nil {
-		e.LastSuccessfulCheckTime = e.NextCheckTime
-		e.YoutubePostedVideos = alreadyPostedVideos
+		posts = append(posts, post)
 	}
-	e.YoutubePostedVideos = append(e.YoutubePostedVideos, newPostedVideos...)
 
-	return e
+	return posts, map[string]string{youtubeStateLastCheck: strconv.FormatInt(checkTime.Unix(), 10)}, nil
 }
 
-func (f *feeds) setNextCheckTime(e models.YoutubeChannelEntry) models.YoutubeChannelEntry {
-	e.NextCheckTime = time.Now().
-		Add(time.Duration(f.service.GetCheckingInterval()) * time.Second).
-		Unix()
-
-	return e
-}
+func (f *feeds) Render(entry models.FeedEntry, post feedsFramework.Post) *discordgo.MessageEmbed {
+	var imageURL string
+	if len(post.ImageURLs) > 0 {
+		imageURL = post.ImageURLs[0]
+	}
 
-func (f *feeds) isPosted(id string, postedIds []string) bool {
-	for _, posted := range postedIds {
-		if id == posted {
-			return true
-		}
+	return &discordgo.MessageEmbed{
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: post.Author,
+			URL:  fmt.Sprintf(youtubeChannelBaseUrl, entry.Target),
+		},
+		Title:       helpers.GetTextLF(helpers.GetLocale(entry.GuildID, ""), "plugins.youtube.channel-embed-title-vod", post.Author),
+		URL:         post.URL,
+		Description: fmt.Sprintf("**%s**", post.Title),
+		Image:       &discordgo.MessageEmbedImage{URL: imageURL},
+		Footer:      &discordgo.MessageEmbedFooter{Text: "YouTube"},
+		Color:       helpers.GetDiscordColorFromHex(youtubeColor),
 	}
-	return false
 }
@@ -5,8 +5,7 @@ import (
 	"strings"
 	"time"
 
-	"gopkg.in/mgo.v2/bson"
-
+	feedsFramework "github.com/Seklfreak/Robyul2/feeds"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/models"
 	youtubeService "github.com/Seklfreak/Robyul2/services/youtube"
@@ -62,7 +61,7 @@ func (h *Handler) Action(command string, content string, msg *discordgo.Message,
 
 func (h *Handler) actionStart(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	if len(args) < 1 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
@@ -88,7 +87,7 @@ func (h *Handler) actionFinish(args []string, in *discordgo.Message, out **disco
 // _yt video <search by keywords...>
 func (h *Handler) actionVideo(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	if len(args) < 2 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
@@ -106,41 +105,41 @@ func (h *Handler) actionVideo(args []string, in *discordgo.Message, out **discor
 	item, err := h.service.SearchQuerySingle(args[1:], "video")
 	if err != nil {
 		logger().Error(err)
-		*out = h.newMsg(err.Error())
+		*out = h.newMsg(in, err.Error())
 		return h.actionFinish
 	}
 
 	if item == nil {
-		*out = h.newMsg("plugins.youtube.video-not-found")
+		*out = h.newMsg(in, "plugins.youtube.video-not-found")
 		return h.actionFinish
 	}
 
-	*out = h.getVideoInfo(item.Id.VideoId)
+	*out = h.getVideoInfo(in, item.Id.VideoId)
 	return h.actionFinish
 }
 
 // _yt video add <video id/link/search keywords> <discord channel>
 func (h *Handler) actionAddVideo(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
-	*out = h.newMsg("bot.arguments.invalid")
+	*out = h.newMsg(in, "bot.arguments.invalid")
 	return h.actionFinish
 }
 
 // _yt video delete <video id> <discord channel>
 func (h *Handler) actionDeleteVideo(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
-	*out = h.newMsg("bot.arguments.invalid")
+	*out = h.newMsg(in, "bot.arguments.invalid")
 	return h.actionFinish
 }
 
 // _yt video list
 func (h *Handler) actionListVideo(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
-	*out = h.newMsg("bot.arguments.invalid")
+	*out = h.newMsg(in, "bot.arguments.invalid")
 	return h.actionFinish
 }
 
 // _yt channel <search by keywords...>
 func (h *Handler) actionChannel(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	if len(args) < 2 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
@@ -151,6 +150,12 @@ func (h *Handler) actionChannel(args []string, in *discordgo.Message, out **disc
 		return h.actionDeleteChannel
 	case "list":
 		return h.actionListChannel
+	case "filter":
+		return h.actionFilterChannel
+	case "template":
+		return h.actionTemplateChannel
+	case "mention":
+		return h.actionMentionChannel
 	}
 
 	// search channel
@@ -166,7 +171,7 @@ func (h *Handler) actionChannel(args []string, in *discordgo.Message, out **disc
 		yc, err := h.service.SearchQuerySingle(args[1:], "channel")
 		if err != nil {
 			logger().Error(err)
-			*out = h.newMsg(err.Error())
+			*out = h.newMsg(in, err.Error())
 			return h.actionFinish
 		}
 		if yc != nil {
@@ -180,11 +185,11 @@ func (h *Handler) actionChannel(args []string, in *discordgo.Message, out **disc
 	}
 
 	if channelId == "" {
-		*out = h.newMsg("plugins.youtube.channel-not-found")
+		*out = h.newMsg(in, "plugins.youtube.channel-not-found")
 		return h.actionFinish
 	}
 
-	*out = h.getChannelInfo(channelId)
+	*out = h.getChannelInfo(in, channelId)
 
 	return h.actionFinish
 }
@@ -192,184 +197,184 @@ func (h *Handler) actionChannel(args []string, in *discordgo.Message, out **disc
 // _yt channel add <channel id/link/search keywords> <discord channel>
 func (h *Handler) actionAddChannel(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	if len(args) < 4 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
 	// check permission
 	if helpers.IsMod(in) == false {
-		*out = h.newMsg("mod.no_permission")
+		*out = h.newMsg(in, "mod.no_permission")
 		return h.actionFinish
 	}
 
 	// check discord channel
 	dc, err := helpers.GetChannelFromMention(in, args[len(args)-1])
 	if err != nil {
-		*out = h.newMsg("bot.arguments.invalid")
+		*out = h.newMsg(in, "bot.arguments.invalid")
 		return h.actionFinish
 	}
 
-	// search channel
-	var channelId, channelTitle string
-	// try ID
-	directItem, err := h.service.GetChannelSingle(args[2])
-	if err == nil && directItem != nil {
-		channelId = directItem.Id
-		channelTitle = directItem.Snippet.Title
-	}
-
-	if channelId == "" {
-		// try searching for text
-		yc, err := h.service.SearchQuerySingle(args[2:len(args)-1], "channel")
-		if err != nil {
-			logger().Error(err)
-			*out = h.newMsg(err.Error())
-			return h.actionFinish
-		}
-		if yc != nil {
-			// Very few channels only have snippet.ChannelID
-			// Maybe it's youtube API bug.
-			channelId = yc.Id.ChannelId
-			if channelId == "" {
-				channelId = yc.Snippet.ChannelId
-			}
-			channelTitle = yc.Snippet.ChannelTitle
-		}
-	}
-
-	entry := models.YoutubeChannelEntry{
-		GuildID:                 dc.GuildID,
-		ChannelID:               dc.ID,
-		NextCheckTime:           time.Now().Unix(),
-		LastSuccessfulCheckTime: time.Now().Unix(),
-
-		YoutubeChannelID:   channelId,
-		YoutubeChannelName: channelTitle,
+	// search channel and insert entry into the db
+	entry, err := AddChannelFeed(models.FeedEntry{
+		GuildID:       dc.GuildID,
+		ChannelID:     dc.ID,
+		AddedByUserID: in.Author.ID,
+		PostMode:      models.FeedPostModeEmbed,
+	}, strings.Join(args[2:len(args)-1], " "))
+	if err == ErrYoutubeChannelNotFound {
+		*out = h.newMsg(in, "plugins.youtube.channel-not-found")
+		return h.actionFinish
 	}
-
-	if entry.YoutubeChannelID == "" || entry.YoutubeChannelName == "" {
-		*out = h.newMsg("plugins.youtube.channel-not-found")
+	if err == feedsFramework.ErrAlreadyAdded {
+		*out = h.newMsg(in, "plugins.feeds.add-already-added", dc.ID)
 		return h.actionFinish
 	}
-
-	// insert entry into the db
-	entryID, err := helpers.MDbInsert(models.YoutubeChannelTable, entry)
 	if err != nil {
 		logger().Error(err)
-		*out = h.newMsg(err.Error())
+		*out = h.newMsg(in, err.Error())
 		return h.actionFinish
 	}
 
-	h.service.IncQuotaEntryCount()
-
-	_, err = helpers.EventlogLog(time.Now(), dc.GuildID, helpers.MdbIdToHuman(entryID),
-		models.EventlogTargetTypeRobyulYouTubeChannelFeed, in.Author.ID,
-		models.EventlogTypeRobyulYouTubeChannelFeedAdd, "",
-		nil,
-		[]models.ElasticEventlogOption{
-			{
-				Key:   "youtube_channel_channelid",
-				Value: dc.ID,
-				Type:  models.EventlogTargetTypeChannel,
-			},
-			{
-				Key:   "youtube_channel_ytchannelid",
-				Value: channelId,
-			},
-			{
-				Key:   "youtube_channel_ytchannelname",
-				Value: channelTitle,
-			},
-		}, false)
-	helpers.RelaxLog(err)
-
-	*out = h.newMsg("plugins.youtube.channel-added-success", channelTitle, dc.ID)
+	*out = h.newMsg(in, "plugins.youtube.channel-added-success", entry.TargetName, dc.ID)
 	return h.actionFinish
 }
 
 // _yt channel delete <channel id> <discord channel>
 func (h *Handler) actionDeleteChannel(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	if len(args) < 3 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
 	if helpers.IsMod(in) == false {
-		*out = h.newMsg("mod.no_permission")
+		*out = h.newMsg(in, "mod.no_permission")
 		return h.actionFinish
 	}
 
 	channel, err := helpers.GetChannel(in.ChannelID)
 	if err != nil {
 		logger().Error(err)
-		*out = h.newMsg(err.Error())
+		*out = h.newMsg(in, err.Error())
 		return h.actionFinish
 	}
 
-	var entryBucket models.YoutubeChannelEntry
-	err = helpers.MdbOne(
-		helpers.MdbCollection(models.YoutubeChannelTable).Find(bson.M{"guildid": channel.GuildID, "_id": helpers.HumanToMdbId(args[2])}),
-		&entryBucket,
-	)
-	if helpers.IsMdbNotFound(err) {
-		*out = h.newMsg("plugins.youtube.channel-delete-not-found-error")
+	entryBucket, err := feedsFramework.Get(channel.GuildID, args[2])
+	if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "youtube") {
+		*out = h.newMsg(in, "plugins.youtube.channel-delete-not-found-error")
 		return h.actionFinish
 	}
 	if err != nil {
 		logger().Error(err)
-		*out = h.newMsg(err.Error())
+		*out = h.newMsg(in, err.Error())
 		return h.actionFinish
 	}
 
-	err = helpers.MDbDelete(models.YoutubeChannelTable, entryBucket.ID)
+	err = RemoveChannelFeed(entryBucket, in.Author.ID)
 	if err != nil {
 		logger().Error(err)
-		*out = h.newMsg(err.Error())
+		*out = h.newMsg(in, err.Error())
 		return h.actionFinish
 	}
 
-	h.service.DecQuotaEntryCount()
+	*out = h.newMsg(in, "Delete channel, ID: "+args[2])
+	return h.actionFinish
+}
+
+// AddChannelFeed adds a YouTube channel feed, used by the youtube command and the REST API
+func AddChannelFeed(entry models.FeedEntry, youtubeChannel string) (models.FeedEntry, error) {
+	entry, err := feedsFramework.AddEntry("youtube", youtubeChannel, entry)
+	if err != nil {
+		return entry, err
+	}
+
+	// receive uploads of new channels right away instead of waiting for the next renewal
+	if activeFeeds != nil && activeFeeds.webSub.Enabled() && !activeFeeds.isSubscribed(entry.Target) {
+		go func(youtubeChannelID string) {
+			defer helpers.Recover()
 
-	_, err = helpers.EventlogLog(time.Now(), channel.GuildID, args[2],
-		models.EventlogTargetTypeRobyulYouTubeChannelFeed, in.Author.ID,
+			err := activeFeeds.subscribe(youtubeChannelID)
+			if err != nil {
+				logger().WithField("youtubeChannelID", youtubeChannelID).Warnf("websub subscribe failed: %s", err.Error())
+			}
+		}(entry.Target)
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulYouTubeChannelFeed, entry.AddedByUserID,
+		models.EventlogTypeRobyulYouTubeChannelFeedAdd, "",
+		nil,
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "youtube_channel_channelid",
+				Value: entry.ChannelID,
+				Type:  models.EventlogTargetTypeChannel,
+			},
+			{
+				Key:   "youtube_channel_ytchannelid",
+				Value: entry.Target,
+			},
+			{
+				Key:   "youtube_channel_ytchannelname",
+				Value: entry.TargetName,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+
+	return entry, nil
+}
+
+// RemoveChannelFeed removes a YouTube channel feed, used by the youtube command and the REST API
+func RemoveChannelFeed(entry models.FeedEntry, userID string) (err error) {
+	err = feedsFramework.Remove(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entry.GuildID, helpers.MdbIdToHuman(entry.ID),
+		models.EventlogTargetTypeRobyulYouTubeChannelFeed, userID,
 		models.EventlogTypeRobyulYouTubeChannelFeedRemove, "",
 		nil,
 		nil, false)
 	helpers.RelaxLog(err)
 
-	*out = h.newMsg("Delete channel, ID: " + args[2])
-	return h.actionFinish
+	return nil
 }
 
 // _yt channel list
 func (h *Handler) actionListChannel(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	if helpers.IsMod(in) == false {
-		*out = h.newMsg("mod.no_permission")
+		*out = h.newMsg(in, "mod.no_permission")
 		return h.actionFinish
 	}
 
 	ch, err := helpers.GetChannel(in.ChannelID)
 	if err != nil {
-		*out = h.newMsg(err.Error())
+		*out = h.newMsg(in, err.Error())
 		return h.actionFinish
 	}
 
-	var entries []models.YoutubeChannelEntry
-	err = helpers.MDbIter(helpers.MdbCollection(models.YoutubeChannelTable).Find(bson.M{"guildid": ch.GuildID})).All(&entries)
+	entries, err := feedsFramework.GetAll(ch.GuildID, "youtube")
 	if err != nil {
 		logger().Error(err)
-		*out = h.newMsg(err.Error())
+		*out = h.newMsg(in, err.Error())
 		return h.actionFinish
 	}
 
 	if entries == nil || len(entries) < 1 {
-		*out = h.newMsg("plugins.youtube.no-entry")
+		*out = h.newMsg(in, "plugins.youtube.no-entry")
 		return h.actionFinish
 	}
 
 	msg := ""
 	for _, e := range entries {
-		msg += helpers.GetTextF("plugins.youtube.channel-list-entry", helpers.MdbIdToHuman(e.ID), e.YoutubeChannelName, e.ChannelID)
+		var specialText string
+		if e.MentionRoleID != "" {
+			specialText += fmt.Sprintf(" mentioning <@&%s>", e.MentionRoleID)
+		}
+		if e.Template != "" {
+			specialText += " using a template"
+		}
+		msg += helpers.GetMessageTextF(in, "plugins.youtube.channel-list-entry", helpers.MdbIdToHuman(e.ID), e.TargetName, e.ChannelID, specialText)
 	}
 
 	for _, resultPage := range helpers.Pagify(msg, "\n") {
@@ -377,30 +382,152 @@ func (h *Handler) actionListChannel(args []string, in *discordgo.Message, out **
 		helpers.Relax(err)
 	}
 
-	*out = h.newMsg("plugins.youtube.channel-list-sum", len(entries))
+	*out = h.newMsg(in, "plugins.youtube.channel-list-sum", len(entries))
+	return h.actionFinish
+}
+
+// _yt channel filter <channel id> [<setting> [<value>]]
+func (h *Handler) actionFilterChannel(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
+	entryBucket, ok := h.findChannelEntry(args, in, out)
+	if !ok {
+		return h.actionFinish
+	}
+
+	beforeValue := feedsFramework.DescribeFilter(entryBucket.Filter)
+	reply, changed := feedsFramework.FilterCommand(helpers.GetMessageLocale(in), &entryBucket.Filter,
+		feedsFramework.SourceFilterOptions("youtube"), entryBucket.TargetName, args[3:])
+	*out = &discordgo.MessageSend{Content: reply}
+	if !changed {
+		return h.actionFinish
+	}
+
+	h.updateChannelEntry(entryBucket, in, out, "youtube_channel_filter", beforeValue, feedsFramework.DescribeFilter(entryBucket.Filter))
+	return h.actionFinish
+}
+
+// _yt channel template <channel id> [<template>|reset]
+func (h *Handler) actionTemplateChannel(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
+	entryBucket, ok := h.findChannelEntry(args, in, out)
+	if !ok {
+		return h.actionFinish
+	}
+
+	beforeValue := entryBucket.Template
+	reply, changed := feedsFramework.TemplateCommand(helpers.GetMessageLocale(in), &entryBucket.Template,
+		entryBucket.TargetName, strings.Join(args[3:], " "))
+	*out = &discordgo.MessageSend{Content: reply}
+	if !changed {
+		return h.actionFinish
+	}
+
+	h.updateChannelEntry(entryBucket, in, out, "youtube_channel_template", beforeValue, entryBucket.Template)
+	return h.actionFinish
+}
+
+// _yt channel mention <channel id> [<role>]
+func (h *Handler) actionMentionChannel(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
+	entryBucket, ok := h.findChannelEntry(args, in, out)
+	if !ok {
+		return h.actionFinish
+	}
+
+	beforeValue := entryBucket.MentionRoleID
+	reply, changed := feedsFramework.MentionCommand(helpers.GetMessageLocale(in), entryBucket.GuildID, &entryBucket.MentionRoleID,
+		entryBucket.TargetName, strings.Join(args[3:], " "))
+	*out = &discordgo.MessageSend{Content: reply}
+	if !changed {
+		return h.actionFinish
+	}
+
+	h.updateChannelEntry(entryBucket, in, out, "youtube_channel_mentionroleid", beforeValue, entryBucket.MentionRoleID)
 	return h.actionFinish
 }
 
+// findChannelEntry returns the channel entry of the ID in args[2] on the guild, sets out if it can not be changed
+func (h *Handler) findChannelEntry(args []string, in *discordgo.Message, out **discordgo.MessageSend) (entryBucket models.FeedEntry, ok bool) {
+	if len(args) < 3 {
+		*out = h.newMsg(in, "bot.arguments.too-few")
+		return entryBucket, false
+	}
+
+	if helpers.IsMod(in) == false {
+		*out = h.newMsg(in, "mod.no_permission")
+		return entryBucket, false
+	}
+
+	channel, err := helpers.GetChannel(in.ChannelID)
+	if err != nil {
+		logger().Error(err)
+		*out = h.newMsg(in, err.Error())
+		return entryBucket, false
+	}
+
+	entryBucket, err = feedsFramework.Get(channel.GuildID, args[2])
+	if helpers.IsMdbNotFound(err) || (err == nil && entryBucket.Source != "youtube") {
+		*out = h.newMsg(in, "plugins.youtube.channel-delete-not-found-error")
+		return entryBucket, false
+	}
+	if err != nil {
+		logger().Error(err)
+		*out = h.newMsg(in, err.Error())
+		return entryBucket, false
+	}
+
+	return entryBucket, true
+}
+
+// updateChannelEntry saves a changed channel entry and logs the change to the eventlog
+func (h *Handler) updateChannelEntry(entryBucket models.FeedEntry, in *discordgo.Message, out **discordgo.MessageSend, key, oldValue, newValue string) {
+	err := feedsFramework.Update(entryBucket)
+	if err != nil {
+		logger().Error(err)
+		*out = h.newMsg(in, err.Error())
+		return
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), entryBucket.GuildID, helpers.MdbIdToHuman(entryBucket.ID),
+		models.EventlogTargetTypeRobyulYouTubeChannelFeed, in.Author.ID,
+		models.EventlogTypeRobyulYouTubeChannelFeedUpdate, "",
+		[]models.ElasticEventlogChange{
+			{
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
+			},
+		},
+		[]models.ElasticEventlogOption{
+			{
+				Key:   "youtube_channel_ytchannelid",
+				Value: entryBucket.Target,
+			},
+			{
+				Key:   "youtube_channel_ytchannelname",
+				Value: entryBucket.TargetName,
+			},
+		}, false)
+	helpers.RelaxLog(err)
+}
+
 // _yt system restart
 func (h *Handler) actionSystem(args []string, in *discordgo.Message, out **discordgo.MessageSend) action {
 	if len(args) < 2 {
-		*out = h.newMsg("bot.arguments.too-few")
+		*out = h.newMsg(in, "bot.arguments.too-few")
 		return h.actionFinish
 	}
 
 	if args[1] != "restart" {
-		*out = h.newMsg("bot.arguments.invalid")
+		*out = h.newMsg(in, "bot.arguments.invalid")
 		return h.actionFinish
 	}
 
 	if helpers.IsBotAdmin(in.Author.ID) == false {
-		*out = h.newMsg("botadmin.no_permission")
+		*out = h.newMsg(in, "botadmin.no_permission")
 		return h.actionFinish
 	}
 
 	go h.Init(nil)
 
-	*out = h.newMsg("plugins.youtube.service-restart")
+	*out = h.newMsg(in, "plugins.youtube.service-restart")
 	return h.actionFinish
 }
 
@@ -409,18 +536,18 @@ func (h *Handler) actionSearch(args []string, in *discordgo.Message, out **disco
 	item, err := h.service.SearchQuerySingle(args[0:], "channel, video")
 	if err != nil {
 		logger().Error(err)
-		*out = h.newMsg(err.Error())
+		*out = h.newMsg(in, err.Error())
 		return h.actionFinish
 	}
 
 	if item == nil {
-		*out = h.newMsg("plugins.youtube.not-found")
+		*out = h.newMsg(in, "plugins.youtube.not-found")
 		return h.actionFinish
 	}
 
 	switch item.Id.Kind {
 	case "youtube#video":
-		*out = h.getVideoInfo(item.Id.VideoId)
+		*out = h.getVideoInfo(in, item.Id.VideoId)
 	case "youtube#channel":
 		// Very few channels only have snippet.ChannelID
 		// Maybe it's youtube API bug.
@@ -428,24 +555,24 @@ func (h *Handler) actionSearch(args []string, in *discordgo.Message, out **disco
 		if channelId == "" {
 			channelId = item.Snippet.ChannelId
 		}
-		*out = h.getChannelInfo(channelId)
+		*out = h.getChannelInfo(in, channelId)
 	default:
-		*out = h.newMsg("plugins.youtube.video-not-found")
+		*out = h.newMsg(in, "plugins.youtube.video-not-found")
 	}
 
 	return h.actionFinish
 }
 
 // getVideoInfo returns information of given video id through *discordgo.MessageSend.
-func (h *Handler) getVideoInfo(videoId string) (data *discordgo.MessageSend) {
+func (h *Handler) getVideoInfo(in *discordgo.Message, videoId string) (data *discordgo.MessageSend) {
 	video, err := h.service.GetVideoSingle(videoId)
 	if err != nil {
 		logger().Error(err)
-		return h.newMsg(err.Error())
+		return h.newMsg(in, err.Error())
 	}
 
 	if video == nil {
-		return h.newMsg("plugins.youtube.video-not-found")
+		return h.newMsg(in, "plugins.youtube.video-not-found")
 	}
 
 	data = &discordgo.MessageSend{}
@@ -474,15 +601,15 @@ func (h *Handler) getVideoInfo(videoId string) (data *discordgo.MessageSend) {
 }
 
 // getChannelInfo returns information of given channel id through *discordgo.MessageSend.
-func (h *Handler) getChannelInfo(channelId string) (data *discordgo.MessageSend) {
+func (h *Handler) getChannelInfo(in *discordgo.Message, channelId string) (data *discordgo.MessageSend) {
 	channel, err := h.service.GetChannelSingle(channelId)
 	if err != nil {
 		logger().Error(err)
-		return h.newMsg(err.Error())
+		return h.newMsg(in, err.Error())
 	}
 
 	if channel == nil {
-		return h.newMsg("plugins.youtube.channel-not-found")
+		return h.newMsg(in, "plugins.youtube.channel-not-found")
 	}
 
 	data = &discordgo.MessageSend{}
@@ -508,9 +635,9 @@ func (h *Handler) getChannelInfo(channelId string) (data *discordgo.MessageSend)
 	return
 }
 
-func (h *Handler) newMsg(content string, replacements ...interface{}) *discordgo.MessageSend {
+func (h *Handler) newMsg(in *discordgo.Message, content string, replacements ...interface{}) *discordgo.MessageSend {
 	if len(replacements) < 1 {
-		return &discordgo.MessageSend{Content: helpers.GetText(content)}
+		return &discordgo.MessageSend{Content: helpers.GetMessageText(in, content)}
 	}
-	return &discordgo.MessageSend{Content: helpers.GetTextF(content, replacements...)}
+	return &discordgo.MessageSend{Content: helpers.GetMessageTextF(in, content, replacements...)}
 }
@@ -0,0 +1,299 @@
+package youtube
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	feedsFramework "github.com/Seklfreak/Robyul2/feeds"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	youtubeService "github.com/Seklfreak/Robyul2/services/youtube"
+)
+
+const (
+	webSubRenewInterval = time.Hour
+	// subscriptions are renewed this long before their lease expires
+	webSubRenewBefore = 24 * time.Hour
+	// channels with an active subscription are still polled this often, to catch missed notifications
+	webSubFallbackInterval = 6 * time.Hour
+	// pushed videos published longer ago are edits of old videos
+	webSubMaxVideoAge = 24 * time.Hour
+	// how long the hub has to verify a subscription or unsubscription request
+	webSubVerifyTimeout = time.Hour
+
+	youtubeThumbnailUrl = "https://i.ytimg.com/vi/%s/hqdefault.jpg"
+)
+
+// activeFeeds receives the push notifications of the REST API
+var activeFeeds *feeds
+
+// HandleWebSubVerification answers the verification request of the hub for a subscription or unsubscription
+// only requests this bot is waiting for are confirmed, returns false if the request should not be confirmed
+func HandleWebSubVerification(mode, topic string, leaseSeconds int) (ok bool) {
+	if activeFeeds == nil || !activeFeeds.webSub.Enabled() {
+		return false
+	}
+
+	channelID, ok := youtubeService.WebSubTopicChannelID(topic)
+	if !ok {
+		return false
+	}
+
+	var subscription models.YoutubeWebSubSubscription
+	err := helpers.MdbOneWithoutLogging(
+		helpers.MdbCollection(models.YoutubeWebSubTable).Find(bson.M{"youtubechannelid": channelID}),
+		&subscription,
+	)
+	if err != nil {
+		if !helpers.IsMdbNotFound(err) {
+			helpers.RelaxLog(err)
+		}
+		return false
+	}
+
+	entriesCount, err := helpers.MdbCountWithoutLogging(models.FeedsTable, bson.M{"source": "youtube", "target": channelID})
+	if err != nil {
+		helpers.RelaxLog(err)
+		return false
+	}
+
+	switch mode {
+	case "subscribe":
+		if entriesCount <= 0 || !isWebSubRequestPending(subscription.RequestedAt) {
+			return false
+		}
+
+		subscription.RequestedAt = time.Time{}
+		subscription.LeaseExpiresAt = time.Now().Add(time.Duration(leaseSeconds) * time.Second)
+
+		err = helpers.MDbUpsertWithoutLogging(models.YoutubeWebSubTable, bson.M{"youtubechannelid": channelID}, subscription)
+		if err != nil {
+			helpers.RelaxLog(err)
+			return false
+		}
+
+		logger().WithField("youtubeChannelID", channelID).Infof("websub subscription verified, lease expires at %s",
+			subscription.LeaseExpiresAt.Format(time.RFC3339))
+		return true
+	case "unsubscribe":
+		// the channel was added again in the meantime
+		if entriesCount > 0 || !isWebSubRequestPending(subscription.UnsubscribeRequestedAt) {
+			return false
+		}
+		helpers.RelaxLog(helpers.MdbDeleteQueryWithoutLogging(models.YoutubeWebSubTable, bson.M{"youtubechannelid": channelID}))
+		return true
+	case "denied":
+		if !isWebSubRequestPending(subscription.RequestedAt) {
+			return false
+		}
+		logger().WithField("youtubeChannelID", channelID).Warn("websub subscription denied by the hub, falling back to polling")
+		helpers.RelaxLog(helpers.MdbDeleteQueryWithoutLogging(models.YoutubeWebSubTable, bson.M{"youtubechannelid": channelID}))
+		return true
+	}
+	return false
+}
+
+// isWebSubRequestPending returns true if a request was sent to the hub recently and was not verified yet
+func isWebSubRequestPending(requestedAt time.Time) bool {
+	return !requestedAt.IsZero() && time.Since(requestedAt) < webSubVerifyTimeout
+}
+
+// HandleWebSubNotification posts the new videos of a push notification
+// notifications with an invalid signature are ignored, the hub has to receive a success response anyway
+func HandleWebSubNotification(signature string, body []byte) {
+	if activeFeeds == nil || !activeFeeds.webSub.Enabled() {
+		return
+	}
+
+	if !activeFeeds.webSub.VerifySignature(signature, body) {
+		logger().Warn("received websub notification with an invalid signature")
+		return
+	}
+
+	videos, err := youtubeService.ParseWebSubNotification(body)
+	if err != nil {
+		logger().WithError(err).Warn("failed to parse websub notification")
+		return
+	}
+
+	for _, pushedVideo := range videos {
+		video, ok := activeFeeds.confirmPushedVideo(pushedVideo)
+		if !ok {
+			continue
+		}
+		if !video.Published.IsZero() && time.Since(video.Published) > webSubMaxVideoAge {
+			continue
+		}
+
+		err = feedsFramework.Push(activeFeeds, video.ChannelID, []feedsFramework.Post{pushedVideoToPost(video)})
+		if err != nil {
+			logger().WithField("videoID", video.VideoID).WithError(err).Warn("failed to post pushed video")
+		}
+	}
+}
+
+// confirmPushedVideo looks the pushed video up with the API, the notification only tells which video to check
+// videos which can not be confirmed are left to the polling fallback
+func (f *feeds) confirmPushedVideo(pushedVideo youtubeService.WebSubVideo) (video youtubeService.WebSubVideo, ok bool) {
+	apiVideo, err := f.service.GetVideoSingle(pushedVideo.VideoID)
+	if err != nil {
+		logger().WithField("videoID", pushedVideo.VideoID).Warnf("failed to confirm pushed video: %s", err.Error())
+		return video, false
+	}
+	if apiVideo == nil || apiVideo.Snippet == nil || apiVideo.Snippet.ChannelId != pushedVideo.ChannelID {
+		logger().WithField("videoID", pushedVideo.VideoID).Warn("pushed video does not exist or belongs to another channel")
+		return video, false
+	}
+
+	video = youtubeService.WebSubVideo{
+		VideoID:   apiVideo.Id,
+		ChannelID: apiVideo.Snippet.ChannelId,
+		Title:     apiVideo.Snippet.Title,
+		Author:    apiVideo.Snippet.ChannelTitle,
+		Updated:   pushedVideo.Updated,
+	}
+	video.Published, _ = time.Parse(time.RFC3339, apiVideo.Snippet.PublishedAt)
+	return video, true
+}
+
+// pushedVideoToPost converts a confirmed pushed video for the feeds framework
+func pushedVideoToPost(video youtubeService.WebSubVideo) feedsFramework.Post {
+	return feedsFramework.Post{
+		ID:        video.VideoID,
+		URL:       fmt.Sprintf(youtubeVideoBaseUrl, video.VideoID),
+		Title:     video.Title,
+		Author:    video.Author,
+		ImageURLs: []string{fmt.Sprintf(youtubeThumbnailUrl, video.VideoID)},
+		VideoURL:  fmt.Sprintf(youtubeVideoBaseUrl, video.VideoID),
+		Type:      "video",
+		Time:      video.Published,
+	}
+}
+
+// webSubLoop subscribes to the channels of all entries, renews subscriptions before they expire
+// and unsubscribes from channels without entries
+func (f *feeds) webSubLoop() {
+	defer helpers.Recover()
+	defer func() {
+		if helpers.IsShuttingDown() {
+			return
+		}
+		go func() {
+			logger().Error("The websub loop died. Please investigate! Will be restarted in 60 seconds")
+			if helpers.SleepUnlessShuttingDown(60 * time.Second) {
+				f.webSubLoop()
+			}
+		}()
+	}()
+
+	for {
+		f.renewSubscriptions()
+
+		if !helpers.SleepUnlessShuttingDown(webSubRenewInterval) {
+			return
+		}
+	}
+}
+
+func (f *feeds) renewSubscriptions() {
+	var channelIDs []string
+	err := helpers.MdbCollection(models.FeedsTable).Find(bson.M{"source": "youtube"}).Distinct("target", &channelIDs)
+	helpers.Relax(err)
+
+	var subscriptions []models.YoutubeWebSubSubscription
+	err = helpers.MDbIterWithoutLogging(helpers.MdbCollection(models.YoutubeWebSubTable).Find(nil)).All(&subscriptions)
+	helpers.Relax(err)
+
+	subscriptionsByChannel := make(map[string]models.YoutubeWebSubSubscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		subscriptionsByChannel[subscription.YoutubeChannelID] = subscription
+	}
+
+	var renewed int
+	for _, channelID := range channelIDs {
+		if channelID == "" {
+			continue
+		}
+		subscription, ok := subscriptionsByChannel[channelID]
+		delete(subscriptionsByChannel, channelID)
+
+		if ok && time.Until(subscription.LeaseExpiresAt) > webSubRenewBefore {
+			continue
+		}
+		// waiting for the verification of the hub
+		if ok && subscription.LeaseExpiresAt.IsZero() && time.Since(subscription.RequestedAt) < webSubRenewInterval {
+			continue
+		}
+
+		err = f.subscribe(channelID)
+		if err != nil {
+			logger().WithField("youtubeChannelID", channelID).Warnf("websub subscribe failed: %s", err.Error())
+			continue
+		}
+		renewed++
+	}
+
+	// remaining subscriptions belong to channels without entries
+	for channelID, subscription := range subscriptionsByChannel {
+		err = f.unsubscribe(subscription)
+		if err != nil {
+			logger().WithField("youtubeChannelID", channelID).Warnf("websub unsubscribe failed: %s", err.Error())
+		}
+	}
+
+	logger().Infof("requested %d websub subscriptions and %d unsubscriptions for %d channels",
+		renewed, len(subscriptionsByChannel), len(channelIDs))
+}
+
+// subscribe requests a subscription to the channel, the lease is set when the hub verifies it
+// the request is stored first, the hub may verify it before Subscribe returns
+func (f *feeds) subscribe(channelID string) (err error) {
+	var subscription models.YoutubeWebSubSubscription
+	err = helpers.MdbOneWithoutLogging(
+		helpers.MdbCollection(models.YoutubeWebSubTable).Find(bson.M{"youtubechannelid": channelID}),
+		&subscription,
+	)
+	if err != nil && !helpers.IsMdbNotFound(err) {
+		return err
+	}
+	subscription.YoutubeChannelID = channelID
+	subscription.RequestedAt = time.Now()
+	subscription.UnsubscribeRequestedAt = time.Time{}
+
+	err = helpers.MDbUpsertWithoutLogging(models.YoutubeWebSubTable, bson.M{"youtubechannelid": channelID}, subscription)
+	if err != nil {
+		return err
+	}
+
+	return f.webSub.Subscribe(channelID)
+}
+
+// unsubscribe requests the unsubscription from the channel, the subscription is deleted when the hub verifies it
+func (f *feeds) unsubscribe(subscription models.YoutubeWebSubSubscription) (err error) {
+	subscription.UnsubscribeRequestedAt = time.Now()
+	err = helpers.MDbUpsertWithoutLogging(models.YoutubeWebSubTable, bson.M{"youtubechannelid": subscription.YoutubeChannelID}, subscription)
+	if err != nil {
+		return err
+	}
+
+	return f.webSub.Unsubscribe(subscription.YoutubeChannelID)
+}
+
+// isSubscribed returns true if pushes of the channel are verified and not expired
+func (f *feeds) isSubscribed(channelID string) bool {
+	if !f.webSub.Enabled() {
+		return false
+	}
+
+	count, err := helpers.MdbCountWithoutLogging(models.YoutubeWebSubTable, bson.M{
+		"youtubechannelid": channelID,
+		"leaseexpiresat":   bson.M{"$gt": time.Now()},
+	})
+	if err != nil {
+		helpers.RelaxLog(err)
+		return false
+	}
+	return count > 0
+}
@@ -1,28 +1,47 @@
 package modules
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/commands"
 	"github.com/Seklfreak/Robyul2/generator"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/metrics"
+	"github.com/Seklfreak/Robyul2/metrics/prom"
 	"github.com/Seklfreak/Robyul2/modules/plugins/levels"
-	"github.com/Seklfreak/Robyul2/ratelimits"
 	"github.com/bwmarrin/discordgo"
 )
 
+var (
+	pluginsInitialized int32
+	pluginsTotal       int32
+)
+
+// InitProgress returns how many plugins have been initialized, total is 0 before Init started
+func InitProgress() (initialized, total int) {
+	return int(atomic.LoadInt32(&pluginsInitialized)), int(atomic.LoadInt32(&pluginsTotal))
+}
+
 // Init warms the caches and initializes the plugins
 func Init(session *discordgo.Session) {
 	checkDuplicateCommands()
 
 	pluginCount := len(PluginList)
 	extendedPluginCount := len(PluginExtendedList)
+	atomic.StoreInt32(&pluginsTotal, int32(pluginCount+len(PluginCommandList)+extendedPluginCount))
 	pluginCache = make(map[string]*Plugin)
 	extendedPluginCache = make(map[string]*ExtendedPlugin)
+	commandPluginCache = make(map[string]*CommandPlugin)
 
 	logTemplate := "[PLUG] %s reacts to [ %s]"
 	listeners := ""
@@ -43,6 +62,35 @@ func Init(session *discordgo.Session) {
 		listeners = ""
 
 		(*ref).Init(session)
+		atomic.AddInt32(&pluginsInitialized, 1)
+	}
+
+	logTemplate = "[COMMAND-PLUG] %s reacts to [ %s]"
+	for i := 0; i < len(PluginCommandList); i++ {
+		ref := &PluginCommandList[i]
+
+		for _, command := range (*ref).Commands() {
+			err := commands.Register(command)
+			if err != nil {
+				cache.GetLogger().WithField("module", "modules").Error("Failed to load " + helpers.Typeof(*ref) + ": " + err.Error())
+				os.Exit(1)
+			}
+
+			for _, cmd := range append([]string{command.Name}, command.Aliases...) {
+				commandPluginCache[cmd] = ref
+				listeners += cmd + " "
+			}
+		}
+
+		cache.GetLogger().WithField("module", "modules").Info(fmt.Sprintf(
+			logTemplate,
+			helpers.Typeof(*ref),
+			listeners,
+		))
+		listeners = ""
+
+		(*ref).Init(session)
+		atomic.AddInt32(&pluginsInitialized, 1)
 	}
 
 	listeners = ""
@@ -67,22 +115,27 @@ func Init(session *discordgo.Session) {
 		}
 
 		(*ref).Init(session)
+		atomic.AddInt32(&pluginsInitialized, 1)
 	}
 
 	pluginCommands := make([]string, 0)
 	for k := range pluginCache {
 		pluginCommands = append(pluginCommands, k)
 	}
+	for k := range commandPluginCache {
+		pluginCommands = append(pluginCommands, k)
+	}
 	cache.SetPluginList(pluginCommands)
 	extendedPluginCommands := make([]string, 0)
 	for k := range extendedPluginCache {
 		extendedPluginCommands = append(extendedPluginCommands, k)
 	}
 	cache.SetPluginExtendedList(extendedPluginCommands)
+	cache.SetPluginCommandSets(getCommandSets())
 
 	cache.GetLogger().WithField("module", "modules").Info(
 		"modules",
-		"Initializer finished. Loaded "+strconv.Itoa(len(PluginList))+" plugins and "+strconv.Itoa(len(PluginExtendedList))+" extended plugins",
+		"Initializer finished. Loaded "+strconv.Itoa(len(PluginList))+" plugins, "+strconv.Itoa(len(PluginCommandList))+" command plugins and "+strconv.Itoa(len(PluginExtendedList))+" extended plugins",
 	)
 }
 
@@ -113,6 +166,105 @@ func Uninit(session *discordgo.Session) {
 	)
 }
 
+// Shutdown calls Shutdown of all plugins implementing GracefulPlugin at the same time, and waits until they
+// finished or ctx is done
+func Shutdown(ctx context.Context) {
+	var plugins []BaseModule
+	for _, plugin := range PluginList {
+		plugins = append(plugins, plugin)
+	}
+	for _, plugin := range PluginCommandList {
+		plugins = append(plugins, plugin)
+	}
+	for _, plugin := range PluginExtendedList {
+		plugins = append(plugins, plugin)
+	}
+
+	var running sync.WaitGroup
+	for _, plugin := range plugins {
+		gracefulPlugin, ok := plugin.(GracefulPlugin)
+		if !ok {
+			continue
+		}
+
+		cache.GetLogger().WithField("module", "modules").Info(fmt.Sprintf(
+			"[GRACEFUL-PLUG] %s shutting down…",
+			helpers.Typeof(plugin),
+		))
+
+		running.Add(1)
+		go func(gracefulPlugin GracefulPlugin) {
+			defer running.Done()
+			defer helpers.Recover()
+
+			gracefulPlugin.Shutdown(ctx)
+		}(gracefulPlugin)
+	}
+
+	if !helpers.WaitWithContext(ctx, &running) {
+		cache.GetLogger().WithField("module", "modules").Warn("Shutdown deadline exceeded, not all plugins finished")
+	}
+}
+
+// legacyCommandCosts are the rate limit costs of expensive legacy plugin commands, other commands cost one key
+var legacyCommandCosts = map[string]int{
+	"lyrics":      3,
+	"weather":     2,
+	"wolfram":     3,
+	"w":           3,
+	"ask":         3,
+	"translate":   2,
+	"translator":  2,
+	"t":           2,
+	"google":      2,
+	"profile":     3,
+	"gif-profile": 5,
+}
+
+// getCommandSets returns the commands which are disabled together on guilds, all commands of a plugin,
+// or a command with its aliases for command plugins
+func getCommandSets() (sets [][]string) {
+	for _, plugin := range PluginList {
+		sets = append(sets, plugin.Commands())
+	}
+	for _, plugin := range PluginCommandList {
+		for _, command := range plugin.Commands() {
+			sets = append(sets, append([]string{command.Name}, command.Aliases...))
+		}
+	}
+	for _, plugin := range PluginExtendedList {
+		sets = append(sets, plugin.Commands())
+	}
+	return sets
+}
+
+// IsCommand returns true if a plugin handles the command
+func IsCommand(command string) bool {
+	if _, ok := pluginCache[command]; ok {
+		return true
+	}
+	_, ok := commandPluginCache[command]
+	return ok
+}
+
+// CommandCost returns how many rate limit keys the command uses, ratelimits.command_costs in the config
+// overwrites the costs of the commands
+func CommandCost(command string) (cost int) {
+	if configCost, ok := helpers.GetConfig().Path("ratelimits.command_costs." + command).Data().(float64); ok && configCost >= 0 {
+		return int(configCost)
+	}
+
+	if registered := commands.Get(command); registered != nil {
+		cost = registered.Cost
+	} else {
+		cost = legacyCommandCosts[command]
+	}
+	if cost <= 0 {
+		cost = 1
+	}
+	return cost
+}
+
 // command - The command that triggered this execution
 // content - The content without command
 // msg     - The message object
@@ -121,22 +273,39 @@ func CallBotPlugin(command string, content string, msg *discordgo.Message) {
 	// Defer a recovery in case anything panics
 	defer helpers.RecoverDiscord(msg)
 
-	// Consume a key for this action
-	ratelimits.Container.Drain(1, msg.Author.ID)
-
 	// Track metrics
 	metrics.CommandsExecuted.Add(1)
 
 	// Call the module
 	if ref, ok := pluginCache[command]; ok {
+		defer observeCommand(*ref, command, time.Now())
 		(*ref).Action(command, content, msg, cache.GetSession())
 	}
+	// call the command module
+	if ref, ok := commandPluginCache[command]; ok {
+		defer observeCommand(*ref, command, time.Now())
+		commands.Execute(commands.Get(command), content, msg)
+	}
 	// call the extended module
 	if ref, ok := extendedPluginCache[command]; ok {
+		defer observeCommand(*ref, command, time.Now())
 		(*ref).Action(command, content, msg, cache.GetSession())
 	}
 }
 
+// observeCommand tracks the execution of a command in prometheus, deferred so panicking commands are tracked as well
+func observeCommand(plugin BaseModule, command string, start time.Time) {
+	// use the package name as well, some plugins are just called Handler or Module
+	pluginType := reflect.TypeOf(plugin)
+	if pluginType.Kind() == reflect.Ptr {
+		pluginType = pluginType.Elem()
+	}
+	pluginName := path.Base(pluginType.PkgPath()) + "." + pluginType.Name()
+
+	prom.CommandsExecuted.WithLabelValues(pluginName, command).Inc()
+	prom.CommandDuration.WithLabelValues(pluginName, command).Observe(time.Since(start).Seconds())
+}
+
 func CallExtendedPlugin(content string, msg *discordgo.Message) {
 	defer helpers.Recover()
 
@@ -218,4 +387,19 @@ func checkDuplicateCommands() {
 			cmds[cmd] = t
 		}
 	}
+
+	for _, plug := range PluginCommandList {
+		for _, command := range plug.Commands() {
+			t := helpers.Typeof(plug)
+
+			for _, cmd := range append([]string{command.Name}, command.Aliases...) {
+				if occupant, ok := cmds[cmd]; ok {
+					cache.GetLogger().WithField("module", "modules").Info("Failed to load " + t + " because '" + cmd + "' was already registered by " + occupant)
+					os.Exit(1)
+				}
+
+				cmds[cmd] = t
+			}
+		}
+	}
 }
@@ -20,9 +20,6 @@ const (
 	DROP_SIZE = 3
 )
 
-// Global pointer to a container instance
-var Container = &BucketContainer{}
-
 // Container struct to lock the bucket map
 type BucketContainer struct {
 	sync.RWMutex
@@ -0,0 +1,118 @@
+package ratelimits
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+)
+
+const (
+	commandNoticeKey = "robyul2-discord:ratelimit:commands:notified:%s"
+)
+
+var (
+	commandUserBuckets  *RedisBucketContainer
+	commandGuildBuckets *RedisBucketContainer
+	commandBucketsOnce  sync.Once
+)
+
+// CommandLimitError is returned by DrainCommand if the user or the guild has not enough keys left
+type CommandLimitError struct {
+	// Guild is true if the guild hit its limit, false if the user hit the limit
+	Guild bool
+	// how long to wait until the command can be used again
+	Wait time.Duration
+	// Notify is only true for the first command during a limit, later commands should be ignored silently
+	Notify bool
+}
+
+func (e *CommandLimitError) Error() string {
+	if e.Guild {
+		return fmt.Sprintf("guild is rate limited for %s", e.Wait)
+	}
+	return fmt.Sprintf("user is rate limited for %s", e.Wait)
+}
+
+// getCommandBuckets returns the buckets of users and guilds, configured in the ratelimits section of the config.
+// The defaults allow users 64 commands at once and a new one every 3.3 seconds, and guilds 300 at once and a new
+// one every second.
+func getCommandBuckets() (users, guilds *RedisBucketContainer) {
+	commandBucketsOnce.Do(func() {
+		commandUserBuckets = &RedisBucketContainer{
+			Prefix:       "robyul2-discord:ratelimit:commands:user:",
+			Size:         64,
+			DropInterval: 3300 * time.Millisecond,
+		}
+		commandGuildBuckets = &RedisBucketContainer{
+			Prefix:       "robyul2-discord:ratelimit:commands:guild:",
+			Size:         300,
+			DropInterval: time.Second,
+		}
+		configureBuckets(commandUserBuckets, "ratelimits.command_user_bucket_size", "ratelimits.command_user_drop_interval_seconds")
+		configureBuckets(commandGuildBuckets, "ratelimits.command_guild_bucket_size", "ratelimits.command_guild_drop_interval_seconds")
+	})
+	return commandUserBuckets, commandGuildBuckets
+}
+
+func configureBuckets(buckets *RedisBucketContainer, sizePath, intervalPath string) {
+	if size, ok := helpers.GetConfig().Path(sizePath).Data().(float64); ok && size >= 1 {
+		buckets.Size = int(size)
+	}
+	if interval, ok := helpers.GetConfig().Path(intervalPath).Data().(float64); ok && interval > 0 {
+		buckets.DropInterval = time.Duration(interval * float64(time.Second))
+	}
+}
+
+// DrainCommand removes cost keys from the buckets of the user and the guild, the keys are only removed if both
+// have enough keys left. Returns a *CommandLimitError if the user or the guild is rate limited. Commands are not
+// limited if redis is unavailable.
+func DrainCommand(guildID, userID string, cost int) (err error) {
+	users, guilds := getCommandBuckets()
+
+	// a command has to be affordable with full buckets
+	if cost > users.Size {
+		cost = users.Size
+	}
+	if cost > guilds.Size {
+		cost = guilds.Size
+	}
+
+	buckets := []RedisBucket{{Container: users, Key: userID}}
+	if guildID != "" {
+		buckets = append(buckets, RedisBucket{Container: guilds, Key: guildID})
+	}
+
+	states, err := DrainRedisBuckets(cost, buckets...)
+	if err != ErrNoKeysLeft {
+		helpers.RelaxLog(err)
+		return nil
+	}
+
+	limitErr := &CommandLimitError{}
+	noticeKey := "user:" + userID
+	for i, state := range states {
+		if state.Wait > limitErr.Wait {
+			limitErr.Guild = i > 0
+			limitErr.Wait = state.Wait
+		}
+	}
+	if limitErr.Guild {
+		noticeKey = "guild:" + guildID
+	}
+
+	// notify once per limit, the notice expires with the limit
+	limitErr.Notify, err = cache.GetRedisClient().SetNX(
+		fmt.Sprintf(commandNoticeKey, noticeKey), 1, limitErr.Wait+time.Second).Result()
+	helpers.RelaxLog(err)
+
+	return limitErr
+}
+
+// CommandKeysLeft returns how many keys are left in the bucket of the user
+func CommandKeysLeft(userID string) (left int, err error) {
+	users, _ := getCommandBuckets()
+	return users.Get(userID)
+}
@@ -0,0 +1,146 @@
+package ratelimits
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/go-redis/redis"
+)
+
+var ErrNoKeysLeft = errors.New("No keys left")
+
+// refills and drains buckets atomically, keys are only drained if all buckets have enough keys left.
+// Buckets are hashes with the keys left and the time of the last drop.
+// KEYS: the buckets, ARGV: now in ms, amount to drain, then size and drop interval in ms of every bucket
+// returns whether the keys were drained, then the keys left and the ms to wait of every bucket
+var redisBucketDrainScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local amount = tonumber(ARGV[2])
+
+local buckets = {}
+local drained = 1
+for i, key in ipairs(KEYS) do
+	local size = tonumber(ARGV[1 + i * 2])
+	local interval = tonumber(ARGV[2 + i * 2])
+
+	local bucket = redis.call("HMGET", key, "keys", "dropped")
+	local keys = tonumber(bucket[1])
+	local dropped = tonumber(bucket[2])
+	if keys == nil or dropped == nil then
+		keys = size
+		dropped = now
+	end
+
+	local drops = math.floor((now - dropped) / interval)
+	if drops > 0 then
+		keys = math.min(size, keys + drops)
+		dropped = dropped + drops * interval
+	end
+	if keys >= size then
+		dropped = now
+	end
+
+	if keys < amount then
+		drained = 0
+	end
+	buckets[i] = {keys, dropped, size, interval}
+end
+
+local result = {drained}
+for i, key in ipairs(KEYS) do
+	local keys, dropped, size, interval = unpack(buckets[i])
+	if drained == 1 then
+		keys = keys - amount
+	end
+
+	redis.call("HMSET", key, "keys", keys, "dropped", dropped)
+	redis.call("PEXPIRE", key, (size - keys + 1) * interval)
+
+	local wait = 0
+	if keys < amount then
+		wait = (amount - keys) * interval - (now - dropped)
+	end
+	table.insert(result, keys)
+	table.insert(result, wait)
+end
+return result
+`)
+
+// RedisBucketContainer is a token bucket per key like BucketContainer, stored in redis so all instances share
+// the buckets. Buckets are created full and get one key every DropInterval.
+type RedisBucketContainer struct {
+	// Prefix of the redis keys of the buckets
+	Prefix string
+
+	// The maximum amount of keys in a bucket
+	Size int
+
+	// How often a key drips into the buckets
+	DropInterval time.Duration
+}
+
+// RedisBucket is the bucket of a key in a container
+type RedisBucket struct {
+	Container *RedisBucketContainer
+	Key       string
+}
+
+// RedisBucketState is the state of a bucket after draining
+type RedisBucketState struct {
+	Left int
+	// how long to wait until enough keys are available, 0 if there are enough keys left
+	Wait time.Duration
+}
+
+// Drain removes amount keys from the bucket of key if it has enough keys left. Returns the keys left, and
+// ErrNoKeysLeft with the time until enough keys are available if there are not enough keys.
+func (b *RedisBucketContainer) Drain(amount int, key string) (left int, wait time.Duration, err error) {
+	states, err := DrainRedisBuckets(amount, RedisBucket{Container: b, Key: key})
+	if len(states) < 1 {
+		return 0, 0, err
+	}
+	return states[0].Left, states[0].Wait, err
+}
+
+// Get returns the keys left in the bucket of key
+func (b *RedisBucketContainer) Get(key string) (left int, err error) {
+	left, _, err = b.Drain(0, key)
+	return left, err
+}
+
+// DrainRedisBuckets removes amount keys from all buckets if all of them have enough keys left, or from none.
+// Returns ErrNoKeysLeft if a bucket has not enough keys left, the states are in the order of the buckets.
+func DrainRedisBuckets(amount int, buckets ...RedisBucket) (states []RedisBucketState, err error) {
+	keys := make([]string, 0, len(buckets))
+	args := []interface{}{time.Now().UnixNano() / int64(time.Millisecond), amount}
+	for _, bucket := range buckets {
+		keys = append(keys, bucket.Container.Prefix+bucket.Key)
+		args = append(args, bucket.Container.Size, int64(bucket.Container.DropInterval/time.Millisecond))
+	}
+
+	result, err := redisBucketDrainScript.Run(cache.GetRedisClient(), keys, args...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 1+len(buckets)*2 {
+		return nil, errors.New("unexpected result of the bucket script")
+	}
+
+	states = make([]RedisBucketState, 0, len(buckets))
+	for i := range buckets {
+		left, _ := values[1+i*2].(int64)
+		waitMilliseconds, _ := values[2+i*2].(int64)
+		states = append(states, RedisBucketState{
+			Left: int(left),
+			Wait: time.Duration(waitMilliseconds) * time.Millisecond,
+		})
+	}
+
+	if drained, _ := values[0].(int64); drained != 1 {
+		return states, ErrNoKeysLeft
+	}
+	return states, nil
+}
@@ -0,0 +1,232 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/bwmarrin/discordgo"
+	restful "github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack"
+)
+
+const (
+	oauth2UserRedisKey    = "robyul2-discord:api:oauth2-user:%s"
+	oauth2UserCacheExpiry = 5 * time.Minute
+	// how often the last use of an API token is saved
+	apiTokenLastUsedInterval = time.Hour
+)
+
+// authenticateWebkey accepts the global webkey in the Authorization header, the webkey is not accepted
+// in query strings as they end up in logs
+func authenticateWebkey(request *restful.Request) bool {
+	authorizationHeader := strings.TrimSpace(request.HeaderParameter("Authorization"))
+	if !strings.HasPrefix(authorizationHeader, "Webkey ") {
+		return false
+	}
+
+	webkey := strings.TrimSpace(strings.TrimPrefix(authorizationHeader, "Webkey "))
+	if webkey == "" || webkey != helpers.GetConfig().Path("website.webkey").Data().(string) {
+		return false
+	}
+
+	request.SetAttribute("UserID", "global")
+	return true
+}
+
+// authenticateSession accepts sessions of the website stored in redis
+func authenticateSession(request *restful.Request) bool {
+	authorizationHeader := strings.TrimSpace(request.HeaderParameter("Authorization"))
+	if !strings.HasPrefix(authorizationHeader, "PHP-Session ") {
+		return false
+	}
+
+	sessionID := strings.TrimSpace(strings.TrimPrefix(authorizationHeader, "PHP-Session "))
+	key := "robyul2-web:robyul-session:" + sessionID
+	sessionDataString, err := cache.GetRedisClient().Get(key).Result()
+	if err != nil {
+		return false
+	}
+
+	var sessionData models.Website_Session_Data
+	msgpack.Unmarshal([]byte(sessionDataString), &sessionData)
+	if sessionData.DiscordUserID == "" {
+		return false
+	}
+
+	request.SetAttribute("UserID", sessionData.DiscordUserID)
+	return true
+}
+
+// authenticateOAuth2 accepts Discord OAuth2 bearer tokens, the request is handled as a request of the user the
+// token belongs to. The token has to be issued to an application in website.oauth2_client_ids and has to have
+// the identify scope, no other scopes are needed.
+func authenticateOAuth2(request *restful.Request) bool {
+	authorizationHeader := strings.TrimSpace(request.HeaderParameter("Authorization"))
+	if !strings.HasPrefix(authorizationHeader, "Bearer ") {
+		return false
+	}
+
+	accessToken := strings.TrimSpace(strings.TrimPrefix(authorizationHeader, "Bearer "))
+	if accessToken == "" {
+		return false
+	}
+
+	userID, err := getOAuth2UserID(accessToken)
+	if err != nil || userID == "" {
+		return false
+	}
+
+	request.SetAttribute("UserID", userID)
+	return true
+}
+
+var (
+	// returns the application, the scopes and the user of an OAuth2 access token
+	oauth2AuthorizationEndpoint = discordgo.EndpointAPI + "oauth2/@me"
+)
+
+// oauth2Authorization is the response of GET /oauth2/@me
+type oauth2Authorization struct {
+	Application struct {
+		ID string `json:"id"`
+	} `json:"application"`
+	Scopes []string        `json:"scopes"`
+	User   *discordgo.User `json:"user"` // only set with the identify scope
+}
+
+// getOAuth2UserID returns the ID of the user an OAuth2 access token belongs to, cached for a few minutes,
+// tokens of other applications or without the identify scope are rejected
+func getOAuth2UserID(accessToken string) (userID string, err error) {
+	redis := cache.GetRedisClient()
+	key := fmt.Sprintf(oauth2UserRedisKey, helpers.HashApiToken(accessToken))
+
+	userID, err = redis.Get(key).Result()
+	if err == nil && userID != "" {
+		return userID, nil
+	}
+
+	oauth2Session, err := discordgo.New("Bearer " + accessToken)
+	if err != nil {
+		return "", err
+	}
+	body, err := oauth2Session.RequestWithBucketID("GET", oauth2AuthorizationEndpoint, nil, oauth2AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	var authorization oauth2Authorization
+	err = json.Unmarshal(body, &authorization)
+	if err != nil {
+		return "", err
+	}
+
+	if !oauth2ClientIDAllowed(authorization.Application.ID) {
+		return "", errors.New("oauth2 token of an application which is not allowed")
+	}
+	if !helpers.StringSliceContains("identify", authorization.Scopes) || authorization.User == nil || authorization.User.ID == "" {
+		return "", errors.New("oauth2 token without the identify scope")
+	}
+
+	err = redis.Set(key, authorization.User.ID, oauth2UserCacheExpiry).Err()
+	helpers.RelaxLog(err)
+
+	return authorization.User.ID, nil
+}
+
+// oauth2ClientIDAllowed checks if the client ID is in website.oauth2_client_ids, no client is allowed if it is not set
+func oauth2ClientIDAllowed(clientID string) bool {
+	if clientID == "" || !helpers.GetConfig().ExistsP("website.oauth2_client_ids") {
+		return false
+	}
+	allowedClientIDs, err := helpers.GetConfig().Path("website.oauth2_client_ids").Children()
+	if err != nil {
+		return false
+	}
+	for _, allowedClientID := range allowedClientIDs {
+		if allowedClientIDString, ok := allowedClientID.Data().(string); ok && allowedClientIDString == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticateApiToken accepts API tokens with the scope for the guild in the guild-id path parameter,
+// guild and scope are verified here so the request is handled like a global request
+func authenticateApiToken(request *restful.Request, scope models.ApiTokenScope) bool {
+	authorizationHeader := strings.TrimSpace(request.HeaderParameter("Authorization"))
+	if !strings.HasPrefix(authorizationHeader, "Token ") {
+		return false
+	}
+
+	token := strings.TrimSpace(strings.TrimPrefix(authorizationHeader, "Token "))
+	if !helpers.IsApiToken(token) {
+		return false
+	}
+
+	entry, err := helpers.GetApiToken(token)
+	if err != nil {
+		if !helpers.IsMdbNotFound(err) {
+			helpers.RelaxLog(err)
+		}
+		return false
+	}
+
+	if entry.GuildID == "" || entry.GuildID != request.PathParameter("guild-id") || !entry.HasScope(scope) {
+		return false
+	}
+
+	if time.Since(entry.LastUsedAt) > apiTokenLastUsedInterval {
+		entry.LastUsedAt = time.Now()
+		helpers.RelaxLog(helpers.MDbUpdateWithoutLogging(models.ApiTokensTable, entry.ID, entry))
+	}
+
+	request.SetAttribute("UserID", "global")
+	request.SetAttribute("ApiTokenID", helpers.MdbIdToHuman(entry.ID))
+	request.SetAttribute("ApiTokenUserID", entry.UserID)
+	return true
+}
+
+func webkeyAuthenticate(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if !authenticateWebkey(request) {
+		response.WriteErrorString(401, "401: Not Authorized")
+		return
+	}
+
+	chain.ProcessFilter(request, response)
+}
+
+func sessionAndWebkeyAuthenticate(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if !authenticateWebkey(request) && !authenticateSession(request) && !authenticateOAuth2(request) {
+		response.WriteErrorString(401, "401: Not Authorized")
+		return
+	}
+
+	if !rateLimit(request, response) {
+		return
+	}
+
+	chain.ProcessFilter(request, response)
+}
+
+// scopedAuthenticate returns a filter which accepts everything sessionAndWebkeyAuthenticate accepts
+// and API tokens with the scope
+func scopedAuthenticate(scope models.ApiTokenScope) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if !authenticateWebkey(request) && !authenticateSession(request) && !authenticateOAuth2(request) &&
+			!authenticateApiToken(request, scope) {
+			response.WriteErrorString(401, "401: Not Authorized")
+			return
+		}
+
+		if !rateLimit(request, response) {
+			return
+		}
+
+		chain.ProcessFilter(request, response)
+	}
+}
@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+	restful "github.com/emicklei/go-restful"
+	redisCache "github.com/go-redis/cache"
+)
+
+const (
+	apiResponseCacheKey           = "robyul2-discord:api:response:%s"
+	apiResponseCacheDefaultExpiry = time.Minute
+)
+
+type cachedApiResponse struct {
+	ContentType string
+	Body        []byte
+	ETag        string
+}
+
+// responseRecorder buffers the response of a route so it can be cached and tagged
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+// getApiResponseCacheExpiry returns api.cache_seconds, responses are not cached if it is 0
+func getApiResponseCacheExpiry() time.Duration {
+	if seconds, ok := helpers.GetConfig().Path("api.cache_seconds").Data().(float64); ok {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return apiResponseCacheDefaultExpiry
+}
+
+// cacheResponse caches successful GET responses in redis and tags them with an ETag, requests with a matching
+// If-None-Match header are answered with 304. Cached responses are per user, because routes check permissions
+// in their handler, so the filter has to run after the authentication filter.
+func cacheResponse(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if request.Request.Method != http.MethodGet {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	userID, _ := request.Attribute("UserID").(string)
+	keyHash := sha256.Sum256([]byte(userID + " " + request.Request.URL.RequestURI()))
+	key := fmt.Sprintf(apiResponseCacheKey, hex.EncodeToString(keyHash[:]))
+	expiry := getApiResponseCacheExpiry()
+	cacheCodec := cache.GetRedisCacheCodec()
+
+	var cached cachedApiResponse
+	if expiry > 0 && cacheCodec.Get(key, &cached) == nil {
+		response.AddHeader("X-Cache", "HIT")
+		writeTaggedResponse(request, response.ResponseWriter, cached)
+		return
+	}
+
+	recorder := &responseRecorder{ResponseWriter: response.ResponseWriter, status: http.StatusOK}
+	response.ResponseWriter = recorder
+	chain.ProcessFilter(request, response)
+	response.ResponseWriter = recorder.ResponseWriter
+
+	if recorder.status != http.StatusOK {
+		recorder.ResponseWriter.WriteHeader(recorder.status)
+		recorder.ResponseWriter.Write(recorder.body.Bytes())
+		return
+	}
+
+	cached = cachedApiResponse{
+		ContentType: recorder.Header().Get("Content-Type"),
+		Body:        recorder.body.Bytes(),
+		ETag:        newETag(recorder.body.Bytes()),
+	}
+
+	if expiry > 0 {
+		err := cacheCodec.Set(&redisCache.Item{
+			Key:        key,
+			Object:     cached,
+			Expiration: expiry,
+		})
+		helpers.RelaxLog(err)
+	}
+
+	response.AddHeader("X-Cache", "MISS")
+	writeTaggedResponse(request, response.ResponseWriter, cached)
+}
+
+func writeTaggedResponse(request *restful.Request, writer http.ResponseWriter, cached cachedApiResponse) {
+	writer.Header().Set("ETag", cached.ETag)
+	writer.Header().Set("Cache-Control", "private, no-cache")
+
+	if etagMatches(request.HeaderParameter("If-None-Match"), cached.ETag) {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if cached.ContentType != "" {
+		writer.Header().Set("Content-Type", cached.ContentType)
+	}
+	writer.WriteHeader(http.StatusOK)
+	writer.Write(cached.Body)
+}
+
+func newETag(body []byte) string {
+	hash := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(hash[:16]) + `"`
+}
+
+// etagMatches checks if the ETag is in the If-None-Match header, weak ETags match as well
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
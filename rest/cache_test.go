@@ -0,0 +1,28 @@
+package rest
+
+import "testing"
+
+func TestEtagMatches(t *testing.T) {
+	etag := newETag([]byte(`{"Count":1}`))
+
+	tests := []struct {
+		ifNoneMatch string
+		want        bool
+	}{
+		{"", false},
+		{etag, true},
+		{"W/" + etag, true},
+		{`"other", ` + etag, true},
+		{`"other"`, false},
+		{"*", true},
+	}
+	for _, test := range tests {
+		if got := etagMatches(test.ifNoneMatch, etag); got != test.want {
+			t.Errorf("etagMatches(%q) = %v, want %v", test.ifNoneMatch, got, test.want)
+		}
+	}
+
+	if newETag([]byte(`{"Count":2}`)) == etag {
+		t.Error("different bodies have the same ETag")
+	}
+}
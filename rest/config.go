@@ -0,0 +1,1120 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/feeds"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	"github.com/Seklfreak/Robyul2/modules/plugins"
+	"github.com/Seklfreak/Robyul2/modules/plugins/instagram"
+	"github.com/Seklfreak/Robyul2/modules/plugins/youtube"
+	"github.com/bwmarrin/discordgo"
+	restful "github.com/emicklei/go-restful"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/pkg/errors"
+)
+
+// authorizeConfig checks if the user of the request is allowed to configure the guild,
+// returns the user the eventlog entries are logged for. Global requests are logged for the user
+// who created the API token, or for the bot for webkey requests.
+func authorizeConfig(request *restful.Request, response *restful.Response, guildID string, allowed func(guildID, userID string) bool) (userID string, ok bool) {
+	_, err := helpers.GetGuildWithoutApi(guildID)
+	if err != nil {
+		response.WriteError(http.StatusNotFound, errors.New("guild not found"))
+		return "", false
+	}
+
+	userID = request.Attribute("UserID").(string)
+	if userID == "global" {
+		if tokenUserID, ok := request.Attribute("ApiTokenUserID").(string); ok && tokenUserID != "" {
+			return tokenUserID, true
+		}
+		return cache.GetSession().State.User.ID, true
+	}
+
+	if !allowed(guildID, userID) {
+		response.WriteErrorString(401, "401: Not Authorized")
+		return "", false
+	}
+	return userID, true
+}
+
+func getConfigChannel(guildID, channelID string) (channel *discordgo.Channel, ok bool) {
+	channel, err := helpers.GetChannelWithoutApi(channelID)
+	if err != nil || channel.GuildID != guildID {
+		return nil, false
+	}
+	return channel, true
+}
+
+func getConfigRole(guildID, roleID string) (role *discordgo.Role, ok bool) {
+	role, err := cache.GetSession().State.Role(guildID, roleID)
+	if err != nil {
+		return nil, false
+	}
+	return role, true
+}
+
+func GetConfigCustomCommands(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	_, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	var entries []models.CustomCommandsEntry
+	err := helpers.MDbIter(helpers.MdbCollection(models.CustomCommandsTable).Find(
+		bson.M{"guildid": guildID},
+	).Sort("keyword")).All(&entries)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	result := make([]models.Rest_Config_CustomCommand, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, models.Rest_Config_CustomCommand{
+			ID:              helpers.MdbIdToHuman(entry.ID),
+			Keyword:         entry.Keyword,
+			Content:         entry.Content,
+			CreatedByUserID: entry.CreatedByUserID,
+			CreatedAt:       entry.CreatedAt,
+			Triggered:       entry.Triggered,
+		})
+	}
+
+	response.WriteEntity(result)
+}
+
+func AddConfigCustomCommand(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, func(guildID, userID string) bool {
+		return plugins.CustomCommandsCanEdit(guildID, userID, nil)
+	})
+	if !ok {
+		return
+	}
+
+	received := new(models.Rest_Receive_CustomCommand)
+	err := request.ReadEntity(received)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	received.Content = strings.TrimSpace(received.Content)
+	if received.Keyword == "" || strings.ContainsAny(received.Keyword, " \t\r\n") || received.Content == "" {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid keyword or content"))
+		return
+	}
+
+	entry, err := plugins.CustomCommandsAdd(guildID, userID, received.Keyword, received.Content, "")
+	if err != nil {
+		switch err {
+		case plugins.ErrCustomCommandExists:
+			response.WriteError(http.StatusConflict, err)
+		case plugins.ErrCustomCommandIsCommand:
+			response.WriteError(http.StatusBadRequest, err)
+		default:
+			response.WriteError(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, models.Rest_Config_CustomCommand{
+		ID:              helpers.MdbIdToHuman(entry.ID),
+		Keyword:         entry.Keyword,
+		Content:         entry.Content,
+		CreatedByUserID: entry.CreatedByUserID,
+		CreatedAt:       entry.CreatedAt,
+		Triggered:       entry.Triggered,
+	})
+}
+
+func DeleteConfigCustomCommand(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, func(guildID, userID string) bool {
+		return plugins.CustomCommandsCanEdit(guildID, userID, nil)
+	})
+	if !ok {
+		return
+	}
+
+	var entry models.CustomCommandsEntry
+	err := helpers.MdbOne(
+		helpers.MdbCollection(models.CustomCommandsTable).Find(
+			bson.M{"guildid": guildID, "_id": helpers.HumanToMdbId(request.PathParameter("command-id"))}),
+		&entry,
+	)
+	if helpers.IsMdbNotFound(err) {
+		response.WriteError(http.StatusNotFound, errors.New("custom command not found"))
+		return
+	}
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	if request.Attribute("UserID").(string) != "global" && !plugins.CustomCommandsCanEdit(guildID, userID, &entry) {
+		response.WriteErrorString(401, "401: Not Authorized")
+		return
+	}
+
+	err = plugins.CustomCommandsDelete(entry, userID)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func GetConfigFeeds(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	_, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	items, err := feeds.List(guildID)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	result := make([]models.Rest_Config_Feed, 0, len(items))
+	for _, item := range items {
+		result = append(result, models.Rest_Config_Feed{
+			ID:        item.ID,
+			Source:    item.Source,
+			ChannelID: item.ChannelID,
+			Name:      item.Name,
+			Details:   item.Details,
+		})
+	}
+
+	response.WriteEntity(result)
+}
+
+// AddConfigFeed adds a feed of the integrations with an own command, through their plugin to keep the lookups
+// and the eventlog entries of the commands
+func AddConfigFeed(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+	source := request.PathParameter("source")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	received := new(models.Rest_Receive_Feed)
+	err := request.ReadEntity(received)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	received.Target = strings.TrimSpace(received.Target)
+	if received.Target == "" {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid target"))
+		return
+	}
+	if _, ok := getConfigChannel(guildID, received.ChannelID); !ok {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid channel"))
+		return
+	}
+	if received.MentionRoleID != "" {
+		if role, ok := getConfigRole(guildID, received.MentionRoleID); !ok || !role.Mentionable {
+			response.WriteError(http.StatusBadRequest, errors.New("invalid mention role"))
+			return
+		}
+	}
+	if received.PostDelay < 0 || (received.PostDelay > 0 && source != "reddit") {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid post delay"))
+		return
+	}
+	if (received.ExcludeRTs || received.ExcludeMentions) && source != "twitter" {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid exclude options"))
+		return
+	}
+	switch received.PostMode {
+	case models.FeedPostModeEmbed:
+	case models.FeedPostModeLink:
+		ok = source == "twitter"
+	case models.FeedPostModeText:
+		ok = source == "twitter" || source == "instagram" || source == "reddit"
+	default:
+		ok = false
+	}
+	if !ok {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid post mode"))
+		return
+	}
+
+	newEntry := models.FeedEntry{
+		GuildID:       guildID,
+		ChannelID:     received.ChannelID,
+		AddedByUserID: userID,
+		MentionRoleID: received.MentionRoleID,
+		PostMode:      received.PostMode,
+		PostDelay:     received.PostDelay,
+	}
+	var entry models.FeedEntry
+	switch source {
+	case "twitter":
+		entry, err = plugins.TwitterAddFeed(newEntry, received.Target, received.ExcludeRTs, received.ExcludeMentions)
+	case "instagram":
+		entry, err = instagram.AddFeed(newEntry, received.Target)
+	case "youtube":
+		entry, err = youtube.AddChannelFeed(newEntry, received.Target)
+	case "reddit":
+		entry, err = plugins.RedditAddFeed(newEntry, received.Target)
+	case "vlive":
+		entry, err = plugins.VliveAddFeed(newEntry, received.Target)
+	default:
+		response.WriteError(http.StatusBadRequest, errors.New("feeds of this source can only be added with the commands"))
+		return
+	}
+	if err != nil {
+		switch err {
+		case plugins.ErrTwitterAccountNotFound, instagram.ErrInstagramAccountNotFound, youtube.ErrYoutubeChannelNotFound,
+			plugins.ErrRedditSubredditNotFound, plugins.ErrVliveChannelNotFound:
+			response.WriteError(http.StatusNotFound, err)
+		case feeds.ErrAlreadyAdded:
+			response.WriteError(http.StatusConflict, err)
+		case feeds.ErrUnknownSource:
+			response.WriteError(http.StatusServiceUnavailable, errors.New("the source is not available"))
+		default:
+			response.WriteError(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	item := feeds.NewListItem(entry)
+	response.WriteHeaderAndEntity(http.StatusCreated, models.Rest_Config_Feed{
+		ID:        item.ID,
+		Source:    item.Source,
+		ChannelID: item.ChannelID,
+		Name:      item.Name,
+		Details:   item.Details,
+	})
+}
+
+// DeleteConfigFeed removes a feed, the feeds of the integrations with an own command are removed through
+// their plugin to keep the eventlog entries of the commands
+func DeleteConfigFeed(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+	source := request.PathParameter("source")
+	feedID := request.PathParameter("feed-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	if feeds.GetSource(source) == nil {
+		response.WriteError(http.StatusBadRequest, errors.New("feeds of this source can not be removed"))
+		return
+	}
+
+	entry, err := feeds.Get(guildID, feedID)
+	if err == nil && entry.Source != source {
+		err = mgo.ErrNotFound
+	}
+	if err == nil {
+		switch source {
+		case "twitter":
+			err = plugins.TwitterRemoveFeed(entry, userID)
+		case "instagram":
+			err = instagram.RemoveFeed(entry, userID)
+		case "youtube":
+			err = youtube.RemoveChannelFeed(entry, userID)
+		case "reddit":
+			err = plugins.RedditRemoveFeed(entry, userID)
+		case "vlive":
+			err = plugins.VliveRemoveFeed(entry, userID)
+		default:
+			err = plugins.FeedsRemove(entry, userID)
+		}
+	}
+	if helpers.IsMdbNotFound(err) {
+		response.WriteError(http.StatusNotFound, errors.New("feed not found"))
+		return
+	}
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func GetConfigStarboard(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	_, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	response.WriteEntity(models.Rest_Config_Starboard{
+		ChannelID: helpers.GuildSettingsGetCached(guildID).StarboardChannelID,
+		Minimum:   plugins.StarboardGetMinimum(guildID),
+		Emoji:     plugins.StarboardGetEmoji(guildID),
+	})
+}
+
+// SetConfigStarboard replaces the starboard settings, an empty channel disables the starboard
+// and an empty emoji list resets the emoji to the default emoji
+func SetConfigStarboard(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	received := new(models.Rest_Config_Starboard)
+	err := request.ReadEntity(received)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	if received.ChannelID != "" {
+		if _, ok := getConfigChannel(guildID, received.ChannelID); !ok {
+			response.WriteError(http.StatusBadRequest, errors.New("invalid channel"))
+			return
+		}
+	}
+	if received.Minimum < 1 {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid minimum"))
+		return
+	}
+	newEmoji := make([]string, 0, len(received.Emoji))
+	for _, emoji := range received.Emoji {
+		if !helpers.IsEmoji(emoji) {
+			response.WriteError(http.StatusBadRequest, errors.New("invalid emoji"))
+			return
+		}
+		if helpers.IsDiscordEmoji(emoji) {
+			discordEmoji, err := helpers.GetDiscordEmojiFromText(guildID, emoji)
+			if err != nil || discordEmoji == nil || discordEmoji.Name == "" {
+				response.WriteError(http.StatusBadRequest, errors.New("invalid emoji"))
+				return
+			}
+			emoji = discordEmoji.Name
+		}
+		newEmoji = append(newEmoji, emoji)
+	}
+
+	emojiBefore := plugins.StarboardGetEmoji(guildID)
+	minimumBefore := helpers.GuildSettingsGetCached(guildID).StarboardMinimum
+
+	guildSettings := helpers.GuildSettingsGetCached(guildID)
+	previousChannelID := guildSettings.StarboardChannelID
+	guildSettings.StarboardChannelID = received.ChannelID
+	guildSettings.StarboardMinimum = received.Minimum
+	guildSettings.StarboardEmoji = newEmoji
+	err = helpers.GuildSettingsSet(guildID, guildSettings)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	starboardOptions := []models.ElasticEventlogOption{
+		{
+			Key:   "starboard_emoji",
+			Value: strings.Join(plugins.StarboardGetEmoji(guildID), ";"),
+			Type:  models.EventlogTargetTypeEmoji,
+		},
+		{
+			Key:   "starboard_minimum",
+			Value: strconv.Itoa(plugins.StarboardGetMinimum(guildID)),
+		},
+	}
+
+	if previousChannelID != guildSettings.StarboardChannelID {
+		if guildSettings.StarboardChannelID == "" {
+			_, err = helpers.EventlogLog(time.Now(), guildID, previousChannelID,
+				models.EventlogTargetTypeChannel, userID,
+				models.EventlogTypeRobyulStarboardDelete, "",
+				nil,
+				starboardOptions, false)
+			helpers.RelaxLog(err)
+		} else {
+			changes := make([]models.ElasticEventlogChange, 0)
+			if previousChannelID != "" {
+				changes = []models.ElasticEventlogChange{
+					{
+						Key:      "starboard_channelid",
+						OldValue: previousChannelID,
+						NewValue: guildSettings.StarboardChannelID,
+						Type:     models.EventlogTargetTypeChannel,
+					},
+				}
+			}
+
+			_, err = helpers.EventlogLog(time.Now(), guildID, guildSettings.StarboardChannelID,
+				models.EventlogTargetTypeChannel, userID,
+				models.EventlogTypeRobyulStarboardCreate, "",
+				changes,
+				starboardOptions, false)
+			helpers.RelaxLog(err)
+		}
+	}
+
+	if minimumBefore != guildSettings.StarboardMinimum {
+		_, err = helpers.EventlogLog(time.Now(), guildID, guildSettings.StarboardChannelID,
+			models.EventlogTargetTypeChannel, userID,
+			models.EventlogTypeRobyulStarboardUpdate, "",
+			[]models.ElasticEventlogChange{
+				{
+					Key:      "starboard_minimum",
+					OldValue: strconv.Itoa(minimumBefore),
+					NewValue: strconv.Itoa(guildSettings.StarboardMinimum),
+				},
+			},
+			nil, false)
+		helpers.RelaxLog(err)
+	}
+
+	emojiAfter := plugins.StarboardGetEmoji(guildID)
+	if strings.Join(emojiBefore, ";") != strings.Join(emojiAfter, ";") {
+		added, removed := helpers.StringSliceDiff(emojiBefore, emojiAfter)
+		options := make([]models.ElasticEventlogOption, 0)
+		for _, emoji := range added {
+			options = append(options, models.ElasticEventlogOption{
+				Key:   "starboard_emoji_added",
+				Value: emoji,
+				Type:  models.EventlogTargetTypeEmoji,
+			})
+		}
+		for _, emoji := range removed {
+			options = append(options, models.ElasticEventlogOption{
+				Key:   "starboard_emoji_removed",
+				Value: emoji,
+				Type:  models.EventlogTargetTypeEmoji,
+			})
+		}
+
+		_, err = helpers.EventlogLog(time.Now(), guildID, guildSettings.StarboardChannelID,
+			models.EventlogTargetTypeChannel, userID,
+			models.EventlogTypeRobyulStarboardUpdate, "",
+			[]models.ElasticEventlogChange{
+				{
+					Key:      "starboard_emoji",
+					OldValue: strings.Join(emojiBefore, ";"),
+					NewValue: strings.Join(emojiAfter, ";"),
+				},
+			},
+			options, false)
+		helpers.RelaxLog(err)
+	}
+
+	response.WriteEntity(models.Rest_Config_Starboard{
+		ChannelID: guildSettings.StarboardChannelID,
+		Minimum:   plugins.StarboardGetMinimum(guildID),
+		Emoji:     emojiAfter,
+	})
+}
+
+func GetConfigLevelsRoles(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	_, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	var entries []models.LevelsRoleEntry
+	err := helpers.MDbIter(helpers.MdbCollection(models.LevelsRolesTable).Find(
+		bson.M{"guildid": guildID},
+	).Sort("startlevel")).All(&entries)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	result := make([]models.Rest_Config_LevelsRole, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, models.Rest_Config_LevelsRole{
+			ID:         helpers.MdbIdToHuman(entry.ID),
+			RoleID:     entry.RoleID,
+			StartLevel: entry.StartLevel,
+			LastLevel:  entry.LastLevel,
+		})
+	}
+
+	response.WriteEntity(result)
+}
+
+func AddConfigLevelsRole(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	received := new(models.Rest_Receive_LevelsRole)
+	err := request.ReadEntity(received)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	if _, ok := getConfigRole(guildID, received.RoleID); !ok ||
+		received.StartLevel < 0 || (received.LastLevel < 0 && received.LastLevel != -1) ||
+		(received.LastLevel != -1 && received.StartLevel > received.LastLevel) {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid role or levels"))
+		return
+	}
+
+	entry := models.LevelsRoleEntry{
+		GuildID:    guildID,
+		RoleID:     received.RoleID,
+		StartLevel: received.StartLevel,
+		LastLevel:  received.LastLevel,
+	}
+	entry.ID, err = helpers.MDbInsert(models.LevelsRolesTable, entry)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), guildID, entry.RoleID,
+		models.EventlogTargetTypeRole, userID,
+		models.EventlogTypeRobyulLevelsRoleAdd, "",
+		nil,
+		levelsRoleEventlogOptions(entry), false)
+	helpers.RelaxLog(err)
+
+	response.WriteHeaderAndEntity(http.StatusCreated, models.Rest_Config_LevelsRole{
+		ID:         helpers.MdbIdToHuman(entry.ID),
+		RoleID:     entry.RoleID,
+		StartLevel: entry.StartLevel,
+		LastLevel:  entry.LastLevel,
+	})
+}
+
+func DeleteConfigLevelsRole(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	var entry models.LevelsRoleEntry
+	err := helpers.MdbOne(
+		helpers.MdbCollection(models.LevelsRolesTable).Find(
+			bson.M{"guildid": guildID, "_id": helpers.HumanToMdbId(request.PathParameter("entry-id"))}),
+		&entry,
+	)
+	if helpers.IsMdbNotFound(err) {
+		response.WriteError(http.StatusNotFound, errors.New("levels role not found"))
+		return
+	}
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	err = helpers.MDbDelete(models.LevelsRolesTable, entry.ID)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), guildID, entry.RoleID,
+		models.EventlogTargetTypeRole, userID,
+		models.EventlogTypeRobyulLevelsRoleDelete, "",
+		nil,
+		levelsRoleEventlogOptions(entry), false)
+	helpers.RelaxLog(err)
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func levelsRoleEventlogOptions(entry models.LevelsRoleEntry) (options []models.ElasticEventlogOption) {
+	options = []models.ElasticEventlogOption{
+		{
+			Key:   "role_startlevel",
+			Value: strconv.Itoa(entry.StartLevel),
+		},
+	}
+
+	if entry.LastLevel >= 0 {
+		options = append(options, models.ElasticEventlogOption{
+			Key:   "role_lastlevel",
+			Value: strconv.Itoa(entry.LastLevel),
+		})
+	}
+	return options
+}
+
+func GetConfigAutoRoles(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	_, ok := authorizeConfig(request, response, guildID, helpers.IsAdminByID)
+	if !ok {
+		return
+	}
+
+	settings := helpers.GuildSettingsGetCached(guildID)
+
+	result := make([]models.Rest_Config_AutoRole, 0, len(settings.AutoRoleIDs)+len(settings.DelayedAutoRoles))
+	for _, roleID := range settings.AutoRoleIDs {
+		result = append(result, models.Rest_Config_AutoRole{
+			RoleID: roleID,
+		})
+	}
+	for _, delayedRole := range settings.DelayedAutoRoles {
+		result = append(result, models.Rest_Config_AutoRole{
+			RoleID: delayedRole.RoleID,
+			Delay:  delayedRole.Delay.String(),
+		})
+	}
+
+	response.WriteEntity(result)
+}
+
+func AddConfigAutoRole(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsAdminByID)
+	if !ok {
+		return
+	}
+
+	received := new(models.Rest_Receive_AutoRole)
+	err := request.ReadEntity(received)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	if _, ok := getConfigRole(guildID, received.RoleID); !ok {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid role"))
+		return
+	}
+
+	var delay time.Duration
+	if received.Delay != "" {
+		delay, err = time.ParseDuration(received.Delay)
+		if err != nil || delay < 0 {
+			response.WriteError(http.StatusBadRequest, errors.New("invalid delay"))
+			return
+		}
+	}
+
+	settings := helpers.GuildSettingsGetCached(guildID)
+
+	for _, role := range settings.AutoRoleIDs {
+		if role == received.RoleID {
+			response.WriteError(http.StatusConflict, errors.New("role is an autorole already"))
+			return
+		}
+	}
+	for _, delayedRole := range settings.DelayedAutoRoles {
+		if delayedRole.RoleID == received.RoleID {
+			response.WriteError(http.StatusConflict, errors.New("role is an autorole already"))
+			return
+		}
+	}
+
+	if delay <= 0 {
+		settings.AutoRoleIDs = append(settings.AutoRoleIDs, received.RoleID)
+	} else {
+		settings.DelayedAutoRoles = append(settings.DelayedAutoRoles, models.DelayedAutoRole{
+			RoleID: received.RoleID,
+			Delay:  delay,
+		})
+	}
+
+	err = helpers.GuildSettingsSet(guildID, settings)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), guildID, received.RoleID,
+		models.EventlogTargetTypeRole, userID,
+		models.EventlogTypeRobyulAutoroleAdd, "",
+		nil,
+		autoRoleEventlogOptions(delay), false)
+	helpers.RelaxLog(err)
+
+	result := models.Rest_Config_AutoRole{
+		RoleID: received.RoleID,
+	}
+	if delay > 0 {
+		result.Delay = delay.String()
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, result)
+}
+
+func DeleteConfigAutoRole(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+	roleID := request.PathParameter("role-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsAdminByID)
+	if !ok {
+		return
+	}
+
+	settings := helpers.GuildSettingsGetCached(guildID)
+
+	roleWasInList := false
+	newRoleIDs := make([]string, 0)
+	newDelayedRoles := make([]models.DelayedAutoRole, 0)
+	var delay time.Duration
+
+	for _, role := range settings.AutoRoleIDs {
+		if role == roleID {
+			roleWasInList = true
+		} else {
+			newRoleIDs = append(newRoleIDs, role)
+		}
+	}
+	for _, delayedRole := range settings.DelayedAutoRoles {
+		if delayedRole.RoleID == roleID {
+			delay = delayedRole.Delay
+			roleWasInList = true
+		} else {
+			newDelayedRoles = append(newDelayedRoles, delayedRole)
+		}
+	}
+
+	if !roleWasInList {
+		response.WriteError(http.StatusNotFound, errors.New("autorole not found"))
+		return
+	}
+
+	settings.AutoRoleIDs = newRoleIDs
+	settings.DelayedAutoRoles = newDelayedRoles
+
+	err := helpers.GuildSettingsSet(guildID, settings)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	_, err = helpers.EventlogLog(time.Now(), guildID, roleID,
+		models.EventlogTargetTypeRole, userID,
+		models.EventlogTypeRobyulAutoroleRemove, "",
+		nil,
+		autoRoleEventlogOptions(delay), false)
+	helpers.RelaxLog(err)
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func autoRoleEventlogOptions(delay time.Duration) (options []models.ElasticEventlogOption) {
+	options = make([]models.ElasticEventlogOption, 0)
+	if delay > 0 {
+		options = append(options, models.ElasticEventlogOption{
+			Key:   "autorole_delay",
+			Value: delay.String(),
+		})
+	}
+	return options
+}
+
+func GetConfigModulePermissions(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	_, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	entries := helpers.GetModulePermissionEntries(guildID)
+
+	result := make([]models.Rest_Config_ModulePermission, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, models.Rest_Config_ModulePermission{
+			Type:     entry.Type,
+			TargetID: entry.TargetID,
+			Allowed:  modulePermissionNames(entry.Allowed),
+			Denied:   modulePermissionNames(entry.Denied),
+		})
+	}
+
+	response.WriteEntity(result)
+}
+
+// SetConfigModulePermission replaces the allowed and denied modules of a channel or role,
+// every added or removed module is logged like the modulepermissions command does
+func SetConfigModulePermission(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+	targetType := request.PathParameter("target-type")
+	targetID := request.PathParameter("target-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	var eventlogTargetType string
+	switch targetType {
+	case "channel":
+		if _, ok := getConfigChannel(guildID, targetID); !ok {
+			response.WriteError(http.StatusBadRequest, errors.New("invalid channel"))
+			return
+		}
+		eventlogTargetType = models.EventlogTargetTypeChannel
+	case "role":
+		if _, ok := getConfigRole(guildID, targetID); !ok {
+			response.WriteError(http.StatusBadRequest, errors.New("invalid role"))
+			return
+		}
+		eventlogTargetType = models.EventlogTargetTypeRole
+	default:
+		response.WriteError(http.StatusBadRequest, errors.New("invalid target type"))
+		return
+	}
+
+	received := new(models.Rest_Receive_ModulePermission)
+	err := request.ReadEntity(received)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	newAllowed, ok := modulePermissionsFromNames(received.Allowed)
+	if !ok {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid allowed module"))
+		return
+	}
+	newDenied, ok := modulePermissionsFromNames(received.Denied)
+	if !ok {
+		response.WriteError(http.StatusBadRequest, errors.New("invalid denied module"))
+		return
+	}
+
+	var oldAllowed, oldDenied models.ModulePermissionsModule
+	if targetType == "channel" {
+		oldAllowed = helpers.GetAllowedForChannel(guildID, targetID)
+		oldDenied = helpers.GetDeniedForChannel(guildID, targetID)
+		err = helpers.SetAllowedForChannel(guildID, targetID, newAllowed)
+		if err == nil {
+			err = helpers.SetDeniedForChannel(guildID, targetID, newDenied)
+		}
+	} else {
+		oldAllowed = helpers.GetAllowedForRole(guildID, targetID)
+		oldDenied = helpers.GetDeniedForRole(guildID, targetID)
+		err = helpers.SetAllowedForRole(guildID, targetID, newAllowed)
+		if err == nil {
+			err = helpers.SetDeniedForRole(guildID, targetID, newDenied)
+		}
+	}
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	logModulePermissionChanges(guildID, targetID, eventlogTargetType, userID, "allow", targetType, oldAllowed, newAllowed)
+	logModulePermissionChanges(guildID, targetID, eventlogTargetType, userID, "deny", targetType, oldDenied, newDenied)
+
+	response.WriteEntity(models.Rest_Config_ModulePermission{
+		Type:     targetType,
+		TargetID: targetID,
+		Allowed:  modulePermissionNames(newAllowed),
+		Denied:   modulePermissionNames(newDenied),
+	})
+}
+
+var modulePermissionEventlogTypes = map[string]string{
+	"allow_channel_added":   models.EventlogTypeRobyulModuleAllowChannelAdd,
+	"allow_channel_removed": models.EventlogTypeRobyulModuleAllowChannelRemove,
+	"allow_role_added":      models.EventlogTypeRobyulModuleAllowRoleAdd,
+	"allow_role_removed":    models.EventlogTypeRobyulModuleAllowRoleRemove,
+	"deny_channel_added":    models.EventlogTypeRobyulModuleDenyChannelAdd,
+	"deny_channel_removed":  models.EventlogTypeRobyulModuleDenyChannelRemove,
+	"deny_role_added":       models.EventlogTypeRobyulModuleDenyRoleAdd,
+	"deny_role_removed":     models.EventlogTypeRobyulModuleDenyRoleRemove,
+}
+
+// logModulePermissionChanges logs one eventlog entry for every module added to or removed from the permissions
+// permission	: allow or deny
+// targetType	: channel or role
+func logModulePermissionChanges(guildID, targetID, eventlogTargetType, userID, permission, targetType string, before, after models.ModulePermissionsModule) {
+	for _, module := range helpers.Modules {
+		wasSet := before&module.Permission == module.Permission
+		isSet := after&module.Permission == module.Permission
+		if wasSet == isSet {
+			continue
+		}
+
+		action := "added"
+		if wasSet {
+			action = "removed"
+		}
+		key := permission + "_" + targetType + "_" + action
+
+		_, err := helpers.EventlogLog(time.Now(), guildID, targetID,
+			eventlogTargetType, userID,
+			modulePermissionEventlogTypes[key], "",
+			nil,
+			[]models.ElasticEventlogOption{
+				{
+					Key:   "module_" + key,
+					Value: helpers.GetModuleNameById(module.Permission),
+				},
+			}, false)
+		helpers.RelaxLog(err)
+	}
+}
+
+func modulePermissionNames(permissions models.ModulePermissionsModule) (names []string) {
+	names = make([]string, 0)
+	if permissions < 0 {
+		return names
+	}
+	for _, module := range helpers.Modules {
+		if permissions&module.Permission == module.Permission {
+			names = append(names, helpers.GetModuleNameById(module.Permission))
+		}
+	}
+	return names
+}
+
+func modulePermissionsFromNames(names []string) (permissions models.ModulePermissionsModule, ok bool) {
+NextName:
+	for _, name := range names {
+		for _, module := range helpers.Modules {
+			for _, moduleName := range module.Names {
+				if strings.ToLower(name) == moduleName {
+					permissions |= module.Permission
+					continue NextName
+				}
+			}
+		}
+		return 0, false
+	}
+	return permissions, true
+}
+
+func GetConfigEventlog(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	_, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	settings := helpers.GuildSettingsGetCached(guildID)
+
+	channelIDs := settings.EventlogChannelIDs
+	if channelIDs == nil {
+		channelIDs = make([]string, 0)
+	}
+	response.WriteEntity(models.Rest_Config_Eventlog{
+		Enabled:    !settings.EventlogDisabled,
+		ChannelIDs: channelIDs,
+	})
+}
+
+// SetConfigEventlog replaces the eventlog settings, changing whether the eventlog is enabled requires
+// the admin permissions like the eventlog command does
+func SetConfigEventlog(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	userID, ok := authorizeConfig(request, response, guildID, helpers.IsModByID)
+	if !ok {
+		return
+	}
+
+	received := new(models.Rest_Config_Eventlog)
+	err := request.ReadEntity(received)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	newLogChannelIDs := make([]string, 0, len(received.ChannelIDs))
+	for _, channelID := range received.ChannelIDs {
+		if _, ok := getConfigChannel(guildID, channelID); !ok {
+			response.WriteError(http.StatusBadRequest, errors.New("invalid channel"))
+			return
+		}
+		newLogChannelIDs = append(newLogChannelIDs, channelID)
+	}
+
+	settings := helpers.GuildSettingsGetCached(guildID)
+	beforeEnabled := !settings.EventlogDisabled
+
+	if beforeEnabled != received.Enabled &&
+		request.Attribute("UserID").(string) != "global" && !helpers.IsAdminByID(guildID, userID) {
+		response.WriteErrorString(401, "401: Not Authorized")
+		return
+	}
+
+	if strings.Join(settings.EventlogChannelIDs, ";") != strings.Join(newLogChannelIDs, ";") {
+		_, err = helpers.EventlogLog(time.Now(), guildID, guildID,
+			models.EventlogTargetTypeGuild, userID,
+			models.EventlogTypeRobyulEventlogConfigUpdate, "",
+			[]models.ElasticEventlogChange{
+				{
+					Key:      "eventlog_log_channelids",
+					OldValue: strings.Join(settings.EventlogChannelIDs, ";"),
+					NewValue: strings.Join(newLogChannelIDs, ";"),
+					Type:     models.EventlogTargetTypeChannel,
+				},
+			},
+			nil, false)
+		helpers.RelaxLog(err)
+	}
+
+	enabledChanges := []models.ElasticEventlogChange{
+		{
+			Key:      "eventlog_enabled",
+			OldValue: helpers.StoreBoolAsString(beforeEnabled),
+			NewValue: helpers.StoreBoolAsString(received.Enabled),
+		},
+	}
+
+	// the change is logged while the eventlog is enabled, so before disabling it or after enabling it
+	if beforeEnabled && !received.Enabled {
+		_, err = helpers.EventlogLog(time.Now(), guildID, guildID,
+			models.EventlogTargetTypeGuild, userID,
+			models.EventlogTypeRobyulEventlogConfigUpdate, "",
+			enabledChanges,
+			nil, false)
+		helpers.RelaxLog(err)
+	}
+
+	settings.EventlogChannelIDs = newLogChannelIDs
+	settings.EventlogDisabled = !received.Enabled
+	err = helpers.GuildSettingsSet(guildID, settings)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	if !beforeEnabled && received.Enabled {
+		_, err = helpers.EventlogLog(time.Now(), guildID, guildID,
+			models.EventlogTargetTypeGuild, userID,
+			models.EventlogTypeRobyulEventlogConfigUpdate, "",
+			enabledChanges,
+			nil, false)
+		helpers.RelaxLog(err)
+	}
+
+	response.WriteEntity(models.Rest_Config_Eventlog{
+		Enabled:    received.Enabled,
+		ChannelIDs: newLogChannelIDs,
+	})
+}
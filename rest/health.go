@@ -0,0 +1,14 @@
+package rest
+
+import (
+	"github.com/Seklfreak/Robyul2/health"
+	restful "github.com/emicklei/go-restful"
+)
+
+func GetHealth(_ *restful.Request, response *restful.Response) {
+	health.WriteReport(response.ResponseWriter, health.Liveness())
+}
+
+func GetReadiness(_ *restful.Request, response *restful.Response) {
+	health.WriteReport(response.ResponseWriter, health.Readiness())
+}
@@ -0,0 +1,104 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/models"
+	restful "github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+)
+
+const (
+	// comments are sent regularly so proxies don't close idle streams
+	liveEventsKeepAliveInterval = 30 * time.Second
+)
+
+// GetLiveEvents streams the live events of a guild as Server-Sent Events, the types query parameter
+// selects the streamed event types, comma separated
+func GetLiveEvents(request *restful.Request, response *restful.Response) {
+	guildID := request.PathParameter("guild-id")
+
+	if request.Attribute("UserID").(string) != "global" {
+		if !helpers.IsModByID(guildID, request.Attribute("UserID").(string)) {
+			response.WriteErrorString(401, "401: Not Authorized")
+			return
+		}
+	}
+
+	if _, err := helpers.GetGuildWithoutApi(guildID); err != nil {
+		response.WriteError(http.StatusNotFound, errors.New("guild not found"))
+		return
+	}
+
+	eventTypes := make(map[models.LiveEventType]bool)
+	if typesParameter := request.QueryParameter("types"); typesParameter != "" {
+	NextType:
+		for _, typeText := range strings.Split(typesParameter, ",") {
+			for _, eventType := range models.LiveEventTypes {
+				if string(eventType) == strings.TrimSpace(typeText) {
+					eventTypes[eventType] = true
+					continue NextType
+				}
+			}
+			response.WriteError(http.StatusBadRequest, errors.New("invalid event type "+typeText))
+			return
+		}
+	} else {
+		for _, eventType := range models.LiveEventTypes {
+			eventTypes[eventType] = true
+		}
+	}
+
+	flusher, ok := response.ResponseWriter.(http.Flusher)
+	if !ok {
+		response.WriteError(http.StatusInternalServerError, errors.New("streaming is not supported"))
+		return
+	}
+
+	events, unsubscribe := helpers.SubscribeLiveEvents(guildID)
+	defer unsubscribe()
+
+	response.Header().Set("Content-Type", "text/event-stream")
+	response.Header().Set("Cache-Control", "no-cache")
+	response.Header().Set("Connection", "keep-alive")
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(liveEventsKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-request.Request.Context().Done():
+			return
+		case <-keepAlive.C:
+			_, err := fmt.Fprint(response, ": keep-alive\n\n")
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		case event := <-events:
+			if !eventTypes[event.Type] {
+				continue
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				helpers.RelaxLog(err)
+				continue
+			}
+
+			_, err = fmt.Fprintf(response, "event: %s\ndata: %s\n\n", event.Type, data)
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
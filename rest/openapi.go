@@ -0,0 +1,265 @@
+package rest
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/version"
+	restful "github.com/emicklei/go-restful"
+)
+
+// the services described by GetOpenAPI, set by NewRestServices
+var openAPIServices []*restful.WebService
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func GetOpenAPI(request *restful.Request, response *restful.Response) {
+	response.WriteEntity(NewOpenAPIDocument(openAPIServices))
+}
+
+// NewOpenAPIDocument describes the routes of the services as an OpenAPI 3.0 document, using the
+// documentation, parameters and sample entities of the routes
+func NewOpenAPIDocument(services []*restful.WebService) (document map[string]interface{}) {
+	schemas := make(map[string]interface{})
+	paths := make(map[string]interface{})
+
+	for _, service := range services {
+		for _, route := range service.Routes() {
+			path, parameters := openAPIPath(route)
+
+			pathItem, ok := paths[path].(map[string]interface{})
+			if !ok {
+				pathItem = make(map[string]interface{})
+				paths[path] = pathItem
+			}
+			pathItem[strings.ToLower(route.Method)] = openAPIOperation(route, parameters, service.Documentation(), schemas)
+		}
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   "Robyul REST API",
+			"version": version.BOT_VERSION,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"webkey":  openAPIAuthorizationScheme("Webkey <webkey>, the global webkey of the website"),
+				"session": openAPIAuthorizationScheme("PHP-Session <session id>, a session of the website"),
+				"token": openAPIAuthorizationScheme("Token <API token>, an API token of a guild, " +
+					"created with the apitoken command. Only accepted by guild routes of the token's scopes"),
+				"oauth2": map[string]interface{}{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "Discord OAuth2 access token with the identify scope, issued to an application in website.oauth2_client_ids",
+				},
+			},
+		},
+		"security": []interface{}{
+			map[string]interface{}{"webkey": []string{}},
+			map[string]interface{}{"session": []string{}},
+			map[string]interface{}{"token": []string{}},
+			map[string]interface{}{"oauth2": []string{}},
+		},
+	}
+}
+
+func openAPIAuthorizationScheme(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "apiKey",
+		"in":          "header",
+		"name":        "Authorization",
+		"description": description,
+	}
+}
+
+// openAPIPath returns the path of the route without regular expressions, and its path parameters
+func openAPIPath(route restful.Route) (path string, parameters []string) {
+	parts := strings.Split(route.Path, "/")
+	for i, part := range parts {
+		if !strings.HasPrefix(part, "{") || !strings.HasSuffix(part, "}") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}")
+		if index := strings.Index(name, ":"); index >= 0 {
+			name = name[:index]
+		}
+		parts[i] = "{" + name + "}"
+		parameters = append(parameters, name)
+	}
+	path = strings.Join(parts, "/")
+	if path == "" {
+		path = "/"
+	}
+	return path, parameters
+}
+
+func openAPIOperation(route restful.Route, pathParameters []string, tag string, schemas map[string]interface{}) map[string]interface{} {
+	operation := map[string]interface{}{
+		"operationId": route.Operation,
+		"summary":     route.Doc,
+	}
+	if route.Notes != "" {
+		operation["description"] = route.Notes
+	}
+	if tag != "" {
+		operation["tags"] = []string{tag}
+	}
+	if len(route.Filters) <= 0 {
+		operation["security"] = []interface{}{}
+	}
+
+	descriptions := make(map[string]string)
+	parameters := make([]interface{}, 0)
+	for _, parameter := range route.ParameterDocs {
+		data := parameter.Data()
+		switch data.Kind {
+		case restful.PathParameterKind:
+			descriptions[data.Name] = data.Description
+		case restful.QueryParameterKind, restful.HeaderParameterKind:
+			in := "query"
+			if data.Kind == restful.HeaderParameterKind {
+				in = "header"
+			}
+			parameters = append(parameters, map[string]interface{}{
+				"name":        data.Name,
+				"in":          in,
+				"description": data.Description,
+				"required":    data.Required,
+				"schema":      map[string]interface{}{"type": "string"},
+			})
+		}
+	}
+	for _, name := range pathParameters {
+		parameters = append(parameters, map[string]interface{}{
+			"name":        name,
+			"in":          "path",
+			"description": descriptions[name],
+			"required":    true,
+			"schema":      map[string]interface{}{"type": "string"},
+		})
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+
+	if route.ReadSample != nil {
+		operation["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content":  openAPIContent(route.Consumes, openAPISchema(reflect.TypeOf(route.ReadSample), schemas)),
+		}
+	}
+
+	responses := make(map[string]interface{})
+	success := map[string]interface{}{
+		"description": "OK",
+	}
+	if route.WriteSample != nil {
+		success["content"] = openAPIContent(route.Produces, openAPISchema(reflect.TypeOf(route.WriteSample), schemas))
+	}
+	// the handlers answer with 201 for created entities and 204 for deleted entities
+	successCode := http.StatusOK
+	if route.Method == http.MethodPost && route.WriteSample != nil {
+		successCode = http.StatusCreated
+	} else if route.Method == http.MethodDelete && route.WriteSample == nil {
+		successCode = http.StatusNoContent
+	}
+	responses[strconv.Itoa(successCode)] = success
+	for code, responseError := range route.ResponseErrors {
+		errorResponse := map[string]interface{}{
+			"description": responseError.Message,
+		}
+		if responseError.Model != nil {
+			errorResponse["content"] = openAPIContent(route.Produces, openAPISchema(reflect.TypeOf(responseError.Model), schemas))
+		}
+		responses[strconv.Itoa(code)] = errorResponse
+	}
+	operation["responses"] = responses
+
+	return operation
+}
+
+func openAPIContent(mimeTypes []string, schema map[string]interface{}) map[string]interface{} {
+	content := make(map[string]interface{})
+	for _, mimeType := range mimeTypes {
+		content[mimeType] = map[string]interface{}{
+			"schema": schema,
+		}
+	}
+	if len(content) <= 0 {
+		content[restful.MIME_JSON] = map[string]interface{}{
+			"schema": schema,
+		}
+	}
+	return content
+}
+
+// openAPISchema returns the schema of a type, structs are added to the schemas and referenced
+func openAPISchema(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": openAPISchema(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": openAPISchema(t.Elem(), schemas)}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return openAPIStructSchema(t, schemas)
+		}
+		if _, ok := schemas[t.Name()]; !ok {
+			// placeholder for recursive types
+			schemas[t.Name()] = map[string]interface{}{}
+			schemas[t.Name()] = openAPIStructSchema(t, schemas)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+	}
+	return map[string]interface{}{}
+}
+
+func openAPIStructSchema(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			tagName := strings.Split(tag, ",")[0]
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		properties[name] = openAPISchema(field.Type, schemas)
+	}
+
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+}
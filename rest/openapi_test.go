@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"testing"
+
+	restful "github.com/emicklei/go-restful"
+)
+
+type openAPITestEntity struct {
+	Name     string
+	Children []openAPITestEntity
+	Hidden   string `json:"-"`
+}
+
+func TestNewOpenAPIDocument(t *testing.T) {
+	service := new(restful.WebService)
+	service.Path("/test").Consumes(restful.MIME_JSON).Produces(restful.MIME_JSON)
+	service.Route(service.PUT("/{guild-id}/entities/{entity-id:[0-9]+}").To(GetOpenAPI).
+		Doc("Set an entity").
+		Reads(openAPITestEntity{}).Writes(openAPITestEntity{}))
+
+	document := NewOpenAPIDocument([]*restful.WebService{service})
+
+	pathItem, ok := document["paths"].(map[string]interface{})["/test/{guild-id}/entities/{entity-id}"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("path missing in %v", document["paths"])
+	}
+	operation := pathItem["put"].(map[string]interface{})
+	if operation["summary"] != "Set an entity" {
+		t.Errorf("summary = %v", operation["summary"])
+	}
+	if parameters := operation["parameters"].([]interface{}); len(parameters) != 2 {
+		t.Errorf("expected 2 path parameters, got %d", len(parameters))
+	}
+	if _, ok := operation["requestBody"]; !ok {
+		t.Error("request body missing")
+	}
+
+	schema, ok := document["components"].(map[string]interface{})["schemas"].(map[string]interface{})["openAPITestEntity"].(map[string]interface{})
+	if !ok {
+		t.Fatal("schema missing")
+	}
+	properties := schema["properties"].(map[string]interface{})
+	if _, ok := properties["Hidden"]; ok {
+		t.Error("ignored field in schema")
+	}
+	if _, ok := properties["Children"]; !ok {
+		t.Error("recursive field missing in schema")
+	}
+}
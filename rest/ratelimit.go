@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/ratelimits"
+	restful "github.com/emicklei/go-restful"
+)
+
+const (
+	apiRatelimitDefaultBucketSize   = 60
+	apiRatelimitDefaultDropInterval = time.Second
+)
+
+var (
+	apiRatelimits     *ratelimits.RedisBucketContainer
+	apiRatelimitsOnce sync.Once
+)
+
+// getApiRatelimits returns the buckets of API tokens and users, configured with api.ratelimit_bucket_size
+// and api.ratelimit_drop_interval_seconds
+func getApiRatelimits() *ratelimits.RedisBucketContainer {
+	apiRatelimitsOnce.Do(func() {
+		apiRatelimits = &ratelimits.RedisBucketContainer{
+			Prefix:       "robyul2-discord:api:ratelimit:",
+			Size:         apiRatelimitDefaultBucketSize,
+			DropInterval: apiRatelimitDefaultDropInterval,
+		}
+		if size, ok := helpers.GetConfig().Path("api.ratelimit_bucket_size").Data().(float64); ok && size >= 1 {
+			apiRatelimits.Size = int(size)
+		}
+		if interval, ok := helpers.GetConfig().Path("api.ratelimit_drop_interval_seconds").Data().(float64); ok && interval > 0 {
+			apiRatelimits.DropInterval = time.Duration(interval * float64(time.Second))
+		}
+	})
+	return apiRatelimits
+}
+
+// rateLimit drains the bucket of the API token or user of an authenticated request, returns false and answers
+// with 429 if the bucket is empty. Webkey requests are not limited, the website sends the requests of all its
+// users with the webkey.
+func rateLimit(request *restful.Request, response *restful.Response) bool {
+	var key string
+	if tokenID, ok := request.Attribute("ApiTokenID").(string); ok && tokenID != "" {
+		key = "token:" + tokenID
+	} else if userID, ok := request.Attribute("UserID").(string); ok && userID != "global" {
+		key = "user:" + userID
+	} else {
+		return true
+	}
+
+	buckets := getApiRatelimits()
+	left, wait, err := buckets.Drain(1, key)
+	if err != nil && err != ratelimits.ErrNoKeysLeft {
+		// do not block the API if redis is unavailable
+		helpers.RelaxLog(err)
+		return true
+	}
+
+	response.AddHeader("X-RateLimit-Limit", strconv.Itoa(buckets.Size))
+	response.AddHeader("X-RateLimit-Remaining", strconv.Itoa(left))
+	if err == ratelimits.ErrNoKeysLeft {
+		response.AddHeader("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		response.WriteErrorString(http.StatusTooManyRequests, "429: Too Many Requests")
+		return false
+	}
+	return true
+}
@@ -14,12 +14,16 @@ import (
 
 	"encoding/base64"
 
+	"io/ioutil"
+
 	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/Seklfreak/Robyul2/generator"
+	"github.com/Seklfreak/Robyul2/health"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/models"
 	"github.com/Seklfreak/Robyul2/modules/plugins"
 	"github.com/Seklfreak/Robyul2/modules/plugins/levels"
+	"github.com/Seklfreak/Robyul2/modules/plugins/youtube"
 	"github.com/bradfitz/slice"
 	"github.com/bwmarrin/discordgo"
 	restful "github.com/emicklei/go-restful"
@@ -76,9 +80,9 @@ func NewRestServices() []*restful.WebService {
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 
-	service.Route(service.GET("/{guild-id}").Filter(webkeyAuthenticate).To(GetRankings))
-	service.Route(service.GET("/user/{user-id}/{guild-id}").Filter(webkeyAuthenticate).To(GetUserRanking))
-	service.Route(service.GET("/user/{user-id}/all").Filter(webkeyAuthenticate).To(GetAllUserRanking))
+	service.Route(service.GET("/{guild-id}").Filter(webkeyAuthenticate).Filter(cacheResponse).To(GetRankings))
+	service.Route(service.GET("/user/{user-id}/{guild-id}").Filter(webkeyAuthenticate).Filter(cacheResponse).To(GetUserRanking))
+	service.Route(service.GET("/user/{user-id}/all").Filter(webkeyAuthenticate).Filter(cacheResponse).To(GetAllUserRanking))
 	services = append(services, service)
 
 	service = new(restful.WebService)
@@ -88,7 +92,7 @@ func NewRestServices() []*restful.WebService {
 		Produces(restful.MIME_JSON)
 
 	service.Route(service.GET("/{guild-id}").Filter(sessionAndWebkeyAuthenticate).To(FindGuild))
-	service.Route(service.POST("/{guild-id}/set-settings").Filter(sessionAndWebkeyAuthenticate).To(SetGuildSettings).Reads(&models.Rest_Receive_SetSettings{}))
+	service.Route(service.POST("/{guild-id}/set-settings").Filter(scopedAuthenticate(models.ApiTokenScopeSettingsWrite)).To(SetGuildSettings).Reads(&models.Rest_Receive_SetSettings{}))
 	services = append(services, service)
 
 	service = new(restful.WebService)
@@ -106,13 +110,13 @@ func NewRestServices() []*restful.WebService {
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 
-	service.Route(service.GET("/{guild-id}/messages/{interval}/count").Filter(sessionAndWebkeyAuthenticate).To(GetMessageStatisticsCount))
-	service.Route(service.GET("/{guild-id}/joins/{interval}/count").Filter(sessionAndWebkeyAuthenticate).To(GetJoinsStatisticsCount))
-	service.Route(service.GET("/{guild-id}/leaves/{interval}/count").Filter(sessionAndWebkeyAuthenticate).To(GetLeavesStatisticsCount))
-	service.Route(service.GET("/{guild-id}/by-uniques/{interval}/count").Filter(sessionAndWebkeyAuthenticate).To(GetMessageByUniqueUsersStatisticsCount))
-	service.Route(service.GET("/{guild-id}/serveractivity/{interval}/histogram/{count}").Filter(sessionAndWebkeyAuthenticate).To(GetServerActivityStatisticsHistogram))
-	service.Route(service.GET("/{guild-id}/vanityinvite/{interval}/histogram/{count}").Filter(sessionAndWebkeyAuthenticate).To(GetVanityInviteStatistics))
-	service.Route(service.GET("/bot").Filter(webkeyAuthenticate).To(GotBotStatistics))
+	service.Route(service.GET("/{guild-id}/messages/{interval}/count").Filter(scopedAuthenticate(models.ApiTokenScopeStatisticsRead)).Filter(cacheResponse).To(GetMessageStatisticsCount))
+	service.Route(service.GET("/{guild-id}/joins/{interval}/count").Filter(scopedAuthenticate(models.ApiTokenScopeStatisticsRead)).Filter(cacheResponse).To(GetJoinsStatisticsCount))
+	service.Route(service.GET("/{guild-id}/leaves/{interval}/count").Filter(scopedAuthenticate(models.ApiTokenScopeStatisticsRead)).Filter(cacheResponse).To(GetLeavesStatisticsCount))
+	service.Route(service.GET("/{guild-id}/by-uniques/{interval}/count").Filter(scopedAuthenticate(models.ApiTokenScopeStatisticsRead)).Filter(cacheResponse).To(GetMessageByUniqueUsersStatisticsCount))
+	service.Route(service.GET("/{guild-id}/serveractivity/{interval}/histogram/{count}").Filter(scopedAuthenticate(models.ApiTokenScopeStatisticsRead)).Filter(cacheResponse).To(GetServerActivityStatisticsHistogram))
+	service.Route(service.GET("/{guild-id}/vanityinvite/{interval}/histogram/{count}").Filter(scopedAuthenticate(models.ApiTokenScopeStatisticsRead)).Filter(cacheResponse).To(GetVanityInviteStatistics))
+	service.Route(service.GET("/bot").Filter(webkeyAuthenticate).Filter(cacheResponse).To(GotBotStatistics))
 	services = append(services, service)
 
 	service = new(restful.WebService)
@@ -130,7 +134,7 @@ func NewRestServices() []*restful.WebService {
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 
-	service.Route(service.GET("/{guild-id}").Filter(sessionAndWebkeyAuthenticate).To(GetEventlog))
+	service.Route(service.GET("/{guild-id}").Filter(scopedAuthenticate(models.ApiTokenScopeEventlogRead)).To(GetEventlog))
 	services = append(services, service)
 
 	service = new(restful.WebService)
@@ -160,81 +164,126 @@ func NewRestServices() []*restful.WebService {
 	services = append(services, service)
 
 	service = new(restful.WebService)
-	service.Route(service.GET("/ping").Filter(webkeyAuthenticate).To(Ping))
+	service.
+		Path("/live").
+		Produces("text/event-stream")
+
+	service.Route(service.GET("/{guild-id}/events").Filter(scopedAuthenticate(models.ApiTokenScopeEventlogRead)).To(GetLiveEvents).
+		Doc("Stream eventlog entries, moderation actions, joins, leaves and the message rate of a guild as Server-Sent Events").
+		Param(service.PathParameter("guild-id", "ID of the guild")).
+		Param(service.QueryParameter("types", "comma separated event types to stream: eventlog, moderation, join, leave or message-rate, all types if empty")).
+		Writes(models.LiveEvent{}))
 	services = append(services, service)
 
-	return services
-}
-
-func webkeyAuthenticate(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
-	authorizationHeader := strings.TrimSpace(request.HeaderParameter("Authorization"))
-
-	isAuthenticated := false
-
-	if strings.HasPrefix(authorizationHeader, "Webkey ") {
-		webkey := strings.TrimSpace(strings.Replace(authorizationHeader, "Webkey ", "", -1))
-		if webkey == helpers.GetConfig().Path("website.webkey").Data().(string) {
-			isAuthenticated = true
-			request.SetAttribute("UserID", "global")
-		}
-	}
-
-	queryWebkey := strings.TrimSpace(request.QueryParameter("webkey"))
-	if queryWebkey == helpers.GetConfig().Path("website.webkey").Data().(string) {
-		isAuthenticated = true
-		request.SetAttribute("UserID", "global")
-	}
-
-	if isAuthenticated == false {
-		response.WriteErrorString(401, "401: Not Authorized")
-		return
-	}
-
-	chain.ProcessFilter(request, response)
-	return
-}
-
-func sessionAndWebkeyAuthenticate(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
-	authorizationHeader := strings.TrimSpace(request.HeaderParameter("Authorization"))
+	service = new(restful.WebService)
+	service.
+		Path("/config").
+		Doc("Guild configuration, changes are logged in the eventlog like changes made with the commands").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
 
-	isAuthenticated := false
+	settingsAuthenticate := scopedAuthenticate(models.ApiTokenScopeSettingsWrite)
+	guildIDParameter := service.PathParameter("guild-id", "ID of the guild")
+	service.Route(service.GET("/{guild-id}/customcommands").Filter(settingsAuthenticate).To(GetConfigCustomCommands).
+		Doc("List the custom commands").Param(guildIDParameter).
+		Writes([]models.Rest_Config_CustomCommand{}))
+	service.Route(service.POST("/{guild-id}/customcommands").Filter(settingsAuthenticate).To(AddConfigCustomCommand).
+		Doc("Add a custom command").Param(guildIDParameter).
+		Reads(models.Rest_Receive_CustomCommand{}).Writes(models.Rest_Config_CustomCommand{}).
+		Returns(http.StatusConflict, "a custom command with this keyword exists already", nil))
+	service.Route(service.DELETE("/{guild-id}/customcommands/{command-id}").Filter(settingsAuthenticate).To(DeleteConfigCustomCommand).
+		Doc("Delete a custom command").Param(guildIDParameter).
+		Param(service.PathParameter("command-id", "ID of the custom command")))
+	service.Route(service.GET("/{guild-id}/feeds").Filter(settingsAuthenticate).To(GetConfigFeeds).
+		Doc("List the feeds of all integrations").Param(guildIDParameter).
+		Writes([]models.Rest_Config_Feed{}))
+	service.Route(service.POST("/{guild-id}/feeds/{source}").Filter(settingsAuthenticate).To(AddConfigFeed).
+		Doc("Add a feed").
+		Notes("Feeds of twitter, instagram, youtube, reddit and vlive can be added, the other sources only with the commands.").Param(guildIDParameter).
+		Param(service.PathParameter("source", "source of the feed, for example twitter")).
+		Reads(models.Rest_Receive_Feed{}).Writes(models.Rest_Config_Feed{}).
+		Returns(http.StatusNotFound, "the target was not found", nil).
+		Returns(http.StatusConflict, "the target is posted to the channel already", nil))
+	service.Route(service.DELETE("/{guild-id}/feeds/{source}/{feed-id}").Filter(settingsAuthenticate).To(DeleteConfigFeed).
+		Doc("Remove a feed").Param(guildIDParameter).
+		Param(service.PathParameter("source", "source of the feed, for example twitter or rss")).
+		Param(service.PathParameter("feed-id", "ID of the feed")))
+	service.Route(service.GET("/{guild-id}/starboard").Filter(settingsAuthenticate).To(GetConfigStarboard).
+		Doc("Get the starboard settings").Param(guildIDParameter).
+		Writes(models.Rest_Config_Starboard{}))
+	service.Route(service.PUT("/{guild-id}/starboard").Filter(settingsAuthenticate).To(SetConfigStarboard).
+		Doc("Set the starboard settings").
+		Notes("An empty channel disables the starboard, an empty emoji list resets the emoji to the default emoji.").Param(guildIDParameter).
+		Reads(models.Rest_Config_Starboard{}).Writes(models.Rest_Config_Starboard{}))
+	service.Route(service.GET("/{guild-id}/levels/roles").Filter(settingsAuthenticate).To(GetConfigLevelsRoles).
+		Doc("List the levels roles").Param(guildIDParameter).
+		Writes([]models.Rest_Config_LevelsRole{}))
+	service.Route(service.POST("/{guild-id}/levels/roles").Filter(settingsAuthenticate).To(AddConfigLevelsRole).
+		Doc("Add a levels role").Param(guildIDParameter).
+		Reads(models.Rest_Receive_LevelsRole{}).Writes(models.Rest_Config_LevelsRole{}))
+	service.Route(service.DELETE("/{guild-id}/levels/roles/{entry-id}").Filter(settingsAuthenticate).To(DeleteConfigLevelsRole).
+		Doc("Delete a levels role").Param(guildIDParameter).
+		Param(service.PathParameter("entry-id", "ID of the levels role")))
+	service.Route(service.GET("/{guild-id}/autoroles").Filter(settingsAuthenticate).To(GetConfigAutoRoles).
+		Doc("List the autoroles").Param(guildIDParameter).
+		Writes([]models.Rest_Config_AutoRole{}))
+	service.Route(service.POST("/{guild-id}/autoroles").Filter(settingsAuthenticate).To(AddConfigAutoRole).
+		Doc("Add an autorole").Param(guildIDParameter).
+		Reads(models.Rest_Receive_AutoRole{}).Writes(models.Rest_Config_AutoRole{}).
+		Returns(http.StatusConflict, "the role is an autorole already", nil))
+	service.Route(service.DELETE("/{guild-id}/autoroles/{role-id}").Filter(settingsAuthenticate).To(DeleteConfigAutoRole).
+		Doc("Remove an autorole").Param(guildIDParameter).
+		Param(service.PathParameter("role-id", "ID of the role")))
+	service.Route(service.GET("/{guild-id}/modulepermissions").Filter(settingsAuthenticate).To(GetConfigModulePermissions).
+		Doc("List the module permissions of all channels and roles").Param(guildIDParameter).
+		Writes([]models.Rest_Config_ModulePermission{}))
+	service.Route(service.PUT("/{guild-id}/modulepermissions/{target-type}/{target-id}").Filter(settingsAuthenticate).To(SetConfigModulePermission).
+		Doc("Set the allowed and denied modules of a channel or role").Param(guildIDParameter).
+		Param(service.PathParameter("target-type", "channel or role")).
+		Param(service.PathParameter("target-id", "ID of the channel or role")).
+		Reads(models.Rest_Receive_ModulePermission{}).Writes(models.Rest_Config_ModulePermission{}))
+	service.Route(service.GET("/{guild-id}/eventlog").Filter(settingsAuthenticate).To(GetConfigEventlog).
+		Doc("Get the eventlog settings").Param(guildIDParameter).
+		Writes(models.Rest_Config_Eventlog{}))
+	service.Route(service.PUT("/{guild-id}/eventlog").Filter(settingsAuthenticate).To(SetConfigEventlog).
+		Doc("Set the eventlog settings").
+		Notes("Enabling or disabling the eventlog requires the admin permissions.").Param(guildIDParameter).
+		Reads(models.Rest_Config_Eventlog{}).Writes(models.Rest_Config_Eventlog{}))
+	services = append(services, service)
 
-	if strings.HasPrefix(authorizationHeader, "Webkey ") {
-		webkey := strings.TrimSpace(strings.Replace(authorizationHeader, "Webkey ", "", -1))
-		if webkey == helpers.GetConfig().Path("website.webkey").Data().(string) {
-			isAuthenticated = true
-			request.SetAttribute("UserID", "global")
-		}
-	}
+	// called by the WebSub hub, requests are verified with the secret of the subscription instead of a webkey
+	service = new(restful.WebService)
+	service.
+		Path("/youtube/websub").
+		Consumes("application/atom+xml", "application/xml", "text/xml").
+		Produces("text/plain")
+	service.Route(service.GET("").To(VerifyYouTubeWebSub))
+	service.Route(service.POST("").To(ReceiveYouTubeWebSub))
+	services = append(services, service)
 
-	queryWebkey := strings.TrimSpace(request.QueryParameter("webkey"))
-	if queryWebkey == helpers.GetConfig().Path("website.webkey").Data().(string) {
-		isAuthenticated = true
-		request.SetAttribute("UserID", "global")
-	}
+	service = new(restful.WebService)
+	service.Route(service.GET("/ping").Filter(webkeyAuthenticate).To(Ping))
+	// probes of container orchestration, not authenticated
+	service.Route(service.GET("/healthz").To(GetHealth).Produces(restful.MIME_JSON).
+		Doc("Check if the bot is alive").
+		Notes("Answers with 503 if the bot is stuck and should be restarted.").
+		Writes(health.Report{}))
+	service.Route(service.GET("/readyz").To(GetReadiness).Produces(restful.MIME_JSON).
+		Doc("Check if the bot is ready").
+		Notes("Answers with 503 while the bot is starting, shutting down, or a dependency is unavailable.").
+		Writes(health.Report{}))
+	services = append(services, service)
 
-	if strings.HasPrefix(authorizationHeader, "PHP-Session ") {
-		sessionID := strings.TrimSpace(strings.Replace(authorizationHeader, "PHP-Session ", "", -1))
-		key := "robyul2-web:robyul-session:" + sessionID
-		redis := cache.GetRedisClient()
-		sessionDataString, err := redis.Get(key).Result()
-		if err == nil {
-			var sessionData models.Website_Session_Data
-			msgpack.Unmarshal([]byte(sessionDataString), &sessionData)
-			if sessionData.DiscordUserID != "" {
-				isAuthenticated = true
-				request.SetAttribute("UserID", sessionData.DiscordUserID)
-			}
-		}
-	}
+	service = new(restful.WebService)
+	service.
+		Path("/openapi.json").
+		Produces(restful.MIME_JSON)
+	service.Route(service.GET("").To(GetOpenAPI).Doc("Get the OpenAPI description of the REST API"))
+	services = append(services, service)
 
-	if isAuthenticated == false {
-		response.WriteErrorString(401, "401: Not Authorized")
-		return
-	}
+	openAPIServices = services
 
-	chain.ProcessFilter(request, response)
-	return
+	return services
 }
 
 func GetAllBotGuilds(request *restful.Request, response *restful.Response) {
@@ -1829,3 +1878,32 @@ func Ping(_ *restful.Request, response *restful.Response) {
 	response.Write([]byte("pong"))
 	return
 }
+
+func VerifyYouTubeWebSub(request *restful.Request, response *restful.Response) {
+	leaseSeconds, _ := strconv.Atoi(request.QueryParameter("hub.lease_seconds"))
+
+	if !youtube.HandleWebSubVerification(
+		request.QueryParameter("hub.mode"),
+		request.QueryParameter("hub.topic"),
+		leaseSeconds,
+	) {
+		response.WriteErrorString(http.StatusNotFound, "404: Not Found")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	response.Write([]byte(request.QueryParameter("hub.challenge")))
+}
+
+func ReceiveYouTubeWebSub(request *restful.Request, response *restful.Response) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(response.ResponseWriter, request.Request.Body, 1<<20))
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	// the hub retries notifications which are not acknowledged, so invalid ones are acknowledged too
+	go youtube.HandleWebSubNotification(request.HeaderParameter("X-Hub-Signature"), body)
+
+	response.WriteHeader(http.StatusNoContent)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Seklfreak/Robyul2/cache"
 	"github.com/Seklfreak/Robyul2/helpers"
 	"github.com/Seklfreak/Robyul2/models"
+	"github.com/globalsign/mgo/bson"
 	redisCache "github.com/go-redis/cache"
 )
 
@@ -94,25 +95,14 @@ func (q *quota) Sub(i int64) int64 {
 	return q.data.Left
 }
 
-func (q *quota) IncEntryCount() {
+// UpdateCheckingInterval reads the count of checked channels again and recalculates the checking time interval.
+func (q *quota) UpdateCheckingInterval() (err error) {
 	q.Lock()
 	defer q.Unlock()
 
-	q.entriesCount++
-}
-
-func (q *quota) DecEntryCount() {
-	q.Lock()
-	defer q.Unlock()
-
-	if q.entriesCount > 0 {
-		q.entriesCount--
+	if q.entriesCount, err = q.readEntryCount(); err != nil {
+		return err
 	}
-}
-
-func (q *quota) UpdateCheckingInterval() error {
-	q.Lock()
-	defer q.Unlock()
 
 	q.checkInterval = q.calcCheckingTimeInterval()
 	return q.set()
@@ -125,15 +115,16 @@ func (q *quota) DailyLimitExceeded() {
 	q.data.Left = 0
 }
 
-// readEntryCount reads how many entries in database
+// readEntryCount reads how many channels are checked, the feeds of a channel are checked together,
 // and this will use in check time calculation.
 func (q *quota) readEntryCount() (int64, error) {
-	c, err := helpers.MdbCollection(models.YoutubeChannelTable).Find(nil).Count()
+	var channelIDs []string
+	err := helpers.MdbCollection(models.FeedsTable).Find(bson.M{"source": "youtube"}).Distinct("target", &channelIDs)
 	if err != nil {
 		return 0, err
 	}
 
-	return int64(c), nil
+	return int64(len(channelIDs)), nil
 }
 
 // get previous quota information from redis.
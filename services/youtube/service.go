@@ -144,14 +144,6 @@ func (s *Service) GetChannelSingle(channelId string) (*youtubeAPI.Channel, error
 	return response.Items[0], nil
 }
 
-func (s *Service) IncQuotaEntryCount() {
-	s.quota.IncEntryCount()
-}
-
-func (s *Service) DecQuotaEntryCount() {
-	s.quota.DecEntryCount()
-}
-
 func (s *Service) UpdateCheckingInterval() error {
 	return s.quota.UpdateCheckingInterval()
 }
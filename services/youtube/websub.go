@@ -0,0 +1,177 @@
+package youtube
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/helpers"
+)
+
+const (
+	webSubHubURL   = "https://pubsubhubbub.appspot.com/subscribe"
+	webSubTopicURL = "https://www.youtube.com/xml/feeds/videos.xml?channel_id="
+	// the hub caps leases at about ten days, subscriptions are renewed before they expire
+	webSubLeaseSeconds = 10 * 24 * 60 * 60
+)
+
+var (
+	ErrWebSubDisabled = errors.New("websub callback url or secret is not configured")
+)
+
+// WebSub subscribes to YouTube channel feeds on the PubSubHubbub hub of Google
+// the hub posts new and updated videos to the callback URL, which is served by the REST API
+type WebSub struct {
+	CallbackURL string
+	Secret      string
+	Client      *http.Client
+}
+
+// WebSubVideo is a video of a push notification
+type WebSubVideo struct {
+	VideoID   string
+	ChannelID string
+	Title     string
+	Author    string
+	Published time.Time
+	Updated   time.Time
+}
+
+type webSubFeed struct {
+	Entries []struct {
+		VideoID   string `xml:"http://www.youtube.com/xml/schemas/2015 videoId"`
+		ChannelID string `xml:"http://www.youtube.com/xml/schemas/2015 channelId"`
+		Title     string `xml:"title"`
+		Author    struct {
+			Name string `xml:"name"`
+		} `xml:"author"`
+		Published string `xml:"published"`
+		Updated   string `xml:"updated"`
+	} `xml:"entry"`
+}
+
+// NewWebSub returns a WebSub client configured by youtube.websub_callback_url and youtube.websub_secret
+// without a callback URL or a secret the client is disabled and feeds are only polled
+func NewWebSub() *WebSub {
+	callbackURL, _ := helpers.GetConfig().Path("youtube.websub_callback_url").Data().(string)
+	secret, _ := helpers.GetConfig().Path("youtube.websub_secret").Data().(string)
+
+	return &WebSub{
+		CallbackURL: callbackURL,
+		Secret:      secret,
+		Client:      &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// Enabled returns true if a callback URL and a secret are configured
+// the secret is required, without it anyone could post notifications to the callback
+func (w *WebSub) Enabled() bool {
+	return w != nil && w.CallbackURL != "" && w.Secret != ""
+}
+
+// Subscribe asks the hub to push videos of the channel, the hub confirms the subscription asynchronously with a request to the callback
+func (w *WebSub) Subscribe(channelID string) error {
+	return w.request("subscribe", channelID)
+}
+
+// Unsubscribe asks the hub to stop pushing videos of the channel
+func (w *WebSub) Unsubscribe(channelID string) error {
+	return w.request("unsubscribe", channelID)
+}
+
+// VerifySignature checks the X-Hub-Signature header of a notification, sha1=<hex HMAC of the body>
+// without a secret every notification is rejected
+func (w *WebSub) VerifySignature(signature string, body []byte) bool {
+	if w.Secret == "" {
+		return false
+	}
+
+	parts := strings.SplitN(signature, "=", 2)
+	if len(parts) != 2 || parts[0] != "sha1" {
+		return false
+	}
+	expected, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha1.New, []byte(w.Secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func (w *WebSub) request(mode, channelID string) error {
+	if !w.Enabled() {
+		return ErrWebSubDisabled
+	}
+
+	values := url.Values{}
+	values.Set("hub.callback", w.CallbackURL)
+	values.Set("hub.topic", WebSubTopic(channelID))
+	values.Set("hub.mode", mode)
+	values.Set("hub.verify", "async")
+	values.Set("hub.lease_seconds", strconv.Itoa(webSubLeaseSeconds))
+	values.Set("hub.secret", w.Secret)
+
+	response, err := w.Client.PostForm(webSubHubURL, values)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	// 202 Accepted, the hub verifies the request with the callback
+	if response.StatusCode != http.StatusAccepted && response.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("websub %s of %s failed: status %d", mode, channelID, response.StatusCode)
+	}
+	return nil
+}
+
+// WebSubTopic returns the topic URL of the feed of a channel
+func WebSubTopic(channelID string) string {
+	return webSubTopicURL + channelID
+}
+
+// WebSubTopicChannelID returns the channel ID of a topic URL
+func WebSubTopicChannelID(topic string) (channelID string, ok bool) {
+	topicURL, err := url.Parse(topic)
+	if err != nil || topicURL.Host != "www.youtube.com" || topicURL.Path != "/xml/feeds/videos.xml" {
+		return "", false
+	}
+	channelID = topicURL.Query().Get("channel_id")
+	return channelID, channelID != ""
+}
+
+// ParseWebSubNotification returns the videos of a push notification
+// notifications about deleted videos contain no entries
+func ParseWebSubNotification(body []byte) (videos []WebSubVideo, err error) {
+	var feed webSubFeed
+	err = xml.Unmarshal(body, &feed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range feed.Entries {
+		if entry.VideoID == "" || entry.ChannelID == "" {
+			continue
+		}
+
+		video := WebSubVideo{
+			VideoID:   entry.VideoID,
+			ChannelID: entry.ChannelID,
+			Title:     strings.TrimSpace(entry.Title),
+			Author:    strings.TrimSpace(entry.Author.Name),
+		}
+		video.Published, _ = time.Parse(time.RFC3339, strings.TrimSpace(entry.Published))
+		video.Updated, _ = time.Parse(time.RFC3339, strings.TrimSpace(entry.Updated))
+		videos = append(videos, video)
+	}
+	return videos, nil
+}
@@ -0,0 +1,82 @@
+package youtube
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"testing"
+)
+
+const testWebSubNotification = `<feed xmlns:yt="http://www.youtube.com/xml/schemas/2015" xmlns="http://www.w3.org/2005/Atom">
+  <link rel="hub" href="https://pubsubhubbub.appspot.com"/>
+  <title>YouTube video feed</title>
+  <entry>
+    <id>yt:video:VIDEO_ID</id>
+    <yt:videoId>VIDEO_ID</yt:videoId>
+    <yt:channelId>CHANNEL_ID</yt:channelId>
+    <title>Video title</title>
+    <link rel="alternate" href="http://www.youtube.com/watch?v=VIDEO_ID"/>
+    <author>
+      <name>Channel title</name>
+      <uri>http://www.youtube.com/channel/CHANNEL_ID</uri>
+    </author>
+    <published>2018-05-01T12:00:00+00:00</published>
+    <updated>2018-05-01T12:05:00.123456789+00:00</updated>
+  </entry>
+</feed>`
+
+func TestParseWebSubNotification(t *testing.T) {
+	videos, err := ParseWebSubNotification([]byte(testWebSubNotification))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(videos) != 1 {
+		t.Fatalf("expected 1 video, got %d", len(videos))
+	}
+
+	video := videos[0]
+	if video.VideoID != "VIDEO_ID" || video.ChannelID != "CHANNEL_ID" {
+		t.Errorf("unexpected ids %q %q", video.VideoID, video.ChannelID)
+	}
+	if video.Title != "Video title" || video.Author != "Channel title" {
+		t.Errorf("unexpected title %q or author %q", video.Title, video.Author)
+	}
+	if video.Published.IsZero() || video.Updated.IsZero() {
+		t.Errorf("expected published and updated times, got %v %v", video.Published, video.Updated)
+	}
+}
+
+func TestWebSubVerifySignature(t *testing.T) {
+	body := []byte(testWebSubNotification)
+	mac := hmac.New(sha1.New, []byte("secret"))
+	mac.Write(body)
+	signature := "sha1=" + hex.EncodeToString(mac.Sum(nil))
+
+	webSub := &WebSub{Secret: "secret"}
+	if !webSub.VerifySignature(signature, body) {
+		t.Error("expected valid signature")
+	}
+	if webSub.VerifySignature(signature, append(body, ' ')) {
+		t.Error("expected invalid signature for changed body")
+	}
+	if webSub.VerifySignature("", body) {
+		t.Error("expected invalid signature without header")
+	}
+	if (&WebSub{}).VerifySignature("", body) {
+		t.Error("expected every signature to be invalid without secret")
+	}
+	if (&WebSub{CallbackURL: "https://example.com/youtube/websub"}).Enabled() {
+		t.Error("expected websub to be disabled without secret")
+	}
+}
+
+func TestWebSubTopicChannelID(t *testing.T) {
+	channelID, ok := WebSubTopicChannelID(WebSubTopic("CHANNEL_ID"))
+	if !ok || channelID != "CHANNEL_ID" {
+		t.Errorf("expected CHANNEL_ID, got %q", channelID)
+	}
+
+	if _, ok = WebSubTopicChannelID("https://example.com/xml/feeds/videos.xml?channel_id=CHANNEL_ID"); ok {
+		t.Error("expected foreign topic to be rejected")
+	}
+}
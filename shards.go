@@ -0,0 +1,167 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Seklfreak/Robyul2/cache"
+	"github.com/Seklfreak/Robyul2/helpers"
+	"github.com/Seklfreak/Robyul2/metrics/prom"
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// Discord allows one identify every five seconds
+	shardIdentifyInterval = 5 * time.Second
+)
+
+// NewShardSessions creates one session per shard, the shard count is read from discord.shards
+// or requested from the gateway if it is not set. All sessions share the state of the first session.
+func NewShardSessions(token string) (sessions []*discordgo.Session, err error) {
+	primary, err := newShardSession(token)
+	if err != nil {
+		return nil, err
+	}
+
+	shardCount, err := getShardCount(primary)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions = append(sessions, primary)
+	for shardID := 1; shardID < shardCount; shardID++ {
+		shardSession, err := newShardSession(token)
+		if err != nil {
+			return nil, err
+		}
+		shardSession.State = primary.State
+		sessions = append(sessions, shardSession)
+	}
+
+	for shardID, shardSession := range sessions {
+		shardSession.Lock()
+		shardSession.ShardID = shardID
+		shardSession.ShardCount = shardCount
+		shardSession.Unlock()
+	}
+
+	return sessions, nil
+}
+
+func newShardSession(token string) (session *discordgo.Session, err error) {
+	session, err = discordgo.New(token)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Lock()
+	session.Debug = false
+	//session.LogLevel = discordgo.LogInformational
+	session.LogLevel = discordgo.LogError
+	session.StateEnabled = true
+	session.MaxRestRetries = 5
+	session.State.MaxMessageCount = 10
+	session.Client.Transport = prom.DiscordTransport(http.DefaultTransport)
+	session.Unlock()
+
+	return session, nil
+}
+
+func getShardCount(session *discordgo.Session) (shardCount int, err error) {
+	if configured, ok := helpers.GetConfig().Path("discord.shards").Data().(float64); ok && configured >= 1 {
+		return int(configured), nil
+	}
+
+	gateway, err := session.GatewayBot()
+	if err != nil {
+		return 0, err
+	}
+	if gateway.Shards < 1 {
+		return 1, nil
+	}
+	return gateway.Shards, nil
+}
+
+// OpenShardSessions connects all shards to the gateway, waiting between the identifies of the shards
+func OpenShardSessions(sessions []*discordgo.Session) (err error) {
+	for i, shardSession := range sessions {
+		if i > 0 {
+			time.Sleep(shardIdentifyInterval)
+		}
+
+		cache.GetLogger().WithField("module", "shards").Infof("connecting shard %d/%d", shardSession.ShardID+1, shardSession.ShardCount)
+		err = shardSession.Open()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onShardReady tracks the guilds of the shard and restores the guilds of the other shards in the shared state,
+// the ready event of a shard replaces the guilds of the state with its own guilds
+func onShardReady(session *discordgo.Session, event *discordgo.Ready) {
+	guildIDs := make([]string, 0, len(event.Guilds))
+	for _, guild := range event.Guilds {
+		guildIDs = append(guildIDs, guild.ID)
+	}
+	cache.SetShardGuildIDs(session.ShardID, guildIDs)
+
+	otherGuilds := make([]*discordgo.Guild, 0)
+	for _, guildID := range cache.GetShardGuildIDs(session.ShardID) {
+		guild, err := session.State.Guild(guildID)
+		if err == nil {
+			otherGuilds = append(otherGuilds, guild)
+		}
+	}
+
+	session.State.Lock()
+	stateGuildIDs := make(map[string]bool, len(session.State.Guilds))
+	for _, guild := range session.State.Guilds {
+		stateGuildIDs[guild.ID] = true
+	}
+	for _, guild := range otherGuilds {
+		if !stateGuildIDs[guild.ID] {
+			session.State.Guilds = append(session.State.Guilds, guild)
+		}
+	}
+	session.State.Unlock()
+
+	cache.SetShardReady(session.ShardID, true)
+}
+
+// onShardGuildCreate tracks new guilds of the shard, they might be missing from the guild list of the state
+// if the ready event of another shard replaced it at the same time
+func onShardGuildCreate(session *discordgo.Session, guild *discordgo.GuildCreate) {
+	if cache.AddShardGuildID(session.ShardID, guild.ID) {
+		ensureStateGuild(session.State, guild.ID)
+	}
+}
+
+// ensureStateGuild adds a guild of the state's guild map back to the guild list of the state
+func ensureStateGuild(state *discordgo.State, guildID string) {
+	guild, err := state.Guild(guildID)
+	if err != nil {
+		return
+	}
+
+	state.Lock()
+	defer state.Unlock()
+
+	for _, stateGuild := range state.Guilds {
+		if stateGuild.ID == guildID {
+			return
+		}
+	}
+	state.Guilds = append(state.Guilds, guild)
+}
+
+func BotOnShardResumed(session *discordgo.Session, event *discordgo.Resumed) {
+	cache.SetShardReady(session.ShardID, true)
+	cache.GetLogger().WithField("module", "shards").Infof("shard %d resumed", session.ShardID)
+}
+
+func BotOnShardDisconnect(session *discordgo.Session, event *discordgo.Disconnect) {
+	cache.SetShardReady(session.ShardID, false)
+	cache.GetLogger().WithField("module", "shards").Warnf("shard %d disconnected", session.ShardID)
+}